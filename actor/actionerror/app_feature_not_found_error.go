@@ -0,0 +1,13 @@
+package actionerror
+
+import "fmt"
+
+// AppFeatureNotFoundError is returned when a requested app feature is not
+// supported by the Cloud Controller.
+type AppFeatureNotFoundError struct {
+	FeatureName string
+}
+
+func (e AppFeatureNotFoundError) Error() string {
+	return fmt.Sprintf("App feature '%s' not found.", e.FeatureName)
+}
@@ -0,0 +1,14 @@
+package actionerror
+
+import "fmt"
+
+// AppNotBoundToServiceInstanceError is returned when an app is expected to be
+// bound to a service instance but is not.
+type AppNotBoundToServiceInstanceError struct {
+	AppName             string
+	ServiceInstanceName string
+}
+
+func (e AppNotBoundToServiceInstanceError) Error() string {
+	return fmt.Sprintf("App '%s' is not bound to service instance '%s'.", e.AppName, e.ServiceInstanceName)
+}
@@ -0,0 +1,14 @@
+package actionerror
+
+import "fmt"
+
+// InvalidServiceBrokerCatalogError is returned when a local service broker
+// catalog file cannot be read as an Open Service Broker API catalog.
+type InvalidServiceBrokerCatalogError struct {
+	Path   string
+	Reason string
+}
+
+func (e InvalidServiceBrokerCatalogError) Error() string {
+	return fmt.Sprintf("Invalid service broker catalog file '%s': %s", e.Path, e.Reason)
+}
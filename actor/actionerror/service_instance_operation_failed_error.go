@@ -0,0 +1,18 @@
+package actionerror
+
+import "fmt"
+
+// ServiceInstanceOperationFailedError is returned when the last operation of
+// a service instance ends in the failed state.
+type ServiceInstanceOperationFailedError struct {
+	Name        string
+	Operation   string
+	Description string
+}
+
+func (e ServiceInstanceOperationFailedError) Error() string {
+	if e.Description == "" {
+		return fmt.Sprintf("The %s operation on service instance '%s' failed.", e.Operation, e.Name)
+	}
+	return fmt.Sprintf("The %s operation on service instance '%s' failed: %s", e.Operation, e.Name, e.Description)
+}
@@ -0,0 +1,13 @@
+package actionerror
+
+import "fmt"
+
+// ServiceInstanceOperationTimeoutError is returned when the timeout is reached
+// waiting for the last operation of a service instance to complete.
+type ServiceInstanceOperationTimeoutError struct {
+	Name string
+}
+
+func (e ServiceInstanceOperationTimeoutError) Error() string {
+	return fmt.Sprintf("Timed out waiting for the operation on service instance '%s' to complete.", e.Name)
+}
@@ -0,0 +1,24 @@
+package actionerror
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ServiceParametersInvalidError is returned when the parameters given for a
+// service operation do not match the JSON schema published by the service
+// plan.
+type ServiceParametersInvalidError struct {
+	// Operation describes what the parameters were given for, e.g. "creating
+	// the service instance".
+	Operation string
+	Errors    []string
+}
+
+func (e ServiceParametersInvalidError) Error() string {
+	return fmt.Sprintf(
+		"Parameters for %s do not match the schema provided by the service plan:\n   %s",
+		e.Operation,
+		strings.Join(e.Errors, "\n   "),
+	)
+}
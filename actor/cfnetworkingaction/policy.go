@@ -12,6 +12,8 @@ import (
 
 type Policy struct {
 	SourceName           string
+	SourceSpaceName      string
+	SourceOrgName        string
 	DestinationName      string
 	Protocol             string
 	DestinationSpaceName string
@@ -53,6 +55,20 @@ func (actor Actor) AddNetworkPolicy(srcSpaceGUID, srcAppName, destSpaceGUID, des
 	return allWarnings, err
 }
 
+// NetworkPolicies returns the policies of every app the user can see.
+func (actor Actor) NetworkPolicies() ([]Policy, Warnings, error) {
+	return actor.networkPoliciesForQuery()
+}
+
+// NetworkPoliciesByOrg returns the policies whose source app is in the given
+// org. Their destination apps can be in other orgs.
+func (actor Actor) NetworkPoliciesByOrg(orgGUID string) ([]Policy, Warnings, error) {
+	return actor.networkPoliciesForQuery(ccv3.Query{
+		Key:    ccv3.OrganizationGUIDFilter,
+		Values: []string{orgGUID},
+	})
+}
+
 func (actor Actor) NetworkPoliciesBySpace(spaceGUID string) ([]Policy, Warnings, error) {
 	var allWarnings Warnings
 
@@ -92,6 +108,28 @@ func (actor Actor) NetworkPoliciesBySpaceAndAppName(spaceGUID string, srcAppName
 	return policies, allWarnings, nil
 }
 
+func (actor Actor) networkPoliciesForQuery(query ...ccv3.Query) ([]Policy, Warnings, error) {
+	var allWarnings Warnings
+
+	applications, warnings, err := actor.CloudControllerClient.GetApplications(query...)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return []Policy{}, allWarnings, err
+	}
+
+	if len(applications) == 0 {
+		return []Policy{}, allWarnings, nil
+	}
+
+	policies, warnings, err := actor.getPoliciesForApplications(applications)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return []Policy{}, allWarnings, err
+	}
+
+	return policies, allWarnings, nil
+}
+
 func (actor Actor) RemoveNetworkPolicy(srcSpaceGUID, srcAppName, destSpaceGUID, destAppName, protocol string, startPort, endPort int) (Warnings, error) {
 	var allWarnings Warnings
 
@@ -210,13 +248,22 @@ func (actor Actor) orgNamesBySpaceGUID(spaces []resources.Space) (map[string]str
 }
 
 func (actor Actor) getPoliciesForApplications(applications []resources.Application) ([]Policy, ccv3.Warnings, error) {
-	var allWarnings ccv3.Warnings
-
 	var srcAppGUIDs []string
 	for _, app := range applications {
 		srcAppGUIDs = append(srcAppGUIDs, app.GUID)
 	}
 
+	v1Policies, err := actor.listPoliciesFromSources(srcAppGUIDs)
+	if err != nil {
+		return []Policy{}, nil, err
+	}
+
+	return actor.describePolicies(v1Policies, applications)
+}
+
+// listPoliciesFromSources returns the policies whose source is one of the
+// given apps.
+func (actor Actor) listPoliciesFromSources(srcAppGUIDs []string) ([]cfnetv1.Policy, error) {
 	v1Policies := []cfnetv1.Policy{}
 
 	_, err := batcher.RequestByGUID(srcAppGUIDs, func(guids []string) (ccv3.Warnings, error) {
@@ -229,12 +276,18 @@ func (actor Actor) getPoliciesForApplications(applications []resources.Applicati
 	})
 
 	if err != nil {
-		return []Policy{}, allWarnings, err
+		return nil, err
 	}
 
 	// ListPolicies will return policies with the app guids in either the source or destination.
 	// It needs to be further filtered to only get policies with the app guids in the source.
-	v1Policies = filterPoliciesWithoutMatchingSourceGUIDs(v1Policies, srcAppGUIDs)
+	return filterPoliciesWithoutMatchingSourceGUIDs(v1Policies, srcAppGUIDs), nil
+}
+
+// describePolicies looks up the names of the apps, spaces and orgs of
+// v1Policies. applications must contain the source apps of the policies.
+func (actor Actor) describePolicies(v1Policies []cfnetv1.Policy, applications []resources.Application) ([]Policy, ccv3.Warnings, error) {
+	var allWarnings ccv3.Warnings
 
 	destAppGUIDs := uniqueDestGUIDs(v1Policies)
 
@@ -276,10 +329,13 @@ func (actor Actor) getPoliciesForApplications(applications []resources.Applicati
 
 	var policies []Policy
 	for _, v1Policy := range v1Policies {
+		source := appByGUID[v1Policy.Source.ID]
 		destination := appByGUID[v1Policy.Destination.ID]
 
 		policies = append(policies, Policy{
-			SourceName:           appByGUID[v1Policy.Source.ID].Name,
+			SourceName:           source.Name,
+			SourceSpaceName:      spaceNamesByGUID[source.SpaceGUID],
+			SourceOrgName:        orgNamesBySpaceGUID[source.SpaceGUID],
 			DestinationName:      destination.Name,
 			Protocol:             string(v1Policy.Destination.Protocol),
 			StartPort:            v1Policy.Destination.Ports.Start,
@@ -0,0 +1,253 @@
+package cfnetworkingaction
+
+import (
+	"code.cloudfoundry.org/cfnetworking-cli-api/cfnetworking/cfnetv1"
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/resources"
+)
+
+// PolicySpec describes a desired policy by the names of its apps and of the
+// spaces and orgs they are in.
+type PolicySpec struct {
+	SourceOrgName        string
+	SourceSpaceName      string
+	SourceName           string
+	DestinationOrgName   string
+	DestinationSpaceName string
+	DestinationName      string
+	Protocol             string
+	StartPort            int
+	EndPort              int
+}
+
+// PolicyChanges are the policies that have to be added and removed to bring
+// the existing policies in line with a list of PolicySpecs.
+type PolicyChanges struct {
+	Additions []Policy
+	Removals  []Policy
+
+	additions []cfnetv1.Policy
+	removals  []cfnetv1.Policy
+}
+
+// GetPolicyChanges compares specs with the existing policies of their source
+// apps. With prune, existing policies of every app in the source spaces of
+// specs that are not listed in specs are removed.
+func (actor Actor) GetPolicyChanges(specs []PolicySpec, prune bool) (PolicyChanges, Warnings, error) {
+	var allWarnings Warnings
+
+	resolver := newAppResolver(actor.CloudControllerClient)
+
+	var (
+		desired      []cfnetv1.Policy
+		sourceApps   []resources.Application
+		sourceSpaces []string
+	)
+	seenPolicies := map[cfnetv1.Policy]bool{}
+	seenSources := map[string]bool{}
+	seenSpaces := map[string]bool{}
+
+	for _, spec := range specs {
+		source, warnings, err := resolver.app(spec.SourceOrgName, spec.SourceSpaceName, spec.SourceName)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return PolicyChanges{}, allWarnings, err
+		}
+
+		destination, warnings, err := resolver.app(spec.DestinationOrgName, spec.DestinationSpaceName, spec.DestinationName)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return PolicyChanges{}, allWarnings, err
+		}
+
+		policy := cfnetv1.Policy{
+			Source: cfnetv1.PolicySource{ID: source.GUID},
+			Destination: cfnetv1.PolicyDestination{
+				ID:       destination.GUID,
+				Protocol: cfnetv1.PolicyProtocol(spec.Protocol),
+				Ports:    cfnetv1.Ports{Start: spec.StartPort, End: spec.EndPort},
+			},
+		}
+		if !seenPolicies[policy] {
+			seenPolicies[policy] = true
+			desired = append(desired, policy)
+		}
+
+		if !seenSources[source.GUID] {
+			seenSources[source.GUID] = true
+			sourceApps = append(sourceApps, source)
+		}
+		if !seenSpaces[source.SpaceGUID] {
+			seenSpaces[source.SpaceGUID] = true
+			sourceSpaces = append(sourceSpaces, source.SpaceGUID)
+		}
+	}
+
+	if prune && len(sourceSpaces) > 0 {
+		spaceApps, warnings, err := actor.CloudControllerClient.GetApplications(ccv3.Query{
+			Key:    ccv3.SpaceGUIDFilter,
+			Values: sourceSpaces,
+		})
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return PolicyChanges{}, allWarnings, err
+		}
+
+		for _, app := range spaceApps {
+			if !seenSources[app.GUID] {
+				seenSources[app.GUID] = true
+				sourceApps = append(sourceApps, app)
+			}
+		}
+	}
+
+	var sourceGUIDs []string
+	for _, app := range sourceApps {
+		sourceGUIDs = append(sourceGUIDs, app.GUID)
+	}
+
+	existing, err := actor.listPoliciesFromSources(sourceGUIDs)
+	if err != nil {
+		return PolicyChanges{}, allWarnings, err
+	}
+
+	existingPolicies := map[cfnetv1.Policy]bool{}
+	for _, policy := range existing {
+		existingPolicies[policy] = true
+	}
+
+	var changes PolicyChanges
+	for _, policy := range desired {
+		if !existingPolicies[policy] {
+			changes.additions = append(changes.additions, policy)
+		}
+	}
+	if prune {
+		for _, policy := range existing {
+			if !seenPolicies[policy] {
+				changes.removals = append(changes.removals, policy)
+			}
+		}
+	}
+
+	if len(changes.additions)+len(changes.removals) == 0 {
+		return changes, allWarnings, nil
+	}
+
+	described, warnings, err := actor.describePolicies(append(append([]cfnetv1.Policy{}, changes.additions...), changes.removals...), append(sourceApps, resolver.apps...))
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return PolicyChanges{}, allWarnings, err
+	}
+	changes.Additions = described[:len(changes.additions)]
+	changes.Removals = described[len(changes.additions):]
+
+	return changes, allWarnings, nil
+}
+
+// ApplyPolicyChanges creates and removes the policies of changes, each with a
+// single request to the policy server. Policies are created first so that
+// traffic keeps flowing while a port range is being replaced.
+func (actor Actor) ApplyPolicyChanges(changes PolicyChanges) error {
+	if len(changes.additions) > 0 {
+		err := actor.NetworkingClient.CreatePolicies(changes.additions)
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(changes.removals) > 0 {
+		return actor.NetworkingClient.RemovePolicies(changes.removals)
+	}
+
+	return nil
+}
+
+type spaceKey struct {
+	orgGUID string
+	name    string
+}
+
+type appKey struct {
+	spaceGUID string
+	name      string
+}
+
+// appResolver looks up apps by the names of their org and space, caching
+// every lookup.
+type appResolver struct {
+	client CloudControllerClient
+	orgs   map[string]string
+	spaces map[spaceKey]string
+	byName map[appKey]resources.Application
+	apps   []resources.Application
+}
+
+func newAppResolver(client CloudControllerClient) *appResolver {
+	return &appResolver{
+		client: client,
+		orgs:   map[string]string{},
+		spaces: map[spaceKey]string{},
+		byName: map[appKey]resources.Application{},
+	}
+}
+
+func (resolver *appResolver) app(orgName string, spaceName string, appName string) (resources.Application, ccv3.Warnings, error) {
+	var allWarnings ccv3.Warnings
+
+	orgGUID, ok := resolver.orgs[orgName]
+	if !ok {
+		orgs, warnings, err := resolver.client.GetOrganizations(ccv3.Query{
+			Key:    ccv3.NameFilter,
+			Values: []string{orgName},
+		})
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return resources.Application{}, allWarnings, err
+		}
+		if len(orgs) == 0 {
+			return resources.Application{}, allWarnings, actionerror.OrganizationNotFoundError{Name: orgName}
+		}
+		orgGUID = orgs[0].GUID
+		resolver.orgs[orgName] = orgGUID
+	}
+
+	spaceGUID, ok := resolver.spaces[spaceKey{orgGUID, spaceName}]
+	if !ok {
+		spaces, _, warnings, err := resolver.client.GetSpaces(
+			ccv3.Query{Key: ccv3.NameFilter, Values: []string{spaceName}},
+			ccv3.Query{Key: ccv3.OrganizationGUIDFilter, Values: []string{orgGUID}},
+		)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return resources.Application{}, allWarnings, err
+		}
+		if len(spaces) == 0 {
+			return resources.Application{}, allWarnings, actionerror.SpaceNotFoundError{Name: spaceName}
+		}
+		spaceGUID = spaces[0].GUID
+		resolver.spaces[spaceKey{orgGUID, spaceName}] = spaceGUID
+	}
+
+	app, ok := resolver.byName[appKey{spaceGUID, appName}]
+	if !ok {
+		var (
+			warnings ccv3.Warnings
+			err      error
+		)
+		app, warnings, err = resolver.client.GetApplicationByNameAndSpace(appName, spaceGUID)
+		allWarnings = append(allWarnings, warnings...)
+		if _, isNotFound := err.(ccerror.ApplicationNotFoundError); isNotFound {
+			return resources.Application{}, allWarnings, actionerror.ApplicationNotFoundError{Name: appName}
+		}
+		if err != nil {
+			return resources.Application{}, allWarnings, err
+		}
+		resolver.byName[appKey{spaceGUID, appName}] = app
+		resolver.apps = append(resolver.apps, app)
+	}
+
+	return app, allWarnings, nil
+}
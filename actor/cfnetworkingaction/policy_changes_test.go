@@ -0,0 +1,332 @@
+package cfnetworkingaction_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cfnetworking-cli-api/cfnetworking/cfnetv1"
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/cfnetworkingaction"
+	"code.cloudfoundry.org/cli/actor/cfnetworkingaction/cfnetworkingactionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/resources"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Policy Changes", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *cfnetworkingactionfakes.FakeCloudControllerClient
+		fakeNetworkingClient      *cfnetworkingactionfakes.FakeNetworkingClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(cfnetworkingactionfakes.FakeCloudControllerClient)
+		fakeNetworkingClient = new(cfnetworkingactionfakes.FakeNetworkingClient)
+		actor = NewActor(fakeNetworkingClient, fakeCloudControllerClient)
+	})
+
+	Describe("GetPolicyChanges", func() {
+		var (
+			specs    []PolicySpec
+			prune    bool
+			changes  PolicyChanges
+			warnings Warnings
+			err      error
+		)
+
+		BeforeEach(func() {
+			orgs := []resources.Organization{
+				{GUID: "org-a-guid", Name: "org-a"},
+				{GUID: "org-b-guid", Name: "org-b"},
+			}
+			spaces := []resources.Space{
+				{GUID: "web-guid", Name: "web", Relationships: orgRelationship("org-a-guid")},
+				{GUID: "api-guid", Name: "api", Relationships: orgRelationship("org-a-guid")},
+				{GUID: "data-guid", Name: "data", Relationships: orgRelationship("org-b-guid")},
+			}
+			apps := []resources.Application{
+				{GUID: "frontend-guid", Name: "frontend", SpaceGUID: "web-guid"},
+				{GUID: "admin-guid", Name: "admin", SpaceGUID: "web-guid"},
+				{GUID: "backend-guid", Name: "backend", SpaceGUID: "api-guid"},
+				{GUID: "db-guid", Name: "db", SpaceGUID: "data-guid"},
+			}
+
+			fakeCloudControllerClient.GetOrganizationsStub = func(queries ...ccv3.Query) ([]resources.Organization, ccv3.Warnings, error) {
+				var found []resources.Organization
+				for _, org := range orgs {
+					if matchesQueries(queries, org.GUID, org.Name, "") {
+						found = append(found, org)
+					}
+				}
+				return found, ccv3.Warnings{"orgs-warning"}, nil
+			}
+			fakeCloudControllerClient.GetSpacesStub = func(queries ...ccv3.Query) ([]resources.Space, ccv3.IncludedResources, ccv3.Warnings, error) {
+				var found []resources.Space
+				for _, space := range spaces {
+					if matchesQueries(queries, space.GUID, space.Name, space.Relationships[constant.RelationshipTypeOrganization].GUID) {
+						found = append(found, space)
+					}
+				}
+				return found, ccv3.IncludedResources{}, nil, nil
+			}
+			fakeCloudControllerClient.GetApplicationsStub = func(queries ...ccv3.Query) ([]resources.Application, ccv3.Warnings, error) {
+				var found []resources.Application
+				for _, app := range apps {
+					if matchesQueries(queries, app.GUID, app.Name, app.SpaceGUID) {
+						found = append(found, app)
+					}
+				}
+				return found, nil, nil
+			}
+			fakeCloudControllerClient.GetApplicationByNameAndSpaceStub = func(name string, spaceGUID string) (resources.Application, ccv3.Warnings, error) {
+				for _, app := range apps {
+					if app.Name == name && app.SpaceGUID == spaceGUID {
+						return app, ccv3.Warnings{"app-warning"}, nil
+					}
+				}
+				return resources.Application{}, ccv3.Warnings{"app-warning"}, ccerror.ApplicationNotFoundError{Name: name}
+			}
+
+			fakeNetworkingClient.ListPoliciesReturns([]cfnetv1.Policy{
+				v1Policy("frontend-guid", "backend-guid", "tcp", 8080, 8080),
+				v1Policy("admin-guid", "backend-guid", "tcp", 9000, 9000),
+				v1Policy("backend-guid", "db-guid", "tcp", 5432, 5432),
+			}, nil)
+
+			specs = []PolicySpec{
+				{
+					SourceOrgName: "org-a", SourceSpaceName: "web", SourceName: "frontend",
+					DestinationOrgName: "org-a", DestinationSpaceName: "api", DestinationName: "backend",
+					Protocol: "tcp", StartPort: 8080, EndPort: 8080,
+				},
+				{
+					SourceOrgName: "org-a", SourceSpaceName: "web", SourceName: "frontend",
+					DestinationOrgName: "org-b", DestinationSpaceName: "data", DestinationName: "db",
+					Protocol: "tcp", StartPort: 5432, EndPort: 5433,
+				},
+			}
+			prune = false
+		})
+
+		JustBeforeEach(func() {
+			changes, warnings, err = actor.GetPolicyChanges(specs, prune)
+		})
+
+		It("adds the policies that do not exist yet", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(ContainElements("orgs-warning", "app-warning"))
+
+			Expect(fakeNetworkingClient.ListPoliciesArgsForCall(0)).To(ConsistOf("frontend-guid"))
+
+			Expect(changes.Additions).To(Equal([]Policy{{
+				SourceName:           "frontend",
+				SourceSpaceName:      "web",
+				SourceOrgName:        "org-a",
+				DestinationName:      "db",
+				Protocol:             "tcp",
+				StartPort:            5432,
+				EndPort:              5433,
+				DestinationSpaceName: "data",
+				DestinationOrgName:   "org-b",
+			}}))
+			Expect(changes.Removals).To(BeEmpty())
+		})
+
+		It("looks up each org, space and app once", func() {
+			Expect(fakeCloudControllerClient.GetApplicationByNameAndSpaceCallCount()).To(Equal(3))
+			Expect(fakeCloudControllerClient.GetSpacesArgsForCall(0)).To(Equal([]ccv3.Query{
+				{Key: ccv3.NameFilter, Values: []string{"web"}},
+				{Key: ccv3.OrganizationGUIDFilter, Values: []string{"org-a-guid"}},
+			}))
+		})
+
+		When("prune is set", func() {
+			BeforeEach(func() {
+				prune = true
+			})
+
+			It("removes the unlisted policies of every app in the source spaces", func() {
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(fakeCloudControllerClient.GetApplicationsArgsForCall(0)).To(Equal([]ccv3.Query{
+					{Key: ccv3.SpaceGUIDFilter, Values: []string{"web-guid"}},
+				}))
+				Expect(fakeNetworkingClient.ListPoliciesArgsForCall(0)).To(ConsistOf("frontend-guid", "admin-guid"))
+
+				Expect(changes.Additions).To(HaveLen(1))
+				Expect(changes.Removals).To(Equal([]Policy{{
+					SourceName:           "admin",
+					SourceSpaceName:      "web",
+					SourceOrgName:        "org-a",
+					DestinationName:      "backend",
+					Protocol:             "tcp",
+					StartPort:            9000,
+					EndPort:              9000,
+					DestinationSpaceName: "api",
+					DestinationOrgName:   "org-a",
+				}}))
+			})
+		})
+
+		When("all policies are in place", func() {
+			BeforeEach(func() {
+				specs = specs[:1]
+			})
+
+			It("returns no changes", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(changes.Additions).To(BeEmpty())
+				Expect(changes.Removals).To(BeEmpty())
+			})
+		})
+
+		When("an org does not exist", func() {
+			BeforeEach(func() {
+				specs[1].DestinationOrgName = "org-c"
+			})
+
+			It("returns an organization not found error", func() {
+				Expect(err).To(MatchError(actionerror.OrganizationNotFoundError{Name: "org-c"}))
+			})
+		})
+
+		When("a space does not exist", func() {
+			BeforeEach(func() {
+				specs[1].DestinationSpaceName = "web"
+			})
+
+			It("returns a space not found error", func() {
+				Expect(err).To(MatchError(actionerror.SpaceNotFoundError{Name: "web"}))
+			})
+		})
+
+		When("an app does not exist", func() {
+			BeforeEach(func() {
+				specs[0].SourceName = "missing"
+			})
+
+			It("returns an application not found error", func() {
+				Expect(err).To(MatchError(actionerror.ApplicationNotFoundError{Name: "missing"}))
+				Expect(warnings).To(ContainElement("app-warning"))
+			})
+		})
+
+		When("listing the policies fails", func() {
+			BeforeEach(func() {
+				fakeNetworkingClient.ListPoliciesReturns(nil, errors.New("policy server down"))
+			})
+
+			It("returns the error", func() {
+				Expect(err).To(MatchError("policy server down"))
+			})
+		})
+	})
+
+	Describe("ApplyPolicyChanges", func() {
+		var changes PolicyChanges
+
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetOrganizationsReturns([]resources.Organization{{GUID: "org-guid", Name: "org"}}, nil, nil)
+			fakeCloudControllerClient.GetSpacesReturns([]resources.Space{{GUID: "space-guid", Name: "space", Relationships: orgRelationship("org-guid")}}, ccv3.IncludedResources{}, nil, nil)
+			fakeCloudControllerClient.GetApplicationByNameAndSpaceStub = func(name string, spaceGUID string) (resources.Application, ccv3.Warnings, error) {
+				return resources.Application{GUID: name + "-guid", Name: name, SpaceGUID: spaceGUID}, nil, nil
+			}
+			fakeCloudControllerClient.GetApplicationsReturns([]resources.Application{
+				{GUID: "a-guid", Name: "a", SpaceGUID: "space-guid"},
+				{GUID: "b-guid", Name: "b", SpaceGUID: "space-guid"},
+			}, nil, nil)
+			fakeNetworkingClient.ListPoliciesReturns([]cfnetv1.Policy{
+				v1Policy("b-guid", "a-guid", "udp", 53, 53),
+			}, nil)
+
+			var err error
+			changes, _, err = actor.GetPolicyChanges([]PolicySpec{{
+				SourceOrgName: "org", SourceSpaceName: "space", SourceName: "a",
+				DestinationOrgName: "org", DestinationSpaceName: "space", DestinationName: "b",
+				Protocol: "tcp", StartPort: 8080, EndPort: 8090,
+			}}, true)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("creates and then removes the policies in one request each", func() {
+			Expect(actor.ApplyPolicyChanges(changes)).To(Succeed())
+
+			Expect(fakeNetworkingClient.CreatePoliciesCallCount()).To(Equal(1))
+			Expect(fakeNetworkingClient.CreatePoliciesArgsForCall(0)).To(Equal([]cfnetv1.Policy{
+				v1Policy("a-guid", "b-guid", "tcp", 8080, 8090),
+			}))
+			Expect(fakeNetworkingClient.RemovePoliciesCallCount()).To(Equal(1))
+			Expect(fakeNetworkingClient.RemovePoliciesArgsForCall(0)).To(Equal([]cfnetv1.Policy{
+				v1Policy("b-guid", "a-guid", "udp", 53, 53),
+			}))
+		})
+
+		When("creating the policies fails", func() {
+			BeforeEach(func() {
+				fakeNetworkingClient.CreatePoliciesReturns(errors.New("create failed"))
+			})
+
+			It("does not remove any policies", func() {
+				Expect(actor.ApplyPolicyChanges(changes)).To(MatchError("create failed"))
+				Expect(fakeNetworkingClient.RemovePoliciesCallCount()).To(Equal(0))
+			})
+		})
+
+		When("there are no changes", func() {
+			It("makes no requests", func() {
+				Expect(actor.ApplyPolicyChanges(PolicyChanges{})).To(Succeed())
+				Expect(fakeNetworkingClient.CreatePoliciesCallCount()).To(Equal(0))
+				Expect(fakeNetworkingClient.RemovePoliciesCallCount()).To(Equal(0))
+			})
+		})
+	})
+})
+
+func v1Policy(sourceGUID string, destinationGUID string, protocol string, startPort int, endPort int) cfnetv1.Policy {
+	return cfnetv1.Policy{
+		Source: cfnetv1.PolicySource{ID: sourceGUID},
+		Destination: cfnetv1.PolicyDestination{
+			ID:       destinationGUID,
+			Protocol: cfnetv1.PolicyProtocol(protocol),
+			Ports:    cfnetv1.Ports{Start: startPort, End: endPort},
+		},
+	}
+}
+
+func orgRelationship(orgGUID string) map[constant.RelationshipType]resources.Relationship {
+	return map[constant.RelationshipType]resources.Relationship{
+		constant.RelationshipTypeOrganization: {GUID: orgGUID},
+	}
+}
+
+// matchesQueries reports whether a resource with the given GUID, name and
+// parent GUID matches every filter of queries.
+func matchesQueries(queries []ccv3.Query, guid string, name string, parentGUID string) bool {
+	for _, query := range queries {
+		var value string
+		switch query.Key {
+		case ccv3.GUIDFilter:
+			value = guid
+		case ccv3.NameFilter:
+			value = name
+		case ccv3.OrganizationGUIDFilter, ccv3.SpaceGUIDFilter:
+			value = parentGUID
+		default:
+			continue
+		}
+
+		found := false
+		for _, candidate := range query.Values {
+			if candidate == value {
+				found = true
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
@@ -216,6 +216,8 @@ var _ = Describe("Policy", func() {
 				Expect(policies).To(Equal([]Policy{
 					{
 						SourceName:           "appA",
+						SourceSpaceName:      "spaceA",
+						SourceOrgName:        "orgA",
 						DestinationName:      "appB",
 						Protocol:             "tcp",
 						StartPort:            8080,
@@ -225,6 +227,8 @@ var _ = Describe("Policy", func() {
 					},
 					{
 						SourceName:           "appA",
+						SourceSpaceName:      "spaceA",
+						SourceOrgName:        "orgA",
 						DestinationName:      "appC",
 						Protocol:             "tcp",
 						StartPort:            8080,
@@ -429,6 +433,8 @@ var _ = Describe("Policy", func() {
 				Expect(policies).To(Equal(
 					[]Policy{{
 						SourceName:           "appA",
+						SourceSpaceName:      "spaceA",
+						SourceOrgName:        "orgA",
 						DestinationName:      "appB",
 						Protocol:             "tcp",
 						StartPort:            8080,
@@ -437,6 +443,8 @@ var _ = Describe("Policy", func() {
 						DestinationOrgName:   "orgA",
 					}, {
 						SourceName:           "appB",
+						SourceSpaceName:      "spaceA",
+						SourceOrgName:        "orgA",
 						DestinationName:      "appB",
 						Protocol:             "tcp",
 						StartPort:            8080,
@@ -445,6 +453,8 @@ var _ = Describe("Policy", func() {
 						DestinationOrgName:   "orgA",
 					}, {
 						SourceName:           "appA",
+						SourceSpaceName:      "spaceA",
+						SourceOrgName:        "orgA",
 						DestinationName:      "appC",
 						Protocol:             "tcp",
 						StartPort:            8080,
@@ -595,6 +605,8 @@ var _ = Describe("Policy", func() {
 
 					expectedPolicy := Policy{
 						SourceName:           srcApp.Name,
+						SourceSpaceName:      "space",
+						SourceOrgName:        "org",
 						DestinationName:      destApp.Name,
 						Protocol:             "tcp",
 						StartPort:            8080,
@@ -653,6 +665,117 @@ var _ = Describe("Policy", func() {
 		})
 	})
 
+	Describe("NetworkPoliciesByOrg", func() {
+		var policies []Policy
+
+		BeforeEach(func() {
+			fakeNetworkingClient.ListPoliciesReturns([]cfnetv1.Policy{{
+				Source: cfnetv1.PolicySource{ID: "appAGUID"},
+				Destination: cfnetv1.PolicyDestination{
+					ID:       "appCGUID",
+					Protocol: "udp",
+					Ports:    cfnetv1.Ports{Start: 9000, End: 9100},
+				},
+			}}, nil)
+
+			fakeCloudControllerClient.GetApplicationsReturnsOnCall(0, []resources.Application{
+				{Name: "appA", GUID: "appAGUID", SpaceGUID: "spaceAGUID"},
+			}, []string{"filter-apps-by-org-warning"}, nil)
+			fakeCloudControllerClient.GetApplicationsReturnsOnCall(1, []resources.Application{
+				{Name: "appC", GUID: "appCGUID", SpaceGUID: "spaceCGUID"},
+			}, []string{"filter-apps-by-guid-warning"}, nil)
+
+			fakeCloudControllerClient.GetSpacesReturns([]resources.Space{
+				{
+					GUID: "spaceAGUID",
+					Name: "spaceA",
+					Relationships: map[constant.RelationshipType]resources.Relationship{
+						constant.RelationshipTypeOrganization: {GUID: "orgAGUID"},
+					},
+				},
+				{
+					GUID: "spaceCGUID",
+					Name: "spaceC",
+					Relationships: map[constant.RelationshipType]resources.Relationship{
+						constant.RelationshipTypeOrganization: {GUID: "orgCGUID"},
+					},
+				},
+			}, ccv3.IncludedResources{}, nil, nil)
+
+			fakeCloudControllerClient.GetOrganizationsReturns([]resources.Organization{
+				{GUID: "orgAGUID", Name: "orgA"},
+				{GUID: "orgCGUID", Name: "orgC"},
+			}, nil, nil)
+		})
+
+		JustBeforeEach(func() {
+			policies, warnings, executeErr = actor.NetworkPoliciesByOrg("orgAGUID")
+		})
+
+		It("lists the policies of the apps in the org, including destinations in other orgs", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf("filter-apps-by-org-warning", "filter-apps-by-guid-warning"))
+
+			Expect(fakeCloudControllerClient.GetApplicationsArgsForCall(0)).To(Equal([]ccv3.Query{
+				{Key: ccv3.OrganizationGUIDFilter, Values: []string{"orgAGUID"}},
+			}))
+			Expect(fakeNetworkingClient.ListPoliciesArgsForCall(0)).To(ConsistOf("appAGUID"))
+
+			Expect(policies).To(Equal([]Policy{{
+				SourceName:           "appA",
+				SourceSpaceName:      "spaceA",
+				SourceOrgName:        "orgA",
+				DestinationName:      "appC",
+				Protocol:             "udp",
+				StartPort:            9000,
+				EndPort:              9100,
+				DestinationSpaceName: "spaceC",
+				DestinationOrgName:   "orgC",
+			}}))
+		})
+
+		When("the org has no apps", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturnsOnCall(0, []resources.Application{}, []string{"filter-apps-by-org-warning"}, nil)
+			})
+
+			It("returns no policies without querying the policy server", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(policies).To(BeEmpty())
+				Expect(warnings).To(ConsistOf("filter-apps-by-org-warning"))
+				Expect(fakeNetworkingClient.ListPoliciesCallCount()).To(Equal(0))
+				Expect(fakeCloudControllerClient.GetSpacesCallCount()).To(Equal(0))
+			})
+		})
+
+		When("getting the applications fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturnsOnCall(0, nil, []string{"filter-apps-by-org-warning"}, errors.New("banana"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("banana"))
+				Expect(warnings).To(ConsistOf("filter-apps-by-org-warning"))
+			})
+		})
+	})
+
+	Describe("NetworkPolicies", func() {
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetApplicationsReturns([]resources.Application{}, []string{"all-apps-warning"}, nil)
+		})
+
+		It("lists the policies of all apps", func() {
+			policies, warnings, err := actor.NetworkPolicies()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(policies).To(BeEmpty())
+			Expect(warnings).To(ConsistOf("all-apps-warning"))
+
+			Expect(fakeCloudControllerClient.GetApplicationsCallCount()).To(Equal(1))
+			Expect(fakeCloudControllerClient.GetApplicationsArgsForCall(0)).To(BeEmpty())
+		})
+	})
+
 	Describe("RemoveNetworkPolicy", func() {
 		BeforeEach(func() {
 			fakeNetworkingClient.ListPoliciesReturns([]cfnetv1.Policy{
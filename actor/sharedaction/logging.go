@@ -140,37 +140,59 @@ func GetStreamingLogs(appGUID string, client LogCacheClient) (<-chan LogMessage,
 		}
 
 		const offset = 1 * time.Second
-		walkStartTime := ts.Add(-offset)
+		walkLogs(ctx, appGUID, ts.Add(-offset), client, outgoingLogStream, outgoingErrStream)
+	}()
 
-		logcache.Walk(
-			ctx,
-			appGUID,
-			logcache.Visitor(func(envelopes []*loggregator_v2.Envelope) bool {
-				logMessages := convertEnvelopesToLogMessages(envelopes)
-				for _, logMessage := range logMessages {
-					select {
-					case <-ctx.Done():
-						return false
-					default:
-						outgoingLogStream <- *logMessage
-					}
-				}
-				return true
-			}),
-			client.Read,
-			logcache.WithWalkDelay(2*time.Second),
-			logcache.WithWalkStartTime(walkStartTime),
-			logcache.WithWalkEnvelopeTypes(logcache_v1.EnvelopeType_LOG),
-			logcache.WithWalkBackoff(newCliRetryBackoff(retryInterval, retryCount)),
-			logcache.WithWalkLogger(log.New(channelWriter{
-				errChannel: outgoingErrStream,
-			}, "", 0)),
-		)
+	return outgoingLogStream, outgoingErrStream, cancelFunc
+}
+
+// GetStreamingLogsSince tails the logs of the given source starting at the
+// provided time rather than at the most recent envelope, so that output
+// emitted before streaming began is not lost.
+func GetStreamingLogsSince(sourceID string, start time.Time, client LogCacheClient) (<-chan LogMessage, <-chan error, context.CancelFunc) {
+
+	logrus.Info("Start Tailing Logs")
+
+	outgoingLogStream := make(chan LogMessage, 1000)
+	outgoingErrStream := make(chan error, 1000)
+	ctx, cancelFunc := context.WithCancel(context.Background())
+	go func() {
+		defer close(outgoingLogStream)
+		defer close(outgoingErrStream)
+
+		walkLogs(ctx, sourceID, start, client, outgoingLogStream, outgoingErrStream)
 	}()
 
 	return outgoingLogStream, outgoingErrStream, cancelFunc
 }
 
+func walkLogs(ctx context.Context, sourceID string, start time.Time, client LogCacheClient, logs chan<- LogMessage, errs chan error) {
+	logcache.Walk(
+		ctx,
+		sourceID,
+		logcache.Visitor(func(envelopes []*loggregator_v2.Envelope) bool {
+			logMessages := convertEnvelopesToLogMessages(envelopes)
+			for _, logMessage := range logMessages {
+				select {
+				case <-ctx.Done():
+					return false
+				default:
+					logs <- *logMessage
+				}
+			}
+			return true
+		}),
+		client.Read,
+		logcache.WithWalkDelay(2*time.Second),
+		logcache.WithWalkStartTime(start),
+		logcache.WithWalkEnvelopeTypes(logcache_v1.EnvelopeType_LOG),
+		logcache.WithWalkBackoff(newCliRetryBackoff(retryInterval, retryCount)),
+		logcache.WithWalkLogger(log.New(channelWriter{
+			errChannel: errs,
+		}, "", 0)),
+	)
+}
+
 func latestEnvelopeTimestamp(client LogCacheClient, errs chan error, ctx context.Context, sourceID string) time.Time {
 
 	// Fetching the most recent timestamp could be implemented with client.Read directly rather than using logcache.Walk
@@ -240,6 +262,62 @@ func GetRecentLogs(appGUID string, client LogCacheClient) ([]LogMessage, error)
 	return reorderedLogMessages, nil
 }
 
+// GetLogsSince returns every log line of the given source emitted at or after
+// start, oldest first. Unlike GetRecentLogs it is not capped at
+// RecentLogsLines; it pages forward through Log Cache until it is exhausted.
+func GetLogsSince(sourceID string, start time.Time, client LogCacheClient) ([]LogMessage, error) {
+	var logMessages []LogMessage
+
+	for {
+		envelopes, err := client.Read(
+			context.Background(),
+			sourceID,
+			start,
+			logcache.WithEnvelopeTypes(logcache_v1.EnvelopeType_LOG),
+			logcache.WithLimit(RecentLogsLines),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("Failed to retrieve logs from Log Cache: %s", err)
+		}
+
+		for _, logMessage := range convertEnvelopesToLogMessages(envelopes) {
+			logMessages = append(logMessages, *logMessage)
+		}
+
+		if len(envelopes) < RecentLogsLines {
+			break
+		}
+		start = time.Unix(0, envelopes[len(envelopes)-1].GetTimestamp()+1)
+	}
+
+	return logMessages, nil
+}
+
+// GetLogsBefore returns up to limit log lines of the given source emitted
+// before end, oldest first.
+func GetLogsBefore(sourceID string, end time.Time, limit int, client LogCacheClient) ([]LogMessage, error) {
+	envelopes, err := client.Read(
+		context.Background(),
+		sourceID,
+		time.Time{},
+		logcache.WithEnvelopeTypes(logcache_v1.EnvelopeType_LOG),
+		logcache.WithEndTime(end),
+		logcache.WithLimit(limit),
+		logcache.WithDescending(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to retrieve logs from Log Cache: %s", err)
+	}
+
+	logMessages := convertEnvelopesToLogMessages(envelopes)
+	var reorderedLogMessages []LogMessage
+	for i := len(logMessages) - 1; i >= 0; i-- {
+		reorderedLogMessages = append(reorderedLogMessages, *logMessages[i])
+	}
+
+	return reorderedLogMessages, nil
+}
+
 func convertEnvelopesToLogMessages(envelopes []*loggregator_v2.Envelope) []*LogMessage {
 	var logMessages []*LogMessage
 	for _, envelope := range envelopes {
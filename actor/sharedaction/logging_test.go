@@ -366,4 +366,211 @@ var _ = Describe("Logging Actions", func() {
 		})
 	})
 
+	Describe("GetStreamingLogsSince", func() {
+		var (
+			messages      <-chan sharedaction.LogMessage
+			errs          <-chan error
+			stopStreaming context.CancelFunc
+			startTime     time.Time
+			walkStartTime time.Time
+		)
+
+		BeforeEach(func() {
+			startTime = time.Now().Add(-time.Minute)
+
+			fakeLogCacheClient.ReadStub = func(
+				ctx context.Context,
+				sourceID string,
+				start time.Time,
+				opts ...logcache.ReadOption,
+			) ([]*loggregator_v2.Envelope, error) {
+				if fakeLogCacheClient.ReadCallCount() > 1 {
+					stopStreaming()
+					return []*loggregator_v2.Envelope{}, ctx.Err()
+				}
+
+				walkStartTime = start
+				return []*loggregator_v2.Envelope{{
+					Timestamp: startTime.Add(time.Second).UnixNano(),
+					SourceId:  "some-app-guid",
+					Message: &loggregator_v2.Envelope_Log{
+						Log: &loggregator_v2.Log{
+							Payload: []byte("message-1"),
+							Type:    loggregator_v2.Log_OUT,
+						},
+					},
+				}}, ctx.Err()
+			}
+		})
+
+		JustBeforeEach(func() {
+			messages, errs, stopStreaming = sharedaction.GetStreamingLogsSince("some-app-guid", startTime, fakeLogCacheClient)
+		})
+
+		AfterEach(func() {
+			Eventually(messages).Should(BeClosed())
+			Eventually(errs).Should(BeClosed())
+		})
+
+		It("starts walking at the provided time without peeking at the latest envelope", func() {
+			Eventually(messages).Should(BeClosed())
+			Expect(walkStartTime).To(BeTemporally("==", startTime))
+		})
+
+		It("passes the logs through the messages channel", func() {
+			var message sharedaction.LogMessage
+			Eventually(messages).Should(Receive(&message))
+			Expect(message.Message()).To(Equal("message-1"))
+		})
+	})
+
+	Describe("GetLogsSince", func() {
+		var (
+			startTime time.Time
+			messages  []sharedaction.LogMessage
+			err       error
+		)
+
+		logEnvelope := func(timestamp int64, payload string) *loggregator_v2.Envelope {
+			return &loggregator_v2.Envelope{
+				Timestamp: timestamp,
+				SourceId:  "some-app-guid",
+				Message: &loggregator_v2.Envelope_Log{
+					Log: &loggregator_v2.Log{
+						Payload: []byte(payload),
+						Type:    loggregator_v2.Log_OUT,
+					},
+				},
+			}
+		}
+
+		BeforeEach(func() {
+			startTime = time.Unix(0, 100)
+		})
+
+		JustBeforeEach(func() {
+			messages, err = sharedaction.GetLogsSince("some-app-guid", startTime, fakeLogCacheClient)
+		})
+
+		When("Log Cache returns fewer logs than a full page", func() {
+			BeforeEach(func() {
+				fakeLogCacheClient.ReadReturns([]*loggregator_v2.Envelope{
+					logEnvelope(110, "message-1"),
+					logEnvelope(120, "message-2"),
+				}, nil)
+			})
+
+			It("reads once from the start time and returns the logs in order", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(fakeLogCacheClient.ReadCallCount()).To(Equal(1))
+				_, sourceID, start, _ := fakeLogCacheClient.ReadArgsForCall(0)
+				Expect(sourceID).To(Equal("some-app-guid"))
+				Expect(start).To(Equal(startTime))
+
+				Expect(messages).To(HaveLen(2))
+				Expect(messages[0].Message()).To(Equal("message-1"))
+				Expect(messages[1].Message()).To(Equal("message-2"))
+			})
+		})
+
+		When("Log Cache returns a full page", func() {
+			BeforeEach(func() {
+				var fullPage []*loggregator_v2.Envelope
+				for i := 0; i < sharedaction.RecentLogsLines; i++ {
+					fullPage = append(fullPage, logEnvelope(int64(200+i), "paged-message"))
+				}
+				fakeLogCacheClient.ReadReturnsOnCall(0, fullPage, nil)
+				fakeLogCacheClient.ReadReturnsOnCall(1, []*loggregator_v2.Envelope{logEnvelope(5000, "last-message")}, nil)
+			})
+
+			It("continues reading after the last envelope it received", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(fakeLogCacheClient.ReadCallCount()).To(Equal(2))
+				_, _, start, _ := fakeLogCacheClient.ReadArgsForCall(1)
+				Expect(start).To(Equal(time.Unix(0, int64(200+sharedaction.RecentLogsLines))))
+
+				Expect(messages).To(HaveLen(sharedaction.RecentLogsLines + 1))
+				Expect(messages[len(messages)-1].Message()).To(Equal("last-message"))
+			})
+		})
+
+		When("Log Cache errors", func() {
+			BeforeEach(func() {
+				fakeLogCacheClient.ReadReturns(nil, errors.New("some-error"))
+			})
+
+			It("returns the error", func() {
+				Expect(err).To(MatchError("Failed to retrieve logs from Log Cache: some-error"))
+			})
+		})
+	})
+
+	Describe("GetLogsBefore", func() {
+		var (
+			endTime  time.Time
+			messages []sharedaction.LogMessage
+			err      error
+		)
+
+		logEnvelope := func(timestamp int64, payload string) *loggregator_v2.Envelope {
+			return &loggregator_v2.Envelope{
+				Timestamp: timestamp,
+				SourceId:  "some-app-guid",
+				Message: &loggregator_v2.Envelope_Log{
+					Log: &loggregator_v2.Log{
+						Payload: []byte(payload),
+						Type:    loggregator_v2.Log_OUT,
+					},
+				},
+			}
+		}
+
+		BeforeEach(func() {
+			endTime = time.Unix(0, 500)
+		})
+
+		JustBeforeEach(func() {
+			messages, err = sharedaction.GetLogsBefore("some-app-guid", endTime, 2, fakeLogCacheClient)
+		})
+
+		When("Log Cache returns logs", func() {
+			BeforeEach(func() {
+				fakeLogCacheClient.ReadReturns([]*loggregator_v2.Envelope{
+					logEnvelope(420, "message-2"),
+					logEnvelope(410, "message-1"),
+				}, nil)
+			})
+
+			It("reads the latest logs before the end time and returns them oldest first", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(fakeLogCacheClient.ReadCallCount()).To(Equal(1))
+				_, sourceID, start, opts := fakeLogCacheClient.ReadArgsForCall(0)
+				Expect(sourceID).To(Equal("some-app-guid"))
+				Expect(start).To(Equal(time.Time{}))
+
+				values := url.Values{}
+				for _, opt := range opts {
+					opt(nil, values)
+				}
+				Expect(values.Get("end_time")).To(Equal("500"))
+				Expect(values.Get("limit")).To(Equal("2"))
+				Expect(values.Get("descending")).To(Equal("true"))
+
+				Expect(messages).To(HaveLen(2))
+				Expect(messages[0].Message()).To(Equal("message-1"))
+				Expect(messages[1].Message()).To(Equal("message-2"))
+			})
+		})
+
+		When("Log Cache errors", func() {
+			BeforeEach(func() {
+				fakeLogCacheClient.ReadReturns(nil, errors.New("some-error"))
+			})
+
+			It("returns the error", func() {
+				Expect(err).To(MatchError("Failed to retrieve logs from Log Cache: some-error"))
+			})
+		})
+	})
+
 })
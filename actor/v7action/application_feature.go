@@ -1,16 +1,26 @@
 package v7action
 
 import (
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
 	"code.cloudfoundry.org/cli/resources"
 )
 
 func (actor Actor) GetAppFeature(appGUID string, featureName string) (resources.ApplicationFeature, Warnings, error) {
 	appFeature, warnings, err := actor.CloudControllerClient.GetAppFeature(appGUID, featureName)
+	if _, ok := err.(ccerror.ResourceNotFoundError); ok {
+		return resources.ApplicationFeature{}, Warnings(warnings), actionerror.AppFeatureNotFoundError{FeatureName: featureName}
+	}
 
 	return appFeature, Warnings(warnings), err
 }
 
+func (actor Actor) GetAppFeatures(appGUID string) ([]resources.ApplicationFeature, Warnings, error) {
+	appFeatures, warnings, err := actor.CloudControllerClient.GetAppFeatures(appGUID)
+	return appFeatures, Warnings(warnings), err
+}
+
 func (actor Actor) GetSSHEnabled(appGUID string) (ccv3.SSHEnabled, Warnings, error) {
 	sshEnabled, warnings, err := actor.CloudControllerClient.GetSSHEnabled(appGUID)
 	return sshEnabled, Warnings(warnings), err
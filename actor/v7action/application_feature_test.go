@@ -4,8 +4,10 @@ import (
 	"errors"
 	"time"
 
+	"code.cloudfoundry.org/cli/actor/actionerror"
 	. "code.cloudfoundry.org/cli/actor/v7action"
 	"code.cloudfoundry.org/cli/actor/v7action/v7actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
 	"code.cloudfoundry.org/cli/resources"
 	"code.cloudfoundry.org/clock/fakeclock"
@@ -93,6 +95,69 @@ var _ = Describe("Application Feature Actions", func() {
 					Expect(fakeCloudControllerClient.GetAppFeatureCallCount()).To(Equal(1))
 				})
 			})
+
+			When("the feature does not exist", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetAppFeatureReturns(
+						resources.ApplicationFeature{},
+						ccv3.Warnings{"some-get-ssh-warning"},
+						ccerror.ResourceNotFoundError{Message: "Feature not found"},
+					)
+				})
+
+				It("returns an app feature not found error", func() {
+					Expect(executeErr).To(MatchError(actionerror.AppFeatureNotFoundError{FeatureName: "ssh"}))
+					Expect(warnings).To(ConsistOf("some-get-ssh-warning"))
+				})
+			})
+		})
+	})
+
+	Describe("GetAppFeatures", func() {
+		var (
+			warnings    Warnings
+			executeErr  error
+			appFeatures []resources.ApplicationFeature
+		)
+
+		JustBeforeEach(func() {
+			appFeatures, warnings, executeErr = actor.GetAppFeatures("some-app-guid")
+		})
+
+		When("it succeeds", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetAppFeaturesReturns(
+					[]resources.ApplicationFeature{
+						{Name: "ssh", Description: "Enable SSHing into the app.", Enabled: true},
+						{Name: "revisions", Description: "Enable versioning of an application", Enabled: false},
+					},
+					ccv3.Warnings{"some-warning"},
+					nil,
+				)
+			})
+
+			It("returns the features of the app", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("some-warning"))
+				Expect(appFeatures).To(Equal([]resources.ApplicationFeature{
+					{Name: "ssh", Description: "Enable SSHing into the app.", Enabled: true},
+					{Name: "revisions", Description: "Enable versioning of an application", Enabled: false},
+				}))
+
+				Expect(fakeCloudControllerClient.GetAppFeaturesCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetAppFeaturesArgsForCall(0)).To(Equal("some-app-guid"))
+			})
+		})
+
+		When("the API layer call returns an error", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetAppFeaturesReturns(nil, ccv3.Warnings{"some-warning"}, errors.New("some-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("some-error"))
+				Expect(warnings).To(ConsistOf("some-warning"))
+			})
 		})
 	})
 
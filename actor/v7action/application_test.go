@@ -607,6 +607,43 @@ var _ = Describe("Application Actions", func() {
 		})
 	})
 
+	Describe("GetApplicationsBySpaceAndLabelSelector", func() {
+		When("there are matching applications in the space", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(
+					[]resources.Application{{GUID: "some-app-guid-1", Name: "some-app-1"}},
+					ccv3.Warnings{"warning-1"},
+					nil,
+				)
+			})
+
+			It("filters by the space and label selector", func() {
+				apps, warnings, err := actor.GetApplicationsBySpaceAndLabelSelector("some-space-guid", "tier=backend")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(apps).To(ConsistOf(resources.Application{GUID: "some-app-guid-1", Name: "some-app-1"}))
+				Expect(warnings).To(ConsistOf("warning-1"))
+
+				Expect(fakeCloudControllerClient.GetApplicationsCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetApplicationsArgsForCall(0)).To(ConsistOf(
+					ccv3.Query{Key: ccv3.SpaceGUIDFilter, Values: []string{"some-space-guid"}},
+					ccv3.Query{Key: ccv3.LabelSelectorFilter, Values: []string{"tier=backend"}},
+				))
+			})
+		})
+
+		When("the cloud controller client returns an error", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(nil, ccv3.Warnings{"some-warning"}, errors.New("boom"))
+			})
+
+			It("returns the error and warnings", func() {
+				_, warnings, err := actor.GetApplicationsBySpaceAndLabelSelector("some-space-guid", "tier=backend")
+				Expect(warnings).To(ConsistOf("some-warning"))
+				Expect(err).To(MatchError("boom"))
+			})
+		})
+	})
+
 	Describe("CreateApplicationInSpace", func() {
 		var (
 			application resources.Application
@@ -0,0 +1,149 @@
+package v7action
+
+import (
+	"sort"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/resources"
+)
+
+// BuildpackUsage is a buildpack used to build the current droplet of an app.
+// Outdated is true when the droplet was staged before the admin buildpack of
+// the same name and stack was last updated. It is always false for buildpacks
+// that are not admin buildpacks, such as git URLs.
+type BuildpackUsage struct {
+	App           resources.Application
+	SpaceName     string
+	OrgName       string
+	DropletGUID   string
+	Stack         string
+	BuildpackName string
+	Version       string
+	StagedAt      time.Time
+	Outdated      bool
+}
+
+// GetBuildpackUsage returns the buildpacks used by the current droplets of all
+// apps the user can see, sorted by org, space and app. When buildpackName is
+// given, only the usage of that admin buildpack is returned.
+func (actor Actor) GetBuildpackUsage(buildpackName string) ([]BuildpackUsage, Warnings, error) {
+	buildpacks, allWarnings, err := actor.GetBuildpacks("")
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	buildpackUpdates := map[string][]resources.Buildpack{}
+	for _, buildpack := range buildpacks {
+		buildpackUpdates[buildpack.Name] = append(buildpackUpdates[buildpack.Name], buildpack)
+	}
+	if _, ok := buildpackUpdates[buildpackName]; buildpackName != "" && !ok {
+		return nil, allWarnings, actionerror.BuildpackNotFoundError{BuildpackName: buildpackName}
+	}
+
+	apps, ccWarnings, err := actor.CloudControllerClient.GetApplications(
+		ccv3.Query{Key: ccv3.PerPage, Values: []string{ccv3.MaxPerPage}},
+	)
+	allWarnings = append(allWarnings, ccWarnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	spacesByGUID, orgNames, warnings, err := actor.getSpacesAndOrgNames()
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	var usages []BuildpackUsage
+	for _, app := range apps {
+		droplet, warnings, err := actor.CloudControllerClient.GetApplicationDropletCurrent(app.GUID)
+		allWarnings = append(allWarnings, warnings...)
+		if _, ok := err.(ccerror.DropletNotFoundError); ok {
+			continue
+		}
+		if err != nil {
+			return nil, allWarnings, err
+		}
+
+		space := spacesByGUID[app.SpaceGUID]
+		stagedAt, _ := time.Parse(time.RFC3339, droplet.CreatedAt)
+
+		for _, dropletBuildpack := range droplet.Buildpacks {
+			if buildpackName != "" && dropletBuildpack.Name != buildpackName {
+				continue
+			}
+
+			usages = append(usages, BuildpackUsage{
+				App:           app,
+				SpaceName:     space.Name,
+				OrgName:       orgNames[space.Relationships[constant.RelationshipTypeOrganization].GUID],
+				DropletGUID:   droplet.GUID,
+				Stack:         droplet.Stack,
+				BuildpackName: dropletBuildpack.Name,
+				Version:       dropletBuildpack.Version,
+				StagedAt:      stagedAt,
+				Outdated:      buildpackUpdatedSince(buildpackUpdates[dropletBuildpack.Name], droplet.Stack, stagedAt),
+			})
+		}
+	}
+
+	sort.SliceStable(usages, func(i, j int) bool {
+		a, b := usages[i], usages[j]
+		if a.OrgName != b.OrgName {
+			return a.OrgName < b.OrgName
+		}
+		if a.SpaceName != b.SpaceName {
+			return a.SpaceName < b.SpaceName
+		}
+		return a.App.Name < b.App.Name
+	})
+
+	return usages, allWarnings, nil
+}
+
+// buildpackUpdatedSince reports whether the buildpack for the given stack,
+// or the one without a stack, was updated after stagedAt.
+func buildpackUpdatedSince(buildpacks []resources.Buildpack, stack string, stagedAt time.Time) bool {
+	if stagedAt.IsZero() {
+		return false
+	}
+
+	for _, buildpack := range buildpacks {
+		if buildpack.Stack != "" && buildpack.Stack != stack {
+			continue
+		}
+		updatedAt, err := time.Parse(time.RFC3339, buildpack.UpdatedAt)
+		if err == nil && updatedAt.After(stagedAt) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// getSpacesAndOrgNames returns all spaces the user can see by GUID and the
+// names of their orgs by org GUID.
+func (actor Actor) getSpacesAndOrgNames() (map[string]resources.Space, map[string]string, Warnings, error) {
+	spaces, included, warnings, err := actor.CloudControllerClient.GetSpaces(
+		ccv3.Query{Key: ccv3.Include, Values: []string{"organization"}},
+		ccv3.Query{Key: ccv3.PerPage, Values: []string{ccv3.MaxPerPage}},
+	)
+	if err != nil {
+		return nil, nil, Warnings(warnings), err
+	}
+
+	spacesByGUID := map[string]resources.Space{}
+	for _, space := range spaces {
+		spacesByGUID[space.GUID] = space
+	}
+	orgNames := map[string]string{}
+	for _, org := range included.Organizations {
+		orgNames[org.GUID] = org.Name
+	}
+
+	return spacesByGUID, orgNames, Warnings(warnings), nil
+}
@@ -0,0 +1,163 @@
+package v7action_test
+
+import (
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/actor/v7action/v7actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/resources"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Buildpack Usage Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v7actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v7actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil, nil, nil, nil)
+	})
+
+	Describe("GetBuildpackUsage", func() {
+		var (
+			buildpackName string
+			apps          []resources.Application
+
+			usages     []BuildpackUsage
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			buildpackName = ""
+
+			fakeCloudControllerClient.GetBuildpacksReturns(
+				[]resources.Buildpack{
+					{Name: "java_buildpack", Stack: "cflinuxfs4", UpdatedAt: "2026-03-01T00:00:00Z"},
+					{Name: "java_buildpack", Stack: "cflinuxfs3", UpdatedAt: "2025-01-01T00:00:00Z"},
+					{Name: "go_buildpack", UpdatedAt: "2025-01-01T00:00:00Z"},
+				},
+				ccv3.Warnings{"buildpacks warning"},
+				nil,
+			)
+
+			apps = []resources.Application{
+				{GUID: "old-java-guid", Name: "old-java", SpaceGUID: "dev-guid", State: constant.ApplicationStarted},
+				{GUID: "new-java-guid", Name: "new-java", SpaceGUID: "dev-guid", State: constant.ApplicationStarted},
+				{GUID: "go-guid", Name: "go-app", SpaceGUID: "prod-guid", State: constant.ApplicationStopped},
+				{GUID: "unstaged-guid", Name: "unstaged", SpaceGUID: "prod-guid"},
+			}
+			fakeCloudControllerClient.GetApplicationsReturns(apps, ccv3.Warnings{"apps warning"}, nil)
+
+			fakeCloudControllerClient.GetSpacesReturns(
+				[]resources.Space{
+					{GUID: "dev-guid", Name: "dev", Relationships: resources.Relationships{constant.RelationshipTypeOrganization: {GUID: "org-b-guid"}}},
+					{GUID: "prod-guid", Name: "prod", Relationships: resources.Relationships{constant.RelationshipTypeOrganization: {GUID: "org-a-guid"}}},
+				},
+				ccv3.IncludedResources{Organizations: []resources.Organization{
+					{GUID: "org-a-guid", Name: "org-a"},
+					{GUID: "org-b-guid", Name: "org-b"},
+				}},
+				ccv3.Warnings{"spaces warning"},
+				nil,
+			)
+
+			fakeCloudControllerClient.GetApplicationDropletCurrentStub = func(appGUID string) (resources.Droplet, ccv3.Warnings, error) {
+				switch appGUID {
+				case "old-java-guid":
+					return resources.Droplet{
+						GUID: "old-java-droplet", Stack: "cflinuxfs4", CreatedAt: "2026-02-01T00:00:00Z",
+						Buildpacks: []resources.DropletBuildpack{{Name: "java_buildpack", Version: "4.1"}},
+					}, ccv3.Warnings{"droplet warning"}, nil
+				case "new-java-guid":
+					return resources.Droplet{
+						GUID: "new-java-droplet", Stack: "cflinuxfs4", CreatedAt: "2026-04-01T00:00:00Z",
+						Buildpacks: []resources.DropletBuildpack{{Name: "java_buildpack", Version: "4.2"}},
+					}, nil, nil
+				case "go-guid":
+					return resources.Droplet{
+						GUID: "go-droplet", Stack: "cflinuxfs4", CreatedAt: "2026-02-01T00:00:00Z",
+						Buildpacks: []resources.DropletBuildpack{{Name: "go_buildpack", Version: "1.10"}},
+					}, nil, nil
+				default:
+					return resources.Droplet{}, nil, ccerror.DropletNotFoundError{}
+				}
+			}
+		})
+
+		JustBeforeEach(func() {
+			usages, warnings, executeErr = actor.GetBuildpackUsage(buildpackName)
+		})
+
+		It("returns the buildpacks of the current droplets, sorted by org, space and app", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf("buildpacks warning", "apps warning", "spaces warning", "droplet warning"))
+
+			Expect(fakeCloudControllerClient.GetSpacesArgsForCall(0)).To(Equal([]ccv3.Query{
+				{Key: ccv3.Include, Values: []string{"organization"}},
+				{Key: ccv3.PerPage, Values: []string{ccv3.MaxPerPage}},
+			}))
+			Expect(fakeCloudControllerClient.GetApplicationDropletCurrentCallCount()).To(Equal(4))
+
+			Expect(usages).To(Equal([]BuildpackUsage{
+				{
+					App: apps[2], SpaceName: "prod", OrgName: "org-a", DropletGUID: "go-droplet", Stack: "cflinuxfs4",
+					BuildpackName: "go_buildpack", Version: "1.10", StagedAt: time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC),
+				},
+				{
+					App: apps[1], SpaceName: "dev", OrgName: "org-b", DropletGUID: "new-java-droplet", Stack: "cflinuxfs4",
+					BuildpackName: "java_buildpack", Version: "4.2", StagedAt: time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC),
+				},
+				{
+					App: apps[0], SpaceName: "dev", OrgName: "org-b", DropletGUID: "old-java-droplet", Stack: "cflinuxfs4",
+					BuildpackName: "java_buildpack", Version: "4.1", StagedAt: time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC),
+					Outdated: true,
+				},
+			}))
+		})
+
+		When("a buildpack name is given", func() {
+			BeforeEach(func() {
+				buildpackName = "java_buildpack"
+			})
+
+			It("only returns the usage of that buildpack", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(usages).To(HaveLen(2))
+				Expect(usages[0].BuildpackName).To(Equal("java_buildpack"))
+				Expect(usages[1].BuildpackName).To(Equal("java_buildpack"))
+			})
+		})
+
+		When("the buildpack does not exist", func() {
+			BeforeEach(func() {
+				buildpackName = "missing_buildpack"
+			})
+
+			It("returns a buildpack not found error", func() {
+				Expect(executeErr).To(MatchError(actionerror.BuildpackNotFoundError{BuildpackName: "missing_buildpack"}))
+				Expect(fakeCloudControllerClient.GetApplicationsCallCount()).To(Equal(0))
+			})
+		})
+
+		When("getting a current droplet fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationDropletCurrentStub = nil
+				fakeCloudControllerClient.GetApplicationDropletCurrentReturns(resources.Droplet{}, ccv3.Warnings{"droplet warning"}, errors.New("boom"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("boom"))
+				Expect(warnings).To(ContainElement("droplet warning"))
+			})
+		})
+	})
+})
@@ -46,11 +46,13 @@ type CloudControllerClient interface {
 	DeleteApplicationProcessInstance(appGUID string, processType string, instanceIndex int) (ccv3.Warnings, error)
 	DeleteBuildpack(buildpackGUID string) (ccv3.JobURL, ccv3.Warnings, error)
 	DeleteDomain(domainGUID string) (ccv3.JobURL, ccv3.Warnings, error)
+	DeleteDroplet(dropletGUID string) (ccv3.JobURL, ccv3.Warnings, error)
 	DeleteIsolationSegment(guid string) (ccv3.Warnings, error)
 	DeleteIsolationSegmentOrganization(isolationSegmentGUID string, organizationGUID string) (ccv3.Warnings, error)
 	DeleteOrganization(orgGUID string) (ccv3.JobURL, ccv3.Warnings, error)
 	DeleteOrganizationQuota(quotaGUID string) (ccv3.JobURL, ccv3.Warnings, error)
 	DeleteOrphanedRoutes(spaceGUID string) (ccv3.JobURL, ccv3.Warnings, error)
+	DeletePackage(packageGUID string) (ccv3.JobURL, ccv3.Warnings, error)
 	DeleteRole(roleGUID string) (ccv3.JobURL, ccv3.Warnings, error)
 	DeleteRoute(routeGUID string) (ccv3.JobURL, ccv3.Warnings, error)
 	DeleteRouteBinding(guid string) (ccv3.JobURL, ccv3.Warnings, error)
@@ -88,6 +90,7 @@ type CloudControllerClient interface {
 	GetEvents(query ...ccv3.Query) ([]ccv3.Event, ccv3.Warnings, error)
 	GetFeatureFlag(featureFlagName string) (resources.FeatureFlag, ccv3.Warnings, error)
 	GetFeatureFlags() ([]resources.FeatureFlag, ccv3.Warnings, error)
+	GetOrganizationUsageSummary(orgGUID string) (resources.UsageSummary, ccv3.Warnings, error)
 	GetRoot() (ccv3.Root, ccv3.Warnings, error)
 	GetInfo() (ccv3.Info, ccv3.Warnings, error)
 	GetIsolationSegment(guid string) (resources.IsolationSegment, ccv3.Warnings, error)
@@ -117,6 +120,7 @@ type CloudControllerClient interface {
 	GetServiceCredentialBindings(query ...ccv3.Query) ([]resources.ServiceCredentialBinding, ccv3.Warnings, error)
 	GetServiceCredentialBindingDetails(guid string) (resources.ServiceCredentialBindingDetails, ccv3.Warnings, error)
 	GetServiceInstanceByNameAndSpace(name, spaceGUID string, query ...ccv3.Query) (resources.ServiceInstance, ccv3.IncludedResources, ccv3.Warnings, error)
+	GetServiceInstanceCredentials(serviceInstanceGUID string) (types.JSONObject, ccv3.Warnings, error)
 	GetServiceInstanceParameters(serviceInstanceGUID string) (types.JSONObject, ccv3.Warnings, error)
 	GetServiceInstanceSharedSpaces(serviceInstanceGUID string) ([]ccv3.SpaceWithOrganization, ccv3.Warnings, error)
 	GetServiceInstanceUsageSummary(serviceInstanceGUID string) ([]resources.ServiceInstanceUsageSummary, ccv3.Warnings, error)
@@ -132,10 +136,12 @@ type CloudControllerClient interface {
 	GetSpaceIsolationSegment(spaceGUID string) (resources.Relationship, ccv3.Warnings, error)
 	GetSpaceManifestDiff(spaceGUID string, rawManifest []byte) (resources.ManifestDiff, ccv3.Warnings, error)
 	GetSpaceQuota(spaceQuotaGUID string) (resources.SpaceQuota, ccv3.Warnings, error)
+	GetSpaceUsageSummary(spaceGUID string) (resources.UsageSummary, ccv3.Warnings, error)
 	GetSpaces(query ...ccv3.Query) ([]resources.Space, ccv3.IncludedResources, ccv3.Warnings, error)
 	GetSpaceQuotas(query ...ccv3.Query) ([]resources.SpaceQuota, ccv3.Warnings, error)
 	GetSSHEnabled(appGUID string) (ccv3.SSHEnabled, ccv3.Warnings, error)
 	GetAppFeature(appGUID string, featureName string) (resources.ApplicationFeature, ccv3.Warnings, error)
+	GetAppFeatures(appGUID string) ([]resources.ApplicationFeature, ccv3.Warnings, error)
 	GetStacks(query ...ccv3.Query) ([]resources.Stack, ccv3.Warnings, error)
 	GetStagingSecurityGroups(spaceGUID string, queries ...ccv3.Query) ([]resources.SecurityGroup, ccv3.Warnings, error)
 	GetTask(guid string) (resources.Task, ccv3.Warnings, error)
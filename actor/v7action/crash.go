@@ -0,0 +1,192 @@
+package v7action
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/util/generic"
+)
+
+type CrashCategory string
+
+const (
+	CrashCategoryOutOfMemory  CrashCategory = "out of memory"
+	CrashCategoryHealthCheck  CrashCategory = "health check failure"
+	CrashCategoryNonZeroExit  CrashCategory = "non-zero exit"
+	CrashCategoryUnclassified CrashCategory = "other"
+)
+
+// crashEventTypes are the audit event types recorded when an app instance
+// crashes; app.crash is the name used by older Cloud Controllers.
+var crashEventTypes = []string{"audit.app.process.crash", "app.crash"}
+
+// crashLogReadLimit is how many log lines are read before each crash. The logs
+// of every instance are interleaved, so more lines are read than displayed.
+const crashLogReadLimit = 200
+
+var exitStatusRegexp = regexp.MustCompile(`[Ee]xited with status (-?\d+)`)
+
+type AppCrash struct {
+	Time            time.Time
+	ProcessType     string
+	Index           int
+	InstanceGUID    string
+	Reason          string
+	ExitDescription string
+	Category        CrashCategory
+	// RevisionVersion is the version of the latest revision created before
+	// the crash, or 0 when the app has no revisions.
+	RevisionVersion int
+	// Logs are the last log lines of the crashed instance before the crash.
+	Logs []sharedaction.LogMessage
+}
+
+// GetApplicationCrashes returns the crashes of the app since the given time,
+// oldest first, each with up to logLines log lines that preceded it. Failing
+// to read logs does not fail the report; it is returned as a warning instead.
+func (actor Actor) GetApplicationCrashes(appGUID string, since time.Time, logLines int, client sharedaction.LogCacheClient) ([]AppCrash, Warnings, error) {
+	var allWarnings Warnings
+
+	ccEvents, warnings, err := actor.CloudControllerClient.GetEvents(
+		ccv3.Query{Key: ccv3.TargetGUIDFilter, Values: []string{appGUID}},
+		ccv3.Query{Key: ccv3.EventTypesFilter, Values: crashEventTypes},
+		ccv3.Query{Key: ccv3.CreatedAtsAfterFilter, Values: []string{since.UTC().Format(time.RFC3339)}},
+		ccv3.Query{Key: ccv3.OrderBy, Values: []string{ccv3.CreatedAtAscendingOrder}},
+		ccv3.Query{Key: ccv3.PerPage, Values: []string{ccv3.MaxPerPage}},
+	)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	if len(ccEvents) == 0 {
+		return nil, allWarnings, nil
+	}
+
+	revisions, warnings, err := actor.CloudControllerClient.GetApplicationRevisions(appGUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	var crashes []AppCrash
+	logsFailed := false
+	for _, ccEvent := range ccEvents {
+		crash := convertCrashEvent(ccEvent)
+		crash.RevisionVersion = revisionAt(revisions, crash.Time)
+
+		if logLines > 0 && !logsFailed {
+			logs, err := sharedaction.GetLogsBefore(appGUID, crash.Time.Add(time.Second), crashLogReadLimit, client)
+			if err != nil {
+				allWarnings = append(allWarnings, err.Error())
+				logsFailed = true
+			} else {
+				crash.Logs = instanceLogs(logs, crash, logLines)
+			}
+		}
+
+		crashes = append(crashes, crash)
+	}
+
+	return crashes, allWarnings, nil
+}
+
+func convertCrashEvent(ccEvent ccv3.Event) AppCrash {
+	data := generic.NewMap(ccEvent.Data)
+
+	crash := AppCrash{
+		Time:            ccEvent.CreatedAt,
+		ProcessType:     stringValue(data.Get("process_type")),
+		InstanceGUID:    stringValue(data.Get("instance")),
+		Reason:          stringValue(data.Get("reason")),
+		ExitDescription: stringValue(data.Get("exit_description")),
+	}
+	switch index := data.Get("index").(type) {
+	case json.Number:
+		if value, err := index.Int64(); err == nil {
+			crash.Index = int(value)
+		}
+	case float64:
+		crash.Index = int(index)
+	}
+	crash.Category = categorizeCrash(crash.ExitDescription)
+
+	return crash
+}
+
+func categorizeCrash(exitDescription string) CrashCategory {
+	description := strings.ToLower(exitDescription)
+
+	switch {
+	case strings.Contains(description, "out of memory") || strings.Contains(description, "oomkilled"):
+		return CrashCategoryOutOfMemory
+	case strings.Contains(description, "health check") || strings.Contains(description, "never healthy"):
+		return CrashCategoryHealthCheck
+	}
+
+	if matches := exitStatusRegexp.FindStringSubmatch(exitDescription); matches != nil && matches[1] != "0" {
+		return CrashCategoryNonZeroExit
+	}
+
+	return CrashCategoryUnclassified
+}
+
+// revisionAt returns the version of the latest revision created at or
+// before t.
+func revisionAt(revisions []resources.Revision, t time.Time) int {
+	sorted := make([]resources.Revision, len(revisions))
+	copy(sorted, revisions)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Version < sorted[j].Version })
+
+	version := 0
+	for _, revision := range sorted {
+		createdAt, err := time.Parse(time.RFC3339, revision.CreatedAt)
+		if err != nil || createdAt.After(t) {
+			continue
+		}
+		version = revision.Version
+	}
+	return version
+}
+
+// instanceLogs keeps the last n app logs of the crashed instance. When the
+// crash event does not record the process type, logs of any process with the
+// same index are kept.
+func instanceLogs(logs []sharedaction.LogMessage, crash AppCrash, n int) []sharedaction.LogMessage {
+	var kept []sharedaction.LogMessage
+	for _, log := range logs {
+		if log.SourceInstance() != fmt.Sprint(crash.Index) {
+			continue
+		}
+
+		sourceType := strings.ToUpper(log.SourceType())
+		if crash.ProcessType == "" {
+			if !strings.HasPrefix(sourceType, "APP/PROC/") {
+				continue
+			}
+		} else if sourceType != "APP/PROC/"+strings.ToUpper(crash.ProcessType) {
+			continue
+		}
+
+		kept = append(kept, log)
+	}
+
+	if len(kept) > n {
+		kept = kept[len(kept)-n:]
+	}
+	return kept
+}
+
+func stringValue(value interface{}) string {
+	if value == nil {
+		return ""
+	}
+	return formatDescriptionPart(value)
+}
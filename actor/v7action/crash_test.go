@@ -0,0 +1,222 @@
+package v7action_test
+
+import (
+	"encoding/json"
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction/sharedactionfakes"
+	. "code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/actor/v7action/v7actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/go-loggregator/v9/rpc/loggregator_v2"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Crash Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v7actionfakes.FakeCloudControllerClient
+		fakeLogCacheClient        *sharedactionfakes.FakeLogCacheClient
+	)
+
+	BeforeEach(func() {
+		actor, fakeCloudControllerClient, _, _, _, _, _ = NewTestActor()
+		fakeLogCacheClient = new(sharedactionfakes.FakeLogCacheClient)
+	})
+
+	Describe("GetApplicationCrashes", func() {
+		var (
+			since    time.Time
+			logLines int
+			crashes  []AppCrash
+			warnings Warnings
+			err      error
+		)
+
+		logEnvelope := func(timestamp time.Time, sourceType string, instance string, payload string) *loggregator_v2.Envelope {
+			return &loggregator_v2.Envelope{
+				Timestamp:  timestamp.UnixNano(),
+				SourceId:   "some-app-guid",
+				InstanceId: instance,
+				Tags:       map[string]string{"source_type": sourceType},
+				Message: &loggregator_v2.Envelope_Log{
+					Log: &loggregator_v2.Log{
+						Payload: []byte(payload),
+						Type:    loggregator_v2.Log_OUT,
+					},
+				},
+			}
+		}
+
+		BeforeEach(func() {
+			since = time.Date(2024, 3, 5, 0, 0, 0, 0, time.UTC)
+			logLines = 2
+
+			fakeCloudControllerClient.GetEventsReturns([]ccv3.Event{
+				{
+					CreatedAt: time.Date(2024, 3, 5, 10, 0, 0, 0, time.UTC),
+					Type:      "audit.app.process.crash",
+					Data: map[string]interface{}{
+						"index":            json.Number("0"),
+						"instance":         "instance-guid-0",
+						"process_type":     "web",
+						"reason":           "CRASHED",
+						"exit_description": "APP/PROC/WEB: Exited with status 137 (out of memory)",
+					},
+				},
+				{
+					CreatedAt: time.Date(2024, 3, 5, 12, 0, 0, 0, time.UTC),
+					Type:      "audit.app.process.crash",
+					Data: map[string]interface{}{
+						"index":            json.Number("1"),
+						"reason":           "CRASHED",
+						"exit_description": "Instance never healthy after 1m0s: Failed to make TCP connection to port 8080",
+					},
+				},
+				{
+					CreatedAt: time.Date(2024, 3, 5, 13, 0, 0, 0, time.UTC),
+					Type:      "app.crash",
+					Data: map[string]interface{}{
+						"index":            json.Number("0"),
+						"exit_description": "APP/PROC/WEB: Exited with status 1",
+					},
+				},
+				{
+					CreatedAt: time.Date(2024, 3, 5, 14, 0, 0, 0, time.UTC),
+					Type:      "audit.app.process.crash",
+					Data: map[string]interface{}{
+						"index":            json.Number("0"),
+						"exit_description": "something unexpected",
+					},
+				},
+			}, ccv3.Warnings{"events-warning"}, nil)
+
+			fakeCloudControllerClient.GetApplicationRevisionsReturns([]resources.Revision{
+				{Version: 2, CreatedAt: "2024-03-05T11:00:00Z"},
+				{Version: 1, CreatedAt: "2024-03-01T00:00:00Z"},
+			}, ccv3.Warnings{"revisions-warning"}, nil)
+
+			fakeLogCacheClient.ReadReturns([]*loggregator_v2.Envelope{
+				logEnvelope(time.Date(2024, 3, 5, 9, 59, 59, 0, time.UTC), "APP/PROC/WEB", "0", "third"),
+				logEnvelope(time.Date(2024, 3, 5, 9, 59, 58, 0, time.UTC), "APP/PROC/WEB", "1", "other instance"),
+				logEnvelope(time.Date(2024, 3, 5, 9, 59, 57, 0, time.UTC), "APP/PROC/WEB", "0", "second"),
+				logEnvelope(time.Date(2024, 3, 5, 9, 59, 56, 0, time.UTC), "RTR", "0", "router"),
+				logEnvelope(time.Date(2024, 3, 5, 9, 59, 55, 0, time.UTC), "APP/PROC/WEB", "0", "first"),
+			}, nil)
+		})
+
+		JustBeforeEach(func() {
+			crashes, warnings, err = actor.GetApplicationCrashes("some-app-guid", since, logLines, fakeLogCacheClient)
+		})
+
+		It("queries the crash events of the app since the given time", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("events-warning", "revisions-warning"))
+
+			Expect(fakeCloudControllerClient.GetEventsCallCount()).To(Equal(1))
+			Expect(fakeCloudControllerClient.GetEventsArgsForCall(0)).To(ConsistOf(
+				ccv3.Query{Key: ccv3.TargetGUIDFilter, Values: []string{"some-app-guid"}},
+				ccv3.Query{Key: ccv3.EventTypesFilter, Values: []string{"audit.app.process.crash", "app.crash"}},
+				ccv3.Query{Key: ccv3.CreatedAtsAfterFilter, Values: []string{"2024-03-05T00:00:00Z"}},
+				ccv3.Query{Key: ccv3.OrderBy, Values: []string{ccv3.CreatedAtAscendingOrder}},
+				ccv3.Query{Key: ccv3.PerPage, Values: []string{ccv3.MaxPerPage}},
+			))
+
+			appGUID, _ := fakeCloudControllerClient.GetApplicationRevisionsArgsForCall(0)
+			Expect(appGUID).To(Equal("some-app-guid"))
+		})
+
+		It("categorizes each crash and correlates it with the revision it ran", func() {
+			Expect(crashes).To(HaveLen(4))
+
+			Expect(crashes[0].ProcessType).To(Equal("web"))
+			Expect(crashes[0].Index).To(Equal(0))
+			Expect(crashes[0].InstanceGUID).To(Equal("instance-guid-0"))
+			Expect(crashes[0].Reason).To(Equal("CRASHED"))
+			Expect(crashes[0].Category).To(Equal(CrashCategoryOutOfMemory))
+			Expect(crashes[0].RevisionVersion).To(Equal(1))
+
+			Expect(crashes[1].Index).To(Equal(1))
+			Expect(crashes[1].Category).To(Equal(CrashCategoryHealthCheck))
+			Expect(crashes[1].RevisionVersion).To(Equal(2))
+
+			Expect(crashes[2].Category).To(Equal(CrashCategoryNonZeroExit))
+			Expect(crashes[3].Category).To(Equal(CrashCategoryUnclassified))
+		})
+
+		It("keeps the last log lines of the crashed instance", func() {
+			Expect(fakeLogCacheClient.ReadCallCount()).To(Equal(4))
+			_, sourceID, _, _ := fakeLogCacheClient.ReadArgsForCall(0)
+			Expect(sourceID).To(Equal("some-app-guid"))
+
+			Expect(crashes[0].Logs).To(HaveLen(2))
+			Expect(crashes[0].Logs[0].Message()).To(Equal("second"))
+			Expect(crashes[0].Logs[1].Message()).To(Equal("third"))
+
+			Expect(crashes[1].Logs).To(HaveLen(1))
+			Expect(crashes[1].Logs[0].Message()).To(Equal("other instance"))
+		})
+
+		When("no log lines are requested", func() {
+			BeforeEach(func() {
+				logLines = 0
+			})
+
+			It("does not read from Log Cache", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(fakeLogCacheClient.ReadCallCount()).To(Equal(0))
+				Expect(crashes[0].Logs).To(BeEmpty())
+			})
+		})
+
+		When("reading logs fails", func() {
+			BeforeEach(func() {
+				fakeLogCacheClient.ReadReturns(nil, errors.New("log-cache-error"))
+			})
+
+			It("returns the crashes with a warning and stops reading logs", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(crashes).To(HaveLen(4))
+				Expect(warnings).To(ContainElement("Failed to retrieve logs from Log Cache: log-cache-error"))
+				Expect(fakeLogCacheClient.ReadCallCount()).To(Equal(1))
+			})
+		})
+
+		When("there are no crashes", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetEventsReturns(nil, ccv3.Warnings{"events-warning"}, nil)
+			})
+
+			It("returns no crashes without looking up revisions", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(crashes).To(BeEmpty())
+				Expect(fakeCloudControllerClient.GetApplicationRevisionsCallCount()).To(Equal(0))
+			})
+		})
+
+		When("getting the events fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetEventsReturns(nil, ccv3.Warnings{"events-warning"}, errors.New("events-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(err).To(MatchError("events-error"))
+				Expect(warnings).To(ConsistOf("events-warning"))
+			})
+		})
+
+		When("getting the revisions fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationRevisionsReturns(nil, ccv3.Warnings{"revisions-warning"}, errors.New("revisions-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(err).To(MatchError("revisions-error"))
+				Expect(warnings).To(ConsistOf("events-warning", "revisions-warning"))
+			})
+		})
+	})
+})
@@ -0,0 +1,155 @@
+package v7action
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// redactedMessageKey replaces the system and application environment when
+// the user is not allowed to see them.
+const redactedMessageKey = "redacted_message"
+
+var nonEnvVarCharacters = regexp.MustCompile(`[^A-Z0-9_]+`)
+
+// ExportedEnvironment is the environment an app sees at runtime, flattened
+// into plain string variables suitable for writing to a file.
+type ExportedEnvironment struct {
+	Variables map[string]string
+	// Skipped lists flattened credential names that were not exported
+	// because a variable with the same name already exists.
+	Skipped []string
+	// Redacted is set when the Cloud Controller hid the system or application
+	// environment, so VCAP_SERVICES or VCAP_APPLICATION are missing.
+	Redacted bool
+}
+
+// ExportEnvironment combines the VCAP_SERVICES, VCAP_APPLICATION, running
+// group and user-provided variables of an app the same way they are presented
+// to the app at runtime, with structured values encoded as JSON strings.
+//
+// When flattenCredentials is set, the credentials of every service binding are
+// also exported as separate variables named after the binding and the
+// credential key, e.g. the uri credential of a binding named db becomes
+// DB_URI.
+func ExportEnvironment(envGroups EnvironmentVariableGroups, flattenCredentials bool) (ExportedEnvironment, error) {
+	exported := ExportedEnvironment{Variables: map[string]string{}}
+
+	system, application := envGroups.System, envGroups.Application
+	if _, ok := system[redactedMessageKey]; ok {
+		exported.Redacted = true
+		system = nil
+	}
+	if _, ok := application[redactedMessageKey]; ok {
+		exported.Redacted = true
+		application = nil
+	}
+
+	for _, group := range []map[string]interface{}{envGroups.Running, system, application, envGroups.EnvironmentVariables} {
+		for key, value := range group {
+			stringValue, err := envVarValue(value)
+			if err != nil {
+				return ExportedEnvironment{}, err
+			}
+			exported.Variables[key] = stringValue
+		}
+	}
+
+	if !flattenCredentials {
+		return exported, nil
+	}
+
+	flattened := map[string]string{}
+	for _, binding := range serviceBindings(system["VCAP_SERVICES"]) {
+		name, _ := binding["name"].(string)
+		credentials, ok := binding["credentials"].(map[string]interface{})
+		if name == "" || !ok {
+			continue
+		}
+
+		err := flattenCredential(envVarName(name), credentials, flattened)
+		if err != nil {
+			return ExportedEnvironment{}, err
+		}
+	}
+
+	for key, value := range flattened {
+		if _, exists := exported.Variables[key]; exists {
+			exported.Skipped = append(exported.Skipped, key)
+			continue
+		}
+		exported.Variables[key] = value
+	}
+	sort.Strings(exported.Skipped)
+
+	return exported, nil
+}
+
+func serviceBindings(vcapServices interface{}) []map[string]interface{} {
+	offerings, ok := vcapServices.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	offeringNames := make([]string, 0, len(offerings))
+	for offeringName := range offerings {
+		offeringNames = append(offeringNames, offeringName)
+	}
+	sort.Strings(offeringNames)
+
+	var bindings []map[string]interface{}
+	for _, offeringName := range offeringNames {
+		instances, _ := offerings[offeringName].([]interface{})
+		for _, instance := range instances {
+			if binding, ok := instance.(map[string]interface{}); ok {
+				bindings = append(bindings, binding)
+			}
+		}
+	}
+	return bindings
+}
+
+func flattenCredential(prefix string, value interface{}, flattened map[string]string) error {
+	if nested, ok := value.(map[string]interface{}); ok {
+		for key, nestedValue := range nested {
+			err := flattenCredential(prefix+"_"+envVarName(key), nestedValue, flattened)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	stringValue, err := envVarValue(value)
+	if err != nil {
+		return err
+	}
+	flattened[prefix] = stringValue
+	return nil
+}
+
+func envVarName(name string) string {
+	return strings.Trim(nonEnvVarCharacters.ReplaceAllString(strings.ToUpper(name), "_"), "_")
+}
+
+func envVarValue(value interface{}) (string, error) {
+	switch typedValue := value.(type) {
+	case string:
+		return typedValue, nil
+	case nil:
+		return "", nil
+	case float64:
+		return strconv.FormatFloat(typedValue, 'f', -1, 64), nil
+	case map[string]interface{}, []interface{}:
+		raw, err := json.Marshal(typedValue)
+		if err != nil {
+			return "", err
+		}
+		return string(raw), nil
+	default:
+		return fmt.Sprint(typedValue), nil
+	}
+}
@@ -11,11 +11,33 @@ import (
 )
 
 type Event struct {
-	GUID        string
-	Time        time.Time
-	Type        string
-	ActorName   string
-	Description string
+	GUID             string
+	Time             time.Time
+	Type             string
+	ActorName        string
+	ActorType        string
+	TargetGUID       string
+	TargetType       string
+	TargetName       string
+	SpaceGUID        string
+	OrganizationGUID string
+	Description      string
+}
+
+// EventFilter narrows down the audit events returned by GetEvents. Empty
+// fields are not filtered on. All fields except ActorName and TargetType are
+// sent as query filters. The audit events endpoint does not support filtering
+// on those two, so they are applied to the results, and a search across the
+// foundation should be bounded by Since.
+type EventFilter struct {
+	TargetGUIDs       []string
+	SpaceGUIDs        []string
+	OrganizationGUIDs []string
+	Types             []string
+	ActorName         string
+	TargetType        string
+	Since             time.Time
+	Until             time.Time
 }
 
 func (actor Actor) GetRecentEventsByApplicationNameAndSpace(appName string, spaceGUID string) ([]Event, Warnings, error) {
@@ -40,19 +62,72 @@ func (actor Actor) GetRecentEventsByApplicationNameAndSpace(appName string, spac
 
 	var events []Event
 	for _, ccEvent := range ccEvents {
-		events = append(events, Event{
-			GUID:        ccEvent.GUID,
-			Time:        ccEvent.CreatedAt,
-			Type:        ccEvent.Type,
-			ActorName:   ccEvent.ActorName,
-			Description: generateDescription(ccEvent.Data),
-		})
-
+		events = append(events, convertEvent(ccEvent))
 	}
 
 	return events, allWarnings, nil
 }
 
+// GetEvents returns every audit event matching the filter, most recent first.
+func (actor Actor) GetEvents(filter EventFilter) ([]Event, Warnings, error) {
+	queries := []ccv3.Query{
+		{Key: ccv3.OrderBy, Values: []string{ccv3.CreatedAtDescendingOrder}},
+		{Key: ccv3.PerPage, Values: []string{ccv3.MaxPerPage}},
+	}
+	if len(filter.TargetGUIDs) > 0 {
+		queries = append(queries, ccv3.Query{Key: ccv3.TargetGUIDFilter, Values: filter.TargetGUIDs})
+	}
+	if len(filter.SpaceGUIDs) > 0 {
+		queries = append(queries, ccv3.Query{Key: ccv3.SpaceGUIDFilter, Values: filter.SpaceGUIDs})
+	}
+	if len(filter.OrganizationGUIDs) > 0 {
+		queries = append(queries, ccv3.Query{Key: ccv3.OrganizationGUIDFilter, Values: filter.OrganizationGUIDs})
+	}
+	if len(filter.Types) > 0 {
+		queries = append(queries, ccv3.Query{Key: ccv3.EventTypesFilter, Values: filter.Types})
+	}
+	if !filter.Since.IsZero() {
+		queries = append(queries, ccv3.Query{Key: ccv3.CreatedAtsAfterFilter, Values: []string{filter.Since.UTC().Format(time.RFC3339)}})
+	}
+	if !filter.Until.IsZero() {
+		queries = append(queries, ccv3.Query{Key: ccv3.CreatedAtsBeforeFilter, Values: []string{filter.Until.UTC().Format(time.RFC3339)}})
+	}
+
+	ccEvents, warnings, err := actor.CloudControllerClient.GetEvents(queries...)
+	if err != nil {
+		return nil, Warnings(warnings), err
+	}
+
+	var events []Event
+	for _, ccEvent := range ccEvents {
+		if filter.ActorName != "" && ccEvent.ActorName != filter.ActorName {
+			continue
+		}
+		if filter.TargetType != "" && ccEvent.TargetType != filter.TargetType {
+			continue
+		}
+		events = append(events, convertEvent(ccEvent))
+	}
+
+	return events, Warnings(warnings), nil
+}
+
+func convertEvent(ccEvent ccv3.Event) Event {
+	return Event{
+		GUID:             ccEvent.GUID,
+		Time:             ccEvent.CreatedAt,
+		Type:             ccEvent.Type,
+		ActorName:        ccEvent.ActorName,
+		ActorType:        ccEvent.ActorType,
+		TargetGUID:       ccEvent.TargetGUID,
+		TargetType:       ccEvent.TargetType,
+		TargetName:       ccEvent.TargetName,
+		SpaceGUID:        ccEvent.SpaceGUID,
+		OrganizationGUID: ccEvent.OrganizationGUID,
+		Description:      generateDescription(ccEvent.Data),
+	}
+}
+
 var knownMetadataKeys = []string{
 	"index",
 	"reason",
@@ -2,6 +2,7 @@ package v7action_test
 
 import (
 	"errors"
+	"time"
 
 	. "code.cloudfoundry.org/cli/actor/v7action"
 	"code.cloudfoundry.org/cli/actor/v7action/v7actionfakes"
@@ -123,4 +124,98 @@ var _ = Describe("Event Actions", func() {
 			})
 		})
 	})
+
+	Describe("GetEvents", func() {
+		var (
+			filter   EventFilter
+			events   []Event
+			warnings Warnings
+			err      error
+		)
+
+		BeforeEach(func() {
+			filter = EventFilter{}
+			fakeCloudControllerClient.GetEventsReturns(
+				[]ccv3.Event{
+					{GUID: "event-1", Type: "audit.route.delete-request", ActorName: "alice", TargetType: "route", TargetName: "some-route", Data: map[string]interface{}{"recursive": true}},
+					{GUID: "event-2", Type: "audit.app.update", ActorName: "bob", TargetType: "app", TargetName: "some-app"},
+					{GUID: "event-3", Type: "audit.app.update", ActorName: "alice", TargetType: "app", TargetName: "some-app"},
+				},
+				ccv3.Warnings{"get-events-warning"},
+				nil,
+			)
+		})
+
+		JustBeforeEach(func() {
+			events, warnings, err = actor.GetEvents(filter)
+		})
+
+		When("no filter is given", func() {
+			It("returns every event, most recent first", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-events-warning"))
+				Expect(events).To(Equal([]Event{
+					{GUID: "event-1", Type: "audit.route.delete-request", ActorName: "alice", TargetType: "route", TargetName: "some-route", Description: "recursive: true"},
+					{GUID: "event-2", Type: "audit.app.update", ActorName: "bob", TargetType: "app", TargetName: "some-app"},
+					{GUID: "event-3", Type: "audit.app.update", ActorName: "alice", TargetType: "app", TargetName: "some-app"},
+				}))
+
+				Expect(fakeCloudControllerClient.GetEventsCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetEventsArgsForCall(0)).To(ConsistOf(
+					ccv3.Query{Key: ccv3.OrderBy, Values: []string{ccv3.CreatedAtDescendingOrder}},
+					ccv3.Query{Key: ccv3.PerPage, Values: []string{ccv3.MaxPerPage}},
+				))
+			})
+		})
+
+		When("filters supported by the API are given", func() {
+			BeforeEach(func() {
+				filter = EventFilter{
+					TargetGUIDs:       []string{"target-guid"},
+					SpaceGUIDs:        []string{"space-guid"},
+					OrganizationGUIDs: []string{"org-guid"},
+					Types:             []string{"audit.app.update", "audit.app.delete-request"},
+					Since:             time.Date(2024, 3, 5, 10, 0, 0, 0, time.UTC),
+					Until:             time.Date(2024, 3, 6, 10, 0, 0, 0, time.UTC),
+				}
+			})
+
+			It("passes them as queries", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(fakeCloudControllerClient.GetEventsArgsForCall(0)).To(ConsistOf(
+					ccv3.Query{Key: ccv3.OrderBy, Values: []string{ccv3.CreatedAtDescendingOrder}},
+					ccv3.Query{Key: ccv3.PerPage, Values: []string{ccv3.MaxPerPage}},
+					ccv3.Query{Key: ccv3.TargetGUIDFilter, Values: []string{"target-guid"}},
+					ccv3.Query{Key: ccv3.SpaceGUIDFilter, Values: []string{"space-guid"}},
+					ccv3.Query{Key: ccv3.OrganizationGUIDFilter, Values: []string{"org-guid"}},
+					ccv3.Query{Key: ccv3.EventTypesFilter, Values: []string{"audit.app.update", "audit.app.delete-request"}},
+					ccv3.Query{Key: ccv3.CreatedAtsAfterFilter, Values: []string{"2024-03-05T10:00:00Z"}},
+					ccv3.Query{Key: ccv3.CreatedAtsBeforeFilter, Values: []string{"2024-03-06T10:00:00Z"}},
+				))
+			})
+		})
+
+		When("an actor name and target type are given", func() {
+			BeforeEach(func() {
+				filter = EventFilter{ActorName: "alice", TargetType: "app"}
+			})
+
+			It("only returns the matching events", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(events).To(HaveLen(1))
+				Expect(events[0].GUID).To(Equal("event-3"))
+			})
+		})
+
+		When("the cc client returns an error", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetEventsReturns(nil, ccv3.Warnings{"get-events-warning"}, errors.New("failed to get events"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(err).To(MatchError("failed to get events"))
+				Expect(warnings).To(ConsistOf("get-events-warning"))
+			})
+		})
+	})
 })
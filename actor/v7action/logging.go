@@ -7,6 +7,8 @@ import (
 	"time"
 
 	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/resources"
 	"github.com/SermoDigital/jose/jws"
 )
 
@@ -47,6 +49,73 @@ func (actor Actor) GetRecentLogsForApplicationByNameAndSpace(appName string, spa
 	return logMessages, allWarnings, nil
 }
 
+// TaskLogSourceType returns the source type Log Cache attaches to the log
+// envelopes emitted by the task with the given name.
+func TaskLogSourceType(taskName string) string {
+	return "APP/TASK/" + taskName
+}
+
+// GetRecentLogsForTask returns the logs emitted by the given task of the
+// application since the task was created. For completed tasks, logs emitted
+// after the task completed, such as those of a later run of a task with the
+// same name, are left out.
+func (actor Actor) GetRecentLogsForTask(appGUID string, task resources.Task, client sharedaction.LogCacheClient) ([]sharedaction.LogMessage, error) {
+	createdAt, err := time.Parse(time.RFC3339, task.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	var completedAt time.Time
+	if task.State == constant.TaskSucceeded || task.State == constant.TaskFailed {
+		completedAt, err = time.Parse(time.RFC3339, task.UpdatedAt)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	logMessages, err := sharedaction.GetLogsSince(appGUID, createdAt, client)
+	if err != nil {
+		return nil, err
+	}
+
+	var taskMessages []sharedaction.LogMessage
+	for _, message := range logMessages {
+		// The completion time only has second precision, so the log lines
+		// emitted during the task's last second are compared by their second.
+		if !completedAt.IsZero() && message.Timestamp().Truncate(time.Second).After(completedAt) {
+			continue
+		}
+		if message.SourceType() == TaskLogSourceType(task.Name) {
+			taskMessages = append(taskMessages, message)
+		}
+	}
+
+	return taskMessages, nil
+}
+
+// GetStreamingLogsForTask tails the logs emitted by the given task of the
+// application, starting from when the task was created.
+func (actor Actor) GetStreamingLogsForTask(appGUID string, task resources.Task, client sharedaction.LogCacheClient) (<-chan sharedaction.LogMessage, <-chan error, context.CancelFunc, error) {
+	createdAt, err := time.Parse(time.RFC3339, task.CreatedAt)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	appMessages, logErrs, cancelFunc := sharedaction.GetStreamingLogsSince(appGUID, createdAt, client)
+
+	taskMessages := make(chan sharedaction.LogMessage, cap(appMessages))
+	go func() {
+		defer close(taskMessages)
+		for message := range appMessages {
+			if message.SourceType() == TaskLogSourceType(task.Name) {
+				taskMessages <- message
+			}
+		}
+	}()
+
+	return taskMessages, logErrs, cancelFunc, nil
+}
+
 func (actor Actor) ScheduleTokenRefresh(
 	after func(time.Duration) <-chan time.Time,
 	stop chan struct{},
@@ -10,6 +10,7 @@ import (
 	. "code.cloudfoundry.org/cli/actor/v7action"
 	"code.cloudfoundry.org/cli/actor/v7action/v7actionfakes"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
 	"code.cloudfoundry.org/cli/resources"
 	logcache "code.cloudfoundry.org/go-log-cache/v2"
 	"code.cloudfoundry.org/go-loggregator/v9/rpc/loggregator_v2"
@@ -137,6 +138,172 @@ var _ = Describe("Logging Actions", func() {
 		})
 	})
 
+	Describe("GetRecentLogsForTask", func() {
+		var (
+			task     resources.Task
+			messages []sharedaction.LogMessage
+			err      error
+		)
+
+		taskEnvelopeAt := func(timestamp time.Time, payload string, sourceType string) *loggregator_v2.Envelope {
+			return &loggregator_v2.Envelope{
+				Timestamp:  timestamp.UnixNano(),
+				SourceId:   "some-app-guid",
+				InstanceId: "0",
+				Message: &loggregator_v2.Envelope_Log{
+					Log: &loggregator_v2.Log{
+						Payload: []byte(payload),
+						Type:    loggregator_v2.Log_OUT,
+					},
+				},
+				Tags: map[string]string{
+					"source_type": sourceType,
+				},
+			}
+		}
+
+		taskEnvelope := func(payload string, sourceType string) *loggregator_v2.Envelope {
+			return taskEnvelopeAt(time.Date(2020, 1, 1, 0, 0, 1, 0, time.UTC), payload, sourceType)
+		}
+
+		BeforeEach(func() {
+			task = resources.Task{Name: "some-task", CreatedAt: "2020-01-01T00:00:00Z"}
+			fakeLogCacheClient.ReadReturns([]*loggregator_v2.Envelope{
+				taskEnvelope("task-message", "APP/TASK/some-task"),
+				taskEnvelope("web-message", "APP/PROC/WEB"),
+				taskEnvelope("other-task-message", "APP/TASK/other-task"),
+			}, nil)
+		})
+
+		JustBeforeEach(func() {
+			messages, err = actor.GetRecentLogsForTask("some-app-guid", task, fakeLogCacheClient)
+		})
+
+		It("returns only the logs of the task, read from when the task was created", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(messages).To(HaveLen(1))
+			Expect(messages[0].Message()).To(Equal("task-message"))
+
+			_, sourceID, start, _ := fakeLogCacheClient.ReadArgsForCall(0)
+			Expect(sourceID).To(Equal("some-app-guid"))
+			Expect(start).To(BeTemporally("==", time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)))
+		})
+
+		When("the task has completed", func() {
+			BeforeEach(func() {
+				task.State = constant.TaskSucceeded
+				task.UpdatedAt = "2020-01-01T00:00:02Z"
+				fakeLogCacheClient.ReadReturns([]*loggregator_v2.Envelope{
+					taskEnvelopeAt(time.Date(2020, 1, 1, 0, 0, 1, 0, time.UTC), "task-message", "APP/TASK/some-task"),
+					taskEnvelopeAt(time.Date(2020, 1, 1, 0, 0, 2, int(500*time.Millisecond), time.UTC), "exit-message", "APP/TASK/some-task"),
+					taskEnvelopeAt(time.Date(2020, 1, 1, 0, 0, 3, 0, time.UTC), "later-run-message", "APP/TASK/some-task"),
+				}, nil)
+			})
+
+			It("leaves out the logs emitted after the second the task completed in", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(messages).To(HaveLen(2))
+				Expect(messages[0].Message()).To(Equal("task-message"))
+				Expect(messages[1].Message()).To(Equal("exit-message"))
+			})
+
+			When("the task's completion time cannot be parsed", func() {
+				BeforeEach(func() {
+					task.UpdatedAt = "not-a-time"
+				})
+
+				It("returns an error without reading logs", func() {
+					Expect(err).To(HaveOccurred())
+					Expect(fakeLogCacheClient.ReadCallCount()).To(Equal(0))
+				})
+			})
+		})
+
+		When("the task's creation time cannot be parsed", func() {
+			BeforeEach(func() {
+				task.CreatedAt = "not-a-time"
+			})
+
+			It("returns an error without reading logs", func() {
+				Expect(err).To(HaveOccurred())
+				Expect(fakeLogCacheClient.ReadCallCount()).To(Equal(0))
+			})
+		})
+
+		When("Log Cache errors", func() {
+			BeforeEach(func() {
+				fakeLogCacheClient.ReadReturns(nil, errors.New("some-error"))
+			})
+
+			It("returns the error", func() {
+				Expect(err).To(MatchError("Failed to retrieve logs from Log Cache: some-error"))
+			})
+		})
+	})
+
+	Describe("GetStreamingLogsForTask", func() {
+		var (
+			messages      <-chan sharedaction.LogMessage
+			logErrs       <-chan error
+			stopStreaming context.CancelFunc
+			err           error
+		)
+
+		BeforeEach(func() {
+			fakeLogCacheClient.ReadStub = func(
+				ctx context.Context,
+				sourceID string,
+				start time.Time,
+				opts ...logcache.ReadOption,
+			) ([]*loggregator_v2.Envelope, error) {
+				if fakeLogCacheClient.ReadCallCount() > 1 {
+					stopStreaming()
+					return []*loggregator_v2.Envelope{}, ctx.Err()
+				}
+
+				envelope := func(payload string, sourceType string) *loggregator_v2.Envelope {
+					return &loggregator_v2.Envelope{
+						Timestamp: time.Now().Add(-3 * time.Second).UnixNano(),
+						SourceId:  sourceID,
+						Message: &loggregator_v2.Envelope_Log{
+							Log: &loggregator_v2.Log{
+								Payload: []byte(payload),
+								Type:    loggregator_v2.Log_OUT,
+							},
+						},
+						Tags: map[string]string{
+							"source_type": sourceType,
+						},
+					}
+				}
+				return []*loggregator_v2.Envelope{
+					envelope("web-message", "APP/PROC/WEB"),
+					envelope("task-message", "APP/TASK/some-task"),
+				}, ctx.Err()
+			}
+
+			messages, logErrs, stopStreaming, err = actor.GetStreamingLogsForTask(
+				"some-app-guid",
+				resources.Task{Name: "some-task", CreatedAt: time.Now().Add(-time.Minute).Format(time.RFC3339)},
+				fakeLogCacheClient,
+			)
+		})
+
+		AfterEach(func() {
+			Eventually(messages).Should(BeClosed())
+			Eventually(logErrs).Should(BeClosed())
+		})
+
+		It("passes only the task's logs through the messages channel", func() {
+			Expect(err).ToNot(HaveOccurred())
+
+			var message sharedaction.LogMessage
+			Eventually(messages).Should(Receive(&message))
+			Expect(message.Message()).To(Equal("task-message"))
+			Eventually(messages).Should(BeClosed())
+		})
+	})
+
 	Describe("GetStreamingLogsForApplicationByNameAndSpace", func() {
 		When("the application can be found", func() {
 			var (
@@ -1,14 +1,25 @@
 package v7action
 
 import (
+	"strings"
+
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/resources"
 )
 
 type ServiceOfferingWithPlans ccv3.ServiceOfferingWithPlans
 
 type MarketplaceFilter struct {
-	SpaceGUID, ServiceOfferingName, ServiceBrokerName string
-	ShowUnavailable                                   bool
+	SpaceGUID, ServiceOfferingName, ServiceBrokerName, ServicePlanName string
+	ShowUnavailable                                                    bool
+
+	// SearchText matches offerings by name, description or tag, and plans by
+	// name or description, ignoring case.
+	SearchText string
+	// Tag matches offerings with the tag, ignoring case.
+	Tag string
+	// FreeOnly and Bindable keep only plans that are free or bindable.
+	FreeOnly, Bindable bool
 }
 
 func (actor Actor) Marketplace(filter MarketplaceFilter) ([]ServiceOfferingWithPlans, Warnings, error) {
@@ -35,6 +46,13 @@ func (actor Actor) Marketplace(filter MarketplaceFilter) ([]ServiceOfferingWithP
 		})
 	}
 
+	if filter.ServicePlanName != "" {
+		query = append(query, ccv3.Query{
+			Key:    ccv3.NameFilter,
+			Values: []string{filter.ServicePlanName},
+		})
+	}
+
 	if !filter.ShowUnavailable {
 		query = append(query, ccv3.Query{
 			Key:    ccv3.AvailableFilter,
@@ -47,10 +65,53 @@ func (actor Actor) Marketplace(filter MarketplaceFilter) ([]ServiceOfferingWithP
 		return nil, Warnings(warnings), err
 	}
 
-	result := make([]ServiceOfferingWithPlans, len(serviceOffering))
-	for i := range serviceOffering {
-		result[i] = ServiceOfferingWithPlans(serviceOffering[i])
+	result := make([]ServiceOfferingWithPlans, 0, len(serviceOffering))
+	for _, offering := range serviceOffering {
+		if filter.Tag != "" && !containsFold(offering.Tags, filter.Tag) {
+			continue
+		}
+
+		offering.Plans = filter.matchingPlans(offering)
+		if len(offering.Plans) > 0 {
+			result = append(result, ServiceOfferingWithPlans(offering))
+		}
 	}
 
 	return result, Warnings(warnings), nil
 }
+
+func (filter MarketplaceFilter) matchingPlans(offering ccv3.ServiceOfferingWithPlans) []resources.ServicePlan {
+	offeringMatches := filter.SearchText == "" ||
+		containsTextFold(filter.SearchText, append([]string{offering.Name, offering.Description}, offering.Tags...)...)
+
+	var plans []resources.ServicePlan
+	for _, plan := range offering.Plans {
+		switch {
+		case filter.FreeOnly && !plan.Free:
+		case filter.Bindable && !plan.Bindable:
+		case !offeringMatches && !containsTextFold(filter.SearchText, plan.Name, plan.Description):
+		default:
+			plans = append(plans, plan)
+		}
+	}
+	return plans
+}
+
+func containsFold(values []string, value string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, value) {
+			return true
+		}
+	}
+	return false
+}
+
+func containsTextFold(text string, values ...string) bool {
+	text = strings.ToLower(text)
+	for _, v := range values {
+		if strings.Contains(strings.ToLower(v), text) {
+			return true
+		}
+	}
+	return false
+}
@@ -167,6 +167,131 @@ var _ = Describe("marketplace", func() {
 			})
 		})
 
+		When("a service plan name is specified", func() {
+			It("adds the service plan name to the query", func() {
+				_, _, _ = actor.Marketplace(MarketplaceFilter{ServicePlanName: "small"})
+
+				queries := fakeCloudControllerClient.GetServicePlansWithOfferingsArgsForCall(0)
+				Expect(queries).To(ContainElement(ccv3.Query{Key: ccv3.NameFilter, Values: []string{"small"}}))
+			})
+		})
+
+		Describe("filtering offerings and plans", func() {
+			var (
+				filter    MarketplaceFilter
+				offerings []ServiceOfferingWithPlans
+			)
+
+			offeringNames := func() []string {
+				var names []string
+				for _, o := range offerings {
+					names = append(names, o.Name)
+				}
+				return names
+			}
+
+			BeforeEach(func() {
+				filter = MarketplaceFilter{}
+
+				fakeCloudControllerClient.GetServicePlansWithOfferingsReturns(
+					[]ccv3.ServiceOfferingWithPlans{
+						{
+							Name:        "mysql",
+							Description: "Relational databases",
+							Tags:        []string{"SQL", "relational"},
+							Plans: []resources.ServicePlan{
+								{Name: "small", Description: "Dev database", Free: true, Bindable: true},
+								{Name: "large", Description: "Production cluster", Bindable: true},
+							},
+						},
+						{
+							Name:        "redis",
+							Description: "Key-value store",
+							Tags:        []string{"cache"},
+							Plans: []resources.ServicePlan{
+								{Name: "cache-small", Description: "Shared instance", Free: true},
+								{Name: "cache-ha", Description: "Highly available cluster"},
+							},
+						},
+					},
+					nil,
+					nil,
+				)
+			})
+
+			JustBeforeEach(func() {
+				var err error
+				offerings, _, err = actor.Marketplace(filter)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			When("searching by offering text", func() {
+				BeforeEach(func() {
+					filter.SearchText = "RELATIONAL"
+				})
+
+				It("keeps all the plans of the matching offerings", func() {
+					Expect(offeringNames()).To(Equal([]string{"mysql"}))
+					Expect(offerings[0].Plans).To(HaveLen(2))
+				})
+			})
+
+			When("searching by plan text", func() {
+				BeforeEach(func() {
+					filter.SearchText = "cluster"
+				})
+
+				It("keeps only the matching plans", func() {
+					Expect(offeringNames()).To(Equal([]string{"mysql", "redis"}))
+					Expect(offerings[0].Plans).To(ConsistOf(HaveField("Name", "large")))
+					Expect(offerings[1].Plans).To(ConsistOf(HaveField("Name", "cache-ha")))
+				})
+			})
+
+			When("filtering by tag", func() {
+				BeforeEach(func() {
+					filter.Tag = "sql"
+				})
+
+				It("keeps the offerings with the tag", func() {
+					Expect(offeringNames()).To(Equal([]string{"mysql"}))
+				})
+			})
+
+			When("filtering free plans", func() {
+				BeforeEach(func() {
+					filter.FreeOnly = true
+				})
+
+				It("keeps only free plans", func() {
+					Expect(offeringNames()).To(Equal([]string{"mysql", "redis"}))
+					Expect(offerings[0].Plans).To(ConsistOf(HaveField("Name", "small")))
+					Expect(offerings[1].Plans).To(ConsistOf(HaveField("Name", "cache-small")))
+				})
+			})
+
+			When("filtering bindable plans", func() {
+				BeforeEach(func() {
+					filter.Bindable = true
+				})
+
+				It("drops offerings without bindable plans", func() {
+					Expect(offeringNames()).To(Equal([]string{"mysql"}))
+					Expect(offerings[0].Plans).To(HaveLen(2))
+				})
+			})
+
+			When("nothing matches", func() {
+				BeforeEach(func() {
+					filter.SearchText = "mongo"
+				})
+
+				It("returns no offerings", func() {
+					Expect(offerings).To(BeEmpty())
+				})
+			})
+		})
+
 		When("the client returns an error", func() {
 			BeforeEach(func() {
 				fakeCloudControllerClient.GetServicePlansWithOfferingsReturns(
@@ -75,3 +75,19 @@ func (actor Actor) DeleteInstanceByApplicationNameSpaceProcessTypeAndIndex(appNa
 
 	return allWarnings, nil
 }
+
+// GetProcessInstances returns the current state and usage of every instance
+// of the given process.
+func (actor Actor) GetProcessInstances(processGUID string) ([]ProcessInstance, Warnings, error) {
+	ccInstances, warnings, err := actor.CloudControllerClient.GetProcessInstances(processGUID)
+	if err != nil {
+		return nil, Warnings(warnings), err
+	}
+
+	var instances []ProcessInstance
+	for _, instance := range ccInstances {
+		instances = append(instances, ProcessInstance(instance))
+	}
+
+	return instances, Warnings(warnings), nil
+}
@@ -9,6 +9,7 @@ import (
 	"code.cloudfoundry.org/cli/actor/v7action/v7actionfakes"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
 	"code.cloudfoundry.org/cli/resources"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -120,4 +121,52 @@ var _ = Describe("instance actions", func() {
 			})
 		})
 	})
+
+	Describe("GetProcessInstances", func() {
+		var (
+			instances []ProcessInstance
+			warnings  Warnings
+			err       error
+		)
+
+		JustBeforeEach(func() {
+			instances, warnings, err = actor.GetProcessInstances("some-process-guid")
+		})
+
+		When("getting the instances succeeds", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetProcessInstancesReturns(
+					[]ccv3.ProcessInstance{
+						{Index: 0, State: constant.ProcessInstanceRunning},
+						{Index: 1, State: constant.ProcessInstanceCrashed},
+					},
+					ccv3.Warnings{"instances-warning"},
+					nil,
+				)
+			})
+
+			It("returns the instances and warnings", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("instances-warning"))
+				Expect(instances).To(Equal([]ProcessInstance{
+					{Index: 0, State: constant.ProcessInstanceRunning},
+					{Index: 1, State: constant.ProcessInstanceCrashed},
+				}))
+
+				Expect(fakeCloudControllerClient.GetProcessInstancesCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetProcessInstancesArgsForCall(0)).To(Equal("some-process-guid"))
+			})
+		})
+
+		When("getting the instances fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetProcessInstancesReturns(nil, ccv3.Warnings{"instances-warning"}, errors.New("stats-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(err).To(MatchError("stats-error"))
+				Expect(warnings).To(ConsistOf("instances-warning"))
+			})
+		})
+	})
 })
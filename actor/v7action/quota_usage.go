@@ -0,0 +1,217 @@
+package v7action
+
+import (
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/types"
+)
+
+type QuotaResource string
+
+const (
+	QuotaResourceMemory           QuotaResource = "memory"
+	QuotaResourceInstances        QuotaResource = "app instances"
+	QuotaResourceRoutes           QuotaResource = "routes"
+	QuotaResourceServiceInstances QuotaResource = "service instances"
+	QuotaResourceTasks            QuotaResource = "app tasks"
+	QuotaResourceLogRate          QuotaResource = "log rate"
+)
+
+// QuotaUsage is the usage of a resource in an org, or in a space when
+// SpaceName is set, against the limit of the quota applied to it. Memory is in
+// megabytes and log rate in bytes per second. An unset Limit is unlimited.
+// The task limit applies to each app, while Used counts the running tasks of
+// all apps.
+type QuotaUsage struct {
+	OrgName   string
+	SpaceName string
+	QuotaName string
+	Resource  QuotaResource
+	Used      int
+	Limit     types.NullInt
+}
+
+// Percentage returns Used as a percentage of Limit, and false when the
+// resource is unlimited or, like tasks, limited per app.
+func (usage QuotaUsage) Percentage() (float64, bool) {
+	if !usage.Limit.IsSet || usage.Resource == QuotaResourceTasks {
+		return 0, false
+	}
+	if usage.Limit.Value <= 0 {
+		if usage.Used > 0 {
+			return 100, true
+		}
+		return 0, true
+	}
+	return float64(usage.Used) * 100 / float64(usage.Limit.Value), true
+}
+
+// GetQuotaUsage returns the quota usage of the named org, or of all orgs when
+// orgName is empty, and of their spaces when includeSpaces is set. The log rate
+// in use is the sum of the log rate limits of the started processes; processes
+// without a limit are not counted.
+func (actor Actor) GetQuotaUsage(orgName string, includeSpaces bool) ([]QuotaUsage, Warnings, error) {
+	var (
+		allWarnings Warnings
+		orgs        []resources.Organization
+	)
+
+	if orgName != "" {
+		org, warnings, err := actor.GetOrganizationByName(orgName)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return nil, allWarnings, err
+		}
+		orgs = []resources.Organization{org}
+	} else {
+		var (
+			warnings Warnings
+			err      error
+		)
+		orgs, warnings, err = actor.GetOrganizations("")
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return nil, allWarnings, err
+		}
+	}
+
+	orgQuotas, ccWarnings, err := actor.CloudControllerClient.GetOrganizationQuotas()
+	allWarnings = append(allWarnings, ccWarnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+	orgQuotasByGUID := map[string]resources.Quota{}
+	for _, quota := range orgQuotas {
+		orgQuotasByGUID[quota.GUID] = quota.Quota
+	}
+
+	var usages []QuotaUsage
+	for _, org := range orgs {
+		orgUsages, warnings, err := actor.getOrganizationQuotaUsage(org, orgQuotasByGUID[org.QuotaGUID], includeSpaces)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return nil, allWarnings, err
+		}
+		usages = append(usages, orgUsages...)
+	}
+
+	return usages, allWarnings, nil
+}
+
+func (actor Actor) getOrganizationQuotaUsage(org resources.Organization, quota resources.Quota, includeSpaces bool) ([]QuotaUsage, Warnings, error) {
+	var allWarnings Warnings
+
+	summary, warnings, err := actor.CloudControllerClient.GetOrganizationUsageSummary(org.GUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	logRates, logRateWarnings, err := actor.getLogRateUsageBySpace(org.GUID)
+	allWarnings = append(allWarnings, logRateWarnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	orgLogRate := 0
+	for _, logRate := range logRates {
+		orgLogRate += logRate
+	}
+
+	usages := quotaUsages(org.Name, "", quota, summary, orgLogRate)
+	if !includeSpaces {
+		return usages, allWarnings, nil
+	}
+
+	spaces, _, warnings, err := actor.CloudControllerClient.GetSpaces(
+		ccv3.Query{Key: ccv3.OrganizationGUIDFilter, Values: []string{org.GUID}},
+		ccv3.Query{Key: ccv3.OrderBy, Values: []string{ccv3.NameOrder}},
+		ccv3.Query{Key: ccv3.PerPage, Values: []string{ccv3.MaxPerPage}},
+	)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	spaceQuotas, warnings, err := actor.CloudControllerClient.GetSpaceQuotas(
+		ccv3.Query{Key: ccv3.OrganizationGUIDFilter, Values: []string{org.GUID}},
+	)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+	spaceQuotasBySpaceGUID := map[string]resources.Quota{}
+	for _, spaceQuota := range spaceQuotas {
+		for _, spaceGUID := range spaceQuota.SpaceGUIDs {
+			spaceQuotasBySpaceGUID[spaceGUID] = spaceQuota.Quota
+		}
+	}
+
+	for _, space := range spaces {
+		summary, warnings, err := actor.CloudControllerClient.GetSpaceUsageSummary(space.GUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return nil, allWarnings, err
+		}
+		usages = append(usages, quotaUsages(org.Name, space.Name, spaceQuotasBySpaceGUID[space.GUID], summary, logRates[space.GUID])...)
+	}
+
+	return usages, allWarnings, nil
+}
+
+func (actor Actor) getLogRateUsageBySpace(orgGUID string) (map[string]int, Warnings, error) {
+	apps, warnings, err := actor.CloudControllerClient.GetApplications(
+		ccv3.Query{Key: ccv3.OrganizationGUIDFilter, Values: []string{orgGUID}},
+		ccv3.Query{Key: ccv3.StatesFilter, Values: []string{string(constant.ApplicationStarted)}},
+		ccv3.Query{Key: ccv3.PerPage, Values: []string{ccv3.MaxPerPage}},
+	)
+	if err != nil || len(apps) == 0 {
+		return nil, Warnings(warnings), err
+	}
+
+	spaceGUIDsByAppGUID := map[string]string{}
+	var appGUIDs []string
+	for _, app := range apps {
+		spaceGUIDsByAppGUID[app.GUID] = app.SpaceGUID
+		appGUIDs = append(appGUIDs, app.GUID)
+	}
+
+	processes, processWarnings, err := actor.CloudControllerClient.GetProcesses(
+		ccv3.Query{Key: ccv3.AppGUIDFilter, Values: appGUIDs},
+		ccv3.Query{Key: ccv3.PerPage, Values: []string{ccv3.MaxPerPage}},
+	)
+	warnings = append(warnings, processWarnings...)
+	if err != nil {
+		return nil, Warnings(warnings), err
+	}
+
+	logRates := map[string]int{}
+	for _, process := range processes {
+		if !process.Instances.IsSet || !process.LogRateLimitInBPS.IsSet || process.LogRateLimitInBPS.Value < 0 {
+			continue
+		}
+		logRates[spaceGUIDsByAppGUID[process.AppGUID]] += process.Instances.Value * process.LogRateLimitInBPS.Value
+	}
+
+	return logRates, Warnings(warnings), nil
+}
+
+func quotaUsages(orgName, spaceName string, quota resources.Quota, summary resources.UsageSummary, logRate int) []QuotaUsage {
+	usage := func(resource QuotaResource, used int, limit *types.NullInt) QuotaUsage {
+		quotaUsage := QuotaUsage{OrgName: orgName, SpaceName: spaceName, QuotaName: quota.Name, Resource: resource, Used: used}
+		if limit != nil {
+			quotaUsage.Limit = *limit
+		}
+		return quotaUsage
+	}
+
+	return []QuotaUsage{
+		usage(QuotaResourceMemory, summary.MemoryInMB, quota.Apps.TotalMemory),
+		usage(QuotaResourceInstances, summary.StartedInstances, quota.Apps.TotalAppInstances),
+		usage(QuotaResourceRoutes, summary.Routes, quota.Routes.TotalRoutes),
+		usage(QuotaResourceServiceInstances, summary.ServiceInstances, quota.Services.TotalServiceInstances),
+		usage(QuotaResourceTasks, summary.PerAppTasks, quota.Apps.PerAppTasks),
+		usage(QuotaResourceLogRate, logRate, quota.Apps.TotalLogVolume),
+	}
+}
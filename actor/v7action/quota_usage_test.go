@@ -0,0 +1,235 @@
+package v7action_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/actor/v7action/v7actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Quota Usage Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v7actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v7actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil, nil, nil, nil)
+	})
+
+	Describe("QuotaUsage.Percentage", func() {
+		It("returns the usage as a percentage of the limit", func() {
+			percentage, limited := QuotaUsage{Used: 3, Limit: types.NullInt{IsSet: true, Value: 4}}.Percentage()
+			Expect(limited).To(BeTrue())
+			Expect(percentage).To(Equal(75.0))
+		})
+
+		It("returns false for unlimited resources", func() {
+			_, limited := QuotaUsage{Used: 3}.Percentage()
+			Expect(limited).To(BeFalse())
+		})
+
+		It("returns false for tasks, whose limit applies to each app", func() {
+			_, limited := QuotaUsage{Resource: QuotaResourceTasks, Used: 3, Limit: types.NullInt{IsSet: true, Value: 4}}.Percentage()
+			Expect(limited).To(BeFalse())
+		})
+
+		It("treats any usage of a zero limit as full", func() {
+			percentage, limited := QuotaUsage{Used: 1, Limit: types.NullInt{IsSet: true, Value: 0}}.Percentage()
+			Expect(limited).To(BeTrue())
+			Expect(percentage).To(Equal(100.0))
+		})
+	})
+
+	Describe("GetQuotaUsage", func() {
+		var (
+			orgName       string
+			includeSpaces bool
+			usages        []QuotaUsage
+			warnings      Warnings
+			executeErr    error
+		)
+
+		BeforeEach(func() {
+			orgName = ""
+			includeSpaces = false
+
+			fakeCloudControllerClient.GetOrganizationsReturns(
+				[]resources.Organization{{GUID: "org-guid", Name: "my-org", QuotaGUID: "quota-guid"}},
+				ccv3.Warnings{"orgs warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetOrganizationQuotasReturns(
+				[]resources.OrganizationQuota{{Quota: resources.Quota{
+					GUID: "quota-guid",
+					Name: "big",
+					Apps: resources.AppLimit{
+						TotalMemory:       &types.NullInt{IsSet: true, Value: 2048},
+						TotalAppInstances: &types.NullInt{IsSet: true, Value: 10},
+						TotalLogVolume:    &types.NullInt{IsSet: false},
+						PerAppTasks:       &types.NullInt{IsSet: true, Value: 5},
+					},
+					Routes:   resources.RouteLimit{TotalRoutes: &types.NullInt{IsSet: true, Value: 4}},
+					Services: resources.ServiceLimit{TotalServiceInstances: &types.NullInt{IsSet: false}},
+				}}},
+				ccv3.Warnings{"quotas warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetOrganizationUsageSummaryReturns(
+				resources.UsageSummary{StartedInstances: 3, MemoryInMB: 1536, Routes: 4, ServiceInstances: 2, PerAppTasks: 1},
+				ccv3.Warnings{"org usage warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetApplicationsReturns(
+				[]resources.Application{
+					{GUID: "app-1-guid", SpaceGUID: "space-1-guid"},
+					{GUID: "app-2-guid", SpaceGUID: "space-2-guid"},
+				},
+				ccv3.Warnings{"apps warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetProcessesReturns(
+				[]resources.Process{
+					{AppGUID: "app-1-guid", Instances: types.NullInt{IsSet: true, Value: 2}, LogRateLimitInBPS: types.NullInt{IsSet: true, Value: 1024}},
+					{AppGUID: "app-2-guid", Instances: types.NullInt{IsSet: true, Value: 1}, LogRateLimitInBPS: types.NullInt{IsSet: true, Value: 512}},
+					{AppGUID: "app-2-guid", Instances: types.NullInt{IsSet: true, Value: 1}, LogRateLimitInBPS: types.NullInt{IsSet: true, Value: -1}},
+				},
+				ccv3.Warnings{"processes warning"},
+				nil,
+			)
+		})
+
+		JustBeforeEach(func() {
+			usages, warnings, executeErr = actor.GetQuotaUsage(orgName, includeSpaces)
+		})
+
+		It("returns the usage of all orgs against their quotas", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf("orgs warning", "quotas warning", "org usage warning", "apps warning", "processes warning"))
+
+			Expect(usages).To(Equal([]QuotaUsage{
+				{OrgName: "my-org", QuotaName: "big", Resource: QuotaResourceMemory, Used: 1536, Limit: types.NullInt{IsSet: true, Value: 2048}},
+				{OrgName: "my-org", QuotaName: "big", Resource: QuotaResourceInstances, Used: 3, Limit: types.NullInt{IsSet: true, Value: 10}},
+				{OrgName: "my-org", QuotaName: "big", Resource: QuotaResourceRoutes, Used: 4, Limit: types.NullInt{IsSet: true, Value: 4}},
+				{OrgName: "my-org", QuotaName: "big", Resource: QuotaResourceServiceInstances, Used: 2},
+				{OrgName: "my-org", QuotaName: "big", Resource: QuotaResourceTasks, Used: 1, Limit: types.NullInt{IsSet: true, Value: 5}},
+				{OrgName: "my-org", QuotaName: "big", Resource: QuotaResourceLogRate, Used: 2560},
+			}))
+
+			Expect(fakeCloudControllerClient.GetOrganizationUsageSummaryArgsForCall(0)).To(Equal("org-guid"))
+			Expect(fakeCloudControllerClient.GetApplicationsArgsForCall(0)).To(ConsistOf(
+				ccv3.Query{Key: ccv3.OrganizationGUIDFilter, Values: []string{"org-guid"}},
+				ccv3.Query{Key: ccv3.StatesFilter, Values: []string{string(constant.ApplicationStarted)}},
+				ccv3.Query{Key: ccv3.PerPage, Values: []string{ccv3.MaxPerPage}},
+			))
+			Expect(fakeCloudControllerClient.GetProcessesArgsForCall(0)).To(ConsistOf(
+				ccv3.Query{Key: ccv3.AppGUIDFilter, Values: []string{"app-1-guid", "app-2-guid"}},
+				ccv3.Query{Key: ccv3.PerPage, Values: []string{ccv3.MaxPerPage}},
+			))
+			Expect(fakeCloudControllerClient.GetSpacesCallCount()).To(Equal(0))
+		})
+
+		When("an org name is given", func() {
+			BeforeEach(func() {
+				orgName = "my-org"
+			})
+
+			It("only returns the usage of that org", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(fakeCloudControllerClient.GetOrganizationsArgsForCall(0)).To(ContainElement(
+					ccv3.Query{Key: ccv3.NameFilter, Values: []string{"my-org"}},
+				))
+				Expect(usages).To(HaveLen(6))
+			})
+		})
+
+		When("no apps are started", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(nil, nil, nil)
+			})
+
+			It("does not get any processes", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(fakeCloudControllerClient.GetProcessesCallCount()).To(Equal(0))
+				Expect(usages[5].Used).To(Equal(0))
+			})
+		})
+
+		When("spaces are included", func() {
+			BeforeEach(func() {
+				includeSpaces = true
+
+				fakeCloudControllerClient.GetSpacesReturns(
+					[]resources.Space{{GUID: "space-1-guid", Name: "dev"}, {GUID: "space-2-guid", Name: "prod"}},
+					ccv3.IncludedResources{},
+					ccv3.Warnings{"spaces warning"},
+					nil,
+				)
+				fakeCloudControllerClient.GetSpaceQuotasReturns(
+					[]resources.SpaceQuota{{
+						Quota: resources.Quota{
+							Name: "small",
+							Apps: resources.AppLimit{TotalMemory: &types.NullInt{IsSet: true, Value: 1024}},
+						},
+						SpaceGUIDs: []string{"space-1-guid"},
+					}},
+					ccv3.Warnings{"space quotas warning"},
+					nil,
+				)
+				fakeCloudControllerClient.GetSpaceUsageSummaryStub = func(spaceGUID string) (resources.UsageSummary, ccv3.Warnings, error) {
+					if spaceGUID == "space-1-guid" {
+						return resources.UsageSummary{MemoryInMB: 1024}, ccv3.Warnings{"space usage warning"}, nil
+					}
+					return resources.UsageSummary{MemoryInMB: 512}, nil, nil
+				}
+			})
+
+			It("also returns the usage of each space against its space quota", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(warnings).To(ContainElements("spaces warning", "space quotas warning", "space usage warning"))
+
+				Expect(fakeCloudControllerClient.GetSpacesArgsForCall(0)).To(ContainElement(
+					ccv3.Query{Key: ccv3.OrganizationGUIDFilter, Values: []string{"org-guid"}},
+				))
+				Expect(fakeCloudControllerClient.GetSpaceQuotasArgsForCall(0)).To(ConsistOf(
+					ccv3.Query{Key: ccv3.OrganizationGUIDFilter, Values: []string{"org-guid"}},
+				))
+
+				Expect(usages).To(HaveLen(18))
+				Expect(usages[6]).To(Equal(QuotaUsage{OrgName: "my-org", SpaceName: "dev", QuotaName: "small", Resource: QuotaResourceMemory, Used: 1024, Limit: types.NullInt{IsSet: true, Value: 1024}}))
+				Expect(usages[11]).To(Equal(QuotaUsage{OrgName: "my-org", SpaceName: "dev", QuotaName: "small", Resource: QuotaResourceLogRate, Used: 2048}))
+				Expect(usages[12]).To(Equal(QuotaUsage{OrgName: "my-org", SpaceName: "prod", Resource: QuotaResourceMemory, Used: 512}))
+				Expect(usages[17]).To(Equal(QuotaUsage{OrgName: "my-org", SpaceName: "prod", Resource: QuotaResourceLogRate, Used: 512}))
+			})
+		})
+
+		When("getting the usage summary fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetOrganizationUsageSummaryReturns(resources.UsageSummary{}, ccv3.Warnings{"org usage warning"}, errors.New("boom"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("boom"))
+				Expect(warnings).To(ContainElement("org usage warning"))
+			})
+		})
+
+		When("getting the processes fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetProcessesReturns(nil, ccv3.Warnings{"processes warning"}, errors.New("boom"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("boom"))
+				Expect(warnings).To(ContainElements("apps warning", "processes warning"))
+			})
+		})
+	})
+})
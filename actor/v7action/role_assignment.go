@@ -0,0 +1,276 @@
+package v7action
+
+import (
+	"sort"
+	"strings"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/util/railway"
+)
+
+const defaultUserOrigin = "uaa"
+
+// RoleAssignment is an org or space role of a user or client. SpaceName is
+// empty for org roles. For clients, Username is the client ID.
+type RoleAssignment struct {
+	Type      constant.RoleType
+	Username  string
+	Origin    string
+	IsClient  bool
+	OrgName   string
+	SpaceName string
+}
+
+func (role RoleAssignment) key() string {
+	identity := strings.ToLower(role.Username)
+	switch {
+	case role.IsClient:
+		identity = "client:" + role.Username
+	case role.Origin == "":
+		identity += "@" + defaultUserOrigin
+	default:
+		identity += "@" + role.Origin
+	}
+	return strings.Join([]string{string(role.Type), role.OrgName, role.SpaceName, identity}, "/")
+}
+
+type RoleChangeType string
+
+const (
+	RoleAdded   RoleChangeType = "add"
+	RoleRemoved RoleChangeType = "remove"
+)
+
+// RoleChange is a role that GetRoleChanges found to be missing or, when
+// pruning, unwanted. RoleGUID is only set for removals.
+type RoleChange struct {
+	RoleAssignment
+	Action    RoleChangeType
+	RoleGUID  string
+	OrgGUID   string
+	SpaceGUID string
+}
+
+// GetRoleChanges compares the desired roles with the roles in the orgs and
+// spaces they mention. Missing roles are added. When prune is set, space roles
+// in those spaces that are not desired are removed, and so are org roles in
+// orgs that have at least one desired org role. An org that is only mentioned
+// for its spaces keeps its org roles, and the implicit organization_user role
+// is never removed.
+func (actor Actor) GetRoleChanges(desired []RoleAssignment, prune bool) ([]RoleChange, Warnings, error) {
+	var (
+		allWarnings Warnings
+		orgGUIDs    = map[string]string{}
+		spaceGUIDs  = map[[2]string]string{}
+	)
+
+	for _, role := range desired {
+		if _, found := orgGUIDs[role.OrgName]; !found {
+			org, warnings, err := actor.GetOrganizationByName(role.OrgName)
+			allWarnings = append(allWarnings, warnings...)
+			if err != nil {
+				return nil, allWarnings, err
+			}
+			orgGUIDs[role.OrgName] = org.GUID
+		}
+
+		spaceKey := [2]string{role.OrgName, role.SpaceName}
+		if _, found := spaceGUIDs[spaceKey]; role.SpaceName != "" && !found {
+			space, warnings, err := actor.GetSpaceByNameAndOrganization(role.SpaceName, orgGUIDs[role.OrgName])
+			allWarnings = append(allWarnings, warnings...)
+			if err != nil {
+				return nil, allWarnings, err
+			}
+			spaceGUIDs[spaceKey] = space.GUID
+		}
+	}
+
+	current, warnings, err := actor.getRoleAssignments(orgGUIDs, spaceGUIDs)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	var changes []RoleChange
+
+	currentKeys := map[string]bool{}
+	for _, change := range current {
+		currentKeys[change.key()] = true
+	}
+	desiredKeys := map[string]bool{}
+	for _, role := range desired {
+		if desiredKeys[role.key()] {
+			continue
+		}
+		desiredKeys[role.key()] = true
+
+		if !currentKeys[role.key()] {
+			changes = append(changes, RoleChange{
+				RoleAssignment: role,
+				Action:         RoleAdded,
+				OrgGUID:        orgGUIDs[role.OrgName],
+				SpaceGUID:      spaceGUIDs[[2]string{role.OrgName, role.SpaceName}],
+			})
+		}
+	}
+
+	if prune {
+		orgsWithOrgRoles := map[string]bool{}
+		for _, role := range desired {
+			if role.SpaceName == "" {
+				orgsWithOrgRoles[role.OrgName] = true
+			}
+		}
+
+		for _, change := range current {
+			if change.Type == constant.OrgUserRole || desiredKeys[change.key()] {
+				continue
+			}
+			if change.SpaceName == "" && !orgsWithOrgRoles[change.OrgName] {
+				continue
+			}
+			changes = append(changes, change)
+		}
+	}
+
+	sort.SliceStable(changes, func(i, j int) bool {
+		return roleChangeOrder(changes[i]) < roleChangeOrder(changes[j])
+	})
+
+	return changes, allWarnings, nil
+}
+
+// ApplyRoleChanges makes the changes returned by GetRoleChanges, stopping at
+// the first one that fails.
+func (actor Actor) ApplyRoleChanges(changes []RoleChange) (Warnings, error) {
+	var allWarnings Warnings
+
+	for _, change := range changes {
+		var (
+			warnings Warnings
+			err      error
+		)
+
+		switch {
+		case change.Action == RoleRemoved:
+			warnings, err = actor.deleteRole(change.RoleGUID)
+		case change.SpaceGUID != "":
+			warnings, err = actor.CreateSpaceRole(change.Type, change.OrgGUID, change.SpaceGUID, change.Username, change.Origin, change.IsClient)
+		default:
+			warnings, err = actor.CreateOrgRole(change.Type, change.OrgGUID, change.Username, change.Origin, change.IsClient)
+		}
+
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return allWarnings, err
+		}
+	}
+
+	return allWarnings, nil
+}
+
+// getRoleAssignments returns the current roles in the orgs and spaces as
+// removals, so that they can be pruned as they are.
+func (actor Actor) getRoleAssignments(orgGUIDs map[string]string, spaceGUIDs map[[2]string]string) ([]RoleChange, Warnings, error) {
+	var orgGUIDList, spaceGUIDList []string
+	orgNames := map[string]string{}
+	for name, guid := range orgGUIDs {
+		orgNames[guid] = name
+		orgGUIDList = append(orgGUIDList, guid)
+	}
+	spaceNames := map[string][2]string{}
+	for names, guid := range spaceGUIDs {
+		spaceNames[guid] = names
+		spaceGUIDList = append(spaceGUIDList, guid)
+	}
+
+	var (
+		roles    []resources.Role
+		included ccv3.IncludedResources
+	)
+
+	warnings, err := railway.Sequentially(
+		func() (warnings ccv3.Warnings, err error) {
+			roles, included, warnings, err = actor.getRolesWithUsers(ccv3.OrganizationGUIDFilter, orgGUIDList)
+			return
+		},
+		func() (warnings ccv3.Warnings, err error) {
+			spaceRoles, spaceIncluded, warnings, err := actor.getRolesWithUsers(ccv3.SpaceGUIDFilter, spaceGUIDList)
+			roles = append(roles, spaceRoles...)
+			included.Merge(spaceIncluded)
+			return warnings, err
+		},
+	)
+	if err != nil {
+		return nil, Warnings(warnings), err
+	}
+
+	users := map[string]resources.User{}
+	for _, user := range included.Users {
+		users[user.GUID] = user
+	}
+
+	var current []RoleChange
+	for _, role := range roles {
+		user := users[role.UserGUID]
+		assignment := RoleAssignment{Type: role.Type, Username: user.Username, Origin: user.Origin}
+		if user.Username == "" {
+			assignment.Username = user.GUID
+			assignment.Origin = ""
+			assignment.IsClient = true
+		}
+
+		change := RoleChange{RoleAssignment: assignment, Action: RoleRemoved, RoleGUID: role.GUID, OrgGUID: role.OrgGUID, SpaceGUID: role.SpaceGUID}
+		if role.SpaceGUID != "" {
+			names := spaceNames[role.SpaceGUID]
+			change.OrgName, change.SpaceName = names[0], names[1]
+		} else {
+			change.OrgName = orgNames[role.OrgGUID]
+		}
+		current = append(current, change)
+	}
+
+	return current, Warnings(warnings), nil
+}
+
+func (actor Actor) getRolesWithUsers(filter ccv3.QueryKey, guids []string) ([]resources.Role, ccv3.IncludedResources, ccv3.Warnings, error) {
+	if len(guids) == 0 {
+		return nil, ccv3.IncludedResources{}, nil, nil
+	}
+	sort.Strings(guids)
+
+	return actor.CloudControllerClient.GetRoles(
+		ccv3.Query{Key: filter, Values: guids},
+		ccv3.Query{Key: ccv3.Include, Values: []string{"user"}},
+		ccv3.Query{Key: ccv3.PerPage, Values: []string{ccv3.MaxPerPage}},
+	)
+}
+
+func (actor Actor) deleteRole(roleGUID string) (Warnings, error) {
+	jobURL, warnings, err := actor.CloudControllerClient.DeleteRole(roleGUID)
+	if err != nil {
+		return Warnings(warnings), err
+	}
+
+	pollWarnings, err := actor.CloudControllerClient.PollJob(jobURL)
+	warnings = append(warnings, pollWarnings...)
+	return Warnings(warnings), err
+}
+
+// roleChangeOrder sorts additions of org roles before those of space roles, and
+// removals of space roles before those of org roles, so that users are members
+// of an org while they have roles in its spaces.
+func roleChangeOrder(change RoleChange) string {
+	rank := "0"
+	switch {
+	case change.Action == RoleAdded && change.SpaceName != "":
+		rank = "1"
+	case change.Action == RoleRemoved && change.SpaceName != "":
+		rank = "2"
+	case change.Action == RoleRemoved:
+		rank = "3"
+	}
+	return strings.Join([]string{rank, change.OrgName, change.SpaceName, strings.ToLower(change.Username), string(change.Type)}, "\x00")
+}
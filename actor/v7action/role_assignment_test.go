@@ -0,0 +1,263 @@
+package v7action_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/actor/v7action/v7actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/resources"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Role Assignment Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v7actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v7actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil, nil, nil, nil)
+	})
+
+	Describe("GetRoleChanges", func() {
+		var (
+			desired    []RoleAssignment
+			prune      bool
+			changes    []RoleChange
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			prune = false
+			desired = []RoleAssignment{
+				{Type: constant.OrgManagerRole, Username: "alice", OrgName: "my-org"},
+				{Type: constant.OrgAuditorRole, Username: "Bob", Origin: "ldap", OrgName: "my-org"},
+				{Type: constant.SpaceDeveloperRole, Username: "bob", Origin: "ldap", OrgName: "my-org", SpaceName: "dev"},
+				{Type: constant.SpaceDeveloperRole, Username: "bob", Origin: "ldap", OrgName: "my-org", SpaceName: "dev"},
+			}
+
+			fakeCloudControllerClient.GetOrganizationsReturns(
+				[]resources.Organization{{GUID: "org-guid", Name: "my-org"}},
+				ccv3.Warnings{"org warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetSpacesReturns(
+				[]resources.Space{{GUID: "space-guid", Name: "dev"}},
+				ccv3.IncludedResources{},
+				ccv3.Warnings{"space warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetRolesReturnsOnCall(0,
+				[]resources.Role{
+					{GUID: "role-1-guid", Type: constant.OrgManagerRole, UserGUID: "alice-guid", OrgGUID: "org-guid"},
+					{GUID: "role-2-guid", Type: constant.OrgUserRole, UserGUID: "carol-guid", OrgGUID: "org-guid"},
+					{GUID: "role-3-guid", Type: constant.OrgAuditorRole, UserGUID: "client-guid", OrgGUID: "org-guid"},
+				},
+				ccv3.IncludedResources{Users: []resources.User{
+					{GUID: "alice-guid", Username: "alice", Origin: "uaa"},
+					{GUID: "carol-guid", Username: "carol", Origin: "uaa"},
+					{GUID: "client-guid"},
+				}},
+				ccv3.Warnings{"org roles warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetRolesReturnsOnCall(1,
+				[]resources.Role{
+					{GUID: "role-4-guid", Type: constant.SpaceManagerRole, UserGUID: "carol-guid", SpaceGUID: "space-guid"},
+				},
+				ccv3.IncludedResources{Users: []resources.User{
+					{GUID: "carol-guid", Username: "carol", Origin: "uaa"},
+				}},
+				ccv3.Warnings{"space roles warning"},
+				nil,
+			)
+		})
+
+		JustBeforeEach(func() {
+			changes, warnings, executeErr = actor.GetRoleChanges(desired, prune)
+		})
+
+		It("gets the current roles of the orgs and spaces in the file", func() {
+			Expect(fakeCloudControllerClient.GetOrganizationsCallCount()).To(Equal(1))
+			Expect(fakeCloudControllerClient.GetSpacesCallCount()).To(Equal(1))
+
+			Expect(fakeCloudControllerClient.GetRolesCallCount()).To(Equal(2))
+			Expect(fakeCloudControllerClient.GetRolesArgsForCall(0)).To(ConsistOf(
+				ccv3.Query{Key: ccv3.OrganizationGUIDFilter, Values: []string{"org-guid"}},
+				ccv3.Query{Key: ccv3.Include, Values: []string{"user"}},
+				ccv3.Query{Key: ccv3.PerPage, Values: []string{ccv3.MaxPerPage}},
+			))
+			Expect(fakeCloudControllerClient.GetRolesArgsForCall(1)).To(ConsistOf(
+				ccv3.Query{Key: ccv3.SpaceGUIDFilter, Values: []string{"space-guid"}},
+				ccv3.Query{Key: ccv3.Include, Values: []string{"user"}},
+				ccv3.Query{Key: ccv3.PerPage, Values: []string{ccv3.MaxPerPage}},
+			))
+		})
+
+		It("returns the missing roles, org roles first", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf("org warning", "space warning", "org roles warning", "space roles warning"))
+
+			Expect(changes).To(Equal([]RoleChange{
+				{
+					RoleAssignment: RoleAssignment{Type: constant.OrgAuditorRole, Username: "Bob", Origin: "ldap", OrgName: "my-org"},
+					Action:         RoleAdded,
+					OrgGUID:        "org-guid",
+				},
+				{
+					RoleAssignment: RoleAssignment{Type: constant.SpaceDeveloperRole, Username: "bob", Origin: "ldap", OrgName: "my-org", SpaceName: "dev"},
+					Action:         RoleAdded,
+					OrgGUID:        "org-guid",
+					SpaceGUID:      "space-guid",
+				},
+			}))
+		})
+
+		When("pruning", func() {
+			BeforeEach(func() {
+				prune = true
+			})
+
+			It("also returns the roles that are not desired, except org user roles", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(changes).To(HaveLen(4))
+				Expect(changes[2]).To(Equal(RoleChange{
+					RoleAssignment: RoleAssignment{Type: constant.SpaceManagerRole, Username: "carol", Origin: "uaa", OrgName: "my-org", SpaceName: "dev"},
+					Action:         RoleRemoved,
+					RoleGUID:       "role-4-guid",
+					SpaceGUID:      "space-guid",
+				}))
+				Expect(changes[3]).To(Equal(RoleChange{
+					RoleAssignment: RoleAssignment{Type: constant.OrgAuditorRole, Username: "client-guid", IsClient: true, OrgName: "my-org"},
+					Action:         RoleRemoved,
+					RoleGUID:       "role-3-guid",
+					OrgGUID:        "org-guid",
+				}))
+			})
+
+			When("the file only lists space roles in the org", func() {
+				BeforeEach(func() {
+					desired = desired[2:]
+				})
+
+				It("keeps the org roles", func() {
+					Expect(executeErr).NotTo(HaveOccurred())
+					Expect(changes).To(Equal([]RoleChange{
+						{
+							RoleAssignment: RoleAssignment{Type: constant.SpaceDeveloperRole, Username: "bob", Origin: "ldap", OrgName: "my-org", SpaceName: "dev"},
+							Action:         RoleAdded,
+							OrgGUID:        "org-guid",
+							SpaceGUID:      "space-guid",
+						},
+						{
+							RoleAssignment: RoleAssignment{Type: constant.SpaceManagerRole, Username: "carol", Origin: "uaa", OrgName: "my-org", SpaceName: "dev"},
+							Action:         RoleRemoved,
+							RoleGUID:       "role-4-guid",
+							SpaceGUID:      "space-guid",
+						},
+					}))
+				})
+			})
+		})
+
+		When("an org does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetOrganizationsReturns(nil, ccv3.Warnings{"org warning"}, nil)
+			})
+
+			It("returns an org not found error", func() {
+				Expect(executeErr).To(MatchError(actionerror.OrganizationNotFoundError{Name: "my-org"}))
+				Expect(warnings).To(ConsistOf("org warning"))
+				Expect(fakeCloudControllerClient.GetRolesCallCount()).To(Equal(0))
+			})
+		})
+
+		When("getting the roles fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetRolesReturnsOnCall(1, nil, ccv3.IncludedResources{}, ccv3.Warnings{"space roles warning"}, errors.New("boom"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("boom"))
+				Expect(warnings).To(ContainElement("space roles warning"))
+			})
+		})
+	})
+
+	Describe("ApplyRoleChanges", func() {
+		var (
+			changes    []RoleChange
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			changes = []RoleChange{
+				{
+					RoleAssignment: RoleAssignment{Type: constant.OrgAuditorRole, Username: "bob", Origin: "ldap", OrgName: "my-org"},
+					Action:         RoleAdded,
+					OrgGUID:        "org-guid",
+				},
+				{
+					RoleAssignment: RoleAssignment{Type: constant.SpaceDeveloperRole, Username: "bob", Origin: "ldap", OrgName: "my-org", SpaceName: "dev"},
+					Action:         RoleAdded,
+					OrgGUID:        "org-guid",
+					SpaceGUID:      "space-guid",
+				},
+				{
+					RoleAssignment: RoleAssignment{Type: constant.SpaceManagerRole, Username: "carol", OrgName: "my-org", SpaceName: "dev"},
+					Action:         RoleRemoved,
+					RoleGUID:       "role-guid",
+				},
+			}
+
+			fakeCloudControllerClient.CreateRoleReturns(resources.Role{}, ccv3.Warnings{"create warning"}, nil)
+			fakeCloudControllerClient.DeleteRoleReturns("job-url", ccv3.Warnings{"delete warning"}, nil)
+			fakeCloudControllerClient.PollJobReturns(ccv3.Warnings{"job warning"}, nil)
+		})
+
+		JustBeforeEach(func() {
+			warnings, executeErr = actor.ApplyRoleChanges(changes)
+		})
+
+		It("adds and removes the roles", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf("create warning", "create warning", "create warning", "delete warning", "job warning"))
+
+			Expect(fakeCloudControllerClient.CreateRoleCallCount()).To(Equal(3))
+			Expect(fakeCloudControllerClient.CreateRoleArgsForCall(0)).To(Equal(resources.Role{
+				Type:     constant.OrgAuditorRole,
+				OrgGUID:  "org-guid",
+				Username: "bob",
+				Origin:   "ldap",
+			}))
+			Expect(fakeCloudControllerClient.CreateRoleArgsForCall(2)).To(Equal(resources.Role{
+				Type:      constant.SpaceDeveloperRole,
+				SpaceGUID: "space-guid",
+				Username:  "bob",
+				Origin:    "ldap",
+			}))
+
+			Expect(fakeCloudControllerClient.DeleteRoleArgsForCall(0)).To(Equal("role-guid"))
+			Expect(fakeCloudControllerClient.PollJobArgsForCall(0)).To(Equal(ccv3.JobURL("job-url")))
+		})
+
+		When("a change fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.CreateRoleReturns(resources.Role{}, ccv3.Warnings{"create warning"}, errors.New("boom"))
+			})
+
+			It("stops and returns the error", func() {
+				Expect(executeErr).To(MatchError("boom"))
+				Expect(warnings).To(ConsistOf("create warning"))
+				Expect(fakeCloudControllerClient.DeleteRoleCallCount()).To(Equal(0))
+			})
+		})
+	})
+})
@@ -1,12 +1,18 @@
 package v7action
 
 import (
+	"sort"
+
 	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
 	"code.cloudfoundry.org/cli/resources"
 	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/batcher"
+	"code.cloudfoundry.org/cli/util/extract"
+	"code.cloudfoundry.org/cli/util/lookuptable"
 	"code.cloudfoundry.org/cli/util/railway"
+	"code.cloudfoundry.org/cli/util/sorting"
 )
 
 type CreateRouteBindingParams struct {
@@ -26,6 +32,23 @@ type DeleteRouteBindingParams struct {
 	Path                string
 }
 
+// GetRouteBindingSummariesParams selects the route bindings to list. When
+// ServiceInstanceName is set, only the bindings of that service instance in
+// the space are listed. Otherwise the bindings of routes in the space, or in
+// the whole org when SpaceGUID is empty, are listed.
+type GetRouteBindingSummariesParams struct {
+	OrgGUID             string
+	SpaceGUID           string
+	ServiceInstanceName string
+}
+
+type RouteBindingSummary struct {
+	resources.RouteBinding
+	Route               resources.Route
+	DomainName          string
+	ServiceInstanceName string
+}
+
 type getRouteForBindingParams struct {
 	SpaceGUID  string
 	DomainName string
@@ -108,6 +131,100 @@ func (actor Actor) DeleteRouteBinding(params DeleteRouteBindingParams) (chan Pol
 	return stream, Warnings(warnings), err
 }
 
+func (actor Actor) GetRouteBindingSummaries(params GetRouteBindingSummariesParams) ([]RouteBindingSummary, Warnings, error) {
+	var (
+		query     ccv3.Query
+		routes    []resources.Route
+		summaries []RouteBindingSummary
+	)
+
+	warnings, err := railway.Sequentially(
+		func() (warnings ccv3.Warnings, err error) {
+			switch {
+			case params.ServiceInstanceName != "":
+				var serviceInstance resources.ServiceInstance
+				serviceInstance, _, warnings, err = actor.getServiceInstanceByNameAndSpace(params.ServiceInstanceName, params.SpaceGUID)
+				query = ccv3.Query{Key: ccv3.ServiceInstanceGUIDFilter, Values: []string{serviceInstance.GUID}}
+			case params.SpaceGUID != "":
+				query = ccv3.Query{Key: ccv3.SpaceGUIDFilter, Values: []string{params.SpaceGUID}}
+			default:
+				query = ccv3.Query{Key: ccv3.OrganizationGUIDFilter, Values: []string{params.OrgGUID}}
+			}
+			return
+		},
+		func() (warnings ccv3.Warnings, err error) {
+			routes, warnings, err = actor.CloudControllerClient.GetRoutes(
+				query,
+				ccv3.Query{Key: ccv3.PerPage, Values: []string{ccv3.MaxPerPage}},
+			)
+			return
+		},
+		func() (warnings ccv3.Warnings, err error) {
+			summaries, warnings, err = actor.getRouteBindingSummaries(routes)
+			return
+		},
+	)
+
+	return summaries, Warnings(warnings), err
+}
+
+// GetRouteBindingSummaryForRoute returns the route service bound to the
+// route. The summary is empty when no route service is bound.
+func (actor Actor) GetRouteBindingSummaryForRoute(route resources.Route) (RouteBindingSummary, Warnings, error) {
+	summaries, warnings, err := actor.getRouteBindingSummaries([]resources.Route{route})
+	if err != nil || len(summaries) == 0 {
+		return RouteBindingSummary{}, Warnings(warnings), err
+	}
+
+	return summaries[0], Warnings(warnings), nil
+}
+
+func (actor Actor) getRouteBindingSummaries(routes []resources.Route) ([]RouteBindingSummary, ccv3.Warnings, error) {
+	var (
+		bindings         []resources.RouteBinding
+		serviceInstances []resources.ServiceInstance
+	)
+
+	warnings, err := batcher.RequestByGUID(
+		extract.UniqueList("GUID", routes),
+		func(guids []string) (ccv3.Warnings, error) {
+			batch, included, warnings, err := actor.CloudControllerClient.GetRouteBindings(
+				ccv3.Query{Key: ccv3.Include, Values: []string{"service_instance"}},
+				ccv3.Query{Key: ccv3.RouteGUIDFilter, Values: guids},
+			)
+			bindings = append(bindings, batch...)
+			serviceInstances = append(serviceInstances, included.ServiceInstances...)
+			return warnings, err
+		},
+	)
+	if err != nil {
+		return nil, warnings, err
+	}
+
+	routesByGUID := make(map[string]resources.Route)
+	for _, route := range routes {
+		routesByGUID[route.GUID] = route
+	}
+	serviceInstanceNamesByGUID := lookuptable.NameFromGUID(serviceInstances)
+
+	summaries := make([]RouteBindingSummary, 0, len(bindings))
+	for _, binding := range bindings {
+		route := routesByGUID[binding.RouteGUID]
+		summaries = append(summaries, RouteBindingSummary{
+			RouteBinding:        binding,
+			Route:               route,
+			DomainName:          getDomainName(route.URL, route.Host, route.Path, route.Port),
+			ServiceInstanceName: serviceInstanceNamesByGUID[binding.ServiceInstanceGUID],
+		})
+	}
+
+	sort.SliceStable(summaries, func(i, j int) bool {
+		return sorting.LessIgnoreCase(summaries[i].Route.URL, summaries[j].Route.URL)
+	})
+
+	return summaries, warnings, nil
+}
+
 func (actor Actor) createRouteBinding(serviceInstanceGUID, routeGUID string, parameters types.OptionalObject) (ccv3.JobURL, ccv3.Warnings, error) {
 	jobURL, warnings, err := actor.CloudControllerClient.CreateRouteBinding(resources.RouteBinding{
 		ServiceInstanceGUID: serviceInstanceGUID,
@@ -621,4 +621,208 @@ var _ = Describe("Route Binding Action", func() {
 			})
 		})
 	})
+
+	Describe("GetRouteBindingSummaries", func() {
+		var (
+			params    GetRouteBindingSummariesParams
+			summaries []RouteBindingSummary
+			warnings  Warnings
+			err       error
+		)
+
+		BeforeEach(func() {
+			params = GetRouteBindingSummariesParams{OrgGUID: "org-guid"}
+
+			fakeCloudControllerClient.GetRoutesReturns(
+				[]resources.Route{
+					{GUID: "route-2-guid", Host: "web", URL: "web.example.com/api", Path: "/api"},
+					{GUID: "route-1-guid", Host: "admin", URL: "admin.example.com"},
+					{GUID: "route-3-guid", Host: "unbound", URL: "unbound.example.com"},
+				},
+				ccv3.Warnings{"get routes warning"},
+				nil,
+			)
+
+			fakeCloudControllerClient.GetRouteBindingsReturns(
+				[]resources.RouteBinding{
+					{
+						GUID:                "binding-1-guid",
+						RouteGUID:           "route-2-guid",
+						ServiceInstanceGUID: "instance-1-guid",
+						RouteServiceURL:     "https://limiter.example.com",
+						LastOperation:       resources.LastOperation{Type: "create", State: "succeeded"},
+					},
+					{
+						GUID:                "binding-2-guid",
+						RouteGUID:           "route-1-guid",
+						ServiceInstanceGUID: "instance-2-guid",
+						RouteServiceURL:     "https://auth.example.com",
+					},
+				},
+				ccv3.IncludedResources{ServiceInstances: []resources.ServiceInstance{
+					{GUID: "instance-1-guid", Name: "rate-limiter"},
+					{GUID: "instance-2-guid", Name: "auth"},
+				}},
+				ccv3.Warnings{"get bindings warning"},
+				nil,
+			)
+		})
+
+		JustBeforeEach(func() {
+			summaries, warnings, err = actor.GetRouteBindingSummaries(params)
+		})
+
+		It("lists the routes in the org", func() {
+			Expect(fakeCloudControllerClient.GetRoutesCallCount()).To(Equal(1))
+			Expect(fakeCloudControllerClient.GetRoutesArgsForCall(0)).To(ConsistOf(
+				ccv3.Query{Key: ccv3.OrganizationGUIDFilter, Values: []string{"org-guid"}},
+				ccv3.Query{Key: ccv3.PerPage, Values: []string{ccv3.MaxPerPage}},
+			))
+		})
+
+		It("gets the bindings of the routes with their service instances", func() {
+			Expect(fakeCloudControllerClient.GetRouteBindingsCallCount()).To(Equal(1))
+			Expect(fakeCloudControllerClient.GetRouteBindingsArgsForCall(0)).To(ConsistOf(
+				ccv3.Query{Key: ccv3.Include, Values: []string{"service_instance"}},
+				ccv3.Query{Key: ccv3.RouteGUIDFilter, Values: []string{"route-2-guid", "route-1-guid", "route-3-guid"}},
+			))
+		})
+
+		It("returns the summaries ordered by route", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf("get routes warning", "get bindings warning"))
+			Expect(summaries).To(HaveLen(2))
+
+			Expect(summaries[0].GUID).To(Equal("binding-2-guid"))
+			Expect(summaries[0].Route.Host).To(Equal("admin"))
+			Expect(summaries[0].DomainName).To(Equal("example.com"))
+			Expect(summaries[0].ServiceInstanceName).To(Equal("auth"))
+			Expect(summaries[0].RouteServiceURL).To(Equal("https://auth.example.com"))
+
+			Expect(summaries[1].GUID).To(Equal("binding-1-guid"))
+			Expect(summaries[1].Route.Path).To(Equal("/api"))
+			Expect(summaries[1].DomainName).To(Equal("example.com"))
+			Expect(summaries[1].ServiceInstanceName).To(Equal("rate-limiter"))
+			Expect(summaries[1].LastOperation).To(Equal(resources.LastOperation{Type: "create", State: "succeeded"}))
+		})
+
+		When("a space is given", func() {
+			BeforeEach(func() {
+				params.SpaceGUID = "space-guid"
+			})
+
+			It("lists the routes in the space", func() {
+				Expect(fakeCloudControllerClient.GetRoutesArgsForCall(0)).To(ContainElement(
+					ccv3.Query{Key: ccv3.SpaceGUIDFilter, Values: []string{"space-guid"}},
+				))
+			})
+		})
+
+		When("a service instance is given", func() {
+			BeforeEach(func() {
+				params.SpaceGUID = "space-guid"
+				params.ServiceInstanceName = "rate-limiter"
+
+				fakeCloudControllerClient.GetServiceInstanceByNameAndSpaceReturns(
+					resources.ServiceInstance{GUID: "instance-1-guid", Name: "rate-limiter"},
+					ccv3.IncludedResources{},
+					ccv3.Warnings{"get instance warning"},
+					nil,
+				)
+			})
+
+			It("lists the routes bound to the service instance", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ContainElement("get instance warning"))
+
+				actualName, actualSpaceGUID, _ := fakeCloudControllerClient.GetServiceInstanceByNameAndSpaceArgsForCall(0)
+				Expect(actualName).To(Equal("rate-limiter"))
+				Expect(actualSpaceGUID).To(Equal("space-guid"))
+
+				Expect(fakeCloudControllerClient.GetRoutesArgsForCall(0)).To(ContainElement(
+					ccv3.Query{Key: ccv3.ServiceInstanceGUIDFilter, Values: []string{"instance-1-guid"}},
+				))
+			})
+
+			When("the service instance does not exist", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetServiceInstanceByNameAndSpaceReturns(
+						resources.ServiceInstance{},
+						ccv3.IncludedResources{},
+						ccv3.Warnings{"get instance warning"},
+						ccerror.ServiceInstanceNotFoundError{Name: "rate-limiter"},
+					)
+				})
+
+				It("returns an error", func() {
+					Expect(err).To(MatchError(actionerror.ServiceInstanceNotFoundError{Name: "rate-limiter"}))
+					Expect(warnings).To(ConsistOf("get instance warning"))
+					Expect(fakeCloudControllerClient.GetRoutesCallCount()).To(Equal(0))
+				})
+			})
+		})
+
+		When("there are no routes", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetRoutesReturns(nil, ccv3.Warnings{"get routes warning"}, nil)
+			})
+
+			It("does not look for bindings", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(summaries).To(BeEmpty())
+				Expect(fakeCloudControllerClient.GetRouteBindingsCallCount()).To(Equal(0))
+			})
+		})
+
+		When("getting the bindings fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetRouteBindingsReturns(nil, ccv3.IncludedResources{}, ccv3.Warnings{"get bindings warning"}, errors.New("boom"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(err).To(MatchError("boom"))
+				Expect(warnings).To(ConsistOf("get routes warning", "get bindings warning"))
+			})
+		})
+	})
+
+	Describe("GetRouteBindingSummaryForRoute", func() {
+		var route resources.Route
+
+		BeforeEach(func() {
+			route = resources.Route{GUID: "route-guid", Host: "web", URL: "web.example.com"}
+		})
+
+		When("a route service is bound", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetRouteBindingsReturns(
+					[]resources.RouteBinding{{GUID: "binding-guid", RouteGUID: "route-guid", ServiceInstanceGUID: "instance-guid", RouteServiceURL: "https://auth.example.com"}},
+					ccv3.IncludedResources{ServiceInstances: []resources.ServiceInstance{{GUID: "instance-guid", Name: "auth"}}},
+					ccv3.Warnings{"get bindings warning"},
+					nil,
+				)
+			})
+
+			It("returns the binding", func() {
+				summary, warnings, err := actor.GetRouteBindingSummaryForRoute(route)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get bindings warning"))
+				Expect(summary.ServiceInstanceName).To(Equal("auth"))
+				Expect(summary.RouteServiceURL).To(Equal("https://auth.example.com"))
+				Expect(summary.Route).To(Equal(route))
+
+				Expect(fakeCloudControllerClient.GetRouteBindingsArgsForCall(0)).To(ContainElement(
+					ccv3.Query{Key: ccv3.RouteGUIDFilter, Values: []string{"route-guid"}},
+				))
+			})
+		})
+
+		When("no route service is bound", func() {
+			It("returns an empty summary", func() {
+				summary, _, err := actor.GetRouteBindingSummaryForRoute(route)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(summary).To(Equal(RouteBindingSummary{}))
+			})
+		})
+	})
 })
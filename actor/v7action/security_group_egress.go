@@ -0,0 +1,175 @@
+package v7action
+
+import (
+	"net/netip"
+	"strconv"
+	"strings"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/resources"
+)
+
+// EgressCheck describes outbound traffic from a space. For the icmp and all
+// protocols the ports are ignored.
+type EgressCheck struct {
+	Destination netip.Prefix
+	Protocol    string
+	StartPort   int
+	EndPort     int
+}
+
+// EgressRuleMatch is a security group rule that allows the traffic of an
+// EgressCheck.
+type EgressRuleMatch struct {
+	SecurityGroupName string
+	Rule              resources.Rule
+}
+
+// EgressCheckResult lists the rules that allow the traffic of an EgressCheck
+// in one lifecycle phase. The traffic is allowed when at least one rule
+// matches.
+type EgressCheckResult struct {
+	Lifecycle constant.SecurityGroupLifecycle
+	Matches   []EgressRuleMatch
+}
+
+func (result EgressCheckResult) Allowed() bool {
+	return len(result.Matches) > 0
+}
+
+// CheckSpaceEgress evaluates the traffic of check against the security groups
+// that apply to the space in each of the given lifecycle phases, including the
+// globally enabled ones.
+func (actor Actor) CheckSpaceEgress(spaceGUID string, check EgressCheck, lifecycles []constant.SecurityGroupLifecycle) ([]EgressCheckResult, Warnings, error) {
+	var (
+		allWarnings Warnings
+		results     []EgressCheckResult
+	)
+
+	for _, lifecycle := range lifecycles {
+		var (
+			securityGroups []resources.SecurityGroup
+			warnings       ccv3.Warnings
+			err            error
+		)
+
+		if lifecycle == constant.SecurityGroupLifecycleStaging {
+			securityGroups, warnings, err = actor.CloudControllerClient.GetStagingSecurityGroups(spaceGUID)
+		} else {
+			securityGroups, warnings, err = actor.CloudControllerClient.GetRunningSecurityGroups(spaceGUID)
+		}
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return nil, allWarnings, err
+		}
+
+		result := EgressCheckResult{Lifecycle: lifecycle}
+		for _, securityGroup := range securityGroups {
+			for _, rule := range securityGroup.Rules {
+				if ruleAllowsEgress(rule, check) {
+					result.Matches = append(result.Matches, EgressRuleMatch{SecurityGroupName: securityGroup.Name, Rule: rule})
+				}
+			}
+		}
+		results = append(results, result)
+	}
+
+	return results, allWarnings, nil
+}
+
+func ruleAllowsEgress(rule resources.Rule, check EgressCheck) bool {
+	protocol := strings.ToLower(rule.Protocol)
+	if protocol != "all" && protocol != check.Protocol {
+		return false
+	}
+
+	if !destinationsContain(rule.Destination, check.Destination) {
+		return false
+	}
+
+	if protocol == "tcp" || protocol == "udp" {
+		return rule.Ports != nil && portsContain(*rule.Ports, check.StartPort, check.EndPort)
+	}
+	return true
+}
+
+// destinationsContain reports whether the comma separated IP addresses, CIDRs
+// and IP ranges of a rule contain every address of prefix.
+func destinationsContain(destinations string, prefix netip.Prefix) bool {
+	first, last := prefixBounds(prefix)
+
+	for _, destination := range strings.Split(destinations, ",") {
+		destination = strings.TrimSpace(destination)
+
+		var start, end netip.Addr
+		switch {
+		case strings.Contains(destination, "/"):
+			rulePrefix, err := netip.ParsePrefix(destination)
+			if err != nil {
+				continue
+			}
+			start, end = prefixBounds(rulePrefix)
+		case strings.Contains(destination, "-"):
+			bounds := strings.SplitN(destination, "-", 2)
+			var startErr, endErr error
+			start, startErr = netip.ParseAddr(strings.TrimSpace(bounds[0]))
+			end, endErr = netip.ParseAddr(strings.TrimSpace(bounds[1]))
+			if startErr != nil || endErr != nil {
+				continue
+			}
+		default:
+			addr, err := netip.ParseAddr(destination)
+			if err != nil {
+				continue
+			}
+			start, end = addr, addr
+		}
+
+		start, end = start.Unmap(), end.Unmap()
+		if start.BitLen() == first.BitLen() && start.Compare(first) <= 0 && end.Compare(last) >= 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+// portsContain reports whether the comma separated ports and port ranges of a
+// rule contain every port from start to end.
+func portsContain(ports string, start int, end int) bool {
+	for _, portRange := range strings.Split(ports, ",") {
+		bounds := strings.SplitN(strings.TrimSpace(portRange), "-", 2)
+
+		low, err := strconv.Atoi(strings.TrimSpace(bounds[0]))
+		if err != nil {
+			continue
+		}
+		high := low
+		if len(bounds) == 2 {
+			high, err = strconv.Atoi(strings.TrimSpace(bounds[1]))
+			if err != nil {
+				continue
+			}
+		}
+
+		if low <= start && end <= high {
+			return true
+		}
+	}
+
+	return false
+}
+
+func prefixBounds(prefix netip.Prefix) (netip.Addr, netip.Addr) {
+	prefix = netip.PrefixFrom(prefix.Addr().Unmap(), prefix.Bits()).Masked()
+	first := prefix.Addr()
+
+	bytes := first.AsSlice()
+	for bit := prefix.Bits(); bit < len(bytes)*8; bit++ {
+		bytes[bit/8] |= 1 << (7 - bit%8)
+	}
+	last, _ := netip.AddrFromSlice(bytes)
+
+	return first, last
+}
@@ -0,0 +1,149 @@
+package v7action_test
+
+import (
+	"errors"
+	"net/netip"
+
+	. "code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/actor/v7action/v7actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/resources"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Security Group Egress Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v7actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v7actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil, nil, nil, nil)
+	})
+
+	Describe("CheckSpaceEgress", func() {
+		var (
+			check      EgressCheck
+			lifecycles []constant.SecurityGroupLifecycle
+			results    []EgressCheckResult
+			warnings   Warnings
+			executeErr error
+
+			dbRule     resources.Rule
+			publicRule resources.Rule
+		)
+
+		BeforeEach(func() {
+			check = EgressCheck{
+				Destination: netip.MustParsePrefix("10.0.1.5/32"),
+				Protocol:    "tcp",
+				StartPort:   5432,
+				EndPort:     5432,
+			}
+			lifecycles = []constant.SecurityGroupLifecycle{constant.SecurityGroupLifecycleRunning, constant.SecurityGroupLifecycleStaging}
+
+			dbPorts := "80, 5000-6000"
+			dbRule = resources.Rule{Protocol: "tcp", Destination: "192.168.0.1,10.0.0.0/16", Ports: &dbPorts}
+			publicPorts := "443"
+			publicRule = resources.Rule{Protocol: "tcp", Destination: "0.0.0.0-9.255.255.255", Ports: &publicPorts}
+
+			fakeCloudControllerClient.GetRunningSecurityGroupsReturns(
+				[]resources.SecurityGroup{
+					{Name: "public", Rules: []resources.Rule{publicRule}},
+					{Name: "db", Rules: []resources.Rule{dbRule, {Protocol: "udp", Destination: "10.0.0.0/8", Ports: &dbPorts}}},
+				},
+				ccv3.Warnings{"running warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetStagingSecurityGroupsReturns(
+				[]resources.SecurityGroup{{Name: "public", Rules: []resources.Rule{publicRule}}},
+				ccv3.Warnings{"staging warning"},
+				nil,
+			)
+		})
+
+		JustBeforeEach(func() {
+			results, warnings, executeErr = actor.CheckSpaceEgress("space-guid", check, lifecycles)
+		})
+
+		It("lists the rules allowing the traffic in each lifecycle", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf("running warning", "staging warning"))
+
+			Expect(fakeCloudControllerClient.GetRunningSecurityGroupsArgsForCall(0)).To(Equal("space-guid"))
+			Expect(fakeCloudControllerClient.GetStagingSecurityGroupsArgsForCall(0)).To(Equal("space-guid"))
+
+			Expect(results).To(Equal([]EgressCheckResult{
+				{
+					Lifecycle: constant.SecurityGroupLifecycleRunning,
+					Matches:   []EgressRuleMatch{{SecurityGroupName: "db", Rule: dbRule}},
+				},
+				{
+					Lifecycle: constant.SecurityGroupLifecycleStaging,
+				},
+			}))
+			Expect(results[0].Allowed()).To(BeTrue())
+			Expect(results[1].Allowed()).To(BeFalse())
+		})
+
+		When("only one lifecycle is checked", func() {
+			BeforeEach(func() {
+				lifecycles = []constant.SecurityGroupLifecycle{constant.SecurityGroupLifecycleStaging}
+			})
+
+			It("only gets the security groups of that lifecycle", func() {
+				Expect(results).To(HaveLen(1))
+				Expect(fakeCloudControllerClient.GetRunningSecurityGroupsCallCount()).To(Equal(0))
+			})
+		})
+
+		DescribeTable("matching rules",
+			func(rule resources.Rule, check EgressCheck, allowed bool) {
+				fakeCloudControllerClient.GetRunningSecurityGroupsReturns([]resources.SecurityGroup{{Name: "sg", Rules: []resources.Rule{rule}}}, nil, nil)
+
+				results, _, err := actor.CheckSpaceEgress("space-guid", check, []constant.SecurityGroupLifecycle{constant.SecurityGroupLifecycleRunning})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(results[0].Allowed()).To(Equal(allowed))
+			},
+			Entry("a single IP", tcpRule("10.0.0.1", "443"), tcpCheck("10.0.0.1/32", 443, 443), true),
+			Entry("another IP", tcpRule("10.0.0.1", "443"), tcpCheck("10.0.0.2/32", 443, 443), false),
+			Entry("a CIDR inside the rule", tcpRule("10.0.0.0/8", "443"), tcpCheck("10.1.0.0/16", 443, 443), true),
+			Entry("a CIDR overlapping the rule", tcpRule("10.0.0.0/24", "443"), tcpCheck("10.0.0.0/16", 443, 443), false),
+			Entry("an IP range", tcpRule("10.0.0.1 - 10.0.0.9", "443"), tcpCheck("10.0.0.9/32", 443, 443), true),
+			Entry("a port range inside the rule", tcpRule("10.0.0.1", "8000-9000"), tcpCheck("10.0.0.1/32", 8080, 8090), true),
+			Entry("a port range overlapping the rule", tcpRule("10.0.0.1", "8000-9000"), tcpCheck("10.0.0.1/32", 8999, 9001), false),
+			Entry("an IPv6 address", tcpRule("2001:db8::/32", "443"), tcpCheck("2001:db8::1/128", 443, 443), true),
+			Entry("an IPv4 address against an IPv6 rule", tcpRule("::/0", "443"), tcpCheck("10.0.0.1/32", 443, 443), false),
+			Entry("another protocol", resources.Rule{Protocol: "udp", Destination: "10.0.0.1", Ports: stringPointer("443")}, tcpCheck("10.0.0.1/32", 443, 443), false),
+			Entry("a rule for all protocols", resources.Rule{Protocol: "all", Destination: "10.0.0.0/8"}, tcpCheck("10.0.0.1/32", 443, 443), true),
+			Entry("icmp", resources.Rule{Protocol: "icmp", Destination: "10.0.0.0/8"}, EgressCheck{Destination: netip.MustParsePrefix("10.0.0.1/32"), Protocol: "icmp"}, true),
+			Entry("a tcp rule without ports", resources.Rule{Protocol: "tcp", Destination: "10.0.0.0/8"}, tcpCheck("10.0.0.1/32", 443, 443), false),
+		)
+
+		When("getting the security groups fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetStagingSecurityGroupsReturns(nil, ccv3.Warnings{"staging warning"}, errors.New("boom"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("boom"))
+				Expect(warnings).To(ConsistOf("running warning", "staging warning"))
+			})
+		})
+	})
+})
+
+func tcpRule(destination string, ports string) resources.Rule {
+	return resources.Rule{Protocol: "tcp", Destination: destination, Ports: &ports}
+}
+
+func tcpCheck(destination string, startPort int, endPort int) EgressCheck {
+	return EgressCheck{Destination: netip.MustParsePrefix(destination), Protocol: "tcp", StartPort: startPort, EndPort: endPort}
+}
+
+func stringPointer(value string) *string {
+	return &value
+}
@@ -40,6 +40,9 @@ func (actor Actor) CreateServiceAppBinding(params CreateServiceAppBindingParams)
 			app, warnings, err = actor.CloudControllerClient.GetApplicationByNameAndSpace(params.AppName, params.SpaceGUID)
 			return
 		},
+		func() (ccv3.Warnings, error) {
+			return actor.validateServiceBindingParameters("binding the service instance", serviceInstance, params.Parameters)
+		},
 		func() (warnings ccv3.Warnings, err error) {
 			jobURL, warnings, err = actor.createServiceAppBinding(serviceInstance.GUID, app.GUID, params.BindingName, params.Parameters)
 			return
@@ -102,6 +105,40 @@ func (actor Actor) DeleteServiceAppBinding(params DeleteServiceAppBindingParams)
 	}
 }
 
+// DeleteServiceAppBindingByGUID deletes the app binding with the given GUID.
+// It is used when an app has more than one binding to the same service
+// instance, so that the binding cannot be found by app and instance name.
+func (actor Actor) DeleteServiceAppBindingByGUID(bindingGUID string) (chan PollJobEvent, Warnings, error) {
+	jobURL, warnings, err := actor.CloudControllerClient.DeleteServiceCredentialBinding(bindingGUID)
+	if err != nil {
+		return nil, Warnings(warnings), err
+	}
+
+	return actor.PollJobToEventStream(jobURL), Warnings(warnings), nil
+}
+
+// GetServiceAppBindingsByServiceInstance returns the app bindings of a service
+// instance, with the name and space of each bound app.
+func (actor Actor) GetServiceAppBindingsByServiceInstance(serviceInstanceName, spaceGUID string) ([]resources.ServiceCredentialBinding, Warnings, error) {
+	var (
+		serviceInstance resources.ServiceInstance
+		bindings        []resources.ServiceCredentialBinding
+	)
+
+	warnings, err := railway.Sequentially(
+		func() (warnings ccv3.Warnings, err error) {
+			serviceInstance, _, warnings, err = actor.getServiceInstanceByNameAndSpace(serviceInstanceName, spaceGUID)
+			return
+		},
+		func() (warnings ccv3.Warnings, err error) {
+			bindings, warnings, err = actor.getServiceInstanceBoundApps(serviceInstance.GUID)
+			return
+		},
+	)
+
+	return bindings, Warnings(warnings), err
+}
+
 func (actor Actor) createServiceAppBinding(serviceInstanceGUID, appGUID, bindingName string, parameters types.OptionalObject) (ccv3.JobURL, ccv3.Warnings, error) {
 	jobURL, warnings, err := actor.CloudControllerClient.CreateServiceCredentialBinding(resources.ServiceCredentialBinding{
 		Type:                resources.AppBinding,
@@ -110,6 +110,91 @@ var _ = Describe("Service App Binding Action", func() {
 			})))
 		})
 
+		Describe("parameter validation", func() {
+			When("the service instance is managed", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetServiceInstanceByNameAndSpaceReturns(
+						resources.ServiceInstance{
+							Name:            serviceInstanceName,
+							GUID:            serviceInstanceGUID,
+							Type:            resources.ManagedServiceInstance,
+							ServicePlanGUID: "fake-plan-guid",
+						},
+						ccv3.IncludedResources{},
+						ccv3.Warnings{"get instance warning"},
+						nil,
+					)
+
+					fakeCloudControllerClient.GetServicePlanByGUIDReturns(
+						resources.ServicePlan{
+							GUID: "fake-plan-guid",
+							ServiceBindingCreateSchema: map[string]interface{}{
+								"properties": map[string]interface{}{
+									"foo": map[string]interface{}{"enum": []interface{}{"baz"}},
+								},
+							},
+						},
+						ccv3.Warnings{"get plan warning"},
+						nil,
+					)
+				})
+
+				It("validates the parameters against the plan's binding schema", func() {
+					Expect(fakeCloudControllerClient.GetServicePlanByGUIDCallCount()).To(Equal(1))
+					Expect(fakeCloudControllerClient.GetServicePlanByGUIDArgsForCall(0)).To(Equal("fake-plan-guid"))
+
+					Expect(executionError).To(MatchError(actionerror.ServiceParametersInvalidError{
+						Operation: "binding the service instance",
+						Errors:    []string{`$.foo: must be one of ["baz"]`},
+					}))
+					Expect(warnings).To(ContainElement("get plan warning"))
+					Expect(fakeCloudControllerClient.CreateServiceCredentialBindingCallCount()).To(BeZero())
+				})
+
+				When("no parameters are given", func() {
+					BeforeEach(func() {
+						params.Parameters = types.OptionalObject{}
+					})
+
+					It("does not get the plan", func() {
+						Expect(executionError).NotTo(HaveOccurred())
+						Expect(fakeCloudControllerClient.GetServicePlanByGUIDCallCount()).To(BeZero())
+					})
+				})
+
+				When("getting the plan fails", func() {
+					BeforeEach(func() {
+						fakeCloudControllerClient.GetServicePlanByGUIDReturns(resources.ServicePlan{}, ccv3.Warnings{"get plan warning"}, errors.New("plan-error"))
+					})
+
+					It("returns the error and warnings", func() {
+						Expect(executionError).To(MatchError("plan-error"))
+						Expect(warnings).To(ContainElement("get plan warning"))
+					})
+				})
+			})
+
+			When("the service instance is user-provided", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetServiceInstanceByNameAndSpaceReturns(
+						resources.ServiceInstance{
+							Name: serviceInstanceName,
+							GUID: serviceInstanceGUID,
+							Type: resources.UserProvidedServiceInstance,
+						},
+						ccv3.IncludedResources{},
+						nil,
+						nil,
+					)
+				})
+
+				It("does not validate the parameters", func() {
+					Expect(executionError).NotTo(HaveOccurred())
+					Expect(fakeCloudControllerClient.GetServicePlanByGUIDCallCount()).To(BeZero())
+				})
+			})
+		})
+
 		Describe("service instance lookup", func() {
 			It("makes the correct call", func() {
 				Expect(fakeCloudControllerClient.GetServiceInstanceByNameAndSpaceCallCount()).To(Equal(1))
@@ -493,4 +578,142 @@ var _ = Describe("Service App Binding Action", func() {
 			})
 		})
 	})
+
+	Describe("DeleteServiceAppBindingByGUID", func() {
+		var (
+			stream         chan PollJobEvent
+			warnings       Warnings
+			executionError error
+		)
+
+		BeforeEach(func() {
+			fakeCloudControllerClient.DeleteServiceCredentialBindingReturns(
+				ccv3.JobURL("fake-job-url"),
+				ccv3.Warnings{"delete binding warning"},
+				nil,
+			)
+
+			fakeStream := make(chan ccv3.PollJobEvent)
+			fakeCloudControllerClient.PollJobToEventStreamReturns(fakeStream)
+			go func() {
+				fakeStream <- ccv3.PollJobEvent{State: constant.JobComplete}
+			}()
+		})
+
+		JustBeforeEach(func() {
+			stream, warnings, executionError = actor.DeleteServiceAppBindingByGUID("fake-binding-guid")
+		})
+
+		It("deletes the binding and returns an event stream", func() {
+			Expect(executionError).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf("delete binding warning"))
+			Eventually(stream).Should(Receive(Equal(PollJobEvent{State: JobComplete})))
+
+			Expect(fakeCloudControllerClient.DeleteServiceCredentialBindingArgsForCall(0)).To(Equal("fake-binding-guid"))
+			Expect(fakeCloudControllerClient.PollJobToEventStreamArgsForCall(0)).To(Equal(ccv3.JobURL("fake-job-url")))
+		})
+
+		When("deleting the binding fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.DeleteServiceCredentialBindingReturns("", ccv3.Warnings{"delete binding warning"}, errors.New("boom"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executionError).To(MatchError("boom"))
+				Expect(warnings).To(ConsistOf("delete binding warning"))
+				Expect(stream).To(BeNil())
+			})
+		})
+	})
+
+	Describe("GetServiceAppBindingsByServiceInstance", func() {
+		const (
+			serviceInstanceName = "fake-service-instance-name"
+			serviceInstanceGUID = "fake-service-instance-guid"
+			spaceGUID           = "fake-space-guid"
+		)
+
+		var (
+			bindings       []resources.ServiceCredentialBinding
+			warnings       Warnings
+			executionError error
+		)
+
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetServiceInstanceByNameAndSpaceReturns(
+				resources.ServiceInstance{Name: serviceInstanceName, GUID: serviceInstanceGUID},
+				ccv3.IncludedResources{},
+				ccv3.Warnings{"get instance warning"},
+				nil,
+			)
+
+			fakeCloudControllerClient.GetServiceCredentialBindingsReturns(
+				[]resources.ServiceCredentialBinding{
+					{GUID: "binding-1", Name: "db", AppGUID: "app-1", AppName: "app-one", AppSpaceGUID: spaceGUID},
+					{GUID: "binding-2", AppGUID: "app-2", AppName: "app-two", AppSpaceGUID: "other-space-guid"},
+				},
+				ccv3.Warnings{"get bindings warning"},
+				nil,
+			)
+		})
+
+		JustBeforeEach(func() {
+			bindings, warnings, executionError = actor.GetServiceAppBindingsByServiceInstance(serviceInstanceName, spaceGUID)
+		})
+
+		It("gets the app bindings of the service instance including the apps", func() {
+			Expect(fakeCloudControllerClient.GetServiceInstanceByNameAndSpaceCallCount()).To(Equal(1))
+			actualName, actualSpaceGUID, _ := fakeCloudControllerClient.GetServiceInstanceByNameAndSpaceArgsForCall(0)
+			Expect(actualName).To(Equal(serviceInstanceName))
+			Expect(actualSpaceGUID).To(Equal(spaceGUID))
+
+			Expect(fakeCloudControllerClient.GetServiceCredentialBindingsCallCount()).To(Equal(1))
+			Expect(fakeCloudControllerClient.GetServiceCredentialBindingsArgsForCall(0)).To(ConsistOf(
+				ccv3.Query{Key: ccv3.Include, Values: []string{"app"}},
+				ccv3.Query{Key: ccv3.ServiceInstanceGUIDFilter, Values: []string{serviceInstanceGUID}},
+				ccv3.Query{Key: ccv3.TypeFilter, Values: []string{"app"}},
+			))
+		})
+
+		It("returns the bindings and warnings", func() {
+			Expect(executionError).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf("get instance warning", "get bindings warning"))
+			Expect(bindings).To(Equal([]resources.ServiceCredentialBinding{
+				{GUID: "binding-1", Name: "db", AppGUID: "app-1", AppName: "app-one", AppSpaceGUID: spaceGUID},
+				{GUID: "binding-2", AppGUID: "app-2", AppName: "app-two", AppSpaceGUID: "other-space-guid"},
+			}))
+		})
+
+		When("the service instance cannot be found", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceInstanceByNameAndSpaceReturns(
+					resources.ServiceInstance{},
+					ccv3.IncludedResources{},
+					ccv3.Warnings{"get instance warning"},
+					ccerror.ServiceInstanceNotFoundError{Name: serviceInstanceName},
+				)
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executionError).To(MatchError(actionerror.ServiceInstanceNotFoundError{Name: serviceInstanceName}))
+				Expect(warnings).To(ConsistOf("get instance warning"))
+				Expect(fakeCloudControllerClient.GetServiceCredentialBindingsCallCount()).To(Equal(0))
+			})
+		})
+
+		When("getting the bindings fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceCredentialBindingsReturns(
+					nil,
+					ccv3.Warnings{"get bindings warning"},
+					errors.New("boom"),
+				)
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executionError).To(MatchError("boom"))
+				Expect(warnings).To(ConsistOf("get instance warning", "get bindings warning"))
+			})
+		})
+	})
 })
@@ -0,0 +1,116 @@
+package v7action
+
+import (
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/concurrency"
+)
+
+// maxConcurrentServiceAppBindingRequests limits the bind and unbind requests
+// made at the same time when changing the bindings of several apps.
+const maxConcurrentServiceAppBindingRequests = 10
+
+type CreateServiceAppBindingsParams struct {
+	SpaceGUID           string
+	ServiceInstanceName string
+	Apps                []resources.Application
+	BindingName         string
+	Parameters          types.OptionalObject
+}
+
+type DeleteServiceAppBindingsParams struct {
+	SpaceGUID           string
+	ServiceInstanceName string
+	Apps                []resources.Application
+}
+
+// ServiceAppBindingJob is the bind or unbind operation started for one of
+// several apps. Stream is nil when the operation failed to start, or when it
+// completed synchronously.
+type ServiceAppBindingJob struct {
+	App      resources.Application
+	Stream   chan PollJobEvent
+	Warnings Warnings
+	Err      error
+}
+
+// CreateServiceAppBindings binds a service instance to each of the apps. The
+// service instance is looked up and the parameters are validated once, then
+// the bindings are requested concurrently. A failure to bind one app is
+// reported in its job rather than as the returned error.
+func (actor Actor) CreateServiceAppBindings(params CreateServiceAppBindingsParams) ([]ServiceAppBindingJob, Warnings, error) {
+	serviceInstance, _, warnings, err := actor.getServiceInstanceByNameAndSpace(params.ServiceInstanceName, params.SpaceGUID)
+	if err != nil {
+		return nil, Warnings(warnings), err
+	}
+
+	validationWarnings, err := actor.validateServiceBindingParameters("binding the service instance", serviceInstance, params.Parameters)
+	warnings = append(warnings, validationWarnings...)
+	if err != nil {
+		return nil, Warnings(warnings), err
+	}
+
+	jobs := actor.startServiceAppBindingJobs(params.Apps, func(app resources.Application) (ccv3.JobURL, ccv3.Warnings, error) {
+		return actor.createServiceAppBinding(serviceInstance.GUID, app.GUID, params.BindingName, params.Parameters)
+	})
+
+	return jobs, Warnings(warnings), nil
+}
+
+// DeleteServiceAppBindings unbinds a service instance from each of the apps,
+// requesting the deletions concurrently. Apps that are not bound to the
+// service instance get a ServiceBindingNotFoundError in their job.
+func (actor Actor) DeleteServiceAppBindings(params DeleteServiceAppBindingsParams) ([]ServiceAppBindingJob, Warnings, error) {
+	serviceInstance, _, warnings, err := actor.getServiceInstanceByNameAndSpace(params.ServiceInstanceName, params.SpaceGUID)
+	if err != nil {
+		return nil, Warnings(warnings), err
+	}
+
+	bindings, bindingsWarnings, err := actor.getServiceInstanceBoundApps(serviceInstance.GUID)
+	warnings = append(warnings, bindingsWarnings...)
+	if err != nil {
+		return nil, Warnings(warnings), err
+	}
+
+	bindingGUIDs := make(map[string]string)
+	for _, binding := range bindings {
+		bindingGUIDs[binding.AppGUID] = binding.GUID
+	}
+
+	jobs := actor.startServiceAppBindingJobs(params.Apps, func(app resources.Application) (ccv3.JobURL, ccv3.Warnings, error) {
+		bindingGUID, ok := bindingGUIDs[app.GUID]
+		if !ok {
+			return "", nil, actionerror.ServiceBindingNotFoundError{
+				AppGUID:             app.GUID,
+				ServiceInstanceGUID: serviceInstance.GUID,
+			}
+		}
+		return actor.CloudControllerClient.DeleteServiceCredentialBinding(bindingGUID)
+	})
+
+	return jobs, Warnings(warnings), nil
+}
+
+// startServiceAppBindingJobs starts an operation for each app, with a limited
+// number of requests in flight. The jobs are returned in the order of the apps.
+func (actor Actor) startServiceAppBindingJobs(apps []resources.Application, start func(resources.Application) (ccv3.JobURL, ccv3.Warnings, error)) []ServiceAppBindingJob {
+	jobs := make([]ServiceAppBindingJob, len(apps))
+
+	concurrency.RunBounded(maxConcurrentServiceAppBindingRequests, len(apps),
+		func(i int) ServiceAppBindingJob {
+			jobURL, warnings, err := start(apps[i])
+			job := ServiceAppBindingJob{App: apps[i], Warnings: Warnings(warnings), Err: err}
+			if err == nil {
+				job.Stream = actor.PollJobToEventStream(jobURL)
+			}
+			return job
+		},
+		func(i int, job ServiceAppBindingJob) {
+			jobs[i] = job
+		},
+	)
+
+	return jobs
+}
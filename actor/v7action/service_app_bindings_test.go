@@ -0,0 +1,265 @@
+package v7action_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/actor/v7action/v7actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Service App Bindings Actions", func() {
+	const (
+		serviceInstanceName = "fake-service-instance-name"
+		serviceInstanceGUID = "fake-service-instance-guid"
+		spaceGUID           = "fake-space-guid"
+	)
+
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v7actionfakes.FakeCloudControllerClient
+		apps                      []resources.Application
+		jobs                      []ServiceAppBindingJob
+		warnings                  Warnings
+		executeErr                error
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v7actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil, nil, nil, nil)
+
+		apps = []resources.Application{
+			{Name: "app-1", GUID: "app-1-guid"},
+			{Name: "app-2", GUID: "app-2-guid"},
+			{Name: "app-3", GUID: "app-3-guid"},
+		}
+
+		fakeCloudControllerClient.GetServiceInstanceByNameAndSpaceReturns(
+			resources.ServiceInstance{
+				Name: serviceInstanceName,
+				GUID: serviceInstanceGUID,
+				Type: resources.UserProvidedServiceInstance,
+			},
+			ccv3.IncludedResources{},
+			ccv3.Warnings{"get instance warning"},
+			nil,
+		)
+
+		fakeCloudControllerClient.PollJobToEventStreamStub = func(jobURL ccv3.JobURL) chan ccv3.PollJobEvent {
+			if jobURL == "" {
+				return nil
+			}
+			stream := make(chan ccv3.PollJobEvent)
+			close(stream)
+			return stream
+		}
+	})
+
+	Describe("CreateServiceAppBindings", func() {
+		var params CreateServiceAppBindingsParams
+
+		BeforeEach(func() {
+			params = CreateServiceAppBindingsParams{
+				SpaceGUID:           spaceGUID,
+				ServiceInstanceName: serviceInstanceName,
+				Apps:                apps,
+				BindingName:         "fake-binding-name",
+				Parameters:          types.NewOptionalObject(map[string]interface{}{"foo": "bar"}),
+			}
+
+			fakeCloudControllerClient.CreateServiceCredentialBindingStub = func(binding resources.ServiceCredentialBinding) (ccv3.JobURL, ccv3.Warnings, error) {
+				switch binding.AppGUID {
+				case "app-2-guid":
+					return "", ccv3.Warnings{"app-2 warning"}, ccerror.ResourceAlreadyExistsError{Message: "already bound"}
+				case "app-3-guid":
+					return "", nil, errors.New("bind-error")
+				default:
+					return "job-url-" + ccv3.JobURL(binding.AppGUID), ccv3.Warnings{"create warning"}, nil
+				}
+			}
+		})
+
+		JustBeforeEach(func() {
+			jobs, warnings, executeErr = actor.CreateServiceAppBindings(params)
+		})
+
+		It("looks up the service instance once", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf("get instance warning"))
+
+			Expect(fakeCloudControllerClient.GetServiceInstanceByNameAndSpaceCallCount()).To(Equal(1))
+			actualName, actualSpaceGUID, _ := fakeCloudControllerClient.GetServiceInstanceByNameAndSpaceArgsForCall(0)
+			Expect(actualName).To(Equal(serviceInstanceName))
+			Expect(actualSpaceGUID).To(Equal(spaceGUID))
+		})
+
+		It("creates a binding for each app", func() {
+			Expect(fakeCloudControllerClient.CreateServiceCredentialBindingCallCount()).To(Equal(3))
+
+			var requested []resources.ServiceCredentialBinding
+			for i := 0; i < 3; i++ {
+				requested = append(requested, fakeCloudControllerClient.CreateServiceCredentialBindingArgsForCall(i))
+			}
+			for _, app := range apps {
+				Expect(requested).To(ContainElement(resources.ServiceCredentialBinding{
+					Type:                resources.AppBinding,
+					Name:                "fake-binding-name",
+					ServiceInstanceGUID: serviceInstanceGUID,
+					AppGUID:             app.GUID,
+					Parameters:          params.Parameters,
+				}))
+			}
+		})
+
+		It("returns a job for each app in order", func() {
+			Expect(jobs).To(HaveLen(3))
+
+			Expect(jobs[0].App).To(Equal(apps[0]))
+			Expect(jobs[0].Err).NotTo(HaveOccurred())
+			Expect(jobs[0].Warnings).To(ConsistOf("create warning"))
+			Expect(jobs[0].Stream).NotTo(BeNil())
+
+			Expect(jobs[1].App).To(Equal(apps[1]))
+			Expect(jobs[1].Err).To(MatchError(actionerror.ResourceAlreadyExistsError{Message: "already bound"}))
+			Expect(jobs[1].Warnings).To(ConsistOf("app-2 warning"))
+			Expect(jobs[1].Stream).To(BeNil())
+
+			Expect(jobs[2].App).To(Equal(apps[2]))
+			Expect(jobs[2].Err).To(MatchError("bind-error"))
+			Expect(jobs[2].Stream).To(BeNil())
+		})
+
+		When("the parameters do not match the plan's schema", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceInstanceByNameAndSpaceReturns(
+					resources.ServiceInstance{
+						Name:            serviceInstanceName,
+						GUID:            serviceInstanceGUID,
+						Type:            resources.ManagedServiceInstance,
+						ServicePlanGUID: "fake-plan-guid",
+					},
+					ccv3.IncludedResources{},
+					ccv3.Warnings{"get instance warning"},
+					nil,
+				)
+				fakeCloudControllerClient.GetServicePlanByGUIDReturns(
+					resources.ServicePlan{ServiceBindingCreateSchema: map[string]interface{}{"additionalProperties": false}},
+					ccv3.Warnings{"get plan warning"},
+					nil,
+				)
+			})
+
+			It("returns the error without binding any app", func() {
+				Expect(executeErr).To(MatchError(actionerror.ServiceParametersInvalidError{
+					Operation: "binding the service instance",
+					Errors:    []string{"$.foo: property is not allowed"},
+				}))
+				Expect(warnings).To(ConsistOf("get instance warning", "get plan warning"))
+				Expect(fakeCloudControllerClient.GetServicePlanByGUIDCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.CreateServiceCredentialBindingCallCount()).To(BeZero())
+			})
+		})
+
+		When("the service instance cannot be found", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceInstanceByNameAndSpaceReturns(
+					resources.ServiceInstance{},
+					ccv3.IncludedResources{},
+					ccv3.Warnings{"get instance warning"},
+					ccerror.ServiceInstanceNotFoundError{Name: serviceInstanceName},
+				)
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError(actionerror.ServiceInstanceNotFoundError{Name: serviceInstanceName}))
+				Expect(warnings).To(ConsistOf("get instance warning"))
+				Expect(jobs).To(BeNil())
+			})
+		})
+	})
+
+	Describe("DeleteServiceAppBindings", func() {
+		var params DeleteServiceAppBindingsParams
+
+		BeforeEach(func() {
+			params = DeleteServiceAppBindingsParams{
+				SpaceGUID:           spaceGUID,
+				ServiceInstanceName: serviceInstanceName,
+				Apps:                apps,
+			}
+
+			fakeCloudControllerClient.GetServiceCredentialBindingsReturns(
+				[]resources.ServiceCredentialBinding{
+					{GUID: "binding-1-guid", AppGUID: "app-1-guid"},
+					{GUID: "binding-3-guid", AppGUID: "app-3-guid"},
+					{GUID: "binding-other-guid", AppGUID: "other-app-guid"},
+				},
+				ccv3.Warnings{"get bindings warning"},
+				nil,
+			)
+
+			fakeCloudControllerClient.DeleteServiceCredentialBindingStub = func(guid string) (ccv3.JobURL, ccv3.Warnings, error) {
+				return ccv3.JobURL("job-url-" + guid), ccv3.Warnings{"delete warning"}, nil
+			}
+		})
+
+		JustBeforeEach(func() {
+			jobs, warnings, executeErr = actor.DeleteServiceAppBindings(params)
+		})
+
+		It("gets the bindings of the service instance", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf("get instance warning", "get bindings warning"))
+
+			Expect(fakeCloudControllerClient.GetServiceCredentialBindingsCallCount()).To(Equal(1))
+			Expect(fakeCloudControllerClient.GetServiceCredentialBindingsArgsForCall(0)).To(ContainElements(
+				ccv3.Query{Key: ccv3.ServiceInstanceGUIDFilter, Values: []string{serviceInstanceGUID}},
+				ccv3.Query{Key: ccv3.TypeFilter, Values: []string{"app"}},
+			))
+		})
+
+		It("deletes the bindings of the apps", func() {
+			Expect(fakeCloudControllerClient.DeleteServiceCredentialBindingCallCount()).To(Equal(2))
+
+			var deleted []string
+			for i := 0; i < 2; i++ {
+				deleted = append(deleted, fakeCloudControllerClient.DeleteServiceCredentialBindingArgsForCall(i))
+			}
+			Expect(deleted).To(ConsistOf("binding-1-guid", "binding-3-guid"))
+		})
+
+		It("reports apps that are not bound", func() {
+			Expect(jobs).To(HaveLen(3))
+
+			Expect(jobs[0].Err).NotTo(HaveOccurred())
+			Expect(jobs[0].Warnings).To(ConsistOf("delete warning"))
+			Expect(jobs[0].Stream).NotTo(BeNil())
+
+			Expect(jobs[1].App).To(Equal(apps[1]))
+			Expect(jobs[1].Err).To(MatchError(actionerror.ServiceBindingNotFoundError{
+				AppGUID:             "app-2-guid",
+				ServiceInstanceGUID: serviceInstanceGUID,
+			}))
+
+			Expect(jobs[2].Err).NotTo(HaveOccurred())
+		})
+
+		When("getting the bindings fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceCredentialBindingsReturns(nil, ccv3.Warnings{"get bindings warning"}, errors.New("bindings-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("bindings-error"))
+				Expect(warnings).To(ConsistOf("get instance warning", "get bindings warning"))
+				Expect(fakeCloudControllerClient.DeleteServiceCredentialBindingCallCount()).To(BeZero())
+			})
+		})
+	})
+})
@@ -0,0 +1,396 @@
+package v7action
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/util/railway"
+	"code.cloudfoundry.org/cli/util/sorting"
+)
+
+const maxServiceBrokerCatalogValueLength = 60
+
+// ServiceBrokerCatalog is the catalog of a service broker as ingested by the
+// Cloud Controller.
+type ServiceBrokerCatalog struct {
+	Broker    resources.ServiceBroker
+	Offerings []ServiceBrokerCatalogOffering
+}
+
+type ServiceBrokerCatalogOffering struct {
+	resources.ServiceOffering
+	Plans []resources.ServicePlan
+}
+
+type ServiceBrokerCatalogChange string
+
+const (
+	// ServiceBrokerCatalogAdded is for offerings and plans that are only in the
+	// local catalog.
+	ServiceBrokerCatalogAdded ServiceBrokerCatalogChange = "added"
+	// ServiceBrokerCatalogRemoved is for offerings and plans that are only in
+	// the ingested catalog.
+	ServiceBrokerCatalogRemoved ServiceBrokerCatalogChange = "removed"
+	// ServiceBrokerCatalogChanged is for fields that differ between the
+	// catalogs.
+	ServiceBrokerCatalogChanged ServiceBrokerCatalogChange = "changed"
+)
+
+// ServiceBrokerCatalogDifference is a difference between the ingested catalog
+// of a broker and a local catalog. Plan is empty for differences in the
+// offering, and Field is empty when a whole offering or plan is added or
+// removed.
+type ServiceBrokerCatalogDifference struct {
+	Change   ServiceBrokerCatalogChange
+	Offering string
+	Plan     string
+	Field    string
+	Ingested string
+	Local    string
+}
+
+// localServiceBrokerCatalog is the subset of the Open Service Broker API
+// catalog that the Cloud Controller ingests.
+type localServiceBrokerCatalog struct {
+	Services []localServiceOffering `json:"services"`
+}
+
+type localServiceOffering struct {
+	ID             string                 `json:"id"`
+	Name           string                 `json:"name"`
+	Description    string                 `json:"description"`
+	Bindable       bool                   `json:"bindable"`
+	PlanUpdateable bool                   `json:"plan_updateable"`
+	Tags           []string               `json:"tags"`
+	Metadata       map[string]interface{} `json:"metadata"`
+	Plans          []localServicePlan     `json:"plans"`
+}
+
+type localServicePlan struct {
+	ID              string                 `json:"id"`
+	Name            string                 `json:"name"`
+	Description     string                 `json:"description"`
+	Free            *bool                  `json:"free"`
+	Bindable        *bool                  `json:"bindable"`
+	Metadata        map[string]interface{} `json:"metadata"`
+	MaintenanceInfo struct {
+		Version     string `json:"version"`
+		Description string `json:"description"`
+	} `json:"maintenance_info"`
+	Schemas struct {
+		ServiceInstance struct {
+			Create localServicePlanSchema `json:"create"`
+			Update localServicePlanSchema `json:"update"`
+		} `json:"service_instance"`
+		ServiceBinding struct {
+			Create localServicePlanSchema `json:"create"`
+		} `json:"service_binding"`
+	} `json:"schemas"`
+}
+
+type localServicePlanSchema struct {
+	Parameters map[string]interface{} `json:"parameters"`
+}
+
+type serviceBrokerCatalogField struct {
+	name     string
+	ingested interface{}
+	local    interface{}
+}
+
+func (actor Actor) GetServiceBrokerCatalog(serviceBrokerName string) (ServiceBrokerCatalog, Warnings, error) {
+	var (
+		broker    resources.ServiceBroker
+		offerings []resources.ServiceOffering
+		plans     []resources.ServicePlan
+	)
+
+	warnings, err := railway.Sequentially(
+		func() (ccv3.Warnings, error) {
+			var (
+				warnings Warnings
+				err      error
+			)
+			broker, warnings, err = actor.GetServiceBrokerByName(serviceBrokerName)
+			return ccv3.Warnings(warnings), err
+		},
+		func() (warnings ccv3.Warnings, err error) {
+			offerings, warnings, err = actor.CloudControllerClient.GetServiceOfferings(
+				ccv3.Query{Key: ccv3.ServiceBrokerGUIDsFilter, Values: []string{broker.GUID}},
+			)
+			return
+		},
+		func() (warnings ccv3.Warnings, err error) {
+			plans, warnings, err = actor.CloudControllerClient.GetServicePlans(
+				ccv3.Query{Key: ccv3.ServiceBrokerGUIDsFilter, Values: []string{broker.GUID}},
+			)
+			return
+		},
+	)
+	if err != nil {
+		return ServiceBrokerCatalog{}, Warnings(warnings), err
+	}
+
+	catalog := ServiceBrokerCatalog{Broker: broker}
+	offeringIndexes := make(map[string]int)
+	for _, offering := range offerings {
+		offering.ServiceBrokerName = broker.Name
+		offeringIndexes[offering.GUID] = len(catalog.Offerings)
+		catalog.Offerings = append(catalog.Offerings, ServiceBrokerCatalogOffering{ServiceOffering: offering})
+	}
+	for _, plan := range plans {
+		if i, ok := offeringIndexes[plan.ServiceOfferingGUID]; ok {
+			catalog.Offerings[i].Plans = append(catalog.Offerings[i].Plans, plan)
+		}
+	}
+
+	sort.Slice(catalog.Offerings, func(i, j int) bool {
+		return sorting.LessIgnoreCase(catalog.Offerings[i].Name, catalog.Offerings[j].Name)
+	})
+	for _, offering := range catalog.Offerings {
+		plans := offering.Plans
+		sort.Slice(plans, func(i, j int) bool { return sorting.LessIgnoreCase(plans[i].Name, plans[j].Name) })
+	}
+
+	return catalog, Warnings(warnings), nil
+}
+
+// DiffServiceBrokerCatalog compares the ingested catalog with the Open Service
+// Broker API catalog in the local file. Offerings and plans are matched by
+// their catalog id, as the Cloud Controller does when a broker is updated, so
+// a renamed offering or plan is reported as a changed name and keeps its
+// service instances.
+func (actor Actor) DiffServiceBrokerCatalog(catalog ServiceBrokerCatalog, localCatalogPath string) ([]ServiceBrokerCatalogDifference, error) {
+	local, err := readLocalServiceBrokerCatalog(localCatalogPath)
+	if err != nil {
+		return nil, err
+	}
+
+	localOfferings := make(map[string]localServiceOffering)
+	for _, offering := range local.Services {
+		localOfferings[offering.ID] = offering
+	}
+
+	var differences []ServiceBrokerCatalogDifference
+	ingestedOfferings := make(map[string]bool)
+	for _, ingested := range catalog.Offerings {
+		ingestedOfferings[ingested.BrokerCatalogID] = true
+
+		localOffering, found := localOfferings[ingested.BrokerCatalogID]
+		if !found {
+			differences = append(differences, ServiceBrokerCatalogDifference{Change: ServiceBrokerCatalogRemoved, Offering: ingested.Name})
+			continue
+		}
+
+		differences = append(differences, diffServiceBrokerCatalogFields(ingested.Name, "", []serviceBrokerCatalogField{
+			{"name", ingested.Name, localOffering.Name},
+			{"description", ingested.Description, localOffering.Description},
+			{"bindable", ingested.Bindable, localOffering.Bindable},
+			{"plan_updateable", ingested.PlanUpdateable, localOffering.PlanUpdateable},
+			{"tags", ingested.Tags.Value, localOffering.Tags},
+			{"metadata", ingested.BrokerCatalogMetadata, localOffering.Metadata},
+		})...)
+		differences = append(differences, diffServiceBrokerCatalogPlans(ingested, localOffering)...)
+	}
+
+	for _, localOffering := range local.Services {
+		if !ingestedOfferings[localOffering.ID] {
+			differences = append(differences, ServiceBrokerCatalogDifference{Change: ServiceBrokerCatalogAdded, Offering: localOffering.Name})
+		}
+	}
+
+	return differences, nil
+}
+
+func readLocalServiceBrokerCatalog(path string) (localServiceBrokerCatalog, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return localServiceBrokerCatalog{}, err
+	}
+
+	var catalog localServiceBrokerCatalog
+	if err := json.Unmarshal(raw, &catalog); err != nil {
+		return localServiceBrokerCatalog{}, actionerror.InvalidServiceBrokerCatalogError{Path: path, Reason: err.Error()}
+	}
+
+	for i, offering := range catalog.Services {
+		if offering.Name == "" {
+			return localServiceBrokerCatalog{}, actionerror.InvalidServiceBrokerCatalogError{
+				Path:   path,
+				Reason: fmt.Sprintf("service offering %d has no name", i),
+			}
+		}
+		if offering.ID == "" {
+			return localServiceBrokerCatalog{}, actionerror.InvalidServiceBrokerCatalogError{
+				Path:   path,
+				Reason: fmt.Sprintf("service offering %s has no id", offering.Name),
+			}
+		}
+		for j, plan := range offering.Plans {
+			if plan.Name == "" {
+				return localServiceBrokerCatalog{}, actionerror.InvalidServiceBrokerCatalogError{
+					Path:   path,
+					Reason: fmt.Sprintf("plan %d of service offering %s has no name", j, offering.Name),
+				}
+			}
+			if plan.ID == "" {
+				return localServiceBrokerCatalog{}, actionerror.InvalidServiceBrokerCatalogError{
+					Path:   path,
+					Reason: fmt.Sprintf("plan %s of service offering %s has no id", plan.Name, offering.Name),
+				}
+			}
+		}
+	}
+
+	return catalog, nil
+}
+
+func diffServiceBrokerCatalogPlans(ingested ServiceBrokerCatalogOffering, local localServiceOffering) []ServiceBrokerCatalogDifference {
+	localPlans := make(map[string]localServicePlan)
+	for _, plan := range local.Plans {
+		localPlans[plan.ID] = plan
+	}
+
+	var differences []ServiceBrokerCatalogDifference
+	ingestedPlans := make(map[string]bool)
+	for _, plan := range ingested.Plans {
+		ingestedPlans[plan.BrokerCatalogID] = true
+
+		localPlan, found := localPlans[plan.BrokerCatalogID]
+		if !found {
+			differences = append(differences, ServiceBrokerCatalogDifference{Change: ServiceBrokerCatalogRemoved, Offering: ingested.Name, Plan: plan.Name})
+			continue
+		}
+
+		// Plans are free and inherit bindable from their offering unless the
+		// broker says otherwise.
+		free := localPlan.Free == nil || *localPlan.Free
+		bindable := local.Bindable
+		if localPlan.Bindable != nil {
+			bindable = *localPlan.Bindable
+		}
+
+		differences = append(differences, diffServiceBrokerCatalogFields(ingested.Name, plan.Name, []serviceBrokerCatalogField{
+			{"name", plan.Name, localPlan.Name},
+			{"description", plan.Description, localPlan.Description},
+			{"free", plan.Free, free},
+			{"bindable", plan.Bindable, bindable},
+			{"maintenance_info.version", plan.MaintenanceInfoVersion, localPlan.MaintenanceInfo.Version},
+			{"maintenance_info.description", plan.MaintenanceInfoDescription, localPlan.MaintenanceInfo.Description},
+			{"metadata", plan.BrokerCatalogMetadata, localPlan.Metadata},
+			{"schemas.service_instance.create", plan.ServiceInstanceCreateSchema, localPlan.Schemas.ServiceInstance.Create.Parameters},
+			{"schemas.service_instance.update", plan.ServiceInstanceUpdateSchema, localPlan.Schemas.ServiceInstance.Update.Parameters},
+			{"schemas.service_binding.create", plan.ServiceBindingCreateSchema, localPlan.Schemas.ServiceBinding.Create.Parameters},
+		})...)
+	}
+
+	for _, localPlan := range local.Plans {
+		if !ingestedPlans[localPlan.ID] {
+			differences = append(differences, ServiceBrokerCatalogDifference{Change: ServiceBrokerCatalogAdded, Offering: ingested.Name, Plan: localPlan.Name})
+		}
+	}
+
+	return differences
+}
+
+func diffServiceBrokerCatalogFields(offering, plan string, fields []serviceBrokerCatalogField) []ServiceBrokerCatalogDifference {
+	var differences []ServiceBrokerCatalogDifference
+	for _, field := range fields {
+		ingested := normalizeServiceBrokerCatalogValue(field.ingested)
+		local := normalizeServiceBrokerCatalogValue(field.local)
+		if reflect.DeepEqual(ingested, local) {
+			continue
+		}
+
+		differences = append(differences, ServiceBrokerCatalogDifference{
+			Change:   ServiceBrokerCatalogChanged,
+			Offering: offering,
+			Plan:     plan,
+			Field:    field.name,
+			Ingested: formatServiceBrokerCatalogValue(ingested),
+			Local:    formatServiceBrokerCatalogValue(local),
+		})
+	}
+	return differences
+}
+
+// normalizeServiceBrokerCatalogValue treats empty lists and objects as
+// missing, since brokers and the Cloud Controller omit them interchangeably.
+// Numbers in objects are compared as float64, however they were decoded.
+func normalizeServiceBrokerCatalogValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case []string:
+		if len(v) == 0 {
+			return nil
+		}
+	case map[string]interface{}:
+		if len(v) == 0 {
+			return nil
+		}
+		return normalizeServiceBrokerCatalogNumbers(v)
+	}
+	return value
+}
+
+func normalizeServiceBrokerCatalogNumbers(value interface{}) interface{} {
+	switch v := value.(type) {
+	case json.Number:
+		if n, err := v.Float64(); err == nil {
+			return n
+		}
+		return v.String()
+	case map[string]interface{}:
+		normalized := make(map[string]interface{}, len(v))
+		for key, item := range v {
+			normalized[key] = normalizeServiceBrokerCatalogNumbers(item)
+		}
+		return normalized
+	case []interface{}:
+		normalized := make([]interface{}, len(v))
+		for i, item := range v {
+			normalized[i] = normalizeServiceBrokerCatalogNumbers(item)
+		}
+		return normalized
+	default:
+		return value
+	}
+}
+
+// formatServiceBrokerCatalogValue renders a catalog value on a single line.
+// Objects are shown as compact JSON, shortened when they are long.
+func formatServiceBrokerCatalogValue(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case bool:
+		return strconv.FormatBool(v)
+	case []string:
+		return strings.Join(v, ", ")
+	case map[string]interface{}:
+		if len(v) == 0 {
+			return ""
+		}
+		raw, err := json.Marshal(v)
+		if err != nil {
+			return fmt.Sprint(v)
+		}
+		formatted := string(raw)
+		if utf8.RuneCountInString(formatted) > maxServiceBrokerCatalogValueLength {
+			formatted = string([]rune(formatted)[:maxServiceBrokerCatalogValueLength-3]) + "..."
+		}
+		return formatted
+	default:
+		return fmt.Sprint(v)
+	}
+}
@@ -0,0 +1,368 @@
+package v7action_test
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/actor/v7action/v7actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Service Broker Catalog Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v7actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v7actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil, nil, nil, nil)
+	})
+
+	Describe("GetServiceBrokerCatalog", func() {
+		var (
+			catalog    ServiceBrokerCatalog
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetServiceBrokersReturns(
+				[]resources.ServiceBroker{{GUID: "broker-guid", Name: "my-broker"}},
+				ccv3.Warnings{"broker warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetServiceOfferingsReturns(
+				[]resources.ServiceOffering{
+					{GUID: "offering-2-guid", Name: "redis"},
+					{GUID: "offering-1-guid", Name: "mysql"},
+				},
+				ccv3.Warnings{"offerings warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetServicePlansReturns(
+				[]resources.ServicePlan{
+					{Name: "small", ServiceOfferingGUID: "offering-1-guid"},
+					{Name: "large", ServiceOfferingGUID: "offering-1-guid"},
+					{Name: "cache", ServiceOfferingGUID: "offering-2-guid"},
+				},
+				ccv3.Warnings{"plans warning"},
+				nil,
+			)
+		})
+
+		JustBeforeEach(func() {
+			catalog, warnings, executeErr = actor.GetServiceBrokerCatalog("my-broker")
+		})
+
+		It("gets the offerings and plans of the broker", func() {
+			Expect(fakeCloudControllerClient.GetServiceBrokersCallCount()).To(Equal(1))
+			Expect(fakeCloudControllerClient.GetServiceBrokersArgsForCall(0)).To(ContainElement(
+				ccv3.Query{Key: ccv3.NameFilter, Values: []string{"my-broker"}},
+			))
+			Expect(fakeCloudControllerClient.GetServiceOfferingsArgsForCall(0)).To(ConsistOf(
+				ccv3.Query{Key: ccv3.ServiceBrokerGUIDsFilter, Values: []string{"broker-guid"}},
+			))
+			Expect(fakeCloudControllerClient.GetServicePlansArgsForCall(0)).To(ConsistOf(
+				ccv3.Query{Key: ccv3.ServiceBrokerGUIDsFilter, Values: []string{"broker-guid"}},
+			))
+		})
+
+		It("returns the offerings with their plans ordered by name", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf("broker warning", "offerings warning", "plans warning"))
+
+			Expect(catalog.Broker.Name).To(Equal("my-broker"))
+			Expect(catalog.Offerings).To(HaveLen(2))
+			Expect(catalog.Offerings[0].Name).To(Equal("mysql"))
+			Expect(catalog.Offerings[0].ServiceBrokerName).To(Equal("my-broker"))
+			Expect(catalog.Offerings[0].Plans).To(Equal([]resources.ServicePlan{
+				{Name: "large", ServiceOfferingGUID: "offering-1-guid"},
+				{Name: "small", ServiceOfferingGUID: "offering-1-guid"},
+			}))
+			Expect(catalog.Offerings[1].Name).To(Equal("redis"))
+			Expect(catalog.Offerings[1].Plans).To(HaveLen(1))
+		})
+
+		When("the broker does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceBrokersReturns(nil, ccv3.Warnings{"broker warning"}, nil)
+			})
+
+			It("returns an error", func() {
+				Expect(executeErr).To(MatchError(actionerror.ServiceBrokerNotFoundError{Name: "my-broker"}))
+				Expect(warnings).To(ConsistOf("broker warning"))
+				Expect(fakeCloudControllerClient.GetServiceOfferingsCallCount()).To(Equal(0))
+			})
+		})
+
+		When("getting the plans fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServicePlansReturns(nil, ccv3.Warnings{"plans warning"}, errors.New("boom"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("boom"))
+				Expect(warnings).To(ConsistOf("broker warning", "offerings warning", "plans warning"))
+			})
+		})
+	})
+
+	Describe("DiffServiceBrokerCatalog", func() {
+		var (
+			catalog      ServiceBrokerCatalog
+			localCatalog string
+			catalogPath  string
+			differences  []ServiceBrokerCatalogDifference
+			executeErr   error
+		)
+
+		BeforeEach(func() {
+			catalog = ServiceBrokerCatalog{
+				Offerings: []ServiceBrokerCatalogOffering{
+					{
+						ServiceOffering: resources.ServiceOffering{
+							Name:                  "mysql",
+							Description:           "MySQL databases",
+							BrokerCatalogID:       "mysql-id",
+							Bindable:              true,
+							Tags:                  types.NewOptionalStringSlice("sql"),
+							BrokerCatalogMetadata: map[string]interface{}{"displayName": "MySQL"},
+						},
+						Plans: []resources.ServicePlan{
+							{
+								Name:                   "small",
+								BrokerCatalogID:        "small-id",
+								Description:            "Small",
+								Free:                   true,
+								Bindable:               true,
+								MaintenanceInfoVersion: "1.0.0",
+								ServiceInstanceCreateSchema: map[string]interface{}{
+									"type": "object",
+								},
+							},
+							{Name: "legacy", BrokerCatalogID: "legacy-id", Free: true, Bindable: true},
+						},
+					},
+					{ServiceOffering: resources.ServiceOffering{Name: "old-service", BrokerCatalogID: "old-id"}},
+				},
+			}
+
+			localCatalog = `{
+				"services": [
+					{
+						"id": "mysql-id",
+						"name": "mysql",
+						"description": "MySQL databases",
+						"bindable": true,
+						"tags": ["sql"],
+						"metadata": {"displayName": "MySQL"},
+						"plans": [
+							{
+								"id": "small-id",
+								"name": "small",
+								"description": "Small",
+								"maintenance_info": {"version": "1.1.0"},
+								"schemas": {
+									"service_instance": {
+										"create": {"parameters": {"type": "object", "required": ["size"]}}
+									}
+								}
+							},
+							{"id": "large-id", "name": "large", "free": false}
+						]
+					},
+					{"id": "new-id", "name": "new-service", "plans": []}
+				]
+			}`
+		})
+
+		JustBeforeEach(func() {
+			catalogPath = filepath.Join(GinkgoT().TempDir(), "catalog.json")
+			Expect(os.WriteFile(catalogPath, []byte(localCatalog), 0600)).To(Succeed())
+
+			differences, executeErr = actor.DiffServiceBrokerCatalog(catalog, catalogPath)
+		})
+
+		It("returns the differences between the catalogs", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(differences).To(Equal([]ServiceBrokerCatalogDifference{
+				{
+					Change:   ServiceBrokerCatalogChanged,
+					Offering: "mysql",
+					Plan:     "small",
+					Field:    "maintenance_info.version",
+					Ingested: "1.0.0",
+					Local:    "1.1.0",
+				},
+				{
+					Change:   ServiceBrokerCatalogChanged,
+					Offering: "mysql",
+					Plan:     "small",
+					Field:    "schemas.service_instance.create",
+					Ingested: `{"type":"object"}`,
+					Local:    `{"required":["size"],"type":"object"}`,
+				},
+				{Change: ServiceBrokerCatalogRemoved, Offering: "mysql", Plan: "legacy"},
+				{Change: ServiceBrokerCatalogAdded, Offering: "mysql", Plan: "large"},
+				{Change: ServiceBrokerCatalogRemoved, Offering: "old-service"},
+				{Change: ServiceBrokerCatalogAdded, Offering: "new-service"},
+			}))
+		})
+
+		When("the catalogs match", func() {
+			BeforeEach(func() {
+				catalog.Offerings = catalog.Offerings[:1]
+				catalog.Offerings[0].Plans = catalog.Offerings[0].Plans[:1]
+				catalog.Offerings[0].Plans[0].MaintenanceInfoVersion = ""
+				catalog.Offerings[0].Plans[0].ServiceInstanceCreateSchema = nil
+				localCatalog = `{
+					"services": [{
+						"id": "mysql-id",
+						"name": "mysql",
+						"description": "MySQL databases",
+						"bindable": true,
+						"tags": ["sql"],
+						"metadata": {"displayName": "MySQL"},
+						"plans": [{"id": "small-id", "name": "small", "description": "Small", "metadata": {}}]
+					}]
+				}`
+			})
+
+			It("returns no differences", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(differences).To(BeEmpty())
+			})
+		})
+
+		When("the ingested catalog has numbers decoded as json.Number", func() {
+			BeforeEach(func() {
+				catalog.Offerings = catalog.Offerings[:1]
+				catalog.Offerings[0].Plans = []resources.ServicePlan{{
+					Name:                  "small",
+					BrokerCatalogID:       "small-id",
+					Free:                  true,
+					Bindable:              true,
+					BrokerCatalogMetadata: map[string]interface{}{"costs": []interface{}{map[string]interface{}{"amount": json.Number("9.5")}}},
+				}}
+				localCatalog = `{
+					"services": [{
+						"id": "mysql-id",
+						"name": "mysql",
+						"description": "MySQL databases",
+						"bindable": true,
+						"tags": ["sql"],
+						"metadata": {"displayName": "MySQL"},
+						"plans": [{"id": "small-id", "name": "small", "metadata": {"costs": [{"amount": 9.50}]}}]
+					}]
+				}`
+			})
+
+			It("compares them as numbers", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(differences).To(BeEmpty())
+			})
+		})
+
+		When("a plan overrides the bindable flag of its offering", func() {
+			BeforeEach(func() {
+				catalog.Offerings = catalog.Offerings[:1]
+				catalog.Offerings[0].Plans = []resources.ServicePlan{{Name: "small", BrokerCatalogID: "small-id", Free: true, Bindable: true}}
+				localCatalog = `{
+					"services": [{
+						"id": "mysql-id",
+						"name": "mysql",
+						"description": "MySQL databases",
+						"bindable": true,
+						"tags": ["sql"],
+						"metadata": {"displayName": "MySQL"},
+						"plans": [{"id": "small-id", "name": "small", "bindable": false}]
+					}]
+				}`
+			})
+
+			It("compares the plan value", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(differences).To(ConsistOf(ServiceBrokerCatalogDifference{
+					Change:   ServiceBrokerCatalogChanged,
+					Offering: "mysql",
+					Plan:     "small",
+					Field:    "bindable",
+					Ingested: "true",
+					Local:    "false",
+				}))
+			})
+		})
+
+		When("an offering and a plan were renamed", func() {
+			BeforeEach(func() {
+				catalog.Offerings = catalog.Offerings[:1]
+				catalog.Offerings[0].Plans = []resources.ServicePlan{{Name: "small", BrokerCatalogID: "small-id", Free: true, Bindable: true}}
+				localCatalog = `{
+					"services": [{
+						"id": "mysql-id",
+						"name": "mysql-v2",
+						"description": "MySQL databases",
+						"bindable": true,
+						"tags": ["sql"],
+						"metadata": {"displayName": "MySQL"},
+						"plans": [{"id": "small-id", "name": "tiny"}]
+					}]
+				}`
+			})
+
+			It("reports the names as changed instead of removing and adding them", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(differences).To(Equal([]ServiceBrokerCatalogDifference{
+					{Change: ServiceBrokerCatalogChanged, Offering: "mysql", Field: "name", Ingested: "mysql", Local: "mysql-v2"},
+					{Change: ServiceBrokerCatalogChanged, Offering: "mysql", Plan: "small", Field: "name", Ingested: "small", Local: "tiny"},
+				}))
+			})
+		})
+
+		When("the local catalog is not valid JSON", func() {
+			BeforeEach(func() {
+				localCatalog = `{"services": [`
+			})
+
+			It("returns an invalid catalog error", func() {
+				Expect(executeErr).To(BeAssignableToTypeOf(actionerror.InvalidServiceBrokerCatalogError{}))
+				Expect(executeErr.(actionerror.InvalidServiceBrokerCatalogError).Path).To(Equal(catalogPath))
+			})
+		})
+
+		When("an offering in the local catalog has no name", func() {
+			BeforeEach(func() {
+				localCatalog = `{"services": [{"id": "some-id"}]}`
+			})
+
+			It("returns an invalid catalog error", func() {
+				Expect(executeErr).To(MatchError(actionerror.InvalidServiceBrokerCatalogError{
+					Path:   catalogPath,
+					Reason: "service offering 0 has no name",
+				}))
+			})
+		})
+
+		When("a plan in the local catalog has no id", func() {
+			BeforeEach(func() {
+				localCatalog = `{"services": [{"id": "some-id", "name": "mysql", "plans": [{"name": "small"}]}]}`
+			})
+
+			It("returns an invalid catalog error", func() {
+				Expect(executeErr).To(MatchError(actionerror.InvalidServiceBrokerCatalogError{
+					Path:   catalogPath,
+					Reason: "plan small of service offering mysql has no id",
+				}))
+			})
+		})
+	})
+})
@@ -76,6 +76,10 @@ func (actor Actor) CreateManagedServiceInstance(params CreateManagedServiceInsta
 			)
 			return ccv3.Warnings(v7Warnings), err
 		},
+		func() (warnings ccv3.Warnings, err error) {
+			err = validateServiceParameters("creating the service instance", servicePlan.ServiceInstanceCreateSchema, params.Parameters)
+			return
+		},
 		func() (warnings ccv3.Warnings, err error) {
 			serviceInstance := resources.ServiceInstance{
 				Type:            resources.ManagedServiceInstance,
@@ -107,7 +111,7 @@ func (actor Actor) UpdateManagedServiceInstance(params UpdateManagedServiceInsta
 		serviceInstance resources.ServiceInstance
 		serviceOffering resources.ServiceOffering
 		serviceBroker   resources.ServiceBroker
-		newPlanGUID     string
+		newPlan         resources.ServicePlan
 		jobURL          ccv3.JobURL
 		stream          chan PollJobEvent
 	)
@@ -129,12 +133,15 @@ func (actor Actor) UpdateManagedServiceInstance(params UpdateManagedServiceInsta
 		},
 		func() (warnings ccv3.Warnings, err error) {
 			if planChangeRequested {
-				newPlanGUID, warnings, err = actor.getPlanForInstanceUpdate(params.ServicePlanName, serviceOffering, serviceBroker)
+				newPlan, warnings, err = actor.getPlanForInstanceUpdate(params.ServicePlanName, serviceOffering, serviceBroker)
 			}
 			return
 		},
 		func() (warnings ccv3.Warnings, err error) {
-			jobURL, warnings, err = actor.updateManagedServiceInstance(serviceInstance, newPlanGUID, params)
+			return actor.validateServiceInstanceUpdateParameters(serviceInstance, newPlan, params.Parameters)
+		},
+		func() (warnings ccv3.Warnings, err error) {
+			jobURL, warnings, err = actor.updateManagedServiceInstance(serviceInstance, newPlan.GUID, params)
 			return
 		},
 		func() (warnings ccv3.Warnings, err error) {
@@ -306,7 +313,7 @@ func (actor Actor) getServiceInstanceForUpdate(serviceInstanceName string, space
 	return serviceInstance, serviceOffering, serviceBroker, warnings, err
 }
 
-func (actor Actor) getPlanForInstanceUpdate(planName string, serviceOffering resources.ServiceOffering, serviceBroker resources.ServiceBroker) (string, ccv3.Warnings, error) {
+func (actor Actor) getPlanForInstanceUpdate(planName string, serviceOffering resources.ServiceOffering, serviceBroker resources.ServiceBroker) (resources.ServicePlan, ccv3.Warnings, error) {
 	plans, warnings, err := actor.CloudControllerClient.GetServicePlans([]ccv3.Query{
 		{Key: ccv3.ServiceOfferingGUIDsFilter, Values: []string{serviceOffering.GUID}},
 		{Key: ccv3.NameFilter, Values: []string{planName}},
@@ -314,16 +321,36 @@ func (actor Actor) getPlanForInstanceUpdate(planName string, serviceOffering res
 
 	switch {
 	case err != nil:
-		return "", warnings, err
+		return resources.ServicePlan{}, warnings, err
 	case len(plans) == 0:
-		return "", warnings, actionerror.ServicePlanNotFoundError{
+		return resources.ServicePlan{}, warnings, actionerror.ServicePlanNotFoundError{
 			PlanName:          planName,
 			OfferingName:      serviceOffering.Name,
 			ServiceBrokerName: serviceBroker.Name,
 		}
 	default:
-		return plans[0].GUID, warnings, nil
+		return plans[0], warnings, nil
+	}
+}
+
+// validateServiceInstanceUpdateParameters checks the update parameters against
+// the schema of the plan the instance is being moved to, or of its current plan.
+func (actor Actor) validateServiceInstanceUpdateParameters(serviceInstance resources.ServiceInstance, newPlan resources.ServicePlan, parameters types.OptionalObject) (ccv3.Warnings, error) {
+	if !parameters.IsSet {
+		return nil, nil
+	}
+
+	plan := newPlan
+	var warnings ccv3.Warnings
+	if plan.GUID == "" {
+		var err error
+		plan, warnings, err = actor.getServicePlanForValidation(serviceInstance.ServicePlanGUID)
+		if err != nil {
+			return warnings, err
+		}
 	}
+
+	return warnings, validateServiceParameters("updating the service instance", plan.ServiceInstanceUpdateSchema, parameters)
 }
 
 func (actor Actor) updateManagedServiceInstance(serviceInstance resources.ServiceInstance, newServicePlanGUID string, params UpdateManagedServiceInstanceParams) (ccv3.JobURL, ccv3.Warnings, error) {
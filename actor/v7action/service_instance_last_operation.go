@@ -0,0 +1,81 @@
+package v7action
+
+import (
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/resources"
+)
+
+// PollServiceInstanceLastOperation polls the last operation of a service
+// instance until it succeeds or fails. handleLastOperation is called with the
+// first operation seen and again whenever its type, state or description
+// changes. A zero timeout polls until the operation completes. When the
+// instance disappears while a delete is in progress, the delete is reported
+// as succeeded.
+//
+// Only the last operation is polled. The Cloud Controller job that started
+// the operation is not linked from the service instance and there is no
+// endpoint to look it up, so a job can only be polled by the command that
+// created it. Broker operations are reflected in the last operation once the
+// job has handed them to the broker.
+func (actor Actor) PollServiceInstanceLastOperation(serviceInstanceName, spaceGUID string, timeout time.Duration, handleLastOperation func(resources.LastOperation)) (Warnings, error) {
+	var (
+		allWarnings Warnings
+		current     resources.LastOperation
+		seen        bool
+		timeoutChan <-chan time.Time
+	)
+
+	report := func(operation resources.LastOperation) {
+		if seen && sameLastOperation(current, operation) {
+			return
+		}
+		seen = true
+		current = operation
+		handleLastOperation(operation)
+	}
+
+	timer := actor.Clock.NewTimer(0)
+	defer timer.Stop()
+	if timeout > 0 {
+		timeoutChan = actor.Clock.After(timeout)
+	}
+
+	for {
+		select {
+		case <-timeoutChan:
+			return allWarnings, actionerror.ServiceInstanceOperationTimeoutError{Name: serviceInstanceName}
+		case <-timer.C():
+			serviceInstance, _, warnings, err := actor.getServiceInstanceByNameAndSpace(serviceInstanceName, spaceGUID)
+			allWarnings = append(allWarnings, warnings...)
+			if _, ok := err.(actionerror.ServiceInstanceNotFoundError); ok && seen && current.Type == resources.DeleteOperation {
+				report(resources.LastOperation{Type: resources.DeleteOperation, State: resources.OperationSucceeded})
+				return allWarnings, nil
+			}
+			if err != nil {
+				return allWarnings, err
+			}
+
+			operation := serviceInstance.LastOperation
+			report(operation)
+
+			switch operation.State {
+			case resources.OperationInProgress:
+				timer.Reset(actor.Config.PollingInterval())
+			case resources.OperationFailed:
+				return allWarnings, actionerror.ServiceInstanceOperationFailedError{
+					Name:        serviceInstanceName,
+					Operation:   string(operation.Type),
+					Description: operation.Description,
+				}
+			default:
+				return allWarnings, nil
+			}
+		}
+	}
+}
+
+func sameLastOperation(a, b resources.LastOperation) bool {
+	return a.Type == b.Type && a.State == b.State && a.Description == b.Description
+}
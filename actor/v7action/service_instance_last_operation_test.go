@@ -0,0 +1,194 @@
+package v7action_test
+
+import (
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/actor/v7action/v7actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/clock/fakeclock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Service Instance Last Operation Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v7actionfakes.FakeCloudControllerClient
+		fakeConfig                *v7actionfakes.FakeConfig
+		fakeClock                 *fakeclock.FakeClock
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v7actionfakes.FakeCloudControllerClient)
+		fakeConfig = new(v7actionfakes.FakeConfig)
+		fakeClock = fakeclock.NewFakeClock(time.Now())
+		actor = NewActor(fakeCloudControllerClient, fakeConfig, nil, nil, nil, fakeClock)
+	})
+
+	Describe("PollServiceInstanceLastOperation", func() {
+		var (
+			timeout    time.Duration
+			reported   []resources.LastOperation
+			warnings   Warnings
+			executeErr error
+			done       chan struct{}
+		)
+
+		instanceWithOperation := func(operation resources.LastOperation) resources.ServiceInstance {
+			return resources.ServiceInstance{Name: "my-db", GUID: "my-db-guid", LastOperation: operation}
+		}
+
+		inProgress := resources.LastOperation{Type: resources.CreateOperation, State: resources.OperationInProgress, Description: "provisioning"}
+
+		BeforeEach(func() {
+			timeout = 0
+			reported = nil
+			done = make(chan struct{})
+
+			fakeCloudControllerClient.GetServiceInstanceByNameAndSpaceReturnsOnCall(0, instanceWithOperation(inProgress), ccv3.IncludedResources{}, ccv3.Warnings{"poll warning 1"}, nil)
+			fakeCloudControllerClient.GetServiceInstanceByNameAndSpaceReturnsOnCall(1, instanceWithOperation(inProgress), ccv3.IncludedResources{}, ccv3.Warnings{"poll warning 2"}, nil)
+			fakeCloudControllerClient.GetServiceInstanceByNameAndSpaceReturnsOnCall(2, instanceWithOperation(resources.LastOperation{
+				Type:  resources.CreateOperation,
+				State: resources.OperationSucceeded,
+			}), ccv3.IncludedResources{}, ccv3.Warnings{"poll warning 3"}, nil)
+		})
+
+		JustBeforeEach(func() {
+			go func() {
+				defer close(done)
+				warnings, executeErr = actor.PollServiceInstanceLastOperation("my-db", "space-guid", timeout, func(operation resources.LastOperation) {
+					reported = append(reported, operation)
+				})
+			}()
+		})
+
+		It("polls the service instance until the operation completes", func() {
+			Eventually(done).Should(BeClosed())
+
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf("poll warning 1", "poll warning 2", "poll warning 3"))
+
+			Expect(fakeCloudControllerClient.GetServiceInstanceByNameAndSpaceCallCount()).To(Equal(3))
+			name, spaceGUID, _ := fakeCloudControllerClient.GetServiceInstanceByNameAndSpaceArgsForCall(0)
+			Expect(name).To(Equal("my-db"))
+			Expect(spaceGUID).To(Equal("space-guid"))
+		})
+
+		It("reports each change of the operation", func() {
+			Eventually(done).Should(BeClosed())
+
+			Expect(reported).To(Equal([]resources.LastOperation{
+				inProgress,
+				{Type: resources.CreateOperation, State: resources.OperationSucceeded},
+			}))
+		})
+
+		When("the operation fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceInstanceByNameAndSpaceReturnsOnCall(2, instanceWithOperation(resources.LastOperation{
+					Type:        resources.CreateOperation,
+					State:       resources.OperationFailed,
+					Description: "quota exceeded",
+				}), ccv3.IncludedResources{}, ccv3.Warnings{"poll warning 3"}, nil)
+			})
+
+			It("returns an operation failed error", func() {
+				Eventually(done).Should(BeClosed())
+
+				Expect(executeErr).To(MatchError(actionerror.ServiceInstanceOperationFailedError{
+					Name:        "my-db",
+					Operation:   "create",
+					Description: "quota exceeded",
+				}))
+				Expect(warnings).To(ConsistOf("poll warning 1", "poll warning 2", "poll warning 3"))
+				Expect(reported).To(HaveLen(2))
+			})
+		})
+
+		When("the instance has no operation in progress", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceInstanceByNameAndSpaceReturnsOnCall(0, instanceWithOperation(resources.LastOperation{
+					Type:  resources.UpdateOperation,
+					State: resources.OperationSucceeded,
+				}), ccv3.IncludedResources{}, ccv3.Warnings{"poll warning 1"}, nil)
+			})
+
+			It("reports the operation and returns immediately", func() {
+				Eventually(done).Should(BeClosed())
+
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(fakeCloudControllerClient.GetServiceInstanceByNameAndSpaceCallCount()).To(Equal(1))
+				Expect(reported).To(Equal([]resources.LastOperation{{Type: resources.UpdateOperation, State: resources.OperationSucceeded}}))
+			})
+		})
+
+		When("the instance is deleted while a delete is in progress", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceInstanceByNameAndSpaceReturnsOnCall(0, instanceWithOperation(resources.LastOperation{
+					Type:  resources.DeleteOperation,
+					State: resources.OperationInProgress,
+				}), ccv3.IncludedResources{}, ccv3.Warnings{"poll warning 1"}, nil)
+				fakeCloudControllerClient.GetServiceInstanceByNameAndSpaceReturnsOnCall(1, resources.ServiceInstance{}, ccv3.IncludedResources{}, ccv3.Warnings{"poll warning 2"}, ccerror.ServiceInstanceNotFoundError{Name: "my-db"})
+			})
+
+			It("reports the delete as succeeded", func() {
+				Eventually(done).Should(BeClosed())
+
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("poll warning 1", "poll warning 2"))
+				Expect(reported).To(Equal([]resources.LastOperation{
+					{Type: resources.DeleteOperation, State: resources.OperationInProgress},
+					{Type: resources.DeleteOperation, State: resources.OperationSucceeded},
+				}))
+			})
+		})
+
+		When("the instance does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceInstanceByNameAndSpaceReturnsOnCall(0, resources.ServiceInstance{}, ccv3.IncludedResources{}, ccv3.Warnings{"poll warning 1"}, ccerror.ServiceInstanceNotFoundError{Name: "my-db"})
+			})
+
+			It("returns a not found error", func() {
+				Eventually(done).Should(BeClosed())
+
+				Expect(executeErr).To(MatchError(actionerror.ServiceInstanceNotFoundError{Name: "my-db"}))
+				Expect(warnings).To(ConsistOf("poll warning 1"))
+				Expect(reported).To(BeEmpty())
+			})
+		})
+
+		When("getting the instance fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceInstanceByNameAndSpaceReturnsOnCall(1, resources.ServiceInstance{}, ccv3.IncludedResources{}, ccv3.Warnings{"poll warning 2"}, errors.New("boom"))
+			})
+
+			It("returns the error and warnings", func() {
+				Eventually(done).Should(BeClosed())
+
+				Expect(executeErr).To(MatchError("boom"))
+				Expect(warnings).To(ConsistOf("poll warning 1", "poll warning 2"))
+			})
+		})
+
+		When("the timeout is reached", func() {
+			BeforeEach(func() {
+				timeout = time.Minute
+				fakeConfig.PollingIntervalReturns(time.Hour)
+			})
+
+			It("returns a timeout error", func() {
+				Eventually(fakeCloudControllerClient.GetServiceInstanceByNameAndSpaceCallCount).Should(Equal(1))
+				fakeClock.WaitForNWatchersAndIncrement(time.Minute, 2)
+				Eventually(done).Should(BeClosed())
+
+				Expect(executeErr).To(MatchError(actionerror.ServiceInstanceOperationTimeoutError{Name: "my-db"}))
+				Expect(warnings).To(ConsistOf("poll warning 1"))
+			})
+		})
+	})
+})
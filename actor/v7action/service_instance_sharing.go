@@ -1,10 +1,17 @@
 package v7action
 
 import (
+	"sort"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
 	"code.cloudfoundry.org/cli/resources"
 	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/batcher"
+	"code.cloudfoundry.org/cli/util/lookuptable"
 	"code.cloudfoundry.org/cli/util/railway"
+	"code.cloudfoundry.org/cli/util/sorting"
 )
 
 type ServiceInstanceSharingParams struct {
@@ -12,6 +19,21 @@ type ServiceInstanceSharingParams struct {
 	OrgName   types.OptionalString
 }
 
+// BulkServiceInstanceSharingParams selects the spaces of one org that a
+// service instance is shared into: either the named spaces, or every space
+// of the org other than the one the instance lives in.
+type BulkServiceInstanceSharingParams struct {
+	SpaceNames []string
+	OrgName    types.OptionalString
+	AllSpaces  bool
+}
+
+type SharedServiceInstanceSummary struct {
+	resources.ServiceInstance
+	SpaceName string
+	SharedTo  []UsageSummaryWithSpaceAndOrg
+}
+
 func (actor Actor) ShareServiceInstanceToSpaceAndOrg(
 	serviceInstanceName, targetedSpaceGUID, targetedOrgGUID string,
 	sharedToDetails ServiceInstanceSharingParams,
@@ -55,12 +77,290 @@ func (actor Actor) UnshareServiceInstanceFromSpaceAndOrg(
 	))
 }
 
+// ShareServiceInstanceToSpaces shares a service instance into several spaces
+// with a single request and returns the spaces it was shared into.
+func (actor Actor) ShareServiceInstanceToSpaces(
+	serviceInstanceName, targetedSpaceGUID, targetedOrgGUID string,
+	sharedToDetails BulkServiceInstanceSharingParams,
+) ([]resources.Space, Warnings, error) {
+	var (
+		serviceInstance resources.ServiceInstance
+		shareToOrgGUID  string
+		shareSpaces     []resources.Space
+	)
+
+	warnings, err := handleServiceInstanceErrors(railway.Sequentially(
+		func() (warnings ccv3.Warnings, err error) {
+			serviceInstance, shareToOrgGUID, warnings, err = actor.getServiceInstanceAndShareToOrg(
+				serviceInstanceName,
+				targetedSpaceGUID,
+				targetedOrgGUID,
+				sharedToDetails.OrgName,
+			)
+			return
+		},
+		func() (warnings ccv3.Warnings, err error) {
+			shareSpaces, warnings, err = actor.getSpacesToShareInto(shareToOrgGUID, targetedSpaceGUID, sharedToDetails)
+			return
+		},
+		func() (warnings ccv3.Warnings, err error) {
+			if len(shareSpaces) > 0 {
+				var spaceGUIDs []string
+				for _, space := range shareSpaces {
+					spaceGUIDs = append(spaceGUIDs, space.GUID)
+				}
+				_, warnings, err = actor.CloudControllerClient.ShareServiceInstanceToSpaces(serviceInstance.GUID, spaceGUIDs)
+			}
+			return
+		},
+	))
+	if err != nil {
+		return nil, warnings, err
+	}
+
+	return shareSpaces, warnings, nil
+}
+
+// UnshareServiceInstanceFromAllSpaces removes every space a service instance
+// is shared into and returns the spaces it was unshared from.
+func (actor Actor) UnshareServiceInstanceFromAllSpaces(serviceInstanceName, targetedSpaceGUID string) ([]ccv3.SpaceWithOrganization, Warnings, error) {
+	var (
+		serviceInstance resources.ServiceInstance
+		sharedSpaces    []ccv3.SpaceWithOrganization
+	)
+
+	warnings, err := handleServiceInstanceErrors(railway.Sequentially(
+		func() (warnings ccv3.Warnings, err error) {
+			serviceInstance, _, warnings, err = actor.CloudControllerClient.GetServiceInstanceByNameAndSpace(serviceInstanceName, targetedSpaceGUID)
+			return
+		},
+		func() (warnings ccv3.Warnings, err error) {
+			sharedSpaces, warnings, err = actor.CloudControllerClient.GetServiceInstanceSharedSpaces(serviceInstance.GUID)
+			return
+		},
+		func() (ccv3.Warnings, error) {
+			var allWarnings ccv3.Warnings
+			for _, space := range sharedSpaces {
+				warnings, err := actor.CloudControllerClient.UnshareServiceInstanceFromSpace(serviceInstance.GUID, space.SpaceGUID)
+				allWarnings = append(allWarnings, warnings...)
+				if err != nil {
+					return allWarnings, err
+				}
+			}
+			return allWarnings, nil
+		},
+	))
+	if err != nil {
+		return nil, warnings, err
+	}
+
+	return sharedSpaces, warnings, nil
+}
+
+// GetSharedServiceInstanceSummaries returns the service instances owned by
+// spaces of an org that are shared into other spaces, with the number of apps
+// bound to them in each of those spaces. Instances shared into the org from
+// elsewhere are left out.
+func (actor Actor) GetSharedServiceInstanceSummaries(orgGUID string) ([]SharedServiceInstanceSummary, Warnings, error) {
+	var (
+		instances      []resources.ServiceInstance
+		included       ccv3.IncludedResources
+		usageSummaries = make(map[string][]resources.ServiceInstanceUsageSummary)
+		sharedSpaces   []ccv3.SpaceWithOrganization
+	)
+
+	warnings, err := railway.Sequentially(
+		func() (warnings ccv3.Warnings, err error) {
+			instances, included, warnings, err = actor.CloudControllerClient.GetServiceInstances(
+				ccv3.Query{Key: ccv3.OrganizationGUIDFilter, Values: []string{orgGUID}},
+				ccv3.Query{Key: ccv3.TypeFilter, Values: []string{string(resources.ManagedServiceInstance)}},
+				ccv3.Query{Key: ccv3.FieldsSpace, Values: []string{"guid", "name", "relationships.organization"}},
+				ccv3.Query{Key: ccv3.PerPage, Values: []string{ccv3.MaxPerPage}},
+			)
+			instances = instancesOwnedByOrg(instances, included.Spaces, orgGUID)
+			return
+		},
+		func() (ccv3.Warnings, error) {
+			var allWarnings ccv3.Warnings
+			for _, instance := range instances {
+				summary, warnings, err := actor.CloudControllerClient.GetServiceInstanceUsageSummary(instance.GUID)
+				allWarnings = append(allWarnings, warnings...)
+				if err != nil {
+					return allWarnings, err
+				}
+				if len(summary) > 0 {
+					usageSummaries[instance.GUID] = summary
+				}
+			}
+			return allWarnings, nil
+		},
+		func() (warnings ccv3.Warnings, err error) {
+			sharedSpaces, warnings, err = actor.getSharedSpaces(usageSummaries)
+			return
+		},
+	)
+	if err != nil {
+		return nil, Warnings(warnings), err
+	}
+
+	spaceNames := lookuptable.NameFromGUID(included.Spaces)
+	var summaries []SharedServiceInstanceSummary
+	for _, instance := range instances {
+		usage, shared := usageSummaries[instance.GUID]
+		if !shared {
+			continue
+		}
+
+		summaries = append(summaries, SharedServiceInstanceSummary{
+			ServiceInstance: instance,
+			SpaceName:       spaceNames[instance.SpaceGUID],
+			SharedTo:        buildUsageSummary(sharedSpaces, usage),
+		})
+	}
+
+	sort.Slice(summaries, func(i, j int) bool {
+		return sorting.LessIgnoreCase(summaries[i].Name, summaries[j].Name)
+	})
+
+	return summaries, Warnings(warnings), nil
+}
+
+// getSharedSpaces looks up, in batches, the spaces and orgs named in the
+// usage summaries of several service instances.
+func (actor Actor) getSharedSpaces(usageSummaries map[string][]resources.ServiceInstanceUsageSummary) ([]ccv3.SpaceWithOrganization, ccv3.Warnings, error) {
+	var (
+		spaceGUIDs   []string
+		seen         = make(map[string]bool)
+		sharedSpaces []ccv3.SpaceWithOrganization
+	)
+
+	for _, summary := range usageSummaries {
+		for _, usage := range summary {
+			if !seen[usage.SpaceGUID] {
+				seen[usage.SpaceGUID] = true
+				spaceGUIDs = append(spaceGUIDs, usage.SpaceGUID)
+			}
+		}
+	}
+	sort.Strings(spaceGUIDs)
+
+	warnings, err := batcher.RequestByGUID(spaceGUIDs, func(guids []string) (ccv3.Warnings, error) {
+		spaces, included, warnings, err := actor.CloudControllerClient.GetSpaces(
+			ccv3.Query{Key: ccv3.GUIDFilter, Values: guids},
+			ccv3.Query{Key: ccv3.Include, Values: []string{"organization"}},
+			ccv3.Query{Key: ccv3.PerPage, Values: []string{ccv3.MaxPerPage}},
+		)
+		orgNames := lookuptable.NameFromGUID(included.Organizations)
+		for _, space := range spaces {
+			org := space.Relationships[constant.RelationshipTypeOrganization]
+			sharedSpaces = append(sharedSpaces, ccv3.SpaceWithOrganization{
+				SpaceGUID:        space.GUID,
+				SpaceName:        space.Name,
+				OrganizationName: orgNames[org.GUID],
+			})
+		}
+		return warnings, err
+	})
+
+	return sharedSpaces, warnings, err
+}
+
+// instancesOwnedByOrg keeps the service instances whose own space belongs to
+// the org, dropping those only shared into it.
+func instancesOwnedByOrg(instances []resources.ServiceInstance, spaces []resources.Space, orgGUID string) []resources.ServiceInstance {
+	ownedSpaces := make(map[string]bool)
+	for _, space := range spaces {
+		if space.Relationships[constant.RelationshipTypeOrganization].GUID == orgGUID {
+			ownedSpaces[space.GUID] = true
+		}
+	}
+
+	var owned []resources.ServiceInstance
+	for _, instance := range instances {
+		if ownedSpaces[instance.SpaceGUID] {
+			owned = append(owned, instance)
+		}
+	}
+	return owned
+}
+
+func (actor Actor) getSpacesToShareInto(orgGUID, sourceSpaceGUID string, sharedToDetails BulkServiceInstanceSharingParams) ([]resources.Space, ccv3.Warnings, error) {
+	query := []ccv3.Query{
+		{Key: ccv3.OrganizationGUIDFilter, Values: []string{orgGUID}},
+		{Key: ccv3.OrderBy, Values: []string{ccv3.NameOrder}},
+	}
+	if !sharedToDetails.AllSpaces {
+		query = append(query, ccv3.Query{Key: ccv3.NameFilter, Values: sharedToDetails.SpaceNames})
+	}
+
+	spaces, _, warnings, err := actor.CloudControllerClient.GetSpaces(query...)
+	if err != nil {
+		return nil, warnings, err
+	}
+
+	if !sharedToDetails.AllSpaces {
+		found := make(map[string]bool)
+		for _, space := range spaces {
+			found[space.Name] = true
+		}
+		for _, name := range sharedToDetails.SpaceNames {
+			if !found[name] {
+				return nil, warnings, actionerror.SpaceNotFoundError{Name: name}
+			}
+		}
+		return spaces, warnings, nil
+	}
+
+	var otherSpaces []resources.Space
+	for _, space := range spaces {
+		if space.GUID != sourceSpaceGUID {
+			otherSpaces = append(otherSpaces, space)
+		}
+	}
+	return otherSpaces, warnings, nil
+}
+
 func (actor Actor) validateSharingDetails(
 	serviceInstanceName, targetedSpaceGUID, targetedOrgGUID string,
 	sharedToDetails ServiceInstanceSharingParams,
 ) (resources.ServiceInstance, resources.Space, ccv3.Warnings, error) {
 	var serviceInstance resources.ServiceInstance
 	var shareSpace resources.Space
+	var shareToOrgGUID string
+
+	warnings, err := railway.Sequentially(
+		func() (warnings ccv3.Warnings, err error) {
+			serviceInstance, shareToOrgGUID, warnings, err = actor.getServiceInstanceAndShareToOrg(
+				serviceInstanceName,
+				targetedSpaceGUID,
+				targetedOrgGUID,
+				sharedToDetails.OrgName,
+			)
+			return
+		},
+		func() (warnings ccv3.Warnings, err error) {
+			var spaceWarnings Warnings
+			shareSpace, spaceWarnings, err = actor.GetSpaceByNameAndOrganization(sharedToDetails.SpaceName, shareToOrgGUID)
+			warnings = ccv3.Warnings(spaceWarnings)
+			return
+		},
+	)
+
+	if err != nil {
+		return resources.ServiceInstance{}, resources.Space{}, warnings, err
+	}
+
+	return serviceInstance, shareSpace, warnings, nil
+}
+
+// getServiceInstanceAndShareToOrg finds the service instance in the targeted
+// space and resolves the org to share into, which is the targeted org unless
+// another org is named.
+func (actor Actor) getServiceInstanceAndShareToOrg(
+	serviceInstanceName, targetedSpaceGUID, targetedOrgGUID string,
+	orgName types.OptionalString,
+) (resources.ServiceInstance, string, ccv3.Warnings, error) {
+	var serviceInstance resources.ServiceInstance
 	var shareToOrgGUID = targetedOrgGUID
 
 	warnings, err := railway.Sequentially(
@@ -69,29 +369,19 @@ func (actor Actor) validateSharingDetails(
 			return
 		},
 		func() (warnings ccv3.Warnings, err error) {
-			if sharedToDetails.OrgName.IsSet {
+			if orgName.IsSet {
 				var (
 					orgWarnings  Warnings
 					organization resources.Organization
 				)
 
-				organization, orgWarnings, err = actor.GetOrganizationByName(sharedToDetails.OrgName.Value)
+				organization, orgWarnings, err = actor.GetOrganizationByName(orgName.Value)
 				warnings = ccv3.Warnings(orgWarnings)
 				shareToOrgGUID = organization.GUID
 			}
 			return
 		},
-		func() (warnings ccv3.Warnings, err error) {
-			var spaceWarnings Warnings
-			shareSpace, spaceWarnings, err = actor.GetSpaceByNameAndOrganization(sharedToDetails.SpaceName, shareToOrgGUID)
-			warnings = ccv3.Warnings(spaceWarnings)
-			return
-		},
 	)
 
-	if err != nil {
-		return resources.ServiceInstance{}, resources.Space{}, warnings, err
-	}
-
-	return serviceInstance, shareSpace, warnings, nil
+	return serviceInstance, shareToOrgGUID, warnings, err
 }
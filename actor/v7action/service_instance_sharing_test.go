@@ -8,6 +8,7 @@ import (
 	"code.cloudfoundry.org/cli/actor/v7action/v7actionfakes"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
 	"code.cloudfoundry.org/cli/resources"
 	"code.cloudfoundry.org/cli/types"
 	. "github.com/onsi/ginkgo/v2"
@@ -325,4 +326,296 @@ var _ = Describe("Service Instance Sharing", func() {
 			})
 		})
 	})
+
+	Describe("ShareServiceInstanceToSpaces", func() {
+		var (
+			params       BulkServiceInstanceSharingParams
+			sharedSpaces []resources.Space
+		)
+
+		BeforeEach(func() {
+			params = BulkServiceInstanceSharingParams{SpaceNames: []string{"dev", "test"}}
+
+			fakeCloudControllerClient.GetServiceInstanceByNameAndSpaceReturns(
+				resources.ServiceInstance{GUID: "service-instance-guid"},
+				ccv3.IncludedResources{},
+				ccv3.Warnings{"instance warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetSpacesReturns(
+				[]resources.Space{
+					{GUID: "dev-guid", Name: "dev"},
+					{GUID: "test-guid", Name: "test"},
+				},
+				ccv3.IncludedResources{},
+				ccv3.Warnings{"spaces warning"},
+				nil,
+			)
+			fakeCloudControllerClient.ShareServiceInstanceToSpacesReturns(resources.RelationshipList{}, ccv3.Warnings{"share warning"}, nil)
+		})
+
+		JustBeforeEach(func() {
+			sharedSpaces, warnings, executionError = actor.ShareServiceInstanceToSpaces(serviceInstanceName, targetedSpaceGUID, targetedOrgGUID, params)
+		})
+
+		It("shares the instance into the named spaces of the targeted org with one request", func() {
+			Expect(executionError).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf("instance warning", "spaces warning", "share warning"))
+			Expect(sharedSpaces).To(HaveLen(2))
+
+			Expect(fakeCloudControllerClient.GetSpacesArgsForCall(0)).To(ConsistOf(
+				ccv3.Query{Key: ccv3.OrganizationGUIDFilter, Values: []string{targetedOrgGUID}},
+				ccv3.Query{Key: ccv3.OrderBy, Values: []string{ccv3.NameOrder}},
+				ccv3.Query{Key: ccv3.NameFilter, Values: []string{"dev", "test"}},
+			))
+
+			Expect(fakeCloudControllerClient.ShareServiceInstanceToSpacesCallCount()).To(Equal(1))
+			instanceGUID, spaceGUIDs := fakeCloudControllerClient.ShareServiceInstanceToSpacesArgsForCall(0)
+			Expect(instanceGUID).To(Equal("service-instance-guid"))
+			Expect(spaceGUIDs).To(Equal([]string{"dev-guid", "test-guid"}))
+		})
+
+		When("a named space does not exist", func() {
+			BeforeEach(func() {
+				params.SpaceNames = []string{"dev", "test", "prod"}
+			})
+
+			It("returns a space not found error without sharing", func() {
+				Expect(executionError).To(MatchError(actionerror.SpaceNotFoundError{Name: "prod"}))
+				Expect(fakeCloudControllerClient.ShareServiceInstanceToSpacesCallCount()).To(Equal(0))
+			})
+		})
+
+		When("sharing into all spaces of another org", func() {
+			BeforeEach(func() {
+				params = BulkServiceInstanceSharingParams{OrgName: types.NewOptionalString(shareToOrgName), AllSpaces: true}
+
+				fakeCloudControllerClient.GetOrganizationsReturns(
+					[]resources.Organization{{GUID: "other-org-guid", Name: shareToOrgName}},
+					ccv3.Warnings{"org warning"},
+					nil,
+				)
+				fakeCloudControllerClient.GetSpacesReturns(
+					[]resources.Space{
+						{GUID: targetedSpaceGUID, Name: "source"},
+						{GUID: "dev-guid", Name: "dev"},
+					},
+					ccv3.IncludedResources{},
+					ccv3.Warnings{"spaces warning"},
+					nil,
+				)
+			})
+
+			It("shares into every space of the org except the source space", func() {
+				Expect(executionError).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("instance warning", "org warning", "spaces warning", "share warning"))
+
+				Expect(fakeCloudControllerClient.GetSpacesArgsForCall(0)).To(ConsistOf(
+					ccv3.Query{Key: ccv3.OrganizationGUIDFilter, Values: []string{"other-org-guid"}},
+					ccv3.Query{Key: ccv3.OrderBy, Values: []string{ccv3.NameOrder}},
+				))
+
+				_, spaceGUIDs := fakeCloudControllerClient.ShareServiceInstanceToSpacesArgsForCall(0)
+				Expect(spaceGUIDs).To(Equal([]string{"dev-guid"}))
+				Expect(sharedSpaces).To(Equal([]resources.Space{{GUID: "dev-guid", Name: "dev"}}))
+			})
+		})
+
+		When("the service instance cannot be found", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceInstanceByNameAndSpaceReturns(
+					resources.ServiceInstance{},
+					ccv3.IncludedResources{},
+					ccv3.Warnings{"instance warning"},
+					ccerror.ServiceInstanceNotFoundError{Name: serviceInstanceName},
+				)
+			})
+
+			It("returns a not found error", func() {
+				Expect(executionError).To(MatchError(actionerror.ServiceInstanceNotFoundError{Name: serviceInstanceName}))
+				Expect(warnings).To(ConsistOf("instance warning"))
+			})
+		})
+
+		When("sharing fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.ShareServiceInstanceToSpacesReturns(resources.RelationshipList{}, ccv3.Warnings{"share warning"}, errors.New("boom"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executionError).To(MatchError("boom"))
+				Expect(warnings).To(ConsistOf("instance warning", "spaces warning", "share warning"))
+				Expect(sharedSpaces).To(BeNil())
+			})
+		})
+	})
+
+	Describe("UnshareServiceInstanceFromAllSpaces", func() {
+		var unsharedSpaces []ccv3.SpaceWithOrganization
+
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetServiceInstanceByNameAndSpaceReturns(
+				resources.ServiceInstance{GUID: "service-instance-guid"},
+				ccv3.IncludedResources{},
+				ccv3.Warnings{"instance warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetServiceInstanceSharedSpacesReturns(
+				[]ccv3.SpaceWithOrganization{
+					{SpaceGUID: "dev-guid", SpaceName: "dev", OrganizationName: "org-1"},
+					{SpaceGUID: "test-guid", SpaceName: "test", OrganizationName: "org-2"},
+				},
+				ccv3.Warnings{"shared spaces warning"},
+				nil,
+			)
+			fakeCloudControllerClient.UnshareServiceInstanceFromSpaceReturns(ccv3.Warnings{"unshare warning"}, nil)
+		})
+
+		JustBeforeEach(func() {
+			unsharedSpaces, warnings, executionError = actor.UnshareServiceInstanceFromAllSpaces(serviceInstanceName, targetedSpaceGUID)
+		})
+
+		It("unshares the instance from every space it is shared into", func() {
+			Expect(executionError).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf("instance warning", "shared spaces warning", "unshare warning", "unshare warning"))
+			Expect(unsharedSpaces).To(HaveLen(2))
+
+			Expect(fakeCloudControllerClient.GetServiceInstanceSharedSpacesArgsForCall(0)).To(Equal("service-instance-guid"))
+			Expect(fakeCloudControllerClient.UnshareServiceInstanceFromSpaceCallCount()).To(Equal(2))
+			instanceGUID, spaceGUID := fakeCloudControllerClient.UnshareServiceInstanceFromSpaceArgsForCall(0)
+			Expect(instanceGUID).To(Equal("service-instance-guid"))
+			Expect(spaceGUID).To(Equal("dev-guid"))
+			_, spaceGUID = fakeCloudControllerClient.UnshareServiceInstanceFromSpaceArgsForCall(1)
+			Expect(spaceGUID).To(Equal("test-guid"))
+		})
+
+		When("unsharing fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.UnshareServiceInstanceFromSpaceReturnsOnCall(0, ccv3.Warnings{"unshare warning"}, errors.New("boom"))
+			})
+
+			It("stops and returns the error", func() {
+				Expect(executionError).To(MatchError("boom"))
+				Expect(warnings).To(ConsistOf("instance warning", "shared spaces warning", "unshare warning"))
+				Expect(fakeCloudControllerClient.UnshareServiceInstanceFromSpaceCallCount()).To(Equal(1))
+			})
+		})
+	})
+
+	Describe("GetSharedServiceInstanceSummaries", func() {
+		var summaries []SharedServiceInstanceSummary
+
+		orgRelationship := func(orgGUID string) resources.Relationships {
+			return resources.Relationships{
+				constant.RelationshipTypeOrganization: resources.Relationship{GUID: orgGUID},
+			}
+		}
+
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetServiceInstancesReturns(
+				[]resources.ServiceInstance{
+					{GUID: "redis-guid", Name: "redis", SpaceGUID: "space-1-guid"},
+					{GUID: "lonely-guid", Name: "lonely", SpaceGUID: "space-1-guid"},
+					{GUID: "db-guid", Name: "Db", SpaceGUID: "space-2-guid"},
+					{GUID: "shared-in-guid", Name: "shared-in", SpaceGUID: "elsewhere-guid"},
+				},
+				ccv3.IncludedResources{Spaces: []resources.Space{
+					{GUID: "space-1-guid", Name: "space-1", Relationships: orgRelationship("some-org-guid")},
+					{GUID: "space-2-guid", Name: "space-2", Relationships: orgRelationship("some-org-guid")},
+					{GUID: "elsewhere-guid", Name: "elsewhere", Relationships: orgRelationship("other-org-guid")},
+				}},
+				ccv3.Warnings{"instances warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetServiceInstanceUsageSummaryStub = func(guid string) ([]resources.ServiceInstanceUsageSummary, ccv3.Warnings, error) {
+				switch guid {
+				case "redis-guid":
+					return []resources.ServiceInstanceUsageSummary{{SpaceGUID: "dev-guid", BoundAppCount: 3}}, ccv3.Warnings{"usage warning"}, nil
+				case "db-guid":
+					return []resources.ServiceInstanceUsageSummary{{SpaceGUID: "test-guid", BoundAppCount: 0}}, nil, nil
+				default:
+					return nil, nil, nil
+				}
+			}
+			fakeCloudControllerClient.GetSpacesReturns(
+				[]resources.Space{
+					{GUID: "dev-guid", Name: "dev", Relationships: orgRelationship("org-1-guid")},
+					{GUID: "test-guid", Name: "test", Relationships: orgRelationship("org-2-guid")},
+				},
+				ccv3.IncludedResources{Organizations: []resources.Organization{
+					{GUID: "org-1-guid", Name: "org-1"},
+					{GUID: "org-2-guid", Name: "org-2"},
+				}},
+				ccv3.Warnings{"spaces warning"},
+				nil,
+			)
+		})
+
+		JustBeforeEach(func() {
+			summaries, warnings, executionError = actor.GetSharedServiceInstanceSummaries("some-org-guid")
+		})
+
+		It("gets the managed service instances of the org with their owning spaces", func() {
+			Expect(fakeCloudControllerClient.GetServiceInstancesArgsForCall(0)).To(ConsistOf(
+				ccv3.Query{Key: ccv3.OrganizationGUIDFilter, Values: []string{"some-org-guid"}},
+				ccv3.Query{Key: ccv3.TypeFilter, Values: []string{"managed"}},
+				ccv3.Query{Key: ccv3.FieldsSpace, Values: []string{"guid", "name", "relationships.organization"}},
+				ccv3.Query{Key: ccv3.PerPage, Values: []string{ccv3.MaxPerPage}},
+			))
+		})
+
+		It("only gets the usage of instances owned by the org", func() {
+			Expect(fakeCloudControllerClient.GetServiceInstanceUsageSummaryCallCount()).To(Equal(3))
+			for i := 0; i < 3; i++ {
+				Expect(fakeCloudControllerClient.GetServiceInstanceUsageSummaryArgsForCall(i)).NotTo(Equal("shared-in-guid"))
+			}
+		})
+
+		It("gets the spaces shared into with one request", func() {
+			Expect(fakeCloudControllerClient.GetServiceInstanceSharedSpacesCallCount()).To(Equal(0))
+			Expect(fakeCloudControllerClient.GetSpacesCallCount()).To(Equal(1))
+			Expect(fakeCloudControllerClient.GetSpacesArgsForCall(0)).To(ConsistOf(
+				ccv3.Query{Key: ccv3.GUIDFilter, Values: []string{"dev-guid", "test-guid"}},
+				ccv3.Query{Key: ccv3.Include, Values: []string{"organization"}},
+				ccv3.Query{Key: ccv3.PerPage, Values: []string{ccv3.MaxPerPage}},
+			))
+		})
+
+		It("returns the shared instances ordered by name", func() {
+			Expect(executionError).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf("instances warning", "usage warning", "spaces warning"))
+
+			Expect(summaries).To(HaveLen(2))
+			Expect(summaries[0].Name).To(Equal("Db"))
+			Expect(summaries[0].SpaceName).To(Equal("space-2"))
+			Expect(summaries[0].SharedTo).To(Equal([]UsageSummaryWithSpaceAndOrg{{SpaceName: "test", OrganizationName: "org-2"}}))
+			Expect(summaries[1].Name).To(Equal("redis"))
+			Expect(summaries[1].SpaceName).To(Equal("space-1"))
+			Expect(summaries[1].SharedTo).To(Equal([]UsageSummaryWithSpaceAndOrg{{SpaceName: "dev", OrganizationName: "org-1", BoundAppCount: 3}}))
+		})
+
+		When("getting the usage summary fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceInstanceUsageSummaryStub = nil
+				fakeCloudControllerClient.GetServiceInstanceUsageSummaryReturns(nil, ccv3.Warnings{"usage warning"}, errors.New("boom"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executionError).To(MatchError("boom"))
+				Expect(warnings).To(ConsistOf("instances warning", "usage warning"))
+				Expect(fakeCloudControllerClient.GetSpacesCallCount()).To(Equal(0))
+			})
+		})
+
+		When("getting the shared spaces fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSpacesReturns(nil, ccv3.IncludedResources{}, ccv3.Warnings{"spaces warning"}, errors.New("boom"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executionError).To(MatchError("boom"))
+				Expect(warnings).To(ConsistOf("instances warning", "usage warning", "spaces warning"))
+			})
+		})
+	})
 })
@@ -562,6 +562,96 @@ var _ = Describe("Service Instance Actions", func() {
 
 		})
 
+		Describe("validating the parameters", func() {
+			When("the new plan publishes an update schema", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetServicePlansReturns(
+						[]resources.ServicePlan{{
+							GUID: newServicePlanGUID,
+							Name: newServicePlanName,
+							ServiceInstanceUpdateSchema: map[string]interface{}{
+								"properties": map[string]interface{}{
+									"foo": map[string]interface{}{"type": "integer"},
+								},
+							},
+						}},
+						ccv3.Warnings{"fake get service plan warning"},
+						nil,
+					)
+				})
+
+				It("returns an error when the parameters do not match", func() {
+					Expect(executeErr).To(MatchError(actionerror.ServiceParametersInvalidError{
+						Operation: "updating the service instance",
+						Errors:    []string{"$.foo: must be of type integer, got string"},
+					}))
+					Expect(fakeCloudControllerClient.GetServicePlanByGUIDCallCount()).To(BeZero())
+					Expect(fakeCloudControllerClient.UpdateServiceInstanceCallCount()).To(BeZero())
+				})
+			})
+
+			When("no plan change requested", func() {
+				BeforeEach(func() {
+					params.ServicePlanName = ""
+
+					fakeCloudControllerClient.GetServicePlanByGUIDReturns(
+						resources.ServicePlan{
+							GUID: servicePlanGUID,
+							ServiceInstanceUpdateSchema: map[string]interface{}{
+								"additionalProperties": false,
+							},
+						},
+						ccv3.Warnings{"fake get current plan warning"},
+						nil,
+					)
+				})
+
+				It("validates against the current plan", func() {
+					Expect(fakeCloudControllerClient.GetServicePlanByGUIDCallCount()).To(Equal(1))
+					Expect(fakeCloudControllerClient.GetServicePlanByGUIDArgsForCall(0)).To(Equal(servicePlanGUID))
+
+					Expect(executeErr).To(MatchError(actionerror.ServiceParametersInvalidError{
+						Operation: "updating the service instance",
+						Errors:    []string{"$.foo: property is not allowed"},
+					}))
+					Expect(warnings).To(ConsistOf("fake get service instance warning", "fake get current plan warning"))
+				})
+
+				When("the current plan is no longer visible", func() {
+					BeforeEach(func() {
+						fakeCloudControllerClient.GetServicePlanByGUIDReturns(resources.ServicePlan{}, nil, ccerror.ResourceNotFoundError{})
+					})
+
+					It("leaves the validation to the broker", func() {
+						Expect(executeErr).NotTo(HaveOccurred())
+						Expect(fakeCloudControllerClient.UpdateServiceInstanceCallCount()).To(Equal(1))
+					})
+				})
+
+				When("getting the current plan fails", func() {
+					BeforeEach(func() {
+						fakeCloudControllerClient.GetServicePlanByGUIDReturns(resources.ServicePlan{}, ccv3.Warnings{"fake get current plan warning"}, errors.New("plan-error"))
+					})
+
+					It("returns the error and warnings", func() {
+						Expect(executeErr).To(MatchError("plan-error"))
+						Expect(warnings).To(ConsistOf("fake get service instance warning", "fake get current plan warning"))
+					})
+				})
+			})
+
+			When("parameters are not being changed", func() {
+				BeforeEach(func() {
+					params.ServicePlanName = ""
+					params.Parameters = types.OptionalObject{}
+				})
+
+				It("does not get the plan", func() {
+					Expect(fakeCloudControllerClient.GetServicePlanByGUIDCallCount()).To(BeZero())
+				})
+			})
+		})
+
 		Describe("detecting no-op updates", func() {
 			When("no updates are requested", func() {
 				BeforeEach(func() {
@@ -942,6 +1032,57 @@ var _ = Describe("Service Instance Actions", func() {
 			})))
 		})
 
+		When("the plan publishes a create schema", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServicePlansReturns(
+					[]resources.ServicePlan{{
+						GUID: "fake-plan-guid",
+						ServiceInstanceCreateSchema: map[string]interface{}{
+							"type":     "object",
+							"required": []interface{}{"size"},
+							"properties": map[string]interface{}{
+								"param1": map[string]interface{}{"enum": []interface{}{"some-value"}},
+							},
+						},
+					}},
+					ccv3.Warnings{"plan-warning"},
+					nil,
+				)
+			})
+
+			It("returns an error without creating the instance when the parameters do not match", func() {
+				Expect(fakeCloudControllerClient.CreateServiceInstanceCallCount()).To(Equal(0))
+				Expect(warnings).To(ConsistOf("plan-warning"))
+				Expect(err).To(MatchError(actionerror.ServiceParametersInvalidError{
+					Operation: "creating the service instance",
+					Errors:    []string{`$: missing required property "size"`},
+				}))
+				Expect(stream).To(BeNil())
+			})
+
+			When("the parameters match", func() {
+				BeforeEach(func() {
+					fakeParams = types.NewOptionalObject(map[string]interface{}{"param1": "some-value", "size": 2.0})
+				})
+
+				It("creates the instance", func() {
+					Expect(err).NotTo(HaveOccurred())
+					Expect(fakeCloudControllerClient.CreateServiceInstanceCallCount()).To(Equal(1))
+				})
+			})
+
+			When("no parameters are given", func() {
+				BeforeEach(func() {
+					fakeParams = types.OptionalObject{}
+				})
+
+				It("creates the instance", func() {
+					Expect(err).NotTo(HaveOccurred())
+					Expect(fakeCloudControllerClient.CreateServiceInstanceCallCount()).To(Equal(1))
+				})
+			})
+		})
+
 		Context("error scenarios", func() {
 			When("no plan found", func() {
 				BeforeEach(func() {
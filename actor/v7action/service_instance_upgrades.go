@@ -0,0 +1,157 @@
+package v7action
+
+import (
+	"sort"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/util/batcher"
+	"code.cloudfoundry.org/cli/util/extract"
+	"code.cloudfoundry.org/cli/util/railway"
+)
+
+// ServiceInstanceUpgradeFilter narrows down the service instances searched for
+// available upgrades. Empty fields do not filter.
+type ServiceInstanceUpgradeFilter struct {
+	SpaceGUID           string
+	OrganizationGUID    string
+	ServiceOfferingName string
+	ServicePlanName     string
+}
+
+// ServiceInstanceUpgradeCandidate is a managed service instance whose plan has
+// a newer maintenance_info version than the instance.
+type ServiceInstanceUpgradeCandidate struct {
+	Name                string
+	GUID                string
+	SpaceGUID           string
+	SpaceName           string
+	OrganizationName    string
+	ServiceOfferingName string
+	ServicePlanName     string
+	CurrentVersion      string
+	AvailableVersion    string
+	// Description is the plan's description of the available version.
+	Description   string
+	LastOperation resources.LastOperation
+}
+
+// OperationInProgress reports whether the instance is busy with another
+// operation, which prevents it from being upgraded.
+func (c ServiceInstanceUpgradeCandidate) OperationInProgress() bool {
+	return c.LastOperation.State == resources.OperationInProgress
+}
+
+// GetServiceInstanceUpgradeCandidates returns the managed service instances
+// matching the filter that have an upgrade available, ordered by org, space
+// and name.
+func (actor Actor) GetServiceInstanceUpgradeCandidates(filter ServiceInstanceUpgradeFilter) ([]ServiceInstanceUpgradeCandidate, Warnings, error) {
+	var (
+		instances []resources.ServiceInstance
+		included  ccv3.IncludedResources
+		plans     []resources.ServicePlan
+	)
+
+	query := []ccv3.Query{
+		{Key: ccv3.TypeFilter, Values: []string{string(resources.ManagedServiceInstance)}},
+		{Key: ccv3.FieldsServicePlan, Values: []string{"guid", "name", "relationships.service_offering"}},
+		{Key: ccv3.FieldsServicePlanServiceOffering, Values: []string{"guid", "name"}},
+		{Key: ccv3.FieldsSpace, Values: []string{"guid", "name", "relationships.organization"}},
+		{Key: ccv3.FieldsSpaceOrganization, Values: []string{"guid", "name"}},
+		{Key: ccv3.PerPage, Values: []string{ccv3.MaxPerPage}},
+	}
+	if filter.SpaceGUID != "" {
+		query = append(query, ccv3.Query{Key: ccv3.SpaceGUIDFilter, Values: []string{filter.SpaceGUID}})
+	}
+	if filter.OrganizationGUID != "" {
+		query = append(query, ccv3.Query{Key: ccv3.OrganizationGUIDFilter, Values: []string{filter.OrganizationGUID}})
+	}
+
+	warnings, err := railway.Sequentially(
+		func() (warnings ccv3.Warnings, err error) {
+			instances, included, warnings, err = actor.CloudControllerClient.GetServiceInstances(query...)
+			return
+		},
+		func() (warnings ccv3.Warnings, err error) {
+			instances = upgradeableInstances(instances)
+			return batcher.RequestByGUID(
+				extract.UniqueList("ServicePlanGUID", instances),
+				func(guids []string) (ccv3.Warnings, error) {
+					batch, warnings, err := actor.CloudControllerClient.GetServicePlans(
+						ccv3.Query{Key: ccv3.GUIDFilter, Values: guids},
+						ccv3.Query{Key: ccv3.PerPage, Values: []string{ccv3.MaxPerPage}},
+					)
+					plans = append(plans, batch...)
+					return warnings, err
+				},
+			)
+		},
+	)
+	if err != nil {
+		return nil, Warnings(warnings), err
+	}
+
+	planDetailsLookup := buildPlanDetailsLookup(included)
+	plansLookup := make(map[string]resources.ServicePlan)
+	for _, plan := range plans {
+		plansLookup[plan.GUID] = plan
+	}
+	spacesLookup := make(map[string]resources.Space)
+	for _, space := range included.Spaces {
+		spacesLookup[space.GUID] = space
+	}
+	orgNamesLookup := make(map[string]string)
+	for _, org := range included.Organizations {
+		orgNamesLookup[org.GUID] = org.Name
+	}
+
+	var candidates []ServiceInstanceUpgradeCandidate
+	for _, instance := range instances {
+		names := planDetailsLookup[instance.ServicePlanGUID]
+		if filter.ServiceOfferingName != "" && names.offering != filter.ServiceOfferingName {
+			continue
+		}
+		if filter.ServicePlanName != "" && names.plan != filter.ServicePlanName {
+			continue
+		}
+
+		plan := plansLookup[instance.ServicePlanGUID]
+		space := spacesLookup[instance.SpaceGUID]
+		candidates = append(candidates, ServiceInstanceUpgradeCandidate{
+			Name:                instance.Name,
+			GUID:                instance.GUID,
+			SpaceGUID:           instance.SpaceGUID,
+			SpaceName:           space.Name,
+			OrganizationName:    orgNamesLookup[space.Relationships[constant.RelationshipTypeOrganization].GUID],
+			ServiceOfferingName: names.offering,
+			ServicePlanName:     names.plan,
+			CurrentVersion:      instance.MaintenanceInfoVersion,
+			AvailableVersion:    plan.MaintenanceInfoVersion,
+			Description:         plan.MaintenanceInfoDescription,
+			LastOperation:       instance.LastOperation,
+		})
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		if candidates[i].OrganizationName != candidates[j].OrganizationName {
+			return candidates[i].OrganizationName < candidates[j].OrganizationName
+		}
+		if candidates[i].SpaceName != candidates[j].SpaceName {
+			return candidates[i].SpaceName < candidates[j].SpaceName
+		}
+		return candidates[i].Name < candidates[j].Name
+	})
+
+	return candidates, Warnings(warnings), nil
+}
+
+func upgradeableInstances(instances []resources.ServiceInstance) []resources.ServiceInstance {
+	var upgradeable []resources.ServiceInstance
+	for _, instance := range instances {
+		if instance.UpgradeAvailable.IsSet && instance.UpgradeAvailable.Value {
+			upgradeable = append(upgradeable, instance)
+		}
+	}
+	return upgradeable
+}
@@ -0,0 +1,247 @@
+package v7action_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/actor/v7action/v7actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Service Instance Upgrades Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v7actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v7actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil, nil, nil, nil)
+	})
+
+	Describe("GetServiceInstanceUpgradeCandidates", func() {
+		var (
+			filter     ServiceInstanceUpgradeFilter
+			candidates []ServiceInstanceUpgradeCandidate
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			filter = ServiceInstanceUpgradeFilter{}
+
+			fakeCloudControllerClient.GetServiceInstancesReturns(
+				[]resources.ServiceInstance{
+					{
+						Name:                   "db-b",
+						GUID:                   "db-b-guid",
+						SpaceGUID:              "space-1-guid",
+						ServicePlanGUID:        "plan-small-guid",
+						MaintenanceInfoVersion: "1.0.0",
+						UpgradeAvailable:       types.NewOptionalBoolean(true),
+						LastOperation:          resources.LastOperation{Type: resources.UpdateOperation, State: resources.OperationInProgress},
+					},
+					{
+						Name:                   "db-a",
+						GUID:                   "db-a-guid",
+						SpaceGUID:              "space-1-guid",
+						ServicePlanGUID:        "plan-large-guid",
+						MaintenanceInfoVersion: "1.0.0",
+						UpgradeAvailable:       types.NewOptionalBoolean(true),
+					},
+					{
+						Name:             "up-to-date",
+						GUID:             "up-to-date-guid",
+						SpaceGUID:        "space-1-guid",
+						ServicePlanGUID:  "plan-small-guid",
+						UpgradeAvailable: types.NewOptionalBoolean(false),
+					},
+					{
+						Name:                   "cache",
+						GUID:                   "cache-guid",
+						SpaceGUID:              "space-2-guid",
+						ServicePlanGUID:        "plan-cache-guid",
+						MaintenanceInfoVersion: "2.0.0",
+						UpgradeAvailable:       types.NewOptionalBoolean(true),
+					},
+				},
+				ccv3.IncludedResources{
+					ServicePlans: []resources.ServicePlan{
+						{GUID: "plan-small-guid", Name: "small", ServiceOfferingGUID: "offering-db-guid"},
+						{GUID: "plan-large-guid", Name: "large", ServiceOfferingGUID: "offering-db-guid"},
+						{GUID: "plan-cache-guid", Name: "small", ServiceOfferingGUID: "offering-cache-guid"},
+					},
+					ServiceOfferings: []resources.ServiceOffering{
+						{GUID: "offering-db-guid", Name: "postgres"},
+						{GUID: "offering-cache-guid", Name: "redis"},
+					},
+					Spaces: []resources.Space{
+						{GUID: "space-1-guid", Name: "space-1", Relationships: resources.Relationships{
+							constant.RelationshipTypeOrganization: resources.Relationship{GUID: "org-b-guid"},
+						}},
+						{GUID: "space-2-guid", Name: "space-2", Relationships: resources.Relationships{
+							constant.RelationshipTypeOrganization: resources.Relationship{GUID: "org-a-guid"},
+						}},
+					},
+					Organizations: []resources.Organization{
+						{GUID: "org-a-guid", Name: "org-a"},
+						{GUID: "org-b-guid", Name: "org-b"},
+					},
+				},
+				ccv3.Warnings{"instances-warning"},
+				nil,
+			)
+
+			fakeCloudControllerClient.GetServicePlansReturns(
+				[]resources.ServicePlan{
+					{GUID: "plan-small-guid", MaintenanceInfoVersion: "1.1.0", MaintenanceInfoDescription: "Postgres 14.2"},
+					{GUID: "plan-large-guid", MaintenanceInfoVersion: "1.1.0", MaintenanceInfoDescription: "Postgres 14.2"},
+					{GUID: "plan-cache-guid", MaintenanceInfoVersion: "3.0.0", MaintenanceInfoDescription: "Redis 7"},
+				},
+				ccv3.Warnings{"plans-warning"},
+				nil,
+			)
+		})
+
+		JustBeforeEach(func() {
+			candidates, warnings, executeErr = actor.GetServiceInstanceUpgradeCandidates(filter)
+		})
+
+		It("lists managed service instances with the plan and space details", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf("instances-warning", "plans-warning"))
+
+			Expect(fakeCloudControllerClient.GetServiceInstancesCallCount()).To(Equal(1))
+			Expect(fakeCloudControllerClient.GetServiceInstancesArgsForCall(0)).To(ConsistOf(
+				ccv3.Query{Key: ccv3.TypeFilter, Values: []string{"managed"}},
+				ccv3.Query{Key: ccv3.FieldsServicePlan, Values: []string{"guid", "name", "relationships.service_offering"}},
+				ccv3.Query{Key: ccv3.FieldsServicePlanServiceOffering, Values: []string{"guid", "name"}},
+				ccv3.Query{Key: ccv3.FieldsSpace, Values: []string{"guid", "name", "relationships.organization"}},
+				ccv3.Query{Key: ccv3.FieldsSpaceOrganization, Values: []string{"guid", "name"}},
+				ccv3.Query{Key: ccv3.PerPage, Values: []string{ccv3.MaxPerPage}},
+			))
+		})
+
+		It("gets the maintenance info of the plans of upgradeable instances", func() {
+			Expect(fakeCloudControllerClient.GetServicePlansCallCount()).To(Equal(1))
+			Expect(fakeCloudControllerClient.GetServicePlansArgsForCall(0)).To(ConsistOf(
+				ccv3.Query{Key: ccv3.GUIDFilter, Values: []string{"plan-small-guid", "plan-large-guid", "plan-cache-guid"}},
+				ccv3.Query{Key: ccv3.PerPage, Values: []string{ccv3.MaxPerPage}},
+			))
+		})
+
+		It("returns the instances with an upgrade available ordered by org, space and name", func() {
+			Expect(candidates).To(Equal([]ServiceInstanceUpgradeCandidate{
+				{
+					Name:                "cache",
+					GUID:                "cache-guid",
+					SpaceGUID:           "space-2-guid",
+					SpaceName:           "space-2",
+					OrganizationName:    "org-a",
+					ServiceOfferingName: "redis",
+					ServicePlanName:     "small",
+					CurrentVersion:      "2.0.0",
+					AvailableVersion:    "3.0.0",
+					Description:         "Redis 7",
+				},
+				{
+					Name:                "db-a",
+					GUID:                "db-a-guid",
+					SpaceGUID:           "space-1-guid",
+					SpaceName:           "space-1",
+					OrganizationName:    "org-b",
+					ServiceOfferingName: "postgres",
+					ServicePlanName:     "large",
+					CurrentVersion:      "1.0.0",
+					AvailableVersion:    "1.1.0",
+					Description:         "Postgres 14.2",
+				},
+				{
+					Name:                "db-b",
+					GUID:                "db-b-guid",
+					SpaceGUID:           "space-1-guid",
+					SpaceName:           "space-1",
+					OrganizationName:    "org-b",
+					ServiceOfferingName: "postgres",
+					ServicePlanName:     "small",
+					CurrentVersion:      "1.0.0",
+					AvailableVersion:    "1.1.0",
+					Description:         "Postgres 14.2",
+					LastOperation:       resources.LastOperation{Type: resources.UpdateOperation, State: resources.OperationInProgress},
+				},
+			}))
+			Expect(candidates[2].OperationInProgress()).To(BeTrue())
+			Expect(candidates[1].OperationInProgress()).To(BeFalse())
+		})
+
+		When("filtering by space and org", func() {
+			BeforeEach(func() {
+				filter.SpaceGUID = "space-1-guid"
+				filter.OrganizationGUID = "org-b-guid"
+			})
+
+			It("passes the filters to the Cloud Controller", func() {
+				Expect(fakeCloudControllerClient.GetServiceInstancesArgsForCall(0)).To(ContainElements(
+					ccv3.Query{Key: ccv3.SpaceGUIDFilter, Values: []string{"space-1-guid"}},
+					ccv3.Query{Key: ccv3.OrganizationGUIDFilter, Values: []string{"org-b-guid"}},
+				))
+			})
+		})
+
+		When("filtering by offering and plan", func() {
+			BeforeEach(func() {
+				filter.ServiceOfferingName = "postgres"
+				filter.ServicePlanName = "small"
+			})
+
+			It("only returns instances of that offering and plan", func() {
+				Expect(candidates).To(HaveLen(1))
+				Expect(candidates[0].Name).To(Equal("db-b"))
+			})
+		})
+
+		When("no instances have an upgrade available", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceInstancesReturns(
+					[]resources.ServiceInstance{{Name: "up-to-date", UpgradeAvailable: types.NewOptionalBoolean(false)}},
+					ccv3.IncludedResources{},
+					nil,
+					nil,
+				)
+			})
+
+			It("returns no candidates without getting plans", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(candidates).To(BeEmpty())
+				Expect(fakeCloudControllerClient.GetServicePlansCallCount()).To(Equal(0))
+			})
+		})
+
+		When("getting the service instances fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceInstancesReturns(nil, ccv3.IncludedResources{}, ccv3.Warnings{"instances-warning"}, errors.New("boom"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("boom"))
+				Expect(warnings).To(ConsistOf("instances-warning"))
+			})
+		})
+
+		When("getting the plans fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServicePlansReturns(nil, ccv3.Warnings{"plans-warning"}, errors.New("plans-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("plans-error"))
+				Expect(warnings).To(ConsistOf("instances-warning", "plans-warning"))
+			})
+		})
+	})
+})
@@ -30,6 +30,9 @@ func (actor Actor) CreateServiceKey(params CreateServiceKeyParams) (chan PollJob
 			serviceInstance, _, warnings, err = actor.getServiceInstanceByNameAndSpace(params.ServiceInstanceName, params.SpaceGUID)
 			return
 		},
+		func() (ccv3.Warnings, error) {
+			return actor.validateServiceBindingParameters("creating the service key", serviceInstance, params.Parameters)
+		},
 		func() (warnings ccv3.Warnings, err error) {
 			jobURL, warnings, err = actor.createServiceKey(serviceInstance.GUID, params.ServiceKeyName, params.Parameters)
 			return
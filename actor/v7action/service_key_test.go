@@ -96,6 +96,91 @@ var _ = Describe("Service Key Action", func() {
 			})))
 		})
 
+		Describe("parameter validation", func() {
+			When("the service instance is managed", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetServiceInstanceByNameAndSpaceReturns(
+						resources.ServiceInstance{
+							Name:            serviceInstanceName,
+							GUID:            serviceInstanceGUID,
+							Type:            resources.ManagedServiceInstance,
+							ServicePlanGUID: "fake-plan-guid",
+						},
+						ccv3.IncludedResources{},
+						ccv3.Warnings{"get instance warning"},
+						nil,
+					)
+
+					fakeCloudControllerClient.GetServicePlanByGUIDReturns(
+						resources.ServicePlan{
+							GUID: "fake-plan-guid",
+							ServiceBindingCreateSchema: map[string]interface{}{
+								"properties": map[string]interface{}{
+									"foo": map[string]interface{}{"enum": []interface{}{"baz"}},
+								},
+							},
+						},
+						ccv3.Warnings{"get plan warning"},
+						nil,
+					)
+				})
+
+				It("validates the parameters against the plan's binding schema", func() {
+					Expect(fakeCloudControllerClient.GetServicePlanByGUIDCallCount()).To(Equal(1))
+					Expect(fakeCloudControllerClient.GetServicePlanByGUIDArgsForCall(0)).To(Equal("fake-plan-guid"))
+
+					Expect(executionError).To(MatchError(actionerror.ServiceParametersInvalidError{
+						Operation: "creating the service key",
+						Errors:    []string{`$.foo: must be one of ["baz"]`},
+					}))
+					Expect(warnings).To(ContainElement("get plan warning"))
+					Expect(fakeCloudControllerClient.CreateServiceCredentialBindingCallCount()).To(BeZero())
+				})
+
+				When("no parameters are given", func() {
+					BeforeEach(func() {
+						params.Parameters = types.OptionalObject{}
+					})
+
+					It("does not get the plan", func() {
+						Expect(executionError).NotTo(HaveOccurred())
+						Expect(fakeCloudControllerClient.GetServicePlanByGUIDCallCount()).To(BeZero())
+					})
+				})
+
+				When("getting the plan fails", func() {
+					BeforeEach(func() {
+						fakeCloudControllerClient.GetServicePlanByGUIDReturns(resources.ServicePlan{}, ccv3.Warnings{"get plan warning"}, errors.New("plan-error"))
+					})
+
+					It("returns the error and warnings", func() {
+						Expect(executionError).To(MatchError("plan-error"))
+						Expect(warnings).To(ContainElement("get plan warning"))
+					})
+				})
+			})
+
+			When("the service instance is user-provided", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetServiceInstanceByNameAndSpaceReturns(
+						resources.ServiceInstance{
+							Name: serviceInstanceName,
+							GUID: serviceInstanceGUID,
+							Type: resources.UserProvidedServiceInstance,
+						},
+						ccv3.IncludedResources{},
+						nil,
+						nil,
+					)
+				})
+
+				It("does not validate the parameters", func() {
+					Expect(executionError).NotTo(HaveOccurred())
+					Expect(fakeCloudControllerClient.GetServicePlanByGUIDCallCount()).To(BeZero())
+				})
+			})
+		})
+
 		Describe("service instance lookup", func() {
 			It("makes the correct call", func() {
 				Expect(fakeCloudControllerClient.GetServiceInstanceByNameAndSpaceCallCount()).To(Equal(1))
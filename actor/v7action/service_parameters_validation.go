@@ -0,0 +1,59 @@
+package v7action
+
+import (
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/jsonschema"
+)
+
+// validateServiceParameters checks parameters against a schema published by a
+// service plan. Parameters that were not provided, and plans without a schema,
+// are not validated.
+func validateServiceParameters(operation string, schema map[string]interface{}, parameters types.OptionalObject) error {
+	if !parameters.IsSet || len(schema) == 0 {
+		return nil
+	}
+
+	validationErrors := jsonschema.Validate(schema, parameters.Value)
+	if len(validationErrors) == 0 {
+		return nil
+	}
+
+	messages := make([]string, len(validationErrors))
+	for i, validationError := range validationErrors {
+		messages[i] = validationError.Error()
+	}
+	return actionerror.ServiceParametersInvalidError{Operation: operation, Errors: messages}
+}
+
+// validateServiceBindingParameters checks the parameters for a new binding or
+// key against the schema of the service instance's plan. User-provided service
+// instances have no plan and are not validated.
+func (actor Actor) validateServiceBindingParameters(operation string, serviceInstance resources.ServiceInstance, parameters types.OptionalObject) (ccv3.Warnings, error) {
+	if !parameters.IsSet || serviceInstance.Type != resources.ManagedServiceInstance {
+		return nil, nil
+	}
+
+	plan, warnings, err := actor.getServicePlanForValidation(serviceInstance.ServicePlanGUID)
+	if err != nil {
+		return warnings, err
+	}
+
+	return warnings, validateServiceParameters(operation, plan.ServiceBindingCreateSchema, parameters)
+}
+
+// getServicePlanForValidation gets a plan so that its schemas can be checked.
+// A plan that is no longer visible to the user is treated as having no
+// schemas, leaving validation to the broker.
+func (actor Actor) getServicePlanForValidation(servicePlanGUID string) (resources.ServicePlan, ccv3.Warnings, error) {
+	plan, warnings, err := actor.CloudControllerClient.GetServicePlanByGUID(servicePlanGUID)
+	switch err.(type) {
+	case ccerror.ResourceNotFoundError, ccerror.ServicePlanNotFound:
+		return resources.ServicePlan{}, warnings, nil
+	default:
+		return plan, warnings, err
+	}
+}
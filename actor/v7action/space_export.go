@@ -0,0 +1,925 @@
+package v7action
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/cfnetworkingaction"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/lookuptable"
+	"code.cloudfoundry.org/cli/util/portrange"
+	"code.cloudfoundry.org/cli/util/railway"
+	"gopkg.in/yaml.v2"
+)
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . SpaceExportNetworkingActor
+
+// SpaceExportNetworkingActor reads the network policies of the exported space.
+type SpaceExportNetworkingActor interface {
+	NetworkPoliciesBySpace(spaceGUID string) ([]cfnetworkingaction.Policy, cfnetworkingaction.Warnings, error)
+}
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . SpaceImportNetworkingActor
+
+// SpaceImportNetworkingActor reads and adds the network policies of the space
+// an export is imported into.
+type SpaceImportNetworkingActor interface {
+	AddNetworkPolicy(srcSpaceGUID string, srcAppName string, destSpaceGUID string, destAppName string, protocol string, startPort int, endPort int) (cfnetworkingaction.Warnings, error)
+	NetworkPoliciesBySpace(spaceGUID string) ([]cfnetworkingaction.Policy, cfnetworkingaction.Warnings, error)
+}
+
+// SpaceExport is a declarative description of the contents of a space. It is
+// produced by GetSpaceExport and applied by ImportSpace.
+type SpaceExport struct {
+	Org              string                       `yaml:"org,omitempty"`
+	Space            string                       `yaml:"space,omitempty"`
+	Quota            string                       `yaml:"quota,omitempty"`
+	Labels           map[string]string            `yaml:"labels,omitempty"`
+	Annotations      map[string]string            `yaml:"annotations,omitempty"`
+	Roles            []SpaceExportRole            `yaml:"roles,omitempty"`
+	Routes           []SpaceExportRoute           `yaml:"routes,omitempty"`
+	ServiceInstances []SpaceExportServiceInstance `yaml:"service_instances,omitempty"`
+	// Applications are the app manifests of the space. Service bindings and
+	// route mappings are part of them.
+	Applications    []yaml.MapSlice            `yaml:"applications,omitempty"`
+	NetworkPolicies []SpaceExportNetworkPolicy `yaml:"network_policies,omitempty"`
+}
+
+type SpaceExportRole struct {
+	Type     string `yaml:"type"`
+	Username string `yaml:"username"`
+	Origin   string `yaml:"origin,omitempty"`
+	Client   bool   `yaml:"client,omitempty"`
+}
+
+type SpaceExportRoute struct {
+	Host    string            `yaml:"host,omitempty"`
+	Domain  string            `yaml:"domain"`
+	Path    string            `yaml:"path,omitempty"`
+	Port    int               `yaml:"port,omitempty"`
+	Options map[string]string `yaml:"options,omitempty"`
+}
+
+func (route SpaceExportRoute) String() string {
+	url := route.Domain
+	if route.Host != "" {
+		url = route.Host + "." + url
+	}
+	if route.Port != 0 {
+		url = url + ":" + strconv.Itoa(route.Port)
+	}
+	return url + route.Path
+}
+
+type SpaceExportServiceInstance struct {
+	Name            string                 `yaml:"name"`
+	Type            string                 `yaml:"type"`
+	Offering        string                 `yaml:"offering,omitempty"`
+	Plan            string                 `yaml:"plan,omitempty"`
+	Broker          string                 `yaml:"broker,omitempty"`
+	Tags            []string               `yaml:"tags,omitempty"`
+	Parameters      map[string]interface{} `yaml:"parameters,omitempty"`
+	Credentials     map[string]interface{} `yaml:"credentials,omitempty"`
+	SyslogDrainURL  string                 `yaml:"syslog_drain_url,omitempty"`
+	RouteServiceURL string                 `yaml:"route_service_url,omitempty"`
+	// CredentialsRedacted is set when the credentials of a user-provided
+	// service instance were left out of the export.
+	CredentialsRedacted bool `yaml:"credentials_redacted,omitempty"`
+}
+
+// SpaceExportNetworkPolicy is a network policy whose source app is in the
+// exported space. An empty destination space means the exported space.
+type SpaceExportNetworkPolicy struct {
+	Source           string `yaml:"source"`
+	Destination      string `yaml:"destination"`
+	DestinationSpace string `yaml:"destination_space,omitempty"`
+	DestinationOrg   string `yaml:"destination_org,omitempty"`
+	Protocol         string `yaml:"protocol"`
+	StartPort        int    `yaml:"start_port"`
+	EndPort          int    `yaml:"end_port"`
+}
+
+type SpaceImportChangeType string
+
+const (
+	SpaceImportAdded   SpaceImportChangeType = "add"
+	SpaceImportUpdated SpaceImportChangeType = "update"
+)
+
+// SpaceImportChange is a change an import makes to bring a space in line with
+// an export. Resource is one of "quota", "label", "annotation", "role",
+// "route", "service instance", "app" or "network policy".
+type SpaceImportChange struct {
+	Type     SpaceImportChangeType
+	Resource string
+	Name     string
+	Detail   string
+}
+
+// SpaceImportPlan holds the changes found by PlanSpaceImport together with
+// the requests ApplySpaceImport sends to make them.
+type SpaceImportPlan struct {
+	Changes []SpaceImportChange
+
+	apply []func() (ccv3.Warnings, error)
+}
+
+// add records changes together with the function that makes them.
+func (plan *SpaceImportPlan) add(apply func() (ccv3.Warnings, error), changes ...SpaceImportChange) {
+	plan.Changes = append(plan.Changes, changes...)
+	plan.apply = append(plan.apply, apply)
+}
+
+// GetSpaceExport describes the contents of the space, including the network
+// policies of its apps. Credentials of user-provided service instances are
+// only included when includeCredentials is set.
+func (actor *Actor) GetSpaceExport(spaceGUID string, orgName string, includeCredentials bool, networking SpaceExportNetworkingActor) (SpaceExport, Warnings, error) {
+	export := SpaceExport{Org: orgName}
+
+	warnings, err := railway.Sequentially(
+		func() (warnings ccv3.Warnings, err error) {
+			var space resources.Space
+			space, warnings, err = actor.getSpaceForExport(spaceGUID)
+			if err != nil {
+				return
+			}
+
+			export.Space = space.Name
+			export.Labels, export.Annotations = metadataForExport(space.Metadata)
+
+			quotaGUID := space.Relationships[constant.RelationshipTypeQuota].GUID
+			if quotaGUID == "" {
+				return
+			}
+			quota, quotaWarnings, err := actor.CloudControllerClient.GetSpaceQuota(quotaGUID)
+			warnings = append(warnings, quotaWarnings...)
+			export.Quota = quota.Name
+			return warnings, err
+		},
+		func() (warnings ccv3.Warnings, err error) {
+			export.Roles, warnings, err = actor.getSpaceExportRoles(spaceGUID)
+			return
+		},
+		func() (warnings ccv3.Warnings, err error) {
+			export.Routes, _, warnings, err = actor.getSpaceExportRoutes(spaceGUID)
+			return
+		},
+		func() (warnings ccv3.Warnings, err error) {
+			export.ServiceInstances, warnings, err = actor.getSpaceExportServiceInstances(spaceGUID, true, includeCredentials)
+			return
+		},
+		func() (warnings ccv3.Warnings, err error) {
+			export.Applications, warnings, err = actor.getSpaceExportApplications(spaceGUID)
+			return
+		},
+		func() (ccv3.Warnings, error) {
+			policies, networkingWarnings, err := networking.NetworkPoliciesBySpace(spaceGUID)
+			for _, policy := range policies {
+				export.NetworkPolicies = append(export.NetworkPolicies, networkPolicyForExport(policy))
+			}
+			return ccv3.Warnings(networkingWarnings), err
+		},
+	)
+
+	return export, Warnings(warnings), err
+}
+
+// PlanSpaceImport finds the changes that reconcile the space with the export.
+// Resources missing from the space are added and differing ones are updated;
+// nothing is deleted. The space is not changed until the plan is passed to
+// ApplySpaceImport.
+func (actor *Actor) PlanSpaceImport(export SpaceExport, spaceGUID string, orgGUID string, networking SpaceImportNetworkingActor) (SpaceImportPlan, Warnings, error) {
+	plan := &SpaceImportPlan{}
+
+	warnings, err := railway.Sequentially(
+		func() (ccv3.Warnings, error) {
+			return actor.planSpaceMetadataImport(export, spaceGUID, orgGUID, plan)
+		},
+		func() (ccv3.Warnings, error) {
+			return actor.planSpaceRolesImport(export, spaceGUID, orgGUID, plan)
+		},
+		func() (ccv3.Warnings, error) {
+			return actor.planSpaceRoutesImport(export, spaceGUID, plan)
+		},
+		func() (ccv3.Warnings, error) {
+			return actor.planSpaceServiceInstancesImport(export, spaceGUID, plan)
+		},
+		func() (ccv3.Warnings, error) {
+			return actor.planSpaceApplicationsImport(export, spaceGUID, plan)
+		},
+		func() (ccv3.Warnings, error) {
+			return actor.planSpaceNetworkPoliciesImport(export, spaceGUID, networking, plan)
+		},
+	)
+	if err != nil {
+		return SpaceImportPlan{}, Warnings(warnings), err
+	}
+
+	return *plan, Warnings(warnings), nil
+}
+
+// ApplySpaceImport makes the changes of a plan in order and stops at the first
+// one that fails.
+func (actor *Actor) ApplySpaceImport(plan SpaceImportPlan) (Warnings, error) {
+	warnings, err := railway.Sequentially(plan.apply...)
+	return Warnings(warnings), err
+}
+
+func (actor *Actor) getSpaceForExport(spaceGUID string) (resources.Space, ccv3.Warnings, error) {
+	spaces, _, warnings, err := actor.CloudControllerClient.GetSpaces(
+		ccv3.Query{Key: ccv3.GUIDFilter, Values: []string{spaceGUID}},
+	)
+	if err != nil {
+		return resources.Space{}, warnings, err
+	}
+	if len(spaces) == 0 {
+		return resources.Space{}, warnings, actionerror.SpaceNotFoundError{GUID: spaceGUID}
+	}
+	return spaces[0], warnings, nil
+}
+
+func (actor *Actor) getSpaceExportRoles(spaceGUID string) ([]SpaceExportRole, ccv3.Warnings, error) {
+	usersByRoleType, warnings, err := actor.GetSpaceUsersByRoleType(spaceGUID)
+	if err != nil {
+		return nil, ccv3.Warnings(warnings), err
+	}
+
+	var roles []SpaceExportRole
+	for roleType, users := range usersByRoleType {
+		for _, user := range users {
+			role := SpaceExportRole{Type: string(roleType), Username: user.Username, Origin: user.Origin}
+			if user.Username == "" {
+				role.Username = user.PresentationName
+				role.Client = true
+			}
+			roles = append(roles, role)
+		}
+	}
+
+	sort.Slice(roles, func(i, j int) bool {
+		if roles[i].Type != roles[j].Type {
+			return roles[i].Type < roles[j].Type
+		}
+		return roles[i].Username < roles[j].Username
+	})
+
+	return roles, ccv3.Warnings(warnings), nil
+}
+
+func (actor *Actor) getSpaceExportRoutes(spaceGUID string) ([]SpaceExportRoute, []resources.Route, ccv3.Warnings, error) {
+	routes, warnings, err := actor.GetRoutesBySpace(spaceGUID, "")
+	if err != nil || len(routes) == 0 {
+		return nil, nil, ccv3.Warnings(warnings), err
+	}
+
+	var domainGUIDs []string
+	for _, route := range routes {
+		domainGUIDs = append(domainGUIDs, route.DomainGUID)
+	}
+	domains, domainWarnings, err := actor.CloudControllerClient.GetDomains(
+		ccv3.Query{Key: ccv3.GUIDFilter, Values: domainGUIDs},
+	)
+	warnings = append(warnings, domainWarnings...)
+	if err != nil {
+		return nil, nil, ccv3.Warnings(warnings), err
+	}
+	domainNameLookup := lookuptable.NameFromGUID(domains)
+
+	exportRoutes := make([]SpaceExportRoute, len(routes))
+	for i, route := range routes {
+		exportRoutes[i] = SpaceExportRoute{
+			Host:    route.Host,
+			Domain:  domainNameLookup[route.DomainGUID],
+			Path:    route.Path,
+			Port:    route.Port,
+			Options: routeOptionsForExport(route.Options),
+		}
+	}
+
+	return exportRoutes, routes, ccv3.Warnings(warnings), nil
+}
+
+func (actor *Actor) getSpaceExportServiceInstances(spaceGUID string, includeParameters, includeCredentials bool) ([]SpaceExportServiceInstance, ccv3.Warnings, error) {
+	instances, included, warnings, err := actor.CloudControllerClient.GetServiceInstances(
+		ccv3.Query{Key: ccv3.SpaceGUIDFilter, Values: []string{spaceGUID}},
+		ccv3.Query{Key: ccv3.FieldsServicePlan, Values: []string{"guid", "name", "relationships.service_offering"}},
+		ccv3.Query{Key: ccv3.FieldsServicePlanServiceOffering, Values: []string{"guid", "name", "relationships.service_broker"}},
+		ccv3.Query{Key: ccv3.FieldsServicePlanServiceOfferingServiceBroker, Values: []string{"guid", "name"}},
+		ccv3.Query{Key: ccv3.OrderBy, Values: []string{ccv3.NameOrder}},
+		ccv3.Query{Key: ccv3.PerPage, Values: []string{ccv3.MaxPerPage}},
+	)
+	if err != nil {
+		return nil, warnings, err
+	}
+
+	// The space filter also matches instances shared into the space, which
+	// belong to the space they were shared from.
+	ownInstances := instances[:0]
+	for _, instance := range instances {
+		if instance.SpaceGUID == spaceGUID {
+			ownInstances = append(ownInstances, instance)
+		}
+	}
+	instances = ownInstances
+
+	planDetailsLookup := buildPlanDetailsLookup(included)
+
+	exportInstances := make([]SpaceExportServiceInstance, len(instances))
+	for i, instance := range instances {
+		exportInstance := SpaceExportServiceInstance{
+			Name:            instance.Name,
+			Type:            string(instance.Type),
+			Tags:            instance.Tags.Value,
+			SyslogDrainURL:  instance.SyslogDrainURL.Value,
+			RouteServiceURL: instance.RouteServiceURL.Value,
+		}
+
+		switch {
+		case instance.Type == resources.ManagedServiceInstance:
+			names := planDetailsLookup[instance.ServicePlanGUID]
+			exportInstance.Offering = names.offering
+			exportInstance.Plan = names.plan
+			exportInstance.Broker = names.broker
+
+			if includeParameters {
+				parameters, parametersWarnings, err := actor.getServiceInstanceParameters(instance.GUID)
+				warnings = append(warnings, parametersWarnings...)
+				switch err.(type) {
+				case nil:
+					exportInstance.Parameters = parameters
+				case actionerror.ServiceInstanceParamsFetchingNotSupportedError:
+				default:
+					return nil, warnings, err
+				}
+			}
+		case includeCredentials:
+			credentials, credentialsWarnings, err := actor.CloudControllerClient.GetServiceInstanceCredentials(instance.GUID)
+			warnings = append(warnings, credentialsWarnings...)
+			if err != nil {
+				return nil, warnings, err
+			}
+			exportInstance.Credentials = credentials
+		default:
+			exportInstance.CredentialsRedacted = true
+		}
+
+		exportInstances[i] = exportInstance
+	}
+
+	return exportInstances, warnings, nil
+}
+
+func (actor *Actor) getSpaceExportApplications(spaceGUID string) ([]yaml.MapSlice, ccv3.Warnings, error) {
+	apps, warnings, err := actor.CloudControllerClient.GetApplications(
+		ccv3.Query{Key: ccv3.SpaceGUIDFilter, Values: []string{spaceGUID}},
+		ccv3.Query{Key: ccv3.OrderBy, Values: []string{ccv3.NameOrder}},
+		ccv3.Query{Key: ccv3.PerPage, Values: []string{ccv3.MaxPerPage}},
+	)
+	if err != nil {
+		return nil, warnings, err
+	}
+
+	var manifests []yaml.MapSlice
+	for _, app := range apps {
+		rawManifest, manifestWarnings, err := actor.CloudControllerClient.GetApplicationManifest(app.GUID)
+		warnings = append(warnings, manifestWarnings...)
+		if err != nil {
+			return nil, warnings, err
+		}
+
+		var manifest struct {
+			Applications []yaml.MapSlice `yaml:"applications"`
+		}
+		if err := yaml.Unmarshal(rawManifest, &manifest); err != nil {
+			return nil, warnings, err
+		}
+		manifests = append(manifests, manifest.Applications...)
+	}
+
+	return manifests, warnings, nil
+}
+
+func (actor *Actor) planSpaceMetadataImport(export SpaceExport, spaceGUID, orgGUID string, plan *SpaceImportPlan) (ccv3.Warnings, error) {
+	space, warnings, err := actor.getSpaceForExport(spaceGUID)
+	if err != nil {
+		return warnings, err
+	}
+
+	if export.Quota != "" {
+		var currentQuota resources.SpaceQuota
+		if quotaGUID := space.Relationships[constant.RelationshipTypeQuota].GUID; quotaGUID != "" {
+			var quotaWarnings ccv3.Warnings
+			currentQuota, quotaWarnings, err = actor.CloudControllerClient.GetSpaceQuota(quotaGUID)
+			warnings = append(warnings, quotaWarnings...)
+			if err != nil {
+				return warnings, err
+			}
+		}
+
+		if currentQuota.Name != export.Quota {
+			change := SpaceImportChange{Type: SpaceImportAdded, Resource: "quota", Name: export.Quota}
+			if currentQuota.Name != "" {
+				change.Type = SpaceImportUpdated
+				change.Detail = "was " + currentQuota.Name
+			}
+			plan.add(func() (ccv3.Warnings, error) {
+				applyWarnings, err := actor.ApplySpaceQuotaByName(export.Quota, spaceGUID, orgGUID)
+				return ccv3.Warnings(applyWarnings), err
+			}, change)
+		}
+	}
+
+	currentLabels, currentAnnotations := metadataForExport(space.Metadata)
+	labels, labelChanges := metadataChanges("label", export.Labels, currentLabels)
+	annotations, annotationChanges := metadataChanges("annotation", export.Annotations, currentAnnotations)
+	if len(labelChanges)+len(annotationChanges) > 0 {
+		plan.add(func() (ccv3.Warnings, error) {
+			updateWarnings, err := actor.updateResourceMetadata("space", spaceGUID, resources.Metadata{Labels: labels, Annotations: annotations}, nil)
+			return ccv3.Warnings(updateWarnings), err
+		}, append(labelChanges, annotationChanges...)...)
+	}
+
+	return warnings, nil
+}
+
+func (actor *Actor) planSpaceRolesImport(export SpaceExport, spaceGUID, orgGUID string, plan *SpaceImportPlan) (ccv3.Warnings, error) {
+	currentRoles, warnings, err := actor.getSpaceExportRoles(spaceGUID)
+	if err != nil {
+		return warnings, err
+	}
+
+	existing := map[SpaceExportRole]bool{}
+	for _, role := range currentRoles {
+		existing[role] = true
+	}
+
+	for _, role := range export.Roles {
+		if existing[role] {
+			continue
+		}
+
+		role := role
+		plan.add(func() (ccv3.Warnings, error) {
+			createWarnings, err := actor.CreateSpaceRole(constant.RoleType(role.Type), orgGUID, spaceGUID, role.Username, role.Origin, role.Client)
+			return ccv3.Warnings(createWarnings), err
+		}, SpaceImportChange{Type: SpaceImportAdded, Resource: "role", Name: role.Username, Detail: role.Type})
+	}
+
+	return warnings, nil
+}
+
+func (actor *Actor) planSpaceRoutesImport(export SpaceExport, spaceGUID string, plan *SpaceImportPlan) (ccv3.Warnings, error) {
+	currentExportRoutes, currentRoutes, warnings, err := actor.getSpaceExportRoutes(spaceGUID)
+	if err != nil {
+		return warnings, err
+	}
+
+	existing := map[string]int{}
+	for i, route := range currentExportRoutes {
+		existing[route.String()] = i
+	}
+
+	for _, route := range export.Routes {
+		route := route
+		i, found := existing[route.String()]
+		switch {
+		case !found:
+			plan.add(func() (ccv3.Warnings, error) {
+				_, createWarnings, err := actor.CreateRoute(spaceGUID, route.Domain, route.Host, route.Path, route.Port, routeOptionsForImport(route.Options))
+				return ccv3.Warnings(createWarnings), err
+			}, SpaceImportChange{Type: SpaceImportAdded, Resource: "route", Name: route.String()})
+		case len(route.Options) > 0 && !reflect.DeepEqual(route.Options, currentExportRoutes[i].Options):
+			routeGUID := currentRoutes[i].GUID
+			plan.add(func() (ccv3.Warnings, error) {
+				_, updateWarnings, err := actor.UpdateRoute(routeGUID, routeOptionsForImport(route.Options))
+				return ccv3.Warnings(updateWarnings), err
+			}, SpaceImportChange{Type: SpaceImportUpdated, Resource: "route", Name: route.String(), Detail: "options"})
+		}
+	}
+
+	return warnings, nil
+}
+
+func (actor *Actor) planSpaceServiceInstancesImport(export SpaceExport, spaceGUID string, plan *SpaceImportPlan) (ccv3.Warnings, error) {
+	var compareCredentials bool
+	for _, instance := range export.ServiceInstances {
+		if instance.Credentials != nil {
+			compareCredentials = true
+		}
+	}
+
+	currentInstances, warnings, err := actor.getSpaceExportServiceInstances(spaceGUID, false, compareCredentials)
+	if err != nil {
+		return warnings, err
+	}
+
+	existing := map[string]SpaceExportServiceInstance{}
+	for _, instance := range currentInstances {
+		existing[instance.Name] = instance
+	}
+
+	for _, instance := range export.ServiceInstances {
+		instance := instance
+		current, found := existing[instance.Name]
+		switch {
+		case !found && instance.Type == string(resources.ManagedServiceInstance):
+			plan.add(func() (ccv3.Warnings, error) {
+				return drainPollJobEvents(actor.CreateManagedServiceInstance(CreateManagedServiceInstanceParams{
+					ServiceOfferingName: instance.Offering,
+					ServicePlanName:     instance.Plan,
+					ServiceInstanceName: instance.Name,
+					ServiceBrokerName:   instance.Broker,
+					SpaceGUID:           spaceGUID,
+					Tags:                optionalTagsForImport(instance.Tags),
+					Parameters:          optionalObjectForImport(instance.Parameters),
+				}))
+			}, SpaceImportChange{Type: SpaceImportAdded, Resource: "service instance", Name: instance.Name, Detail: instance.Offering + " " + instance.Plan})
+		case !found:
+			change := SpaceImportChange{Type: SpaceImportAdded, Resource: "service instance", Name: instance.Name, Detail: string(resources.UserProvidedServiceInstance)}
+			if instance.CredentialsRedacted {
+				change.Detail += ", credentials redacted"
+			}
+			plan.add(func() (ccv3.Warnings, error) {
+				createWarnings, err := actor.CreateUserProvidedServiceInstance(resources.ServiceInstance{
+					Type:            resources.UserProvidedServiceInstance,
+					Name:            instance.Name,
+					SpaceGUID:       spaceGUID,
+					Tags:            optionalTagsForImport(instance.Tags),
+					SyslogDrainURL:  optionalStringForImport(instance.SyslogDrainURL),
+					RouteServiceURL: optionalStringForImport(instance.RouteServiceURL),
+					Credentials:     optionalObjectForImport(jsonObject(instance.Credentials)),
+				})
+				return ccv3.Warnings(createWarnings), err
+			}, change)
+		case current.Type != instance.Type:
+			warnings = append(warnings, fmt.Sprintf("Service instance %s is not imported because a %s service instance with that name already exists.", instance.Name, current.Type))
+		case instance.Type == string(resources.ManagedServiceInstance):
+			params := UpdateManagedServiceInstanceParams{ServiceInstanceName: instance.Name, SpaceGUID: spaceGUID}
+			var details []string
+			if instance.Plan != "" && instance.Plan != current.Plan {
+				params.ServicePlanName = instance.Plan
+				details = append(details, fmt.Sprintf("plan %s -> %s", current.Plan, instance.Plan))
+			}
+			if !equalTags(instance.Tags, current.Tags) {
+				params.Tags = types.NewOptionalStringSlice(instance.Tags...)
+				details = append(details, "tags")
+			}
+			if len(details) == 0 {
+				continue
+			}
+			plan.add(func() (ccv3.Warnings, error) {
+				return drainPollJobEvents(actor.UpdateManagedServiceInstance(params))
+			}, SpaceImportChange{Type: SpaceImportUpdated, Resource: "service instance", Name: instance.Name, Detail: strings.Join(details, ", ")})
+		default:
+			updates := resources.ServiceInstance{}
+			var details []string
+			if instance.SyslogDrainURL != current.SyslogDrainURL {
+				updates.SyslogDrainURL = types.NewOptionalString(instance.SyslogDrainURL)
+				details = append(details, "syslog drain url")
+			}
+			if instance.RouteServiceURL != current.RouteServiceURL {
+				updates.RouteServiceURL = types.NewOptionalString(instance.RouteServiceURL)
+				details = append(details, "route service url")
+			}
+			if !equalTags(instance.Tags, current.Tags) {
+				updates.Tags = types.NewOptionalStringSlice(instance.Tags...)
+				details = append(details, "tags")
+			}
+			if credentials := jsonObject(instance.Credentials); credentials != nil && !equalJSON(credentials, current.Credentials) {
+				updates.Credentials = types.NewOptionalObject(credentials)
+				details = append(details, "credentials")
+			}
+			if len(details) == 0 {
+				continue
+			}
+			plan.add(func() (ccv3.Warnings, error) {
+				updateWarnings, err := actor.UpdateUserProvidedServiceInstance(instance.Name, spaceGUID, updates)
+				return ccv3.Warnings(updateWarnings), err
+			}, SpaceImportChange{Type: SpaceImportUpdated, Resource: "service instance", Name: instance.Name, Detail: strings.Join(details, ", ")})
+		}
+	}
+
+	return warnings, nil
+}
+
+func (actor *Actor) planSpaceApplicationsImport(export SpaceExport, spaceGUID string, plan *SpaceImportPlan) (ccv3.Warnings, error) {
+	if len(export.Applications) == 0 {
+		return nil, nil
+	}
+
+	rawManifest, err := yaml.Marshal(yaml.MapSlice{{Key: "applications", Value: export.Applications}})
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		apps []resources.Application
+		diff resources.ManifestDiff
+	)
+	warnings, err := railway.Sequentially(
+		func() (warnings ccv3.Warnings, err error) {
+			apps, warnings, err = actor.CloudControllerClient.GetApplications(
+				ccv3.Query{Key: ccv3.SpaceGUIDFilter, Values: []string{spaceGUID}},
+				ccv3.Query{Key: ccv3.PerPage, Values: []string{ccv3.MaxPerPage}},
+			)
+			return
+		},
+		func() (warnings ccv3.Warnings, err error) {
+			diff, warnings, err = actor.CloudControllerClient.GetSpaceManifestDiff(spaceGUID, rawManifest)
+			return
+		},
+	)
+	if err != nil {
+		return warnings, err
+	}
+
+	existing := map[string]bool{}
+	for _, app := range apps {
+		existing[app.Name] = true
+	}
+
+	var changes []SpaceImportChange
+	for i, app := range export.Applications {
+		name := fmt.Sprint(manifestValue(app, "name"))
+		if !existing[name] {
+			changes = append(changes, SpaceImportChange{Type: SpaceImportAdded, Resource: "app", Name: name})
+			continue
+		}
+
+		var paths []string
+		prefix := fmt.Sprintf("/applications/%d/", i)
+		for _, d := range diff.Diffs {
+			if strings.HasPrefix(d.Path, prefix) {
+				paths = append(paths, strings.TrimPrefix(d.Path, prefix))
+			}
+		}
+		if len(paths) > 0 {
+			changes = append(changes, SpaceImportChange{Type: SpaceImportUpdated, Resource: "app", Name: name, Detail: strings.Join(paths, ", ")})
+		}
+	}
+
+	if len(changes) > 0 {
+		plan.add(func() (ccv3.Warnings, error) {
+			setWarnings, err := actor.SetSpaceManifest(spaceGUID, rawManifest)
+			return ccv3.Warnings(setWarnings), err
+		}, changes...)
+	}
+
+	return warnings, nil
+}
+
+// planSpaceNetworkPoliciesImport adds the policies of the export that do not
+// exist yet. Their source apps are in the space.
+func (actor *Actor) planSpaceNetworkPoliciesImport(export SpaceExport, spaceGUID string, networking SpaceImportNetworkingActor, plan *SpaceImportPlan) (ccv3.Warnings, error) {
+	if len(export.NetworkPolicies) == 0 {
+		return nil, nil
+	}
+
+	current, networkingWarnings, err := networking.NetworkPoliciesBySpace(spaceGUID)
+	warnings := ccv3.Warnings(networkingWarnings)
+	if err != nil {
+		return warnings, err
+	}
+
+	existing := map[SpaceExportNetworkPolicy]bool{}
+	for _, policy := range current {
+		existing[networkPolicyForExport(policy)] = true
+	}
+
+	for _, policy := range export.NetworkPolicies {
+		if existing[policy] {
+			continue
+		}
+
+		policy := policy
+		plan.add(func() (ccv3.Warnings, error) {
+			return actor.addNetworkPolicyForImport(policy, spaceGUID, networking)
+		}, SpaceImportChange{
+			Type:     SpaceImportAdded,
+			Resource: "network policy",
+			Name:     fmt.Sprintf("%s -> %s", policy.Source, policy.Destination),
+			Detail:   fmt.Sprintf("%s %s", policy.Protocol, portrange.Format(policy.StartPort, policy.EndPort)),
+		})
+	}
+
+	return warnings, nil
+}
+
+func (actor *Actor) addNetworkPolicyForImport(policy SpaceExportNetworkPolicy, spaceGUID string, networking SpaceImportNetworkingActor) (ccv3.Warnings, error) {
+	var warnings ccv3.Warnings
+
+	destinationSpaceGUID := spaceGUID
+	if policy.DestinationSpace != "" {
+		destinationOrg, orgWarnings, err := actor.GetOrganizationByName(policy.DestinationOrg)
+		warnings = append(warnings, orgWarnings...)
+		if err != nil {
+			return warnings, err
+		}
+
+		destinationSpace, spaceWarnings, err := actor.GetSpaceByNameAndOrganization(policy.DestinationSpace, destinationOrg.GUID)
+		warnings = append(warnings, spaceWarnings...)
+		if err != nil {
+			return warnings, err
+		}
+		destinationSpaceGUID = destinationSpace.GUID
+	}
+
+	addWarnings, err := networking.AddNetworkPolicy(spaceGUID, policy.Source, destinationSpaceGUID, policy.Destination, policy.Protocol, policy.StartPort, policy.EndPort)
+	return append(warnings, addWarnings...), err
+}
+
+// networkPolicyForExport leaves out the destination space and org of policies
+// within the source app's space, so that they point into the space an export
+// is imported into.
+func networkPolicyForExport(policy cfnetworkingaction.Policy) SpaceExportNetworkPolicy {
+	exportPolicy := SpaceExportNetworkPolicy{
+		Source:      policy.SourceName,
+		Destination: policy.DestinationName,
+		Protocol:    policy.Protocol,
+		StartPort:   policy.StartPort,
+		EndPort:     policy.EndPort,
+	}
+	if policy.DestinationOrgName != policy.SourceOrgName || policy.DestinationSpaceName != policy.SourceSpaceName {
+		exportPolicy.DestinationSpace = policy.DestinationSpaceName
+		exportPolicy.DestinationOrg = policy.DestinationOrgName
+	}
+	return exportPolicy
+}
+
+func metadataChanges(resource string, exported, current map[string]string) (map[string]types.NullString, []SpaceImportChange) {
+	var keys []string
+	for key := range exported {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var (
+		values  map[string]types.NullString
+		changes []SpaceImportChange
+	)
+	for _, key := range keys {
+		currentValue, found := current[key]
+		if found && currentValue == exported[key] {
+			continue
+		}
+
+		if values == nil {
+			values = map[string]types.NullString{}
+		}
+		values[key] = types.NewNullString(exported[key])
+
+		change := SpaceImportChange{Type: SpaceImportAdded, Resource: resource, Name: key, Detail: exported[key]}
+		if found {
+			change.Type = SpaceImportUpdated
+			change.Detail = fmt.Sprintf("%s -> %s", currentValue, exported[key])
+		}
+		changes = append(changes, change)
+	}
+
+	return values, changes
+}
+
+func drainPollJobEvents(stream chan PollJobEvent, warnings Warnings, err error) (ccv3.Warnings, error) {
+	if err != nil || stream == nil {
+		return ccv3.Warnings(warnings), err
+	}
+
+	for event := range stream {
+		warnings = append(warnings, event.Warnings...)
+		if event.Err != nil {
+			return ccv3.Warnings(warnings), event.Err
+		}
+	}
+	return ccv3.Warnings(warnings), nil
+}
+
+func manifestValue(manifest yaml.MapSlice, key string) interface{} {
+	for _, item := range manifest {
+		if item.Key == key {
+			return item.Value
+		}
+	}
+	return nil
+}
+
+func equalTags(a, b []string) bool {
+	return strings.Join(a, ",") == strings.Join(b, ",")
+}
+
+func optionalTagsForImport(tags []string) types.OptionalStringSlice {
+	if len(tags) == 0 {
+		return types.OptionalStringSlice{}
+	}
+	return types.NewOptionalStringSlice(tags...)
+}
+
+func optionalStringForImport(value string) types.OptionalString {
+	if value == "" {
+		return types.OptionalString{}
+	}
+	return types.NewOptionalString(value)
+}
+
+func optionalObjectForImport(value map[string]interface{}) types.OptionalObject {
+	if value == nil {
+		return types.OptionalObject{}
+	}
+	return types.NewOptionalObject(value)
+}
+
+// jsonObject turns the nested maps read from an export into maps with string
+// keys, which can be sent to and compared with the cloud controller.
+func jsonObject(value map[string]interface{}) map[string]interface{} {
+	if value == nil {
+		return nil
+	}
+	result := map[string]interface{}{}
+	for key, nested := range value {
+		result[key] = jsonValue(nested)
+	}
+	return result
+}
+
+func jsonValue(value interface{}) interface{} {
+	switch typed := value.(type) {
+	case map[interface{}]interface{}:
+		result := map[string]interface{}{}
+		for key, nested := range typed {
+			result[fmt.Sprint(key)] = jsonValue(nested)
+		}
+		return result
+	case map[string]interface{}:
+		return jsonObject(typed)
+	case []interface{}:
+		result := make([]interface{}, len(typed))
+		for i, nested := range typed {
+			result[i] = jsonValue(nested)
+		}
+		return result
+	default:
+		return value
+	}
+}
+
+// equalJSON compares values by their JSON encoding, so that numbers read from
+// YAML match the same numbers read from JSON.
+func equalJSON(a, b map[string]interface{}) bool {
+	encodedA, errA := json.Marshal(a)
+	encodedB, errB := json.Marshal(b)
+	return errA == nil && errB == nil && string(encodedA) == string(encodedB)
+}
+
+func routeOptionsForImport(options map[string]string) map[string]*string {
+	if options == nil {
+		return nil
+	}
+	result := map[string]*string{}
+	for key, value := range options {
+		value := value
+		result[key] = &value
+	}
+	return result
+}
+
+func metadataForExport(metadata *resources.Metadata) (map[string]string, map[string]string) {
+	if metadata == nil {
+		return nil, nil
+	}
+	return nullStringsForExport(metadata.Labels), nullStringsForExport(metadata.Annotations)
+}
+
+func nullStringsForExport(values map[string]types.NullString) map[string]string {
+	var result map[string]string
+	for key, value := range values {
+		if !value.IsSet {
+			continue
+		}
+		if result == nil {
+			result = map[string]string{}
+		}
+		result[key] = value.Value
+	}
+	return result
+}
+
+func routeOptionsForExport(options map[string]*string) map[string]string {
+	var result map[string]string
+	for key, value := range options {
+		if value == nil {
+			continue
+		}
+		if result == nil {
+			result = map[string]string{}
+		}
+		result[key] = *value
+	}
+	return result
+}
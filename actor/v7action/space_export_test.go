@@ -0,0 +1,659 @@
+package v7action_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/cfnetworkingaction"
+	. "code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/actor/v7action/v7actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"gopkg.in/yaml.v2"
+)
+
+var _ = Describe("Space Export Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v7actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v7actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil, nil, nil, nil)
+	})
+
+	Describe("GetSpaceExport", func() {
+		var (
+			fakeNetworkingActor *v7actionfakes.FakeSpaceExportNetworkingActor
+			includeCredentials  bool
+			export              SpaceExport
+			warnings            Warnings
+			executeErr          error
+		)
+
+		BeforeEach(func() {
+			fakeNetworkingActor = new(v7actionfakes.FakeSpaceExportNetworkingActor)
+			includeCredentials = false
+			loadBalancing := "least-connection"
+
+			fakeCloudControllerClient.GetSpacesReturns(
+				[]resources.Space{{
+					GUID:          "space-guid",
+					Name:          "my-space",
+					Relationships: resources.Relationships{constant.RelationshipTypeQuota: {GUID: "quota-guid"}},
+					Metadata: &resources.Metadata{
+						Labels:      map[string]types.NullString{"env": types.NewNullString("prod")},
+						Annotations: map[string]types.NullString{"owner": types.NewNullString("team-a")},
+					},
+				}},
+				ccv3.IncludedResources{},
+				ccv3.Warnings{"space warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetSpaceQuotaReturns(
+				resources.SpaceQuota{Quota: resources.Quota{Name: "big"}},
+				ccv3.Warnings{"quota warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetRolesReturns(
+				[]resources.Role{
+					{Type: constant.SpaceManagerRole, UserGUID: "user-1-guid"},
+					{Type: constant.SpaceDeveloperRole, UserGUID: "user-2-guid"},
+					{Type: constant.SpaceDeveloperRole, UserGUID: "client-guid"},
+				},
+				ccv3.IncludedResources{Users: []resources.User{
+					{GUID: "user-1-guid", Username: "alice", Origin: "uaa"},
+					{GUID: "user-2-guid", Username: "bob", Origin: "ldap"},
+					{GUID: "client-guid", PresentationName: "my-client"},
+				}},
+				ccv3.Warnings{"roles warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetRoutesReturns(
+				[]resources.Route{{
+					GUID:       "route-guid",
+					Host:       "web",
+					Path:       "/api",
+					DomainGUID: "domain-guid",
+					Options:    map[string]*string{"loadbalancing": &loadBalancing},
+				}},
+				ccv3.Warnings{"routes warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetDomainsReturns(
+				[]resources.Domain{{GUID: "domain-guid", Name: "example.com"}},
+				ccv3.Warnings{"domains warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetServiceInstancesReturns(
+				[]resources.ServiceInstance{
+					{
+						GUID:            "db-guid",
+						Name:            "db",
+						Type:            resources.ManagedServiceInstance,
+						SpaceGUID:       "space-guid",
+						ServicePlanGUID: "plan-guid",
+						Tags:            types.NewOptionalStringSlice("sql"),
+					},
+					{
+						GUID:           "creds-guid",
+						Name:           "creds",
+						Type:           resources.UserProvidedServiceInstance,
+						SpaceGUID:      "space-guid",
+						SyslogDrainURL: types.NewOptionalString("syslog://example.com"),
+					},
+					{
+						GUID:      "shared-guid",
+						Name:      "shared-db",
+						Type:      resources.ManagedServiceInstance,
+						SpaceGUID: "other-space-guid",
+					},
+				},
+				ccv3.IncludedResources{
+					ServicePlans:     []resources.ServicePlan{{GUID: "plan-guid", Name: "small", ServiceOfferingGUID: "offering-guid"}},
+					ServiceOfferings: []resources.ServiceOffering{{GUID: "offering-guid", Name: "mysql", ServiceBrokerGUID: "broker-guid"}},
+					ServiceBrokers:   []resources.ServiceBroker{{GUID: "broker-guid", Name: "my-broker"}},
+				},
+				ccv3.Warnings{"instances warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetServiceInstanceParametersReturns(
+				types.JSONObject{"size": "10GB"},
+				ccv3.Warnings{"parameters warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetServiceInstanceCredentialsReturns(
+				types.JSONObject{"password": "secret"},
+				ccv3.Warnings{"credentials warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetApplicationsReturns(
+				[]resources.Application{{GUID: "app-guid", Name: "web"}},
+				ccv3.Warnings{"apps warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetApplicationManifestReturns(
+				[]byte("applications:\n- name: web\n  instances: 2\n  services:\n  - db\n"),
+				ccv3.Warnings{"manifest warning"},
+				nil,
+			)
+			fakeNetworkingActor.NetworkPoliciesBySpaceReturns(nil, nil, nil)
+		})
+
+		JustBeforeEach(func() {
+			export, warnings, executeErr = actor.GetSpaceExport("space-guid", "my-org", includeCredentials, fakeNetworkingActor)
+		})
+
+		It("describes the space", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf(
+				"space warning", "quota warning", "roles warning", "routes warning", "domains warning",
+				"instances warning", "parameters warning", "apps warning", "manifest warning",
+			))
+
+			Expect(fakeCloudControllerClient.GetSpacesArgsForCall(0)).To(ConsistOf(
+				ccv3.Query{Key: ccv3.GUIDFilter, Values: []string{"space-guid"}},
+			))
+			Expect(fakeCloudControllerClient.GetSpaceQuotaArgsForCall(0)).To(Equal("quota-guid"))
+			Expect(fakeCloudControllerClient.GetDomainsArgsForCall(0)).To(ConsistOf(
+				ccv3.Query{Key: ccv3.GUIDFilter, Values: []string{"domain-guid"}},
+			))
+			Expect(fakeCloudControllerClient.GetServiceInstanceParametersArgsForCall(0)).To(Equal("db-guid"))
+			Expect(fakeCloudControllerClient.GetApplicationManifestArgsForCall(0)).To(Equal("app-guid"))
+
+			Expect(export).To(Equal(SpaceExport{
+				Org:         "my-org",
+				Space:       "my-space",
+				Quota:       "big",
+				Labels:      map[string]string{"env": "prod"},
+				Annotations: map[string]string{"owner": "team-a"},
+				Roles: []SpaceExportRole{
+					{Type: "space_developer", Username: "bob", Origin: "ldap"},
+					{Type: "space_developer", Username: "my-client", Client: true},
+					{Type: "space_manager", Username: "alice", Origin: "uaa"},
+				},
+				Routes: []SpaceExportRoute{{
+					Host:    "web",
+					Domain:  "example.com",
+					Path:    "/api",
+					Options: map[string]string{"loadbalancing": "least-connection"},
+				}},
+				ServiceInstances: []SpaceExportServiceInstance{
+					{
+						Name:       "db",
+						Type:       "managed",
+						Offering:   "mysql",
+						Plan:       "small",
+						Broker:     "my-broker",
+						Tags:       []string{"sql"},
+						Parameters: map[string]interface{}{"size": "10GB"},
+					},
+					{
+						Name:                "creds",
+						Type:                "user-provided",
+						SyslogDrainURL:      "syslog://example.com",
+						CredentialsRedacted: true,
+					},
+				},
+				Applications: []yaml.MapSlice{{
+					{Key: "name", Value: "web"},
+					{Key: "instances", Value: 2},
+					{Key: "services", Value: []interface{}{"db"}},
+				}},
+			}))
+			Expect(fakeCloudControllerClient.GetServiceInstanceCredentialsCallCount()).To(Equal(0))
+		})
+
+		When("the space has network policies", func() {
+			BeforeEach(func() {
+				fakeNetworkingActor.NetworkPoliciesBySpaceReturns(
+					[]cfnetworkingaction.Policy{
+						{SourceName: "web", SourceSpaceName: "my-space", SourceOrgName: "my-org", DestinationName: "api", DestinationSpaceName: "my-space", DestinationOrgName: "my-org", Protocol: "tcp", StartPort: 8080, EndPort: 8080},
+						{SourceName: "web", SourceSpaceName: "my-space", SourceOrgName: "my-org", DestinationName: "db", DestinationSpaceName: "data", DestinationOrgName: "my-org", Protocol: "tcp", StartPort: 5432, EndPort: 5433},
+					},
+					cfnetworkingaction.Warnings{"policies warning"},
+					nil,
+				)
+			})
+
+			It("includes them, leaving out the destination of policies within the space", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(warnings).To(ContainElement("policies warning"))
+				Expect(fakeNetworkingActor.NetworkPoliciesBySpaceArgsForCall(0)).To(Equal("space-guid"))
+				Expect(export.NetworkPolicies).To(Equal([]SpaceExportNetworkPolicy{
+					{Source: "web", Destination: "api", Protocol: "tcp", StartPort: 8080, EndPort: 8080},
+					{Source: "web", Destination: "db", DestinationSpace: "data", DestinationOrg: "my-org", Protocol: "tcp", StartPort: 5432, EndPort: 5433},
+				}))
+			})
+		})
+
+		When("getting the network policies fails", func() {
+			BeforeEach(func() {
+				fakeNetworkingActor.NetworkPoliciesBySpaceReturns(nil, cfnetworkingaction.Warnings{"policies warning"}, errors.New("boom"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("boom"))
+				Expect(warnings).To(ContainElement("policies warning"))
+			})
+		})
+
+		When("credentials are included", func() {
+			BeforeEach(func() {
+				includeCredentials = true
+			})
+
+			It("includes the credentials of user-provided service instances", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(warnings).To(ContainElement("credentials warning"))
+				Expect(fakeCloudControllerClient.GetServiceInstanceCredentialsArgsForCall(0)).To(Equal("creds-guid"))
+				Expect(export.ServiceInstances[1].Credentials).To(Equal(map[string]interface{}{"password": "secret"}))
+				Expect(export.ServiceInstances[1].CredentialsRedacted).To(BeFalse())
+			})
+		})
+
+		When("the broker does not support fetching parameters", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceInstanceParametersReturns(
+					nil,
+					ccv3.Warnings{"parameters warning"},
+					ccerror.ServiceInstanceParametersFetchNotSupportedError{},
+				)
+			})
+
+			It("leaves the parameters out", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(export.ServiceInstances[0].Parameters).To(BeNil())
+			})
+		})
+
+		When("the space does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSpacesReturns(nil, ccv3.IncludedResources{}, ccv3.Warnings{"space warning"}, nil)
+			})
+
+			It("returns a space not found error", func() {
+				Expect(executeErr).To(MatchError(actionerror.SpaceNotFoundError{GUID: "space-guid"}))
+				Expect(warnings).To(ConsistOf("space warning"))
+			})
+		})
+
+		When("getting an app manifest fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationManifestReturns(nil, ccv3.Warnings{"manifest warning"}, errors.New("boom"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("boom"))
+				Expect(warnings).To(ContainElement("manifest warning"))
+			})
+		})
+	})
+
+	Describe("PlanSpaceImport and ApplySpaceImport", func() {
+		var (
+			fakeNetworkingActor *v7actionfakes.FakeSpaceImportNetworkingActor
+			export              SpaceExport
+			plan                SpaceImportPlan
+			warnings            Warnings
+			executeErr          error
+		)
+
+		BeforeEach(func() {
+			fakeNetworkingActor = new(v7actionfakes.FakeSpaceImportNetworkingActor)
+
+			export = SpaceExport{
+				Quota:  "big",
+				Labels: map[string]string{"env": "prod", "team": "a"},
+				Roles:  []SpaceExportRole{{Type: "space_developer", Username: "bob", Origin: "ldap"}},
+				Routes: []SpaceExportRoute{{Host: "web", Domain: "example.com"}},
+				ServiceInstances: []SpaceExportServiceInstance{
+					{Name: "db", Type: "managed", Offering: "mysql", Plan: "large"},
+					{Name: "creds", Type: "user-provided", CredentialsRedacted: true},
+				},
+				Applications: []yaml.MapSlice{
+					{{Key: "name", Value: "web"}, {Key: "instances", Value: 3}},
+					{{Key: "name", Value: "worker"}},
+				},
+			}
+
+			fakeCloudControllerClient.GetSpacesReturns(
+				[]resources.Space{{
+					GUID:     "space-guid",
+					Metadata: &resources.Metadata{Labels: map[string]types.NullString{"env": types.NewNullString("dev")}},
+				}},
+				ccv3.IncludedResources{},
+				ccv3.Warnings{"space warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetServiceInstancesReturns(
+				[]resources.ServiceInstance{{
+					GUID:            "db-guid",
+					Name:            "db",
+					Type:            resources.ManagedServiceInstance,
+					SpaceGUID:       "space-guid",
+					ServicePlanGUID: "plan-guid",
+				}},
+				ccv3.IncludedResources{
+					ServicePlans: []resources.ServicePlan{{GUID: "plan-guid", Name: "small"}},
+				},
+				ccv3.Warnings{"instances warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetApplicationsReturns(
+				[]resources.Application{{GUID: "web-guid", Name: "web"}},
+				ccv3.Warnings{"apps warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetSpaceManifestDiffReturns(
+				resources.ManifestDiff{Diffs: []resources.Diff{
+					{Op: resources.ReplaceOperation, Path: "/applications/0/instances", Was: 2, Value: 3},
+					{Op: resources.AddOperation, Path: "/applications/1/name", Value: "worker"},
+				}},
+				ccv3.Warnings{"diff warning"},
+				nil,
+			)
+		})
+
+		JustBeforeEach(func() {
+			plan, warnings, executeErr = actor.PlanSpaceImport(export, "space-guid", "org-guid", fakeNetworkingActor)
+		})
+
+		It("returns the changes needed to reconcile the space", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf("space warning", "instances warning", "apps warning", "diff warning"))
+
+			Expect(plan.Changes).To(Equal([]SpaceImportChange{
+				{Type: SpaceImportAdded, Resource: "quota", Name: "big"},
+				{Type: SpaceImportUpdated, Resource: "label", Name: "env", Detail: "dev -> prod"},
+				{Type: SpaceImportAdded, Resource: "label", Name: "team", Detail: "a"},
+				{Type: SpaceImportAdded, Resource: "role", Name: "bob", Detail: "space_developer"},
+				{Type: SpaceImportAdded, Resource: "route", Name: "web.example.com"},
+				{Type: SpaceImportUpdated, Resource: "service instance", Name: "db", Detail: "plan small -> large"},
+				{Type: SpaceImportAdded, Resource: "service instance", Name: "creds", Detail: "user-provided, credentials redacted"},
+				{Type: SpaceImportUpdated, Resource: "app", Name: "web", Detail: "instances"},
+				{Type: SpaceImportAdded, Resource: "app", Name: "worker"},
+			}))
+
+			_, rawManifest := fakeCloudControllerClient.GetSpaceManifestDiffArgsForCall(0)
+			Expect(string(rawManifest)).To(Equal("applications:\n- name: web\n  instances: 3\n- name: worker\n"))
+		})
+
+		It("does not change the space", func() {
+			Expect(fakeCloudControllerClient.ApplySpaceQuotaCallCount()).To(Equal(0))
+			Expect(fakeCloudControllerClient.UpdateResourceMetadataCallCount()).To(Equal(0))
+			Expect(fakeCloudControllerClient.CreateRoleCallCount()).To(Equal(0))
+			Expect(fakeCloudControllerClient.CreateRouteCallCount()).To(Equal(0))
+			Expect(fakeCloudControllerClient.CreateServiceInstanceCallCount()).To(Equal(0))
+			Expect(fakeCloudControllerClient.UpdateSpaceApplyManifestCallCount()).To(Equal(0))
+			Expect(fakeNetworkingActor.NetworkPoliciesBySpaceCallCount()).To(Equal(0))
+		})
+
+		When("a user-provided service instance has different credentials", func() {
+			BeforeEach(func() {
+				export = SpaceExport{
+					ServiceInstances: []SpaceExportServiceInstance{
+						{Name: "creds", Type: "user-provided", Credentials: map[string]interface{}{
+							"password": "new",
+							"db":       map[interface{}]interface{}{"port": 5432},
+						}},
+						{Name: "same", Type: "user-provided", Credentials: map[string]interface{}{
+							"db": map[interface{}]interface{}{"port": 5432},
+						}},
+					},
+				}
+
+				fakeCloudControllerClient.GetServiceInstancesReturns(
+					[]resources.ServiceInstance{
+						{GUID: "creds-guid", Name: "creds", Type: resources.UserProvidedServiceInstance, SpaceGUID: "space-guid"},
+						{GUID: "same-guid", Name: "same", Type: resources.UserProvidedServiceInstance, SpaceGUID: "space-guid"},
+					},
+					ccv3.IncludedResources{},
+					ccv3.Warnings{"instances warning"},
+					nil,
+				)
+				fakeCloudControllerClient.GetServiceInstanceCredentialsStub = func(guid string) (types.JSONObject, ccv3.Warnings, error) {
+					credentials := types.JSONObject{"db": map[string]interface{}{"port": float64(5432)}}
+					if guid == "creds-guid" {
+						credentials["password"] = "old"
+					}
+					return credentials, ccv3.Warnings{"credentials warning"}, nil
+				}
+			})
+
+			It("plans to update only the instance whose credentials differ", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(warnings).To(ContainElement("credentials warning"))
+				Expect(plan.Changes).To(Equal([]SpaceImportChange{
+					{Type: SpaceImportUpdated, Resource: "service instance", Name: "creds", Detail: "credentials"},
+				}))
+			})
+
+			When("applying the plan", func() {
+				JustBeforeEach(func() {
+					Expect(executeErr).NotTo(HaveOccurred())
+					warnings, executeErr = actor.ApplySpaceImport(plan)
+				})
+
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetServiceInstanceByNameAndSpaceReturns(
+						resources.ServiceInstance{GUID: "creds-guid", Type: resources.UserProvidedServiceInstance},
+						ccv3.IncludedResources{},
+						nil,
+						nil,
+					)
+				})
+
+				It("sends the credentials with string keys", func() {
+					Expect(executeErr).NotTo(HaveOccurred())
+					guid, updates := fakeCloudControllerClient.UpdateServiceInstanceArgsForCall(0)
+					Expect(guid).To(Equal("creds-guid"))
+					Expect(updates.Credentials).To(Equal(types.NewOptionalObject(map[string]interface{}{
+						"password": "new",
+						"db":       map[string]interface{}{"port": 5432},
+					})))
+				})
+			})
+		})
+
+		When("the export has network policies", func() {
+			BeforeEach(func() {
+				export = SpaceExport{
+					NetworkPolicies: []SpaceExportNetworkPolicy{
+						{Source: "web", Destination: "api", Protocol: "tcp", StartPort: 8080, EndPort: 8080},
+						{Source: "web", Destination: "db", DestinationSpace: "data", DestinationOrg: "data-org", Protocol: "tcp", StartPort: 5432, EndPort: 5433},
+					},
+				}
+
+				fakeNetworkingActor.NetworkPoliciesBySpaceReturns(
+					[]cfnetworkingaction.Policy{
+						{SourceName: "web", SourceSpaceName: "my-space", SourceOrgName: "my-org", DestinationName: "api", DestinationSpaceName: "my-space", DestinationOrgName: "my-org", Protocol: "tcp", StartPort: 8080, EndPort: 8080},
+					},
+					cfnetworkingaction.Warnings{"policies warning"},
+					nil,
+				)
+			})
+
+			It("plans to add the missing policies", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(warnings).To(ContainElement("policies warning"))
+				Expect(fakeNetworkingActor.NetworkPoliciesBySpaceArgsForCall(0)).To(Equal("space-guid"))
+				Expect(plan.Changes).To(Equal([]SpaceImportChange{
+					{Type: SpaceImportAdded, Resource: "network policy", Name: "web -> db", Detail: "tcp 5432-5433"},
+				}))
+				Expect(fakeNetworkingActor.AddNetworkPolicyCallCount()).To(Equal(0))
+			})
+
+			When("applying the plan", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetOrganizationsReturns(
+						[]resources.Organization{{GUID: "data-org-guid", Name: "data-org"}},
+						ccv3.Warnings{"org warning"},
+						nil,
+					)
+					fakeCloudControllerClient.GetSpacesReturnsOnCall(1,
+						[]resources.Space{{GUID: "data-space-guid", Name: "data"}},
+						ccv3.IncludedResources{},
+						ccv3.Warnings{"data space warning"},
+						nil,
+					)
+					fakeNetworkingActor.AddNetworkPolicyReturns(cfnetworkingaction.Warnings{"add warning"}, nil)
+				})
+
+				JustBeforeEach(func() {
+					Expect(executeErr).NotTo(HaveOccurred())
+					warnings, executeErr = actor.ApplySpaceImport(plan)
+				})
+
+				It("adds the policies into the destination space", func() {
+					Expect(executeErr).NotTo(HaveOccurred())
+					Expect(warnings).To(ConsistOf("org warning", "data space warning", "add warning"))
+
+					Expect(fakeNetworkingActor.AddNetworkPolicyCallCount()).To(Equal(1))
+					srcSpaceGUID, srcApp, destSpaceGUID, destApp, protocol, startPort, endPort := fakeNetworkingActor.AddNetworkPolicyArgsForCall(0)
+					Expect(srcSpaceGUID).To(Equal("space-guid"))
+					Expect(srcApp).To(Equal("web"))
+					Expect(destSpaceGUID).To(Equal("data-space-guid"))
+					Expect(destApp).To(Equal("db"))
+					Expect(protocol).To(Equal("tcp"))
+					Expect(startPort).To(Equal(5432))
+					Expect(endPort).To(Equal(5433))
+				})
+			})
+
+			When("getting the current policies fails", func() {
+				BeforeEach(func() {
+					fakeNetworkingActor.NetworkPoliciesBySpaceReturns(nil, cfnetworkingaction.Warnings{"policies warning"}, errors.New("boom"))
+				})
+
+				It("returns the error and warnings", func() {
+					Expect(executeErr).To(MatchError("boom"))
+					Expect(warnings).To(ContainElement("policies warning"))
+				})
+			})
+		})
+
+		When("the space already matches the export", func() {
+			BeforeEach(func() {
+				export = SpaceExport{
+					Labels:           map[string]string{"env": "dev"},
+					ServiceInstances: []SpaceExportServiceInstance{{Name: "db", Type: "managed", Plan: "small"}},
+					Applications:     []yaml.MapSlice{{{Key: "name", Value: "web"}}},
+				}
+				fakeCloudControllerClient.GetSpaceManifestDiffReturns(resources.ManifestDiff{}, nil, nil)
+			})
+
+			It("returns no changes", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(plan.Changes).To(BeEmpty())
+			})
+		})
+
+		When("a service instance exists with a different type", func() {
+			BeforeEach(func() {
+				export = SpaceExport{
+					ServiceInstances: []SpaceExportServiceInstance{{Name: "db", Type: "user-provided"}},
+					Applications:     []yaml.MapSlice{{{Key: "name", Value: "web"}}},
+				}
+				fakeCloudControllerClient.GetSpaceManifestDiffReturns(resources.ManifestDiff{}, nil, nil)
+			})
+
+			It("warns that the instance is not imported", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(plan.Changes).To(BeEmpty())
+				Expect(warnings).To(ContainElement("Service instance db is not imported because a managed service instance with that name already exists."))
+			})
+		})
+
+		When("applying the plan", func() {
+			JustBeforeEach(func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				warnings, executeErr = actor.ApplySpaceImport(plan)
+			})
+
+			BeforeEach(func() {
+				export.ServiceInstances = export.ServiceInstances[1:]
+
+				fakeCloudControllerClient.GetSpaceQuotasReturns(
+					[]resources.SpaceQuota{{Quota: resources.Quota{GUID: "big-guid", Name: "big"}}},
+					ccv3.Warnings{"get quota warning"},
+					nil,
+				)
+				fakeCloudControllerClient.ApplySpaceQuotaReturns(resources.RelationshipList{}, ccv3.Warnings{"apply quota warning"}, nil)
+				fakeCloudControllerClient.UpdateResourceMetadataReturns("", ccv3.Warnings{"metadata warning"}, nil)
+				fakeCloudControllerClient.CreateRoleReturns(resources.Role{}, ccv3.Warnings{"role warning"}, nil)
+				fakeCloudControllerClient.GetDomainsReturns(
+					[]resources.Domain{{GUID: "domain-guid", Name: "example.com"}},
+					ccv3.Warnings{"domains warning"},
+					nil,
+				)
+				fakeCloudControllerClient.CreateRouteReturns(resources.Route{}, ccv3.Warnings{"route warning"}, nil)
+				fakeCloudControllerClient.CreateServiceInstanceReturns("", ccv3.Warnings{"instance warning"}, nil)
+				fakeCloudControllerClient.UpdateSpaceApplyManifestReturns("job-url", ccv3.Warnings{"manifest warning"}, nil)
+				fakeCloudControllerClient.PollJobReturns(ccv3.Warnings{"job warning"}, nil)
+			})
+
+			It("makes the changes", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(warnings).To(ContainElements(
+					"apply quota warning", "metadata warning", "role warning", "route warning", "instance warning", "manifest warning", "job warning",
+				))
+
+				quotaGUID, spaceGUID := fakeCloudControllerClient.ApplySpaceQuotaArgsForCall(0)
+				Expect(quotaGUID).To(Equal("big-guid"))
+				Expect(spaceGUID).To(Equal("space-guid"))
+
+				resourceType, resourceGUID, metadata := fakeCloudControllerClient.UpdateResourceMetadataArgsForCall(0)
+				Expect(resourceType).To(Equal("space"))
+				Expect(resourceGUID).To(Equal("space-guid"))
+				Expect(metadata).To(Equal(resources.Metadata{Labels: map[string]types.NullString{
+					"env":  types.NewNullString("prod"),
+					"team": types.NewNullString("a"),
+				}}))
+
+				Expect(fakeCloudControllerClient.CreateRoleCallCount()).To(Equal(2))
+				Expect(fakeCloudControllerClient.CreateRoleArgsForCall(1)).To(Equal(resources.Role{
+					Type:      constant.SpaceDeveloperRole,
+					SpaceGUID: "space-guid",
+					Username:  "bob",
+					Origin:    "ldap",
+				}))
+
+				Expect(fakeCloudControllerClient.CreateRouteArgsForCall(0)).To(Equal(resources.Route{
+					SpaceGUID:  "space-guid",
+					DomainGUID: "domain-guid",
+					Host:       "web",
+				}))
+
+				Expect(fakeCloudControllerClient.CreateServiceInstanceArgsForCall(0)).To(Equal(resources.ServiceInstance{
+					Type:      resources.UserProvidedServiceInstance,
+					Name:      "creds",
+					SpaceGUID: "space-guid",
+				}))
+
+				spaceGUID, rawManifest := fakeCloudControllerClient.UpdateSpaceApplyManifestArgsForCall(0)
+				Expect(spaceGUID).To(Equal("space-guid"))
+				Expect(string(rawManifest)).To(ContainSubstring("name: worker"))
+			})
+
+			When("a change fails", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.CreateRouteReturns(resources.Route{}, ccv3.Warnings{"route warning"}, errors.New("boom"))
+				})
+
+				It("stops and returns the error", func() {
+					Expect(executeErr).To(MatchError("boom"))
+					Expect(warnings).To(ContainElement("route warning"))
+					Expect(fakeCloudControllerClient.CreateServiceInstanceCallCount()).To(Equal(0))
+					Expect(fakeCloudControllerClient.UpdateSpaceApplyManifestCallCount()).To(Equal(0))
+				})
+			})
+		})
+	})
+})
@@ -0,0 +1,177 @@
+package v7action
+
+import (
+	"sort"
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/resources"
+)
+
+type BuildpackCompatibilityStatus string
+
+const (
+	// BuildpackAvailable means an enabled admin buildpack of that name exists
+	// for the target stack.
+	BuildpackAvailable BuildpackCompatibilityStatus = "available"
+	// BuildpackMissing means no enabled admin buildpack of that name exists for
+	// the target stack.
+	BuildpackMissing BuildpackCompatibilityStatus = "missing"
+	// BuildpackUnverified means the buildpack is a URL, such as a git
+	// repository, that cannot be checked against the target stack.
+	BuildpackUnverified BuildpackCompatibilityStatus = "unknown"
+)
+
+// BuildpackCompatibility is a buildpack of an app and whether it can be used
+// on the target stack of a migration.
+type BuildpackCompatibility struct {
+	Name   string
+	Status BuildpackCompatibilityStatus
+}
+
+// StackMigrationApp is an app that has to move to another stack. Buildpacks
+// are the buildpacks of the app's lifecycle or, for apps that use buildpack
+// detection, the buildpacks of its current droplet.
+type StackMigrationApp struct {
+	App        resources.Application
+	SpaceName  string
+	OrgName    string
+	Buildpacks []BuildpackCompatibility
+}
+
+// Ready reports whether all buildpacks of the app are available on the target
+// stack.
+func (app StackMigrationApp) Ready() bool {
+	for _, buildpack := range app.Buildpacks {
+		if buildpack.Status != BuildpackAvailable {
+			return false
+		}
+	}
+	return true
+}
+
+// GetStackMigrationReport returns the apps on fromStack that the user can see,
+// sorted by org, space and app, with the compatibility of their buildpacks
+// with toStack.
+func (actor Actor) GetStackMigrationReport(fromStack string, toStack string) ([]StackMigrationApp, Warnings, error) {
+	var allWarnings Warnings
+	for _, stackName := range []string{fromStack, toStack} {
+		_, warnings, err := actor.GetStackByName(stackName)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return nil, allWarnings, err
+		}
+	}
+
+	apps, ccWarnings, err := actor.CloudControllerClient.GetApplications(
+		ccv3.Query{Key: ccv3.StackFilter, Values: []string{fromStack}},
+		ccv3.Query{Key: ccv3.PerPage, Values: []string{ccv3.MaxPerPage}},
+	)
+	allWarnings = append(allWarnings, ccWarnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	if len(apps) == 0 {
+		return nil, allWarnings, nil
+	}
+
+	spacesByGUID, orgNames, warnings, err := actor.getSpacesAndOrgNames()
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	buildpacks, warnings, err := actor.GetBuildpacks("")
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	availableBuildpacks := map[string]bool{}
+	for _, buildpack := range buildpacks {
+		if buildpack.Enabled.IsSet && !buildpack.Enabled.Value {
+			continue
+		}
+		if buildpack.Stack == "" || buildpack.Stack == toStack {
+			availableBuildpacks[buildpack.Name] = true
+		}
+	}
+
+	var report []StackMigrationApp
+	for _, app := range apps {
+		buildpackNames, warnings, err := actor.appBuildpackNames(app)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return nil, allWarnings, err
+		}
+
+		space := spacesByGUID[app.SpaceGUID]
+		migrationApp := StackMigrationApp{
+			App:       app,
+			SpaceName: space.Name,
+			OrgName:   orgNames[space.Relationships[constant.RelationshipTypeOrganization].GUID],
+		}
+		for _, name := range buildpackNames {
+			migrationApp.Buildpacks = append(migrationApp.Buildpacks, BuildpackCompatibility{
+				Name:   name,
+				Status: buildpackCompatibilityStatus(name, availableBuildpacks),
+			})
+		}
+		report = append(report, migrationApp)
+	}
+
+	sort.SliceStable(report, func(i, j int) bool {
+		a, b := report[i], report[j]
+		if a.OrgName != b.OrgName {
+			return a.OrgName < b.OrgName
+		}
+		if a.SpaceName != b.SpaceName {
+			return a.SpaceName < b.SpaceName
+		}
+		return a.App.Name < b.App.Name
+	})
+
+	return report, allWarnings, nil
+}
+
+// appBuildpackNames returns the buildpacks of the app's lifecycle. When the
+// app uses buildpack detection, the buildpacks detected for its current
+// droplet are returned instead, if it has one.
+func (actor Actor) appBuildpackNames(app resources.Application) ([]string, Warnings, error) {
+	var names []string
+	for _, name := range app.LifecycleBuildpacks {
+		if name != constant.AutodetectBuildpackValueDefault && name != constant.AutodetectBuildpackValueNull {
+			names = append(names, name)
+		}
+	}
+	if len(names) > 0 {
+		return names, nil, nil
+	}
+
+	droplet, warnings, err := actor.GetCurrentDropletByApplication(app.GUID)
+	if _, ok := err.(actionerror.DropletNotFoundError); ok {
+		return nil, warnings, nil
+	}
+	if err != nil {
+		return nil, warnings, err
+	}
+
+	for _, buildpack := range droplet.Buildpacks {
+		names = append(names, buildpack.Name)
+	}
+	return names, warnings, nil
+}
+
+func buildpackCompatibilityStatus(name string, availableBuildpacks map[string]bool) BuildpackCompatibilityStatus {
+	switch {
+	case availableBuildpacks[name]:
+		return BuildpackAvailable
+	case strings.Contains(name, "://"):
+		return BuildpackUnverified
+	default:
+		return BuildpackMissing
+	}
+}
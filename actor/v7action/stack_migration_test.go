@@ -0,0 +1,170 @@
+package v7action_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/actor/v7action/v7actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Stack Migration Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v7actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v7actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil, nil, nil, nil)
+	})
+
+	Describe("StackMigrationApp.Ready", func() {
+		It("is true only when all buildpacks are available", func() {
+			Expect(StackMigrationApp{}.Ready()).To(BeTrue())
+			Expect(StackMigrationApp{Buildpacks: []BuildpackCompatibility{
+				{Name: "java_buildpack", Status: BuildpackAvailable},
+			}}.Ready()).To(BeTrue())
+			Expect(StackMigrationApp{Buildpacks: []BuildpackCompatibility{
+				{Name: "java_buildpack", Status: BuildpackAvailable},
+				{Name: "https://github.com/cloudfoundry/go-buildpack", Status: BuildpackUnverified},
+			}}.Ready()).To(BeFalse())
+		})
+	})
+
+	Describe("GetStackMigrationReport", func() {
+		var (
+			apps []resources.Application
+
+			report     []StackMigrationApp
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetStacksStub = func(queries ...ccv3.Query) ([]resources.Stack, ccv3.Warnings, error) {
+				return []resources.Stack{{Name: queries[0].Values[0]}}, ccv3.Warnings{"stack warning"}, nil
+			}
+
+			apps = []resources.Application{
+				{GUID: "java-guid", Name: "java", SpaceGUID: "dev-guid", LifecycleBuildpacks: []string{"java_buildpack"}},
+				{GUID: "legacy-guid", Name: "legacy", SpaceGUID: "dev-guid", LifecycleBuildpacks: []string{"php_buildpack", "https://example.com/extra.git"}},
+				{GUID: "detected-guid", Name: "detected", SpaceGUID: "prod-guid"},
+				{GUID: "unstaged-guid", Name: "unstaged", SpaceGUID: "prod-guid"},
+			}
+			fakeCloudControllerClient.GetApplicationsReturns(apps, ccv3.Warnings{"apps warning"}, nil)
+
+			fakeCloudControllerClient.GetSpacesReturns(
+				[]resources.Space{
+					{GUID: "dev-guid", Name: "dev", Relationships: resources.Relationships{constant.RelationshipTypeOrganization: {GUID: "org-b-guid"}}},
+					{GUID: "prod-guid", Name: "prod", Relationships: resources.Relationships{constant.RelationshipTypeOrganization: {GUID: "org-a-guid"}}},
+				},
+				ccv3.IncludedResources{Organizations: []resources.Organization{
+					{GUID: "org-a-guid", Name: "org-a"},
+					{GUID: "org-b-guid", Name: "org-b"},
+				}},
+				ccv3.Warnings{"spaces warning"},
+				nil,
+			)
+
+			fakeCloudControllerClient.GetBuildpacksReturns(
+				[]resources.Buildpack{
+					{Name: "java_buildpack", Stack: "cflinuxfs4", Enabled: types.NullBool{IsSet: true, Value: true}},
+					{Name: "php_buildpack", Stack: "cflinuxfs3", Enabled: types.NullBool{IsSet: true, Value: true}},
+					{Name: "php_buildpack", Stack: "cflinuxfs4", Enabled: types.NullBool{IsSet: true, Value: false}},
+					{Name: "go_buildpack", Enabled: types.NullBool{IsSet: true, Value: true}},
+				},
+				ccv3.Warnings{"buildpacks warning"},
+				nil,
+			)
+
+			fakeCloudControllerClient.GetApplicationDropletCurrentStub = func(appGUID string) (resources.Droplet, ccv3.Warnings, error) {
+				if appGUID == "detected-guid" {
+					return resources.Droplet{
+						GUID:       "detected-droplet",
+						Buildpacks: []resources.DropletBuildpack{{Name: "go_buildpack", Version: "1.10"}},
+					}, ccv3.Warnings{"droplet warning"}, nil
+				}
+				return resources.Droplet{}, nil, ccerror.DropletNotFoundError{}
+			}
+		})
+
+		JustBeforeEach(func() {
+			report, warnings, executeErr = actor.GetStackMigrationReport("cflinuxfs3", "cflinuxfs4")
+		})
+
+		It("returns the apps on the stack with the compatibility of their buildpacks, sorted by org, space and app", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf("stack warning", "stack warning", "apps warning", "spaces warning", "buildpacks warning", "droplet warning"))
+
+			Expect(fakeCloudControllerClient.GetApplicationsArgsForCall(0)).To(Equal([]ccv3.Query{
+				{Key: ccv3.StackFilter, Values: []string{"cflinuxfs3"}},
+				{Key: ccv3.PerPage, Values: []string{ccv3.MaxPerPage}},
+			}))
+			Expect(fakeCloudControllerClient.GetApplicationDropletCurrentCallCount()).To(Equal(2))
+
+			Expect(report).To(Equal([]StackMigrationApp{
+				{
+					App: apps[2], SpaceName: "prod", OrgName: "org-a",
+					Buildpacks: []BuildpackCompatibility{{Name: "go_buildpack", Status: BuildpackAvailable}},
+				},
+				{App: apps[3], SpaceName: "prod", OrgName: "org-a"},
+				{
+					App: apps[0], SpaceName: "dev", OrgName: "org-b",
+					Buildpacks: []BuildpackCompatibility{{Name: "java_buildpack", Status: BuildpackAvailable}},
+				},
+				{
+					App: apps[1], SpaceName: "dev", OrgName: "org-b",
+					Buildpacks: []BuildpackCompatibility{
+						{Name: "php_buildpack", Status: BuildpackMissing},
+						{Name: "https://example.com/extra.git", Status: BuildpackUnverified},
+					},
+				},
+			}))
+		})
+
+		When("a stack does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetStacksStub = nil
+				fakeCloudControllerClient.GetStacksReturns(nil, ccv3.Warnings{"stack warning"}, nil)
+			})
+
+			It("returns a stack not found error", func() {
+				Expect(executeErr).To(MatchError(actionerror.StackNotFoundError{Name: "cflinuxfs3"}))
+				Expect(warnings).To(ConsistOf("stack warning"))
+				Expect(fakeCloudControllerClient.GetApplicationsCallCount()).To(Equal(0))
+			})
+		})
+
+		When("no apps are on the stack", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(nil, ccv3.Warnings{"apps warning"}, nil)
+			})
+
+			It("returns an empty report", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(report).To(BeEmpty())
+				Expect(fakeCloudControllerClient.GetSpacesCallCount()).To(Equal(0))
+			})
+		})
+
+		When("getting a current droplet fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationDropletCurrentStub = nil
+				fakeCloudControllerClient.GetApplicationDropletCurrentReturns(resources.Droplet{}, ccv3.Warnings{"droplet warning"}, errors.New("boom"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("boom"))
+				Expect(warnings).To(ContainElement("droplet warning"))
+			})
+		})
+	})
+})
@@ -0,0 +1,395 @@
+package v7action
+
+import (
+	"sort"
+	"time"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/util/batcher"
+)
+
+type StaleResourceType string
+
+const (
+	StaleServiceKey      StaleResourceType = "service key"
+	StaleDroplet         StaleResourceType = "droplet"
+	StalePackage         StaleResourceType = "package"
+	StaleRoute           StaleResourceType = "route"
+	StaleServiceInstance StaleResourceType = "service instance"
+	StaleApp             StaleResourceType = "app"
+)
+
+// staleResourceTypes is the order in which stale resources are listed and
+// deleted, so that nothing is deleted while something else still refers to it.
+var staleResourceTypes = []StaleResourceType{
+	StaleServiceKey,
+	StaleDroplet,
+	StalePackage,
+	StaleRoute,
+	StaleServiceInstance,
+	StaleApp,
+}
+
+// StaleResource is a resource that is likely no longer needed. Parent is the
+// name of the app of a droplet or package, or of the service instance of a
+// service key. Timestamp is when the resource was last updated or created, and
+// is zero for routes and service instances.
+type StaleResource struct {
+	Type      StaleResourceType
+	GUID      string
+	Name      string
+	Parent    string
+	SpaceName string
+	Timestamp time.Time
+}
+
+// GetStaleResources returns the stale resources in the given space, or in
+// all spaces of the given org when spaceGUID is empty:
+//   - stopped apps that have not been updated since olderThan
+//   - service instances without any app, key or route bindings; instances
+//     shared into the spaces from elsewhere are left out
+//   - routes without destinations
+//   - droplets other than the current droplet of their app, and packages
+//     other than the newest package of their app and the package its current
+//     droplet was staged from, created before olderThan
+//   - service keys created before olderThan
+func (actor Actor) GetStaleResources(orgGUID string, spaceGUID string, olderThan time.Time) ([]StaleResource, Warnings, error) {
+	scope := ccv3.Query{Key: ccv3.OrganizationGUIDFilter, Values: []string{orgGUID}}
+	spaceScope := scope
+	if spaceGUID != "" {
+		scope = ccv3.Query{Key: ccv3.SpaceGUIDFilter, Values: []string{spaceGUID}}
+		spaceScope = ccv3.Query{Key: ccv3.GUIDFilter, Values: []string{spaceGUID}}
+	}
+	perPage := ccv3.Query{Key: ccv3.PerPage, Values: []string{ccv3.MaxPerPage}}
+
+	var (
+		allWarnings      Warnings
+		spaces           []resources.Space
+		apps             []resources.Application
+		droplets         []resources.Droplet
+		packages         []resources.Package
+		routes           []resources.Route
+		serviceInstances []resources.ServiceInstance
+	)
+
+	requests := []func() (ccv3.Warnings, error){
+		func() (warnings ccv3.Warnings, err error) {
+			spaces, _, warnings, err = actor.CloudControllerClient.GetSpaces(spaceScope, perPage)
+			return
+		},
+		func() (warnings ccv3.Warnings, err error) {
+			apps, warnings, err = actor.CloudControllerClient.GetApplications(scope, perPage)
+			return
+		},
+		func() (warnings ccv3.Warnings, err error) {
+			droplets, warnings, err = actor.CloudControllerClient.GetDroplets(scope, perPage)
+			return
+		},
+		func() (warnings ccv3.Warnings, err error) {
+			packages, warnings, err = actor.CloudControllerClient.GetPackages(scope, perPage)
+			return
+		},
+		func() (warnings ccv3.Warnings, err error) {
+			routes, warnings, err = actor.CloudControllerClient.GetRoutes(scope, perPage)
+			return
+		},
+		func() (warnings ccv3.Warnings, err error) {
+			serviceInstances, _, warnings, err = actor.CloudControllerClient.GetServiceInstances(scope, perPage)
+			return
+		},
+	}
+	for _, request := range requests {
+		warnings, err := request()
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return nil, allWarnings, err
+		}
+	}
+
+	spaceNames := map[string]string{}
+	for _, space := range spaces {
+		spaceNames[space.GUID] = space.Name
+	}
+	appsByGUID := map[string]resources.Application{}
+	for _, app := range apps {
+		appsByGUID[app.GUID] = app
+	}
+
+	ownServiceInstances := serviceInstances[:0]
+	for _, serviceInstance := range serviceInstances {
+		if _, ok := spaceNames[serviceInstance.SpaceGUID]; ok {
+			ownServiceInstances = append(ownServiceInstances, serviceInstance)
+		}
+	}
+	serviceInstances = ownServiceInstances
+
+	currentDroplets := map[string]resources.Droplet{}
+	currentDroplet := func(appGUID string) (resources.Droplet, error) {
+		if current, ok := currentDroplets[appGUID]; ok {
+			return current, nil
+		}
+		current, warnings, err := actor.CloudControllerClient.GetApplicationDropletCurrent(appGUID)
+		allWarnings = append(allWarnings, warnings...)
+		if _, noDroplet := err.(ccerror.DropletNotFoundError); err != nil && !noDroplet {
+			return resources.Droplet{}, err
+		}
+		currentDroplets[appGUID] = current
+		return current, nil
+	}
+
+	var stale []StaleResource
+
+	for _, app := range apps {
+		if app.State != constant.ApplicationStopped {
+			continue
+		}
+		if updatedAt, ok := staleSince(app.UpdatedAt, olderThan); ok {
+			stale = append(stale, StaleResource{
+				Type:      StaleApp,
+				GUID:      app.GUID,
+				Name:      app.Name,
+				SpaceName: spaceNames[app.SpaceGUID],
+				Timestamp: updatedAt,
+			})
+		}
+	}
+
+	for _, droplet := range droplets {
+		app, ok := appsByGUID[droplet.AppGUID]
+		if !ok {
+			continue
+		}
+		createdAt, ok := staleSince(droplet.CreatedAt, olderThan)
+		if !ok {
+			continue
+		}
+
+		current, err := currentDroplet(app.GUID)
+		if err != nil {
+			return nil, allWarnings, err
+		}
+
+		if droplet.GUID != current.GUID {
+			stale = append(stale, StaleResource{
+				Type:      StaleDroplet,
+				GUID:      droplet.GUID,
+				Name:      droplet.GUID,
+				Parent:    app.Name,
+				SpaceName: spaceNames[app.SpaceGUID],
+				Timestamp: createdAt,
+			})
+		}
+	}
+
+	newestPackages := map[string]resources.Package{}
+	for _, pkg := range packages {
+		appGUID := pkg.Relationships[constant.RelationshipTypeApplication].GUID
+		if newest, ok := newestPackages[appGUID]; !ok || pkg.CreatedAt > newest.CreatedAt {
+			newestPackages[appGUID] = pkg
+		}
+	}
+	for _, pkg := range packages {
+		appGUID := pkg.Relationships[constant.RelationshipTypeApplication].GUID
+		app, ok := appsByGUID[appGUID]
+		if !ok || pkg.GUID == newestPackages[appGUID].GUID {
+			continue
+		}
+		createdAt, ok := staleSince(pkg.CreatedAt, olderThan)
+		if !ok {
+			continue
+		}
+
+		current, err := currentDroplet(appGUID)
+		if err != nil {
+			return nil, allWarnings, err
+		}
+
+		if pkg.GUID != current.PackageGUID {
+			stale = append(stale, StaleResource{
+				Type:      StalePackage,
+				GUID:      pkg.GUID,
+				Name:      pkg.GUID,
+				Parent:    app.Name,
+				SpaceName: spaceNames[app.SpaceGUID],
+				Timestamp: createdAt,
+			})
+		}
+	}
+
+	for _, route := range routes {
+		if len(route.Destinations) == 0 {
+			stale = append(stale, StaleResource{
+				Type:      StaleRoute,
+				GUID:      route.GUID,
+				Name:      route.URL,
+				SpaceName: spaceNames[route.SpaceGUID],
+			})
+		}
+	}
+
+	serviceInstanceGUIDs := make([]string, 0, len(serviceInstances))
+	for _, serviceInstance := range serviceInstances {
+		serviceInstanceGUIDs = append(serviceInstanceGUIDs, serviceInstance.GUID)
+	}
+
+	var (
+		bindings      []resources.ServiceCredentialBinding
+		routeBindings []resources.RouteBinding
+	)
+	warnings, err := batcher.RequestByGUID(serviceInstanceGUIDs, func(guids []string) (ccv3.Warnings, error) {
+		batch, warnings, err := actor.CloudControllerClient.GetServiceCredentialBindings(
+			ccv3.Query{Key: ccv3.ServiceInstanceGUIDFilter, Values: guids},
+			perPage,
+		)
+		bindings = append(bindings, batch...)
+		if err != nil {
+			return warnings, err
+		}
+
+		routeBatch, _, routeWarnings, err := actor.CloudControllerClient.GetRouteBindings(
+			ccv3.Query{Key: ccv3.ServiceInstanceGUIDFilter, Values: guids},
+			perPage,
+		)
+		routeBindings = append(routeBindings, routeBatch...)
+		return append(warnings, routeWarnings...), err
+	})
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	boundServiceInstances := map[string]bool{}
+	for _, routeBinding := range routeBindings {
+		boundServiceInstances[routeBinding.ServiceInstanceGUID] = true
+	}
+	serviceInstancesByGUID := map[string]resources.ServiceInstance{}
+	for _, serviceInstance := range serviceInstances {
+		serviceInstancesByGUID[serviceInstance.GUID] = serviceInstance
+	}
+
+	for _, binding := range bindings {
+		boundServiceInstances[binding.ServiceInstanceGUID] = true
+		if binding.Type != resources.KeyBinding {
+			continue
+		}
+		if createdAt, ok := staleSince(binding.CreatedAt, olderThan); ok {
+			serviceInstance := serviceInstancesByGUID[binding.ServiceInstanceGUID]
+			stale = append(stale, StaleResource{
+				Type:      StaleServiceKey,
+				GUID:      binding.GUID,
+				Name:      binding.Name,
+				Parent:    serviceInstance.Name,
+				SpaceName: spaceNames[serviceInstance.SpaceGUID],
+				Timestamp: createdAt,
+			})
+		}
+	}
+
+	for _, serviceInstance := range serviceInstances {
+		if !boundServiceInstances[serviceInstance.GUID] {
+			stale = append(stale, StaleResource{
+				Type:      StaleServiceInstance,
+				GUID:      serviceInstance.GUID,
+				Name:      serviceInstance.Name,
+				SpaceName: spaceNames[serviceInstance.SpaceGUID],
+			})
+		}
+	}
+
+	sort.SliceStable(stale, func(i, j int) bool {
+		a, b := stale[i], stale[j]
+		if a.SpaceName != b.SpaceName {
+			return a.SpaceName < b.SpaceName
+		}
+		if a.Type != b.Type {
+			return staleResourceRank(a.Type) < staleResourceRank(b.Type)
+		}
+		if a.Parent != b.Parent {
+			return a.Parent < b.Parent
+		}
+		return a.Name < b.Name
+	})
+
+	return stale, allWarnings, nil
+}
+
+// DeleteStaleResources deletes the given resources, stopping at the first one
+// that fails. Service keys are deleted first and apps last, so that service
+// instances are unbound before they are deleted. Resources that no longer
+// exist are skipped.
+func (actor Actor) DeleteStaleResources(staleResources []StaleResource) (Warnings, error) {
+	ordered := make([]StaleResource, len(staleResources))
+	copy(ordered, staleResources)
+	sort.SliceStable(ordered, func(i, j int) bool {
+		return staleResourceRank(ordered[i].Type) < staleResourceRank(ordered[j].Type)
+	})
+
+	var allWarnings Warnings
+	for _, resource := range ordered {
+		warnings, err := actor.deleteStaleResource(resource)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return allWarnings, err
+		}
+	}
+
+	return allWarnings, nil
+}
+
+func (actor Actor) deleteStaleResource(resource StaleResource) (Warnings, error) {
+	var (
+		jobURL   ccv3.JobURL
+		warnings ccv3.Warnings
+		err      error
+	)
+
+	switch resource.Type {
+	case StaleServiceKey:
+		jobURL, warnings, err = actor.CloudControllerClient.DeleteServiceCredentialBinding(resource.GUID)
+	case StaleDroplet:
+		jobURL, warnings, err = actor.CloudControllerClient.DeleteDroplet(resource.GUID)
+	case StalePackage:
+		jobURL, warnings, err = actor.CloudControllerClient.DeletePackage(resource.GUID)
+	case StaleRoute:
+		jobURL, warnings, err = actor.CloudControllerClient.DeleteRoute(resource.GUID)
+	case StaleServiceInstance:
+		jobURL, warnings, err = actor.CloudControllerClient.DeleteServiceInstance(resource.GUID)
+	case StaleApp:
+		jobURL, warnings, err = actor.CloudControllerClient.DeleteApplication(resource.GUID)
+	}
+
+	if err == nil {
+		var pollWarnings ccv3.Warnings
+		pollWarnings, err = actor.CloudControllerClient.PollJob(jobURL)
+		warnings = append(warnings, pollWarnings...)
+	}
+
+	switch err.(type) {
+	case ccerror.ApplicationNotFoundError, ccerror.DropletNotFoundError, ccerror.ResourceNotFoundError:
+		return Warnings(warnings), nil
+	default:
+		return Warnings(warnings), err
+	}
+}
+
+// staleSince parses the given timestamp and reports whether it is before
+// cutoff. Missing or unparsable timestamps are never stale.
+func staleSince(timestamp string, cutoff time.Time) (time.Time, bool) {
+	parsed, err := time.Parse(time.RFC3339, timestamp)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return parsed, parsed.Before(cutoff)
+}
+
+func staleResourceRank(resourceType StaleResourceType) int {
+	for rank, t := range staleResourceTypes {
+		if t == resourceType {
+			return rank
+		}
+	}
+	return len(staleResourceTypes)
+}
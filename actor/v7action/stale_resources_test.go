@@ -0,0 +1,360 @@
+package v7action_test
+
+import (
+	"errors"
+	"time"
+
+	. "code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/actor/v7action/v7actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/resources"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Stale Resources Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v7actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v7actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil, nil, nil, nil)
+	})
+
+	Describe("GetStaleResources", func() {
+		var (
+			spaceGUID string
+			cutoff    time.Time
+
+			staleResources []StaleResource
+			warnings       Warnings
+			executeErr     error
+		)
+
+		timestamp := func(value string) time.Time {
+			parsed, err := time.Parse(time.RFC3339, value)
+			Expect(err).NotTo(HaveOccurred())
+			return parsed
+		}
+
+		appPackage := func(guid string, appGUID string, createdAt string) resources.Package {
+			return resources.Package{
+				GUID:          guid,
+				CreatedAt:     createdAt,
+				Relationships: resources.Relationships{constant.RelationshipTypeApplication: {GUID: appGUID}},
+			}
+		}
+
+		BeforeEach(func() {
+			spaceGUID = ""
+			cutoff = timestamp("2026-01-01T00:00:00Z")
+
+			fakeCloudControllerClient.GetSpacesReturns(
+				[]resources.Space{{GUID: "dev-guid", Name: "dev"}, {GUID: "prod-guid", Name: "prod"}},
+				ccv3.IncludedResources{},
+				ccv3.Warnings{"spaces warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetApplicationsReturns(
+				[]resources.Application{
+					{GUID: "old-stopped-guid", Name: "old-stopped", SpaceGUID: "dev-guid", State: constant.ApplicationStopped, UpdatedAt: "2025-06-01T00:00:00Z"},
+					{GUID: "new-stopped-guid", Name: "new-stopped", SpaceGUID: "dev-guid", State: constant.ApplicationStopped, UpdatedAt: "2026-02-01T00:00:00Z"},
+					{GUID: "started-guid", Name: "started", SpaceGUID: "prod-guid", State: constant.ApplicationStarted, UpdatedAt: "2025-06-01T00:00:00Z"},
+				},
+				ccv3.Warnings{"apps warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetDropletsReturns(
+				[]resources.Droplet{
+					{GUID: "old-droplet", AppGUID: "started-guid", CreatedAt: "2025-03-01T00:00:00Z"},
+					{GUID: "current-droplet", AppGUID: "started-guid", CreatedAt: "2025-04-01T00:00:00Z"},
+					{GUID: "new-droplet", AppGUID: "started-guid", CreatedAt: "2026-02-01T00:00:00Z"},
+				},
+				ccv3.Warnings{"droplets warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetApplicationDropletCurrentReturns(
+				resources.Droplet{GUID: "current-droplet"},
+				ccv3.Warnings{"current droplet warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetPackagesReturns(
+				[]resources.Package{
+					appPackage("old-package", "started-guid", "2025-03-01T00:00:00Z"),
+					appPackage("newest-package", "started-guid", "2025-04-01T00:00:00Z"),
+					appPackage("only-package", "old-stopped-guid", "2025-01-01T00:00:00Z"),
+				},
+				ccv3.Warnings{"packages warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetRoutesReturns(
+				[]resources.Route{
+					{GUID: "unmapped-route-guid", URL: "unmapped.example.com", SpaceGUID: "prod-guid"},
+					{GUID: "mapped-route-guid", URL: "mapped.example.com", SpaceGUID: "prod-guid", Destinations: []resources.RouteDestination{{GUID: "destination-guid"}}},
+				},
+				ccv3.Warnings{"routes warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetServiceInstancesReturns(
+				[]resources.ServiceInstance{
+					{GUID: "unbound-guid", Name: "unbound", SpaceGUID: "prod-guid"},
+					{GUID: "keyed-guid", Name: "keyed", SpaceGUID: "prod-guid"},
+					{GUID: "route-service-guid", Name: "route-service", SpaceGUID: "prod-guid"},
+				},
+				ccv3.IncludedResources{},
+				ccv3.Warnings{"instances warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetServiceCredentialBindingsReturns(
+				[]resources.ServiceCredentialBinding{
+					{GUID: "old-key-guid", Name: "old-key", Type: resources.KeyBinding, ServiceInstanceGUID: "keyed-guid", CreatedAt: "2025-01-01T00:00:00Z"},
+					{GUID: "new-key-guid", Name: "new-key", Type: resources.KeyBinding, ServiceInstanceGUID: "keyed-guid", CreatedAt: "2026-02-01T00:00:00Z"},
+				},
+				ccv3.Warnings{"bindings warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetRouteBindingsReturns(
+				[]resources.RouteBinding{{GUID: "route-binding-guid", ServiceInstanceGUID: "route-service-guid"}},
+				ccv3.IncludedResources{},
+				ccv3.Warnings{"route bindings warning"},
+				nil,
+			)
+		})
+
+		JustBeforeEach(func() {
+			staleResources, warnings, executeErr = actor.GetStaleResources("org-guid", spaceGUID, cutoff)
+		})
+
+		It("returns the stale resources of the org, sorted by space and type", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf(
+				"spaces warning", "apps warning", "droplets warning", "current droplet warning", "packages warning",
+				"routes warning", "instances warning", "bindings warning", "route bindings warning",
+			))
+
+			Expect(staleResources).To(Equal([]StaleResource{
+				{Type: StaleApp, GUID: "old-stopped-guid", Name: "old-stopped", SpaceName: "dev", Timestamp: timestamp("2025-06-01T00:00:00Z")},
+				{Type: StaleServiceKey, GUID: "old-key-guid", Name: "old-key", Parent: "keyed", SpaceName: "prod", Timestamp: timestamp("2025-01-01T00:00:00Z")},
+				{Type: StaleDroplet, GUID: "old-droplet", Name: "old-droplet", Parent: "started", SpaceName: "prod", Timestamp: timestamp("2025-03-01T00:00:00Z")},
+				{Type: StalePackage, GUID: "old-package", Name: "old-package", Parent: "started", SpaceName: "prod", Timestamp: timestamp("2025-03-01T00:00:00Z")},
+				{Type: StaleRoute, GUID: "unmapped-route-guid", Name: "unmapped.example.com", SpaceName: "prod"},
+				{Type: StaleServiceInstance, GUID: "unbound-guid", Name: "unbound", SpaceName: "prod"},
+			}))
+		})
+
+		It("queries all resources of the org", func() {
+			orgScope := []ccv3.Query{
+				{Key: ccv3.OrganizationGUIDFilter, Values: []string{"org-guid"}},
+				{Key: ccv3.PerPage, Values: []string{ccv3.MaxPerPage}},
+			}
+			Expect(fakeCloudControllerClient.GetSpacesArgsForCall(0)).To(Equal(orgScope))
+			Expect(fakeCloudControllerClient.GetApplicationsArgsForCall(0)).To(Equal(orgScope))
+			Expect(fakeCloudControllerClient.GetDropletsArgsForCall(0)).To(Equal(orgScope))
+			Expect(fakeCloudControllerClient.GetPackagesArgsForCall(0)).To(Equal(orgScope))
+			Expect(fakeCloudControllerClient.GetRoutesArgsForCall(0)).To(Equal(orgScope))
+			Expect(fakeCloudControllerClient.GetServiceInstancesArgsForCall(0)).To(Equal(orgScope))
+
+			Expect(fakeCloudControllerClient.GetApplicationDropletCurrentCallCount()).To(Equal(1))
+			Expect(fakeCloudControllerClient.GetApplicationDropletCurrentArgsForCall(0)).To(Equal("started-guid"))
+
+			Expect(fakeCloudControllerClient.GetServiceCredentialBindingsArgsForCall(0)).To(Equal([]ccv3.Query{
+				{Key: ccv3.ServiceInstanceGUIDFilter, Values: []string{"unbound-guid", "keyed-guid", "route-service-guid"}},
+				{Key: ccv3.PerPage, Values: []string{ccv3.MaxPerPage}},
+			}))
+			Expect(fakeCloudControllerClient.GetRouteBindingsArgsForCall(0)).To(Equal([]ccv3.Query{
+				{Key: ccv3.ServiceInstanceGUIDFilter, Values: []string{"unbound-guid", "keyed-guid", "route-service-guid"}},
+				{Key: ccv3.PerPage, Values: []string{ccv3.MaxPerPage}},
+			}))
+		})
+
+		When("a space is given", func() {
+			BeforeEach(func() {
+				spaceGUID = "dev-guid"
+			})
+
+			It("queries the resources of the space", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(fakeCloudControllerClient.GetSpacesArgsForCall(0)).To(Equal([]ccv3.Query{
+					{Key: ccv3.GUIDFilter, Values: []string{"dev-guid"}},
+					{Key: ccv3.PerPage, Values: []string{ccv3.MaxPerPage}},
+				}))
+				Expect(fakeCloudControllerClient.GetApplicationsArgsForCall(0)).To(Equal([]ccv3.Query{
+					{Key: ccv3.SpaceGUIDFilter, Values: []string{"dev-guid"}},
+					{Key: ccv3.PerPage, Values: []string{ccv3.MaxPerPage}},
+				}))
+			})
+		})
+
+		When("an app has no current droplet", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationDropletCurrentReturns(resources.Droplet{}, nil, ccerror.DropletNotFoundError{})
+			})
+
+			It("reports all of its old droplets", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+
+				var droplets []string
+				for _, resource := range staleResources {
+					if resource.Type == StaleDroplet {
+						droplets = append(droplets, resource.GUID)
+					}
+				}
+				Expect(droplets).To(ConsistOf("old-droplet", "current-droplet"))
+			})
+		})
+
+		When("the current droplet was staged from an older package", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationDropletCurrentReturns(
+					resources.Droplet{GUID: "current-droplet", PackageGUID: "old-package"},
+					nil,
+					nil,
+				)
+			})
+
+			It("keeps that package", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				for _, resource := range staleResources {
+					Expect(resource.Type).NotTo(Equal(StalePackage))
+				}
+				Expect(fakeCloudControllerClient.GetApplicationDropletCurrentCallCount()).To(Equal(1))
+			})
+		})
+
+		When("a service instance is shared into the org from another space", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceInstancesReturns(
+					[]resources.ServiceInstance{
+						{GUID: "unbound-guid", Name: "unbound", SpaceGUID: "prod-guid"},
+						{GUID: "shared-guid", Name: "shared", SpaceGUID: "other-space-guid"},
+					},
+					ccv3.IncludedResources{},
+					nil,
+					nil,
+				)
+			})
+
+			It("neither reports it nor looks up its bindings", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+
+				var instances []string
+				for _, resource := range staleResources {
+					if resource.Type == StaleServiceInstance {
+						instances = append(instances, resource.GUID)
+					}
+				}
+				Expect(instances).To(ConsistOf("unbound-guid"))
+
+				Expect(fakeCloudControllerClient.GetServiceCredentialBindingsArgsForCall(0)).To(Equal([]ccv3.Query{
+					{Key: ccv3.ServiceInstanceGUIDFilter, Values: []string{"unbound-guid"}},
+					{Key: ccv3.PerPage, Values: []string{ccv3.MaxPerPage}},
+				}))
+			})
+		})
+
+		When("there are no service instances", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceInstancesReturns(nil, ccv3.IncludedResources{}, nil, nil)
+			})
+
+			It("does not look up any bindings", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(fakeCloudControllerClient.GetServiceCredentialBindingsCallCount()).To(Equal(0))
+				Expect(fakeCloudControllerClient.GetRouteBindingsCallCount()).To(Equal(0))
+			})
+		})
+
+		When("getting the current droplet fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationDropletCurrentReturns(resources.Droplet{}, ccv3.Warnings{"current droplet warning"}, errors.New("boom"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("boom"))
+				Expect(warnings).To(ContainElement("current droplet warning"))
+			})
+		})
+
+		When("getting the apps fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(nil, ccv3.Warnings{"apps warning"}, errors.New("boom"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("boom"))
+				Expect(warnings).To(ConsistOf("spaces warning", "apps warning"))
+				Expect(fakeCloudControllerClient.GetDropletsCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Describe("DeleteStaleResources", func() {
+		var staleResources []StaleResource
+
+		BeforeEach(func() {
+			staleResources = []StaleResource{
+				{Type: StaleApp, GUID: "app-guid"},
+				{Type: StaleServiceInstance, GUID: "instance-guid"},
+				{Type: StaleRoute, GUID: "route-guid"},
+				{Type: StalePackage, GUID: "package-guid"},
+				{Type: StaleDroplet, GUID: "droplet-guid"},
+				{Type: StaleServiceKey, GUID: "key-guid"},
+			}
+
+			fakeCloudControllerClient.DeleteServiceCredentialBindingReturns("key-job", ccv3.Warnings{"key warning"}, nil)
+			fakeCloudControllerClient.DeleteDropletReturns("droplet-job", ccv3.Warnings{"droplet warning"}, nil)
+			fakeCloudControllerClient.DeletePackageReturns("package-job", ccv3.Warnings{"package warning"}, nil)
+			fakeCloudControllerClient.DeleteRouteReturns("route-job", ccv3.Warnings{"route warning"}, nil)
+			fakeCloudControllerClient.DeleteServiceInstanceReturns("", ccv3.Warnings{"instance warning"}, nil)
+			fakeCloudControllerClient.DeleteApplicationReturns("app-job", ccv3.Warnings{"app warning"}, nil)
+			fakeCloudControllerClient.PollJobReturns(ccv3.Warnings{"job warning"}, nil)
+		})
+
+		It("deletes service keys first and apps last", func() {
+			warnings, err := actor.DeleteStaleResources(staleResources)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(ContainElements("key warning", "droplet warning", "package warning", "route warning", "instance warning", "app warning"))
+
+			Expect(fakeCloudControllerClient.DeleteServiceCredentialBindingArgsForCall(0)).To(Equal("key-guid"))
+			Expect(fakeCloudControllerClient.DeleteDropletArgsForCall(0)).To(Equal("droplet-guid"))
+			Expect(fakeCloudControllerClient.DeletePackageArgsForCall(0)).To(Equal("package-guid"))
+			Expect(fakeCloudControllerClient.DeleteRouteArgsForCall(0)).To(Equal("route-guid"))
+			instanceGUID, _ := fakeCloudControllerClient.DeleteServiceInstanceArgsForCall(0)
+			Expect(instanceGUID).To(Equal("instance-guid"))
+			Expect(fakeCloudControllerClient.DeleteApplicationArgsForCall(0)).To(Equal("app-guid"))
+
+			Expect(fakeCloudControllerClient.PollJobCallCount()).To(Equal(6))
+			Expect(fakeCloudControllerClient.PollJobArgsForCall(0)).To(Equal(ccv3.JobURL("key-job")))
+			Expect(fakeCloudControllerClient.PollJobArgsForCall(5)).To(Equal(ccv3.JobURL("app-job")))
+		})
+
+		When("a resource no longer exists", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.DeleteDropletReturns("", ccv3.Warnings{"droplet warning"}, ccerror.DropletNotFoundError{})
+			})
+
+			It("skips it", func() {
+				_, err := actor.DeleteStaleResources(staleResources)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(fakeCloudControllerClient.DeleteApplicationCallCount()).To(Equal(1))
+			})
+		})
+
+		When("deleting a resource fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.PollJobReturns(ccv3.Warnings{"job warning"}, errors.New("job failed"))
+			})
+
+			It("stops and returns the error", func() {
+				warnings, err := actor.DeleteStaleResources(staleResources)
+				Expect(err).To(MatchError("job failed"))
+				Expect(warnings).To(ConsistOf("key warning", "job warning"))
+				Expect(fakeCloudControllerClient.DeleteDropletCallCount()).To(Equal(0))
+			})
+		})
+	})
+})
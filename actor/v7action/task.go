@@ -26,10 +26,35 @@ func (actor Actor) RunTask(appGUID string, task resources.Task) (resources.Task,
 	return resources.Task(createdTask), Warnings(warnings), err
 }
 
+// TaskFilter narrows down the tasks returned by GetApplicationTasksWithFilter.
+// Zero values mean no filtering on that field.
+type TaskFilter struct {
+	States []constant.TaskState
+	Since  time.Time
+}
+
 // GetApplicationTasks returns a list of tasks associated with the provided
 // application GUID.
 func (actor Actor) GetApplicationTasks(appGUID string, sortOrder SortOrder) ([]resources.Task, Warnings, error) {
-	tasks, warnings, err := actor.CloudControllerClient.GetApplicationTasks(appGUID)
+	return actor.GetApplicationTasksWithFilter(appGUID, sortOrder, TaskFilter{})
+}
+
+// GetApplicationTasksWithFilter returns the tasks associated with the
+// provided application GUID that match the given filter.
+func (actor Actor) GetApplicationTasksWithFilter(appGUID string, sortOrder SortOrder, filter TaskFilter) ([]resources.Task, Warnings, error) {
+	var queries []ccv3.Query
+	if len(filter.States) > 0 {
+		var states []string
+		for _, state := range filter.States {
+			states = append(states, string(state))
+		}
+		queries = append(queries, ccv3.Query{Key: ccv3.StatesFilter, Values: states})
+	}
+	if !filter.Since.IsZero() {
+		queries = append(queries, ccv3.Query{Key: ccv3.CreatedAtsAfterFilter, Values: []string{filter.Since.UTC().Format(time.RFC3339)}})
+	}
+
+	tasks, warnings, err := actor.CloudControllerClient.GetApplicationTasks(appGUID, queries...)
 	actorWarnings := Warnings(warnings)
 	if err != nil {
 		return nil, actorWarnings, err
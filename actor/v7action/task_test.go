@@ -2,6 +2,7 @@ package v7action_test
 
 import (
 	"errors"
+	"time"
 
 	"code.cloudfoundry.org/cli/actor/actionerror"
 	. "code.cloudfoundry.org/cli/actor/v7action"
@@ -204,6 +205,71 @@ var _ = Describe("Task Actions", func() {
 		})
 	})
 
+	Describe("GetApplicationTasksWithFilter", func() {
+		var (
+			filter   TaskFilter
+			tasks    []resources.Task
+			warnings Warnings
+			err      error
+		)
+
+		BeforeEach(func() {
+			filter = TaskFilter{}
+			fakeCloudControllerClient.GetApplicationTasksReturns(
+				[]resources.Task{{GUID: "task-1-guid", SequenceID: 1}, {GUID: "task-2-guid", SequenceID: 2}},
+				ccv3.Warnings{"warning-1"},
+				nil,
+			)
+		})
+
+		JustBeforeEach(func() {
+			tasks, warnings, err = actor.GetApplicationTasksWithFilter("some-app-guid", Ascending, filter)
+		})
+
+		When("the filter is empty", func() {
+			It("does not send any queries", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("warning-1"))
+				Expect(tasks).To(HaveLen(2))
+
+				_, query := fakeCloudControllerClient.GetApplicationTasksArgsForCall(0)
+				Expect(query).To(BeEmpty())
+			})
+		})
+
+		When("states and a creation time are provided", func() {
+			BeforeEach(func() {
+				filter = TaskFilter{
+					States: []constant.TaskState{constant.TaskRunning, constant.TaskFailed},
+					Since:  time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC),
+				}
+			})
+
+			It("filters the tasks on the cloud controller", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(tasks[0].SequenceID).To(BeEquivalentTo(1))
+
+				appGUID, query := fakeCloudControllerClient.GetApplicationTasksArgsForCall(0)
+				Expect(appGUID).To(Equal("some-app-guid"))
+				Expect(query).To(ConsistOf(
+					ccv3.Query{Key: ccv3.StatesFilter, Values: []string{"RUNNING", "FAILED"}},
+					ccv3.Query{Key: ccv3.CreatedAtsAfterFilter, Values: []string{"2020-01-02T03:04:05Z"}},
+				))
+			})
+		})
+
+		When("the cloud controller client returns an error", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationTasksReturns(nil, ccv3.Warnings{"warning-1"}, errors.New("cc-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(err).To(MatchError("cc-error"))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+	})
+
 	Describe("GetTaskBySequenceIDAndApplication", func() {
 		When("the cloud controller client does not return an error", func() {
 			When("the task is found", func() {
@@ -0,0 +1,135 @@
+package v7action
+
+import (
+	"sort"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/resources"
+)
+
+// UserRole is an org or space role of a user together with the names of its
+// org and space. SpaceGUID and SpaceName are empty for org roles.
+type UserRole struct {
+	GUID      string
+	Type      constant.RoleType
+	OrgGUID   string
+	OrgName   string
+	SpaceGUID string
+	SpaceName string
+}
+
+// GetUserRoles looks up the user with the given username and (if provided)
+// origin in UAA and returns all of their org and space roles, sorted by org
+// and space with the org roles first.
+func (actor Actor) GetUserRoles(username string, origin string) (resources.User, []UserRole, Warnings, error) {
+	user, err := actor.GetUser(username, origin)
+	if err != nil {
+		return resources.User{}, nil, nil, err
+	}
+	user.Username = username
+
+	roles, included, warnings, err := actor.CloudControllerClient.GetRoles(
+		ccv3.Query{Key: ccv3.UserGUIDFilter, Values: []string{user.GUID}},
+		ccv3.Query{Key: ccv3.Include, Values: []string{"space", "organization"}},
+		ccv3.Query{Key: ccv3.PerPage, Values: []string{ccv3.MaxPerPage}},
+	)
+	allWarnings := Warnings(warnings)
+	if err != nil {
+		return resources.User{}, nil, allWarnings, err
+	}
+
+	orgNames := map[string]string{}
+	for _, org := range included.Organizations {
+		orgNames[org.GUID] = org.Name
+	}
+	spaces := map[string]resources.Space{}
+	for _, space := range included.Spaces {
+		spaces[space.GUID] = space
+	}
+
+	// Only the orgs of org roles are included, so the orgs of space roles
+	// may have to be looked up.
+	var missingOrgGUIDs []string
+	for _, space := range spaces {
+		orgGUID := space.Relationships[constant.RelationshipTypeOrganization].GUID
+		if _, ok := orgNames[orgGUID]; !ok {
+			orgNames[orgGUID] = ""
+			missingOrgGUIDs = append(missingOrgGUIDs, orgGUID)
+		}
+	}
+	if len(missingOrgGUIDs) > 0 {
+		sort.Strings(missingOrgGUIDs)
+		orgs, warnings, err := actor.CloudControllerClient.GetOrganizations(ccv3.Query{
+			Key:    ccv3.GUIDFilter,
+			Values: missingOrgGUIDs,
+		})
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return resources.User{}, nil, allWarnings, err
+		}
+		for _, org := range orgs {
+			orgNames[org.GUID] = org.Name
+		}
+	}
+
+	var userRoles []UserRole
+	for _, role := range roles {
+		userRole := UserRole{GUID: role.GUID, Type: role.Type, OrgGUID: role.OrgGUID}
+		if role.SpaceGUID != "" {
+			space := spaces[role.SpaceGUID]
+			userRole.SpaceGUID = role.SpaceGUID
+			userRole.SpaceName = space.Name
+			userRole.OrgGUID = space.Relationships[constant.RelationshipTypeOrganization].GUID
+		}
+		userRole.OrgName = orgNames[userRole.OrgGUID]
+		userRoles = append(userRoles, userRole)
+	}
+
+	sort.Slice(userRoles, func(i, j int) bool {
+		a, b := userRoles[i], userRoles[j]
+		if a.OrgName != b.OrgName {
+			return a.OrgName < b.OrgName
+		}
+		if a.SpaceName != b.SpaceName {
+			return a.SpaceName < b.SpaceName
+		}
+		return a.Type < b.Type
+	})
+
+	return user, userRoles, allWarnings, nil
+}
+
+// DeleteUserRoles deletes the given roles, stopping at the first one that
+// fails. Space roles are deleted before org roles and organization_user roles
+// last, as the Cloud Controller does not remove users from an org while they
+// have other roles in it.
+func (actor Actor) DeleteUserRoles(roles []UserRole) (Warnings, error) {
+	ordered := make([]UserRole, len(roles))
+	copy(ordered, roles)
+	sort.SliceStable(ordered, func(i, j int) bool {
+		return userRoleDeletionRank(ordered[i]) < userRoleDeletionRank(ordered[j])
+	})
+
+	var allWarnings Warnings
+	for _, role := range ordered {
+		warnings, err := actor.deleteRole(role.GUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return allWarnings, err
+		}
+	}
+
+	return allWarnings, nil
+}
+
+func userRoleDeletionRank(role UserRole) int {
+	switch {
+	case role.SpaceGUID != "":
+		return 0
+	case role.Type != constant.OrgUserRole:
+		return 1
+	default:
+		return 2
+	}
+}
@@ -0,0 +1,179 @@
+package v7action_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/actor/v7action/v7actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/api/uaa"
+	"code.cloudfoundry.org/cli/resources"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("User Roles Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v7actionfakes.FakeCloudControllerClient
+		fakeUAAClient             *v7actionfakes.FakeUAAClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v7actionfakes.FakeCloudControllerClient)
+		fakeUAAClient = new(v7actionfakes.FakeUAAClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil, fakeUAAClient, nil, nil)
+	})
+
+	Describe("GetUserRoles", func() {
+		var (
+			user     resources.User
+			roles    []UserRole
+			warnings Warnings
+			err      error
+		)
+
+		BeforeEach(func() {
+			fakeUAAClient.ListUsersReturns([]uaa.User{{ID: "alice-guid", Origin: "ldap"}}, nil)
+
+			fakeCloudControllerClient.GetRolesReturns(
+				[]resources.Role{
+					{GUID: "role-1", Type: constant.SpaceDeveloperRole, SpaceGUID: "dev-guid"},
+					{GUID: "role-2", Type: constant.OrgUserRole, OrgGUID: "org-b-guid"},
+					{GUID: "role-3", Type: constant.OrgManagerRole, OrgGUID: "org-b-guid"},
+					{GUID: "role-4", Type: constant.SpaceAuditorRole, SpaceGUID: "prod-guid"},
+				},
+				ccv3.IncludedResources{
+					Organizations: []resources.Organization{{GUID: "org-b-guid", Name: "org-b"}},
+					Spaces: []resources.Space{
+						{GUID: "dev-guid", Name: "dev", Relationships: resources.Relationships{
+							constant.RelationshipTypeOrganization: {GUID: "org-b-guid"},
+						}},
+						{GUID: "prod-guid", Name: "prod", Relationships: resources.Relationships{
+							constant.RelationshipTypeOrganization: {GUID: "org-a-guid"},
+						}},
+					},
+				},
+				ccv3.Warnings{"roles warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetOrganizationsReturns(
+				[]resources.Organization{{GUID: "org-a-guid", Name: "org-a"}},
+				ccv3.Warnings{"orgs warning"},
+				nil,
+			)
+		})
+
+		JustBeforeEach(func() {
+			user, roles, warnings, err = actor.GetUserRoles("alice", "ldap")
+		})
+
+		It("returns the roles of the user across all orgs and spaces", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf("roles warning", "orgs warning"))
+			Expect(user).To(Equal(resources.User{GUID: "alice-guid", Username: "alice", Origin: "ldap"}))
+
+			username, origin := fakeUAAClient.ListUsersArgsForCall(0)
+			Expect(username).To(Equal("alice"))
+			Expect(origin).To(Equal("ldap"))
+
+			Expect(fakeCloudControllerClient.GetRolesArgsForCall(0)).To(Equal([]ccv3.Query{
+				{Key: ccv3.UserGUIDFilter, Values: []string{"alice-guid"}},
+				{Key: ccv3.Include, Values: []string{"space", "organization"}},
+				{Key: ccv3.PerPage, Values: []string{ccv3.MaxPerPage}},
+			}))
+			Expect(fakeCloudControllerClient.GetOrganizationsArgsForCall(0)).To(Equal([]ccv3.Query{
+				{Key: ccv3.GUIDFilter, Values: []string{"org-a-guid"}},
+			}))
+
+			Expect(roles).To(Equal([]UserRole{
+				{GUID: "role-4", Type: constant.SpaceAuditorRole, OrgGUID: "org-a-guid", OrgName: "org-a", SpaceGUID: "prod-guid", SpaceName: "prod"},
+				{GUID: "role-3", Type: constant.OrgManagerRole, OrgGUID: "org-b-guid", OrgName: "org-b"},
+				{GUID: "role-2", Type: constant.OrgUserRole, OrgGUID: "org-b-guid", OrgName: "org-b"},
+				{GUID: "role-1", Type: constant.SpaceDeveloperRole, OrgGUID: "org-b-guid", OrgName: "org-b", SpaceGUID: "dev-guid", SpaceName: "dev"},
+			}))
+		})
+
+		When("the orgs of all space roles are included", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetRolesReturns(
+					[]resources.Role{{GUID: "role-1", Type: constant.OrgAuditorRole, OrgGUID: "org-b-guid"}},
+					ccv3.IncludedResources{Organizations: []resources.Organization{{GUID: "org-b-guid", Name: "org-b"}}},
+					nil,
+					nil,
+				)
+			})
+
+			It("does not look up any orgs", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(roles).To(HaveLen(1))
+				Expect(fakeCloudControllerClient.GetOrganizationsCallCount()).To(Equal(0))
+			})
+		})
+
+		When("the user does not exist", func() {
+			BeforeEach(func() {
+				fakeUAAClient.ListUsersReturns(nil, nil)
+			})
+
+			It("returns a user not found error", func() {
+				Expect(err).To(MatchError(actionerror.UserNotFoundError{Username: "alice", Origin: "ldap"}))
+				Expect(fakeCloudControllerClient.GetRolesCallCount()).To(Equal(0))
+			})
+		})
+
+		When("getting the roles fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetRolesReturns(nil, ccv3.IncludedResources{}, ccv3.Warnings{"roles warning"}, errors.New("boom"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(err).To(MatchError("boom"))
+				Expect(warnings).To(ConsistOf("roles warning"))
+			})
+		})
+	})
+
+	Describe("DeleteUserRoles", func() {
+		var roles []UserRole
+
+		BeforeEach(func() {
+			roles = []UserRole{
+				{GUID: "org-user-role", Type: constant.OrgUserRole, OrgGUID: "org-guid"},
+				{GUID: "org-manager-role", Type: constant.OrgManagerRole, OrgGUID: "org-guid"},
+				{GUID: "space-role", Type: constant.SpaceDeveloperRole, OrgGUID: "org-guid", SpaceGUID: "space-guid"},
+			}
+			fakeCloudControllerClient.DeleteRoleReturns("job-url", ccv3.Warnings{"delete warning"}, nil)
+			fakeCloudControllerClient.PollJobReturns(ccv3.Warnings{"job warning"}, nil)
+		})
+
+		It("deletes space roles, then org roles and the org membership last", func() {
+			warnings, err := actor.DeleteUserRoles(roles)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(HaveLen(6))
+
+			Expect(fakeCloudControllerClient.DeleteRoleCallCount()).To(Equal(3))
+			Expect(fakeCloudControllerClient.DeleteRoleArgsForCall(0)).To(Equal("space-role"))
+			Expect(fakeCloudControllerClient.DeleteRoleArgsForCall(1)).To(Equal("org-manager-role"))
+			Expect(fakeCloudControllerClient.DeleteRoleArgsForCall(2)).To(Equal("org-user-role"))
+			Expect(fakeCloudControllerClient.PollJobArgsForCall(0)).To(Equal(ccv3.JobURL("job-url")))
+
+			Expect(roles[0].GUID).To(Equal("org-user-role"))
+		})
+
+		When("deleting a role fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.PollJobReturns(ccv3.Warnings{"job warning"}, errors.New("job failed"))
+			})
+
+			It("stops and returns the error", func() {
+				warnings, err := actor.DeleteUserRoles(roles)
+				Expect(err).To(MatchError("job failed"))
+				Expect(warnings).To(ConsistOf("delete warning", "job warning"))
+				Expect(fakeCloudControllerClient.DeleteRoleCallCount()).To(Equal(1))
+			})
+		})
+	})
+})
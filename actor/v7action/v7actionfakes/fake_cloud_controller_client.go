@@ -498,6 +498,21 @@ type FakeCloudControllerClient struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
+	DeleteDropletStub        func(string) (ccv3.JobURL, ccv3.Warnings, error)
+	deleteDropletMutex       sync.RWMutex
+	deleteDropletArgsForCall []struct {
+		arg1 string
+	}
+	deleteDropletReturns struct {
+		result1 ccv3.JobURL
+		result2 ccv3.Warnings
+		result3 error
+	}
+	deleteDropletReturnsOnCall map[int]struct {
+		result1 ccv3.JobURL
+		result2 ccv3.Warnings
+		result3 error
+	}
 	DeleteIsolationSegmentStub        func(string) (ccv3.Warnings, error)
 	deleteIsolationSegmentMutex       sync.RWMutex
 	deleteIsolationSegmentArgsForCall []struct {
@@ -570,6 +585,21 @@ type FakeCloudControllerClient struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
+	DeletePackageStub        func(string) (ccv3.JobURL, ccv3.Warnings, error)
+	deletePackageMutex       sync.RWMutex
+	deletePackageArgsForCall []struct {
+		arg1 string
+	}
+	deletePackageReturns struct {
+		result1 ccv3.JobURL
+		result2 ccv3.Warnings
+		result3 error
+	}
+	deletePackageReturnsOnCall map[int]struct {
+		result1 ccv3.JobURL
+		result2 ccv3.Warnings
+		result3 error
+	}
 	DeleteRoleStub        func(string) (ccv3.JobURL, ccv3.Warnings, error)
 	deleteRoleMutex       sync.RWMutex
 	deleteRoleArgsForCall []struct {
@@ -782,6 +812,21 @@ type FakeCloudControllerClient struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
+	GetAppFeaturesStub        func(string) ([]resources.ApplicationFeature, ccv3.Warnings, error)
+	getAppFeaturesMutex       sync.RWMutex
+	getAppFeaturesArgsForCall []struct {
+		arg1 string
+	}
+	getAppFeaturesReturns struct {
+		result1 []resources.ApplicationFeature
+		result2 ccv3.Warnings
+		result3 error
+	}
+	getAppFeaturesReturnsOnCall map[int]struct {
+		result1 []resources.ApplicationFeature
+		result2 ccv3.Warnings
+		result3 error
+	}
 	GetApplicationByNameAndSpaceStub        func(string, string) (resources.Application, ccv3.Warnings, error)
 	getApplicationByNameAndSpaceMutex       sync.RWMutex
 	getApplicationByNameAndSpaceArgsForCall []struct {
@@ -1311,6 +1356,21 @@ type FakeCloudControllerClient struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
+	GetOrganizationUsageSummaryStub        func(string) (resources.UsageSummary, ccv3.Warnings, error)
+	getOrganizationUsageSummaryMutex       sync.RWMutex
+	getOrganizationUsageSummaryArgsForCall []struct {
+		arg1 string
+	}
+	getOrganizationUsageSummaryReturns struct {
+		result1 resources.UsageSummary
+		result2 ccv3.Warnings
+		result3 error
+	}
+	getOrganizationUsageSummaryReturnsOnCall map[int]struct {
+		result1 resources.UsageSummary
+		result2 ccv3.Warnings
+		result3 error
+	}
 	GetOrganizationsStub        func(...ccv3.Query) ([]resources.Organization, ccv3.Warnings, error)
 	getOrganizationsMutex       sync.RWMutex
 	getOrganizationsArgsForCall []struct {
@@ -1620,6 +1680,21 @@ type FakeCloudControllerClient struct {
 		result3 ccv3.Warnings
 		result4 error
 	}
+	GetServiceInstanceCredentialsStub        func(string) (types.JSONObject, ccv3.Warnings, error)
+	getServiceInstanceCredentialsMutex       sync.RWMutex
+	getServiceInstanceCredentialsArgsForCall []struct {
+		arg1 string
+	}
+	getServiceInstanceCredentialsReturns struct {
+		result1 types.JSONObject
+		result2 ccv3.Warnings
+		result3 error
+	}
+	getServiceInstanceCredentialsReturnsOnCall map[int]struct {
+		result1 types.JSONObject
+		result2 ccv3.Warnings
+		result3 error
+	}
 	GetServiceInstanceParametersStub        func(string) (types.JSONObject, ccv3.Warnings, error)
 	getServiceInstanceParametersMutex       sync.RWMutex
 	getServiceInstanceParametersArgsForCall []struct {
@@ -1880,6 +1955,21 @@ type FakeCloudControllerClient struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
+	GetSpaceUsageSummaryStub        func(string) (resources.UsageSummary, ccv3.Warnings, error)
+	getSpaceUsageSummaryMutex       sync.RWMutex
+	getSpaceUsageSummaryArgsForCall []struct {
+		arg1 string
+	}
+	getSpaceUsageSummaryReturns struct {
+		result1 resources.UsageSummary
+		result2 ccv3.Warnings
+		result3 error
+	}
+	getSpaceUsageSummaryReturnsOnCall map[int]struct {
+		result1 resources.UsageSummary
+		result2 ccv3.Warnings
+		result3 error
+	}
 	GetSpacesStub        func(...ccv3.Query) ([]resources.Space, ccv3.IncludedResources, ccv3.Warnings, error)
 	getSpacesMutex       sync.RWMutex
 	getSpacesArgsForCall []struct {
@@ -4957,6 +5047,73 @@ func (fake *FakeCloudControllerClient) DeleteDomainReturnsOnCall(i int, result1
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) DeleteDroplet(arg1 string) (ccv3.JobURL, ccv3.Warnings, error) {
+	fake.deleteDropletMutex.Lock()
+	ret, specificReturn := fake.deleteDropletReturnsOnCall[len(fake.deleteDropletArgsForCall)]
+	fake.deleteDropletArgsForCall = append(fake.deleteDropletArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.DeleteDropletStub
+	fakeReturns := fake.deleteDropletReturns
+	fake.recordInvocation("DeleteDroplet", []interface{}{arg1})
+	fake.deleteDropletMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) DeleteDropletCallCount() int {
+	fake.deleteDropletMutex.RLock()
+	defer fake.deleteDropletMutex.RUnlock()
+	return len(fake.deleteDropletArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) DeleteDropletCalls(stub func(string) (ccv3.JobURL, ccv3.Warnings, error)) {
+	fake.deleteDropletMutex.Lock()
+	defer fake.deleteDropletMutex.Unlock()
+	fake.DeleteDropletStub = stub
+}
+
+func (fake *FakeCloudControllerClient) DeleteDropletArgsForCall(i int) string {
+	fake.deleteDropletMutex.RLock()
+	defer fake.deleteDropletMutex.RUnlock()
+	argsForCall := fake.deleteDropletArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeCloudControllerClient) DeleteDropletReturns(result1 ccv3.JobURL, result2 ccv3.Warnings, result3 error) {
+	fake.deleteDropletMutex.Lock()
+	defer fake.deleteDropletMutex.Unlock()
+	fake.DeleteDropletStub = nil
+	fake.deleteDropletReturns = struct {
+		result1 ccv3.JobURL
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) DeleteDropletReturnsOnCall(i int, result1 ccv3.JobURL, result2 ccv3.Warnings, result3 error) {
+	fake.deleteDropletMutex.Lock()
+	defer fake.deleteDropletMutex.Unlock()
+	fake.DeleteDropletStub = nil
+	if fake.deleteDropletReturnsOnCall == nil {
+		fake.deleteDropletReturnsOnCall = make(map[int]struct {
+			result1 ccv3.JobURL
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.deleteDropletReturnsOnCall[i] = struct {
+		result1 ccv3.JobURL
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) DeleteIsolationSegment(arg1 string) (ccv3.Warnings, error) {
 	fake.deleteIsolationSegmentMutex.Lock()
 	ret, specificReturn := fake.deleteIsolationSegmentReturnsOnCall[len(fake.deleteIsolationSegmentArgsForCall)]
@@ -5287,6 +5444,73 @@ func (fake *FakeCloudControllerClient) DeleteOrphanedRoutesReturnsOnCall(i int,
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) DeletePackage(arg1 string) (ccv3.JobURL, ccv3.Warnings, error) {
+	fake.deletePackageMutex.Lock()
+	ret, specificReturn := fake.deletePackageReturnsOnCall[len(fake.deletePackageArgsForCall)]
+	fake.deletePackageArgsForCall = append(fake.deletePackageArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.DeletePackageStub
+	fakeReturns := fake.deletePackageReturns
+	fake.recordInvocation("DeletePackage", []interface{}{arg1})
+	fake.deletePackageMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) DeletePackageCallCount() int {
+	fake.deletePackageMutex.RLock()
+	defer fake.deletePackageMutex.RUnlock()
+	return len(fake.deletePackageArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) DeletePackageCalls(stub func(string) (ccv3.JobURL, ccv3.Warnings, error)) {
+	fake.deletePackageMutex.Lock()
+	defer fake.deletePackageMutex.Unlock()
+	fake.DeletePackageStub = stub
+}
+
+func (fake *FakeCloudControllerClient) DeletePackageArgsForCall(i int) string {
+	fake.deletePackageMutex.RLock()
+	defer fake.deletePackageMutex.RUnlock()
+	argsForCall := fake.deletePackageArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeCloudControllerClient) DeletePackageReturns(result1 ccv3.JobURL, result2 ccv3.Warnings, result3 error) {
+	fake.deletePackageMutex.Lock()
+	defer fake.deletePackageMutex.Unlock()
+	fake.DeletePackageStub = nil
+	fake.deletePackageReturns = struct {
+		result1 ccv3.JobURL
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) DeletePackageReturnsOnCall(i int, result1 ccv3.JobURL, result2 ccv3.Warnings, result3 error) {
+	fake.deletePackageMutex.Lock()
+	defer fake.deletePackageMutex.Unlock()
+	fake.DeletePackageStub = nil
+	if fake.deletePackageReturnsOnCall == nil {
+		fake.deletePackageReturnsOnCall = make(map[int]struct {
+			result1 ccv3.JobURL
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.deletePackageReturnsOnCall[i] = struct {
+		result1 ccv3.JobURL
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) DeleteRole(arg1 string) (ccv3.JobURL, ccv3.Warnings, error) {
 	fake.deleteRoleMutex.Lock()
 	ret, specificReturn := fake.deleteRoleReturnsOnCall[len(fake.deleteRoleArgsForCall)]
@@ -6231,6 +6455,73 @@ func (fake *FakeCloudControllerClient) GetAppFeatureReturnsOnCall(i int, result1
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) GetAppFeatures(arg1 string) ([]resources.ApplicationFeature, ccv3.Warnings, error) {
+	fake.getAppFeaturesMutex.Lock()
+	ret, specificReturn := fake.getAppFeaturesReturnsOnCall[len(fake.getAppFeaturesArgsForCall)]
+	fake.getAppFeaturesArgsForCall = append(fake.getAppFeaturesArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.GetAppFeaturesStub
+	fakeReturns := fake.getAppFeaturesReturns
+	fake.recordInvocation("GetAppFeatures", []interface{}{arg1})
+	fake.getAppFeaturesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetAppFeaturesCallCount() int {
+	fake.getAppFeaturesMutex.RLock()
+	defer fake.getAppFeaturesMutex.RUnlock()
+	return len(fake.getAppFeaturesArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetAppFeaturesCalls(stub func(string) ([]resources.ApplicationFeature, ccv3.Warnings, error)) {
+	fake.getAppFeaturesMutex.Lock()
+	defer fake.getAppFeaturesMutex.Unlock()
+	fake.GetAppFeaturesStub = stub
+}
+
+func (fake *FakeCloudControllerClient) GetAppFeaturesArgsForCall(i int) string {
+	fake.getAppFeaturesMutex.RLock()
+	defer fake.getAppFeaturesMutex.RUnlock()
+	argsForCall := fake.getAppFeaturesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeCloudControllerClient) GetAppFeaturesReturns(result1 []resources.ApplicationFeature, result2 ccv3.Warnings, result3 error) {
+	fake.getAppFeaturesMutex.Lock()
+	defer fake.getAppFeaturesMutex.Unlock()
+	fake.GetAppFeaturesStub = nil
+	fake.getAppFeaturesReturns = struct {
+		result1 []resources.ApplicationFeature
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetAppFeaturesReturnsOnCall(i int, result1 []resources.ApplicationFeature, result2 ccv3.Warnings, result3 error) {
+	fake.getAppFeaturesMutex.Lock()
+	defer fake.getAppFeaturesMutex.Unlock()
+	fake.GetAppFeaturesStub = nil
+	if fake.getAppFeaturesReturnsOnCall == nil {
+		fake.getAppFeaturesReturnsOnCall = make(map[int]struct {
+			result1 []resources.ApplicationFeature
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.getAppFeaturesReturnsOnCall[i] = struct {
+		result1 []resources.ApplicationFeature
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) GetApplicationByNameAndSpace(arg1 string, arg2 string) (resources.Application, ccv3.Warnings, error) {
 	fake.getApplicationByNameAndSpaceMutex.Lock()
 	ret, specificReturn := fake.getApplicationByNameAndSpaceReturnsOnCall[len(fake.getApplicationByNameAndSpaceArgsForCall)]
@@ -8566,6 +8857,73 @@ func (fake *FakeCloudControllerClient) GetOrganizationQuotasReturnsOnCall(i int,
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) GetOrganizationUsageSummary(arg1 string) (resources.UsageSummary, ccv3.Warnings, error) {
+	fake.getOrganizationUsageSummaryMutex.Lock()
+	ret, specificReturn := fake.getOrganizationUsageSummaryReturnsOnCall[len(fake.getOrganizationUsageSummaryArgsForCall)]
+	fake.getOrganizationUsageSummaryArgsForCall = append(fake.getOrganizationUsageSummaryArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.GetOrganizationUsageSummaryStub
+	fakeReturns := fake.getOrganizationUsageSummaryReturns
+	fake.recordInvocation("GetOrganizationUsageSummary", []interface{}{arg1})
+	fake.getOrganizationUsageSummaryMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationUsageSummaryCallCount() int {
+	fake.getOrganizationUsageSummaryMutex.RLock()
+	defer fake.getOrganizationUsageSummaryMutex.RUnlock()
+	return len(fake.getOrganizationUsageSummaryArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationUsageSummaryCalls(stub func(string) (resources.UsageSummary, ccv3.Warnings, error)) {
+	fake.getOrganizationUsageSummaryMutex.Lock()
+	defer fake.getOrganizationUsageSummaryMutex.Unlock()
+	fake.GetOrganizationUsageSummaryStub = stub
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationUsageSummaryArgsForCall(i int) string {
+	fake.getOrganizationUsageSummaryMutex.RLock()
+	defer fake.getOrganizationUsageSummaryMutex.RUnlock()
+	argsForCall := fake.getOrganizationUsageSummaryArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationUsageSummaryReturns(result1 resources.UsageSummary, result2 ccv3.Warnings, result3 error) {
+	fake.getOrganizationUsageSummaryMutex.Lock()
+	defer fake.getOrganizationUsageSummaryMutex.Unlock()
+	fake.GetOrganizationUsageSummaryStub = nil
+	fake.getOrganizationUsageSummaryReturns = struct {
+		result1 resources.UsageSummary
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationUsageSummaryReturnsOnCall(i int, result1 resources.UsageSummary, result2 ccv3.Warnings, result3 error) {
+	fake.getOrganizationUsageSummaryMutex.Lock()
+	defer fake.getOrganizationUsageSummaryMutex.Unlock()
+	fake.GetOrganizationUsageSummaryStub = nil
+	if fake.getOrganizationUsageSummaryReturnsOnCall == nil {
+		fake.getOrganizationUsageSummaryReturnsOnCall = make(map[int]struct {
+			result1 resources.UsageSummary
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.getOrganizationUsageSummaryReturnsOnCall[i] = struct {
+		result1 resources.UsageSummary
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) GetOrganizations(arg1 ...ccv3.Query) ([]resources.Organization, ccv3.Warnings, error) {
 	fake.getOrganizationsMutex.Lock()
 	ret, specificReturn := fake.getOrganizationsReturnsOnCall[len(fake.getOrganizationsArgsForCall)]
@@ -9911,6 +10269,73 @@ func (fake *FakeCloudControllerClient) GetServiceInstanceByNameAndSpaceReturnsOn
 	}{result1, result2, result3, result4}
 }
 
+func (fake *FakeCloudControllerClient) GetServiceInstanceCredentials(arg1 string) (types.JSONObject, ccv3.Warnings, error) {
+	fake.getServiceInstanceCredentialsMutex.Lock()
+	ret, specificReturn := fake.getServiceInstanceCredentialsReturnsOnCall[len(fake.getServiceInstanceCredentialsArgsForCall)]
+	fake.getServiceInstanceCredentialsArgsForCall = append(fake.getServiceInstanceCredentialsArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.GetServiceInstanceCredentialsStub
+	fakeReturns := fake.getServiceInstanceCredentialsReturns
+	fake.recordInvocation("GetServiceInstanceCredentials", []interface{}{arg1})
+	fake.getServiceInstanceCredentialsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetServiceInstanceCredentialsCallCount() int {
+	fake.getServiceInstanceCredentialsMutex.RLock()
+	defer fake.getServiceInstanceCredentialsMutex.RUnlock()
+	return len(fake.getServiceInstanceCredentialsArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetServiceInstanceCredentialsCalls(stub func(string) (types.JSONObject, ccv3.Warnings, error)) {
+	fake.getServiceInstanceCredentialsMutex.Lock()
+	defer fake.getServiceInstanceCredentialsMutex.Unlock()
+	fake.GetServiceInstanceCredentialsStub = stub
+}
+
+func (fake *FakeCloudControllerClient) GetServiceInstanceCredentialsArgsForCall(i int) string {
+	fake.getServiceInstanceCredentialsMutex.RLock()
+	defer fake.getServiceInstanceCredentialsMutex.RUnlock()
+	argsForCall := fake.getServiceInstanceCredentialsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeCloudControllerClient) GetServiceInstanceCredentialsReturns(result1 types.JSONObject, result2 ccv3.Warnings, result3 error) {
+	fake.getServiceInstanceCredentialsMutex.Lock()
+	defer fake.getServiceInstanceCredentialsMutex.Unlock()
+	fake.GetServiceInstanceCredentialsStub = nil
+	fake.getServiceInstanceCredentialsReturns = struct {
+		result1 types.JSONObject
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetServiceInstanceCredentialsReturnsOnCall(i int, result1 types.JSONObject, result2 ccv3.Warnings, result3 error) {
+	fake.getServiceInstanceCredentialsMutex.Lock()
+	defer fake.getServiceInstanceCredentialsMutex.Unlock()
+	fake.GetServiceInstanceCredentialsStub = nil
+	if fake.getServiceInstanceCredentialsReturnsOnCall == nil {
+		fake.getServiceInstanceCredentialsReturnsOnCall = make(map[int]struct {
+			result1 types.JSONObject
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.getServiceInstanceCredentialsReturnsOnCall[i] = struct {
+		result1 types.JSONObject
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) GetServiceInstanceParameters(arg1 string) (types.JSONObject, ccv3.Warnings, error) {
 	fake.getServiceInstanceParametersMutex.Lock()
 	ret, specificReturn := fake.getServiceInstanceParametersReturnsOnCall[len(fake.getServiceInstanceParametersArgsForCall)]
@@ -11061,6 +11486,73 @@ func (fake *FakeCloudControllerClient) GetSpaceQuotasReturnsOnCall(i int, result
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) GetSpaceUsageSummary(arg1 string) (resources.UsageSummary, ccv3.Warnings, error) {
+	fake.getSpaceUsageSummaryMutex.Lock()
+	ret, specificReturn := fake.getSpaceUsageSummaryReturnsOnCall[len(fake.getSpaceUsageSummaryArgsForCall)]
+	fake.getSpaceUsageSummaryArgsForCall = append(fake.getSpaceUsageSummaryArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.GetSpaceUsageSummaryStub
+	fakeReturns := fake.getSpaceUsageSummaryReturns
+	fake.recordInvocation("GetSpaceUsageSummary", []interface{}{arg1})
+	fake.getSpaceUsageSummaryMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceUsageSummaryCallCount() int {
+	fake.getSpaceUsageSummaryMutex.RLock()
+	defer fake.getSpaceUsageSummaryMutex.RUnlock()
+	return len(fake.getSpaceUsageSummaryArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceUsageSummaryCalls(stub func(string) (resources.UsageSummary, ccv3.Warnings, error)) {
+	fake.getSpaceUsageSummaryMutex.Lock()
+	defer fake.getSpaceUsageSummaryMutex.Unlock()
+	fake.GetSpaceUsageSummaryStub = stub
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceUsageSummaryArgsForCall(i int) string {
+	fake.getSpaceUsageSummaryMutex.RLock()
+	defer fake.getSpaceUsageSummaryMutex.RUnlock()
+	argsForCall := fake.getSpaceUsageSummaryArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceUsageSummaryReturns(result1 resources.UsageSummary, result2 ccv3.Warnings, result3 error) {
+	fake.getSpaceUsageSummaryMutex.Lock()
+	defer fake.getSpaceUsageSummaryMutex.Unlock()
+	fake.GetSpaceUsageSummaryStub = nil
+	fake.getSpaceUsageSummaryReturns = struct {
+		result1 resources.UsageSummary
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceUsageSummaryReturnsOnCall(i int, result1 resources.UsageSummary, result2 ccv3.Warnings, result3 error) {
+	fake.getSpaceUsageSummaryMutex.Lock()
+	defer fake.getSpaceUsageSummaryMutex.Unlock()
+	fake.GetSpaceUsageSummaryStub = nil
+	if fake.getSpaceUsageSummaryReturnsOnCall == nil {
+		fake.getSpaceUsageSummaryReturnsOnCall = make(map[int]struct {
+			result1 resources.UsageSummary
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.getSpaceUsageSummaryReturnsOnCall[i] = struct {
+		result1 resources.UsageSummary
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) GetSpaces(arg1 ...ccv3.Query) ([]resources.Space, ccv3.IncludedResources, ccv3.Warnings, error) {
 	fake.getSpacesMutex.Lock()
 	ret, specificReturn := fake.getSpacesReturnsOnCall[len(fake.getSpacesArgsForCall)]
@@ -15261,6 +15753,8 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.deleteBuildpackMutex.RUnlock()
 	fake.deleteDomainMutex.RLock()
 	defer fake.deleteDomainMutex.RUnlock()
+	fake.deleteDropletMutex.RLock()
+	defer fake.deleteDropletMutex.RUnlock()
 	fake.deleteIsolationSegmentMutex.RLock()
 	defer fake.deleteIsolationSegmentMutex.RUnlock()
 	fake.deleteIsolationSegmentOrganizationMutex.RLock()
@@ -15271,6 +15765,8 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.deleteOrganizationQuotaMutex.RUnlock()
 	fake.deleteOrphanedRoutesMutex.RLock()
 	defer fake.deleteOrphanedRoutesMutex.RUnlock()
+	fake.deletePackageMutex.RLock()
+	defer fake.deletePackageMutex.RUnlock()
 	fake.deleteRoleMutex.RLock()
 	defer fake.deleteRoleMutex.RUnlock()
 	fake.deleteRouteMutex.RLock()
@@ -15299,6 +15795,8 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.entitleIsolationSegmentToOrganizationsMutex.RUnlock()
 	fake.getAppFeatureMutex.RLock()
 	defer fake.getAppFeatureMutex.RUnlock()
+	fake.getAppFeaturesMutex.RLock()
+	defer fake.getAppFeaturesMutex.RUnlock()
 	fake.getApplicationByNameAndSpaceMutex.RLock()
 	defer fake.getApplicationByNameAndSpaceMutex.RUnlock()
 	fake.getApplicationDropletCurrentMutex.RLock()
@@ -15369,6 +15867,8 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.getOrganizationQuotaMutex.RUnlock()
 	fake.getOrganizationQuotasMutex.RLock()
 	defer fake.getOrganizationQuotasMutex.RUnlock()
+	fake.getOrganizationUsageSummaryMutex.RLock()
+	defer fake.getOrganizationUsageSummaryMutex.RUnlock()
 	fake.getOrganizationsMutex.RLock()
 	defer fake.getOrganizationsMutex.RUnlock()
 	fake.getPackageMutex.RLock()
@@ -15409,6 +15909,8 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.getServiceCredentialBindingsMutex.RUnlock()
 	fake.getServiceInstanceByNameAndSpaceMutex.RLock()
 	defer fake.getServiceInstanceByNameAndSpaceMutex.RUnlock()
+	fake.getServiceInstanceCredentialsMutex.RLock()
+	defer fake.getServiceInstanceCredentialsMutex.RUnlock()
 	fake.getServiceInstanceParametersMutex.RLock()
 	defer fake.getServiceInstanceParametersMutex.RUnlock()
 	fake.getServiceInstanceSharedSpacesMutex.RLock()
@@ -15443,6 +15945,8 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.getSpaceQuotaMutex.RUnlock()
 	fake.getSpaceQuotasMutex.RLock()
 	defer fake.getSpaceQuotasMutex.RUnlock()
+	fake.getSpaceUsageSummaryMutex.RLock()
+	defer fake.getSpaceUsageSummaryMutex.RUnlock()
 	fake.getSpacesMutex.RLock()
 	defer fake.getSpacesMutex.RUnlock()
 	fake.getStacksMutex.RLock()
@@ -0,0 +1,122 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package v7actionfakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/actor/cfnetworkingaction"
+	"code.cloudfoundry.org/cli/actor/v7action"
+)
+
+type FakeSpaceExportNetworkingActor struct {
+	NetworkPoliciesBySpaceStub        func(string) ([]cfnetworkingaction.Policy, cfnetworkingaction.Warnings, error)
+	networkPoliciesBySpaceMutex       sync.RWMutex
+	networkPoliciesBySpaceArgsForCall []struct {
+		arg1 string
+	}
+	networkPoliciesBySpaceReturns struct {
+		result1 []cfnetworkingaction.Policy
+		result2 cfnetworkingaction.Warnings
+		result3 error
+	}
+	networkPoliciesBySpaceReturnsOnCall map[int]struct {
+		result1 []cfnetworkingaction.Policy
+		result2 cfnetworkingaction.Warnings
+		result3 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeSpaceExportNetworkingActor) NetworkPoliciesBySpace(arg1 string) ([]cfnetworkingaction.Policy, cfnetworkingaction.Warnings, error) {
+	fake.networkPoliciesBySpaceMutex.Lock()
+	ret, specificReturn := fake.networkPoliciesBySpaceReturnsOnCall[len(fake.networkPoliciesBySpaceArgsForCall)]
+	fake.networkPoliciesBySpaceArgsForCall = append(fake.networkPoliciesBySpaceArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.NetworkPoliciesBySpaceStub
+	fakeReturns := fake.networkPoliciesBySpaceReturns
+	fake.recordInvocation("NetworkPoliciesBySpace", []interface{}{arg1})
+	fake.networkPoliciesBySpaceMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeSpaceExportNetworkingActor) NetworkPoliciesBySpaceCallCount() int {
+	fake.networkPoliciesBySpaceMutex.RLock()
+	defer fake.networkPoliciesBySpaceMutex.RUnlock()
+	return len(fake.networkPoliciesBySpaceArgsForCall)
+}
+
+func (fake *FakeSpaceExportNetworkingActor) NetworkPoliciesBySpaceCalls(stub func(string) ([]cfnetworkingaction.Policy, cfnetworkingaction.Warnings, error)) {
+	fake.networkPoliciesBySpaceMutex.Lock()
+	defer fake.networkPoliciesBySpaceMutex.Unlock()
+	fake.NetworkPoliciesBySpaceStub = stub
+}
+
+func (fake *FakeSpaceExportNetworkingActor) NetworkPoliciesBySpaceArgsForCall(i int) string {
+	fake.networkPoliciesBySpaceMutex.RLock()
+	defer fake.networkPoliciesBySpaceMutex.RUnlock()
+	argsForCall := fake.networkPoliciesBySpaceArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeSpaceExportNetworkingActor) NetworkPoliciesBySpaceReturns(result1 []cfnetworkingaction.Policy, result2 cfnetworkingaction.Warnings, result3 error) {
+	fake.networkPoliciesBySpaceMutex.Lock()
+	defer fake.networkPoliciesBySpaceMutex.Unlock()
+	fake.NetworkPoliciesBySpaceStub = nil
+	fake.networkPoliciesBySpaceReturns = struct {
+		result1 []cfnetworkingaction.Policy
+		result2 cfnetworkingaction.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeSpaceExportNetworkingActor) NetworkPoliciesBySpaceReturnsOnCall(i int, result1 []cfnetworkingaction.Policy, result2 cfnetworkingaction.Warnings, result3 error) {
+	fake.networkPoliciesBySpaceMutex.Lock()
+	defer fake.networkPoliciesBySpaceMutex.Unlock()
+	fake.NetworkPoliciesBySpaceStub = nil
+	if fake.networkPoliciesBySpaceReturnsOnCall == nil {
+		fake.networkPoliciesBySpaceReturnsOnCall = make(map[int]struct {
+			result1 []cfnetworkingaction.Policy
+			result2 cfnetworkingaction.Warnings
+			result3 error
+		})
+	}
+	fake.networkPoliciesBySpaceReturnsOnCall[i] = struct {
+		result1 []cfnetworkingaction.Policy
+		result2 cfnetworkingaction.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeSpaceExportNetworkingActor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.networkPoliciesBySpaceMutex.RLock()
+	defer fake.networkPoliciesBySpaceMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeSpaceExportNetworkingActor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v7action.SpaceExportNetworkingActor = new(FakeSpaceExportNetworkingActor)
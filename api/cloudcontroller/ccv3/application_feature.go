@@ -22,6 +22,23 @@ func (client *Client) GetAppFeature(appGUID string, featureName string) (resourc
 	return responseBody, warnings, err
 }
 
+// GetAppFeatures lists every feature of the given application.
+func (client *Client) GetAppFeatures(appGUID string) ([]resources.ApplicationFeature, Warnings, error) {
+	var features []resources.ApplicationFeature
+
+	_, warnings, err := client.MakeListRequest(RequestParams{
+		RequestName:  internal.GetApplicationFeatureListRequest,
+		URIParams:    internal.Params{"app_guid": appGUID},
+		ResponseBody: resources.ApplicationFeature{},
+		AppendToList: func(item interface{}) error {
+			features = append(features, item.(resources.ApplicationFeature))
+			return nil
+		},
+	})
+
+	return features, warnings, err
+}
+
 func (client *Client) GetSSHEnabled(appGUID string) (SSHEnabled, Warnings, error) {
 	var responseBody SSHEnabled
 
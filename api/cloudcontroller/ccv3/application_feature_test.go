@@ -19,6 +19,87 @@ var _ = Describe("Application", func() {
 		client, _ = NewTestClient()
 	})
 
+	Describe("GetAppFeatures", func() {
+		var (
+			features   []resources.ApplicationFeature
+			warnings   Warnings
+			executeErr error
+			appGUID    = "some-app-guid"
+		)
+
+		JustBeforeEach(func() {
+			features, warnings, executeErr = client.GetAppFeatures(appGUID)
+		})
+
+		When("the app exists", func() {
+			BeforeEach(func() {
+				response := `{
+   "resources": [
+      {
+         "name": "ssh",
+         "description": "Enable SSHing into the app.",
+         "enabled": true
+      },
+      {
+         "name": "revisions",
+         "description": "Enable versioning of an application",
+         "enabled": false
+      }
+   ],
+   "pagination": {
+      "total_results": 2,
+      "total_pages": 1,
+      "first": { "href": "/v3/apps/some-app-guid/features" },
+      "last": { "href": "/v3/apps/some-app-guid/features" },
+      "next": null,
+      "previous": null
+   }
+}`
+
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, fmt.Sprintf("/v3/apps/%s/features", appGUID)),
+						RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the features and all warnings", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("this is a warning"))
+				Expect(features).To(Equal([]resources.ApplicationFeature{
+					{Name: "ssh", Description: "Enable SSHing into the app.", Enabled: true},
+					{Name: "revisions", Description: "Enable versioning of an application", Enabled: false},
+				}))
+			})
+		})
+
+		When("the cloud controller returns errors and warnings", func() {
+			BeforeEach(func() {
+				response := `{
+  "errors": [
+    {
+      "code": 10010,
+      "detail": "App not found",
+      "title": "CF-ResourceNotFound"
+    }
+  ]
+}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, fmt.Sprintf("/v3/apps/%s/features", appGUID)),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError(ccerror.ApplicationNotFoundError{}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+	})
+
 	Describe("UpdateAppFeature", func() {
 		var (
 			warnings   Warnings
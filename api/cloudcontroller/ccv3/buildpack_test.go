@@ -57,6 +57,7 @@ var _ = Describe("Buildpacks", func() {
 							"name": "ruby_buildpack",
 							"state": "AWAITING_UPLOAD",
 							"stack": "windows64",
+							"updated_at": "2026-03-01T12:00:00Z",
 							"position": 1,
 							"enabled": true,
 							"locked": false,
@@ -122,14 +123,15 @@ var _ = Describe("Buildpacks", func() {
 
 				Expect(buildpacks).To(ConsistOf(
 					Buildpack{
-						Name:     "ruby_buildpack",
-						GUID:     "guid1",
-						Position: types.NullInt{Value: 1, IsSet: true},
-						Enabled:  types.NullBool{Value: true, IsSet: true},
-						Locked:   types.NullBool{Value: false, IsSet: true},
-						Stack:    "windows64",
-						State:    "AWAITING_UPLOAD",
-						Metadata: &Metadata{Labels: map[string]types.NullString{}},
+						Name:      "ruby_buildpack",
+						GUID:      "guid1",
+						Position:  types.NullInt{Value: 1, IsSet: true},
+						Enabled:   types.NullBool{Value: true, IsSet: true},
+						Locked:    types.NullBool{Value: false, IsSet: true},
+						Stack:     "windows64",
+						State:     "AWAITING_UPLOAD",
+						UpdatedAt: "2026-03-01T12:00:00Z",
+						Metadata:  &Metadata{Labels: map[string]types.NullString{}},
 					},
 					Buildpack{
 						Name:     "staticfile_buildpack",
@@ -260,14 +262,15 @@ var _ = Describe("Buildpacks", func() {
 				Expect(warnings).To(ConsistOf("this is a warning"))
 
 				expectedBuildpack := Buildpack{
-					GUID:     "some-bp-guid",
-					Name:     "some-buildpack",
-					Stack:    "some-stack",
-					Enabled:  types.NullBool{Value: true, IsSet: true},
-					Filename: "",
-					Locked:   types.NullBool{Value: false, IsSet: true},
-					State:    constant.BuildpackAwaitingUpload,
-					Position: types.NullInt{Value: 42, IsSet: true},
+					GUID:      "some-bp-guid",
+					Name:      "some-buildpack",
+					Stack:     "some-stack",
+					Enabled:   types.NullBool{Value: true, IsSet: true},
+					Filename:  "",
+					Locked:    types.NullBool{Value: false, IsSet: true},
+					State:     constant.BuildpackAwaitingUpload,
+					UpdatedAt: "2016-10-17T20:00:42Z",
+					Position:  types.NullInt{Value: 42, IsSet: true},
 					Links: resources.APILinks{
 						"upload": resources.APILink{
 							Method: "POST",
@@ -569,14 +572,15 @@ var _ = Describe("Buildpacks", func() {
 				Expect(warnings).To(ConsistOf("this is a warning"))
 
 				expectedBuildpack := resources.Buildpack{
-					GUID:     "some-bp-guid",
-					Name:     "some-buildpack",
-					Stack:    "some-stack",
-					Enabled:  types.NullBool{Value: true, IsSet: true},
-					Filename: "",
-					Locked:   types.NullBool{Value: true, IsSet: true},
-					State:    constant.BuildpackAwaitingUpload,
-					Position: types.NullInt{Value: 42, IsSet: true},
+					GUID:      "some-bp-guid",
+					Name:      "some-buildpack",
+					Stack:     "some-stack",
+					Enabled:   types.NullBool{Value: true, IsSet: true},
+					Filename:  "",
+					Locked:    types.NullBool{Value: true, IsSet: true},
+					State:     constant.BuildpackAwaitingUpload,
+					UpdatedAt: "2016-10-17T20:00:42Z",
+					Position:  types.NullInt{Value: 42, IsSet: true},
 					Links: resources.APILinks{
 						"upload": resources.APILink{
 							Method: "POST",
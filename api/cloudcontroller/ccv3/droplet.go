@@ -33,6 +33,16 @@ func (client *Client) CreateDroplet(appGUID string) (resources.Droplet, Warnings
 	return responseBody, warnings, err
 }
 
+// DeleteDroplet deletes the droplet with the given GUID.
+func (client *Client) DeleteDroplet(dropletGUID string) (JobURL, Warnings, error) {
+	jobURL, warnings, err := client.MakeRequest(RequestParams{
+		RequestName: internal.DeleteDropletRequest,
+		URIParams:   internal.Params{"droplet_guid": dropletGUID},
+	})
+
+	return jobURL, warnings, err
+}
+
 // GetApplicationDropletCurrent returns the current droplet for a given
 // application.
 func (client *Client) GetApplicationDropletCurrent(appGUID string) (resources.Droplet, Warnings, error) {
@@ -70,6 +70,35 @@ var _ = Describe("Droplet", func() {
 		})
 	})
 
+	Describe("DeleteDroplet", func() {
+		var (
+			jobURL     JobURL
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			jobURL, warnings, executeErr = client.DeleteDroplet("some-droplet-guid")
+		})
+
+		BeforeEach(func() {
+			requester.MakeRequestReturns("some-job-url", Warnings{"some-warning"}, errors.New("some-error"))
+		})
+
+		It("makes the correct request", func() {
+			Expect(requester.MakeRequestCallCount()).To(Equal(1))
+			actualParams := requester.MakeRequestArgsForCall(0)
+			Expect(actualParams.RequestName).To(Equal(internal.DeleteDropletRequest))
+			Expect(actualParams.URIParams).To(Equal(internal.Params{"droplet_guid": "some-droplet-guid"}))
+		})
+
+		It("returns the job URL and all warnings", func() {
+			Expect(jobURL).To(Equal(JobURL("some-job-url")))
+			Expect(warnings).To(ConsistOf("some-warning"))
+			Expect(executeErr).To(MatchError("some-error"))
+		})
+	})
+
 	Describe("GetApplicationDropletCurrent", func() {
 		var (
 			droplet    resources.Droplet
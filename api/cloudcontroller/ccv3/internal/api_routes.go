@@ -13,11 +13,13 @@ const (
 	DeleteApplicationRequest                                    = "DeleteApplication"
 	DeleteBuildpackRequest                                      = "DeleteBuildpack"
 	DeleteDomainRequest                                         = "DeleteDomainRequest"
+	DeleteDropletRequest                                        = "DeleteDroplet"
 	DeleteIsolationSegmentRelationshipOrganizationRequest       = "DeleteIsolationSegmentRelationshipOrganization"
 	DeleteIsolationSegmentRequest                               = "DeleteIsolationSegment"
 	DeleteOrganizationRequest                                   = "DeleteOrganization"
 	DeleteOrganizationQuotaRequest                              = "DeleteOrganizationQuota"
 	DeleteOrphanedRoutesRequest                                 = "DeleteOrphanedRoutes"
+	DeletePackageRequest                                        = "DeletePackage"
 	DeleteRoleRequest                                           = "DeleteRoleRequest"
 	DeleteRouteRequest                                          = "DeleteRouteRequest"
 	DeleteRouteBindingRequest                                   = "DeleteRouteBinding"
@@ -38,6 +40,7 @@ const (
 	GetApplicationDropletCurrentRequest                         = "GetApplicationDropletCurrent"
 	GetApplicationEnvRequest                                    = "GetApplicationEnv"
 	GetApplicationFeaturesRequest                               = "GetApplicationFeatures"
+	GetApplicationFeatureListRequest                            = "GetApplicationFeatureList"
 	GetApplicationManifestRequest                               = "GetApplicationManifest"
 	GetApplicationProcessRequest                                = "GetApplicationProcess"
 	GetApplicationProcessesRequest                              = "GetApplicationProcesses"
@@ -70,6 +73,7 @@ const (
 	GetOrganizationRelationshipDefaultIsolationSegmentRequest   = "GetOrganizationRelationshipDefaultIsolationSegment"
 	GetOrganizationRequest                                      = "GetOrganization"
 	GetOrganizationsRequest                                     = "GetOrganizations"
+	GetOrganizationUsageSummaryRequest                          = "GetOrganizationUsageSummary"
 	GetPackageRequest                                           = "GetPackage"
 	GetPackagesRequest                                          = "GetPackages"
 	GetPackageDropletsRequest                                   = "GetPackageDroplets"
@@ -85,6 +89,7 @@ const (
 	GetServiceBrokersRequest                                    = "GetServiceBrokers"
 	GetServiceCredentialBindingsRequest                         = "GetServiceCredentialBindings"
 	GetServiceCredentialBindingDetailsRequest                   = "GetServiceCredentialBindingDetails"
+	GetServiceInstanceCredentialsRequest                        = "GetServiceInstanceCredentials"
 	GetServiceInstanceParametersRequest                         = "GetServiceInstanceParameters"
 	GetServiceInstancesRequest                                  = "GetServiceInstances"
 	GetServiceInstanceRelationshipsSharedSpacesRequest          = "GetServiceInstanceRelationshipSharedSpacesRequest"
@@ -98,6 +103,7 @@ const (
 	GetSpaceRelationshipIsolationSegmentRequest                 = "GetSpaceRelationshipIsolationSegment"
 	GetSpaceRunningSecurityGroupsRequest                        = "GetSpaceRunningSecurityGroups"
 	GetSpacesRequest                                            = "GetSpaces"
+	GetSpaceUsageSummaryRequest                                 = "GetSpaceUsageSummary"
 	GetSpaceQuotaRequest                                        = "GetSpaceQuota"
 	GetSpaceQuotasRequest                                       = "GetSpaceQuotas"
 	GetSpaceStagingSecurityGroupsRequest                        = "GetSpaceStagingSecurityGroups"
@@ -191,6 +197,7 @@ var APIRoutes = map[string]Route{
 	PatchApplicationRequest:                                     {Path: "/v3/apps/:app_guid", Method: http.MethodPatch},
 	PatchApplicationFeaturesRequest:                             {Path: "/v3/apps/:app_guid/features/:name", Method: http.MethodPatch},
 	GetApplicationFeaturesRequest:                               {Path: "/v3/apps/:app_guid/features/:name", Method: http.MethodGet},
+	GetApplicationFeatureListRequest:                            {Path: "/v3/apps/:app_guid/features", Method: http.MethodGet},
 	PostApplicationActionApplyManifest:                          {Path: "/v3/apps/:app_guid/actions/apply_manifest", Method: http.MethodPost},
 	PostApplicationActionRestartRequest:                         {Path: "/v3/apps/:app_guid/actions/restart", Method: http.MethodPost},
 	PostApplicationActionStartRequest:                           {Path: "/v3/apps/:app_guid/actions/start", Method: http.MethodPost},
@@ -233,6 +240,7 @@ var APIRoutes = map[string]Route{
 	GetDropletsRequest:                                          {Path: "/v3/droplets", Method: http.MethodGet},
 	PostDropletRequest:                                          {Path: "/v3/droplets", Method: http.MethodPost},
 	GetDropletRequest:                                           {Path: "/v3/droplets/:droplet_guid", Method: http.MethodGet},
+	DeleteDropletRequest:                                        {Path: "/v3/droplets/:droplet_guid", Method: http.MethodDelete},
 	PostDropletBitsRequest:                                      {Path: "/v3/droplets/:droplet_guid/upload", Method: http.MethodPost},
 	GetDropletBitsRequest:                                       {Path: "/v3/droplets/:droplet_guid/download", Method: http.MethodGet},
 	GetEnvironmentVariableGroupRequest:                          {Path: "/v3/environment_variable_groups/:group_name", Method: http.MethodGet},
@@ -249,6 +257,7 @@ var APIRoutes = map[string]Route{
 	PostIsolationSegmentRelationshipOrganizationsRequest:        {Path: "/v3/isolation_segments/:isolation_segment_guid/relationships/organizations", Method: http.MethodPost},
 	DeleteIsolationSegmentRelationshipOrganizationRequest:       {Path: "/v3/isolation_segments/:isolation_segment_guid/relationships/organizations/:organization_guid", Method: http.MethodDelete},
 	GetOrganizationsRequest:                                     {Path: "/v3/organizations", Method: http.MethodGet},
+	GetOrganizationUsageSummaryRequest:                          {Path: "/v3/organizations/:organization_guid/usage_summary", Method: http.MethodGet},
 	PostOrganizationRequest:                                     {Path: "/v3/organizations", Method: http.MethodPost},
 	GetOrganizationRequest:                                      {Path: "/v3/organizations/:organization_guid", Method: http.MethodGet},
 	DeleteOrganizationRequest:                                   {Path: "/v3/organizations/:organization_guid/", Method: http.MethodDelete},
@@ -266,6 +275,7 @@ var APIRoutes = map[string]Route{
 	GetPackagesRequest:                                          {Path: "/v3/packages", Method: http.MethodGet},
 	PostPackageRequest:                                          {Path: "/v3/packages", Method: http.MethodPost},
 	GetPackageRequest:                                           {Path: "/v3/packages/:package_guid", Method: http.MethodGet},
+	DeletePackageRequest:                                        {Path: "/v3/packages/:package_guid", Method: http.MethodDelete},
 	PostPackageBitsRequest:                                      {Path: "/v3/packages/:package_guid/upload", Method: http.MethodPost},
 	GetPackageDropletsRequest:                                   {Path: "/v3/packages/:package_guid/droplets", Method: http.MethodGet},
 	GetProcessRequest:                                           {Path: "/v3/processes/:process_guid", Method: http.MethodGet},
@@ -307,6 +317,7 @@ var APIRoutes = map[string]Route{
 	GetServiceCredentialBindingDetailsRequest:                   {Path: "/v3/service_credential_bindings/:service_credential_binding_guid/details", Method: http.MethodGet},
 	GetServiceInstancesRequest:                                  {Path: "/v3/service_instances", Method: http.MethodGet},
 	PostServiceInstanceRequest:                                  {Path: "/v3/service_instances", Method: http.MethodPost},
+	GetServiceInstanceCredentialsRequest:                        {Path: "/v3/service_instances/:service_instance_guid/credentials", Method: http.MethodGet},
 	GetServiceInstanceParametersRequest:                         {Path: "/v3/service_instances/:service_instance_guid/parameters", Method: http.MethodGet},
 	PatchServiceInstanceRequest:                                 {Path: "/v3/service_instances/:service_instance_guid", Method: http.MethodPatch},
 	DeleteServiceInstanceRequest:                                {Path: "/v3/service_instances/:service_instance_guid", Method: http.MethodDelete},
@@ -328,6 +339,7 @@ var APIRoutes = map[string]Route{
 	GetRouteBindingsRequest:                                     {Path: "/v3/service_route_bindings", Method: http.MethodGet},
 	DeleteRouteBindingRequest:                                   {Path: "/v3/service_route_bindings/:route_binding_guid", Method: http.MethodDelete},
 	GetSpacesRequest:                                            {Path: "/v3/spaces", Method: http.MethodGet},
+	GetSpaceUsageSummaryRequest:                                 {Path: "/v3/spaces/:space_guid/usage_summary", Method: http.MethodGet},
 	PostSpaceRequest:                                            {Path: "/v3/spaces", Method: http.MethodPost},
 	DeleteSpaceRequest:                                          {Path: "/v3/spaces/:space_guid", Method: http.MethodDelete},
 	PatchSpaceRequest:                                           {Path: "/v3/spaces/:space_guid", Method: http.MethodPatch},
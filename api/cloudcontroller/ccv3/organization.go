@@ -89,6 +89,20 @@ func (client *Client) GetOrganizations(query ...Query) ([]resources.Organization
 	return organizations, warnings, err
 }
 
+// GetOrganizationUsageSummary gets the usage of the organization that counts
+// toward its quota.
+func (client *Client) GetOrganizationUsageSummary(orgGUID string) (resources.UsageSummary, Warnings, error) {
+	var responseBody resources.UsageSummary
+
+	_, warnings, err := client.MakeRequest(RequestParams{
+		RequestName:  internal.GetOrganizationUsageSummaryRequest,
+		URIParams:    internal.Params{"organization_guid": orgGUID},
+		ResponseBody: &responseBody,
+	})
+
+	return responseBody, warnings, err
+}
+
 // UpdateOrganization updates an organization with the given properties.
 func (client *Client) UpdateOrganization(org resources.Organization) (resources.Organization, Warnings, error) {
 	orgGUID := org.GUID
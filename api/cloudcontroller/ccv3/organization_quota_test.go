@@ -159,6 +159,7 @@ var _ = Describe("Organization Quotas", func() {
 								InstanceMemory:    &types.NullInt{Value: 1024, IsSet: true},
 								TotalAppInstances: &types.NullInt{Value: 10, IsSet: true},
 								TotalLogVolume:    &types.NullInt{Value: 8, IsSet: true},
+								PerAppTasks:       &types.NullInt{Value: 5, IsSet: true},
 							},
 							Services: resources.ServiceLimit{
 								TotalServiceInstances: &types.NullInt{Value: 10, IsSet: true},
@@ -179,6 +180,7 @@ var _ = Describe("Organization Quotas", func() {
 								InstanceMemory:    &types.NullInt{Value: 1024, IsSet: true},
 								TotalAppInstances: &types.NullInt{Value: 8, IsSet: true},
 								TotalLogVolume:    &types.NullInt{Value: 16, IsSet: true},
+								PerAppTasks:       &types.NullInt{Value: 5, IsSet: true},
 							},
 							Services: resources.ServiceLimit{
 								TotalServiceInstances: &types.NullInt{Value: 8, IsSet: true},
@@ -263,6 +265,7 @@ var _ = Describe("Organization Quotas", func() {
 								InstanceMemory:    &types.NullInt{Value: 1024, IsSet: true},
 								TotalAppInstances: &types.NullInt{Value: 8, IsSet: true},
 								TotalLogVolume:    &types.NullInt{Value: 8, IsSet: true},
+								PerAppTasks:       &types.NullInt{Value: 5, IsSet: true},
 							},
 							Services: resources.ServiceLimit{
 								TotalServiceInstances: &types.NullInt{Value: 8, IsSet: true},
@@ -392,6 +395,7 @@ var _ = Describe("Organization Quotas", func() {
 								InstanceMemory:    &types.NullInt{Value: 1024, IsSet: true},
 								TotalAppInstances: &types.NullInt{Value: 10, IsSet: true},
 								TotalLogVolume:    &types.NullInt{Value: 8, IsSet: true},
+								PerAppTasks:       &types.NullInt{Value: 5, IsSet: true},
 							},
 							Services: resources.ServiceLimit{
 								TotalServiceInstances: &types.NullInt{Value: 10, IsSet: true},
@@ -31,6 +31,16 @@ func (client *Client) CreatePackage(pkg resources.Package) (resources.Package, W
 	return responseBody, warnings, err
 }
 
+// DeletePackage deletes the package with the given GUID.
+func (client *Client) DeletePackage(packageGUID string) (JobURL, Warnings, error) {
+	jobURL, warnings, err := client.MakeRequest(RequestParams{
+		RequestName: internal.DeletePackageRequest,
+		URIParams:   internal.Params{"package_guid": packageGUID},
+	})
+
+	return jobURL, warnings, err
+}
+
 // GetPackage returns the package with the given GUID.
 func (client *Client) GetPackage(packageGUID string) (resources.Package, Warnings, error) {
 	var responseBody resources.Package
@@ -219,6 +219,63 @@ var _ = Describe("Package", func() {
 		})
 	})
 
+	Describe("DeletePackage", func() {
+		var (
+			jobURL     JobURL
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			jobURL, warnings, executeErr = client.DeletePackage("some-package-guid")
+		})
+
+		When("the package exists", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodDelete, "/v3/packages/some-package-guid"),
+						RespondWith(http.StatusAccepted, nil, http.Header{
+							"X-Cf-Warnings": {"this is a warning"},
+							"Location":      {"https://api.test.com/v3/jobs/job-guid"},
+						}),
+					),
+				)
+			})
+
+			It("returns the job URL and all warnings", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(jobURL).To(Equal(JobURL("https://api.test.com/v3/jobs/job-guid")))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+
+		When("the package does not exist", func() {
+			BeforeEach(func() {
+				response := `{
+	"errors": [
+		{
+			"code": 10010,
+			"detail": "Package not found",
+			"title": "CF-ResourceNotFound"
+		}
+	]
+}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodDelete, "/v3/packages/some-package-guid"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns a resource not found error and all warnings", func() {
+				Expect(executeErr).To(MatchError(ccerror.ResourceNotFoundError{Message: "Package not found"}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+	})
+
 	Describe("GetPackage", func() {
 		var (
 			pkg        resources.Package
@@ -13,6 +13,10 @@ const (
 	AppGUIDFilter QueryKey = "app_guids"
 	// AvailableFilter is a query parameter for listing available resources
 	AvailableFilter QueryKey = "available"
+	// CreatedAtsAfterFilter is a query parameter for listing objects created after a timestamp.
+	CreatedAtsAfterFilter QueryKey = "created_ats[gt]"
+	// CreatedAtsBeforeFilter is a query parameter for listing objects created before a timestamp.
+	CreatedAtsBeforeFilter QueryKey = "created_ats[lt]"
 	// GUIDFilter is a query parameter for listing objects by GUID.
 	GUIDFilter QueryKey = "guids"
 	// LabelSelectorFilter is a query parameter for listing objects by label
@@ -35,6 +39,8 @@ const (
 	StatusValueFilter QueryKey = "status_values"
 	// DomainGUIDFilter is a query param for listing events by target_guid
 	TargetGUIDFilter QueryKey = "target_guids"
+	// EventTypesFilter is a query param for listing audit events by type
+	EventTypesFilter QueryKey = "types"
 	// DomainGUIDFilter is a query param for listing objects by domain_guid
 	DomainGUIDFilter QueryKey = "domain_guids"
 	// HostsFilter is a query param for listing objects by hostname
@@ -118,6 +124,10 @@ const (
 	// in descending order.
 	CreatedAtDescendingOrder = "-created_at"
 
+	// CreatedAtAscendingOrder is a query value for ordering by created_at timestamp,
+	// in ascending order.
+	CreatedAtAscendingOrder = "created_at"
+
 	// SourceGUID is the query parameter for getting an object. Currently it's used as a package GUID
 	// to retrieve a package to later copy it to an app (CopyPackage())
 	SourceGUID = "source_guid"
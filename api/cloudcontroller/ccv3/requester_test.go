@@ -198,14 +198,15 @@ var _ = Describe("shared request helpers", func() {
 					Expect(warnings).To(ConsistOf("this is a warning"))
 
 					expectedBuildpack := Buildpack{
-						GUID:     "some-bp-guid",
-						Name:     "some-buildpack",
-						Stack:    "some-stack",
-						Enabled:  types.NullBool{Value: true, IsSet: true},
-						Filename: "",
-						Locked:   types.NullBool{Value: false, IsSet: true},
-						State:    constant.BuildpackAwaitingUpload,
-						Position: types.NullInt{Value: 42, IsSet: true},
+						GUID:      "some-bp-guid",
+						Name:      "some-buildpack",
+						Stack:     "some-stack",
+						Enabled:   types.NullBool{Value: true, IsSet: true},
+						Filename:  "",
+						Locked:    types.NullBool{Value: false, IsSet: true},
+						State:     constant.BuildpackAwaitingUpload,
+						UpdatedAt: "2016-10-17T20:00:42Z",
+						Position:  types.NullInt{Value: 42, IsSet: true},
 						Links: APILinks{
 							"upload": APILink{
 								Method: "POST",
@@ -321,6 +321,47 @@ var _ = Describe("Service Instance", func() {
 		})
 	})
 
+	Describe("GetServiceInstanceCredentials", func() {
+		const guid = "fake-service-instance-guid"
+
+		BeforeEach(func() {
+			requester.MakeRequestCalls(func(params RequestParams) (JobURL, Warnings, error) {
+				Expect(json.Unmarshal([]byte(`{"username":"admin"}`), params.ResponseBody)).To(Succeed())
+				return "", Warnings{"one", "two"}, nil
+			})
+		})
+
+		It("makes the correct API request", func() {
+			_, _, err := client.GetServiceInstanceCredentials(guid)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(requester.MakeRequestCallCount()).To(Equal(1))
+			actualRequest := requester.MakeRequestArgsForCall(0)
+			Expect(actualRequest.RequestName).To(Equal(internal.GetServiceInstanceCredentialsRequest))
+			Expect(actualRequest.URIParams).To(Equal(internal.Params{"service_instance_guid": guid}))
+		})
+
+		It("returns the credentials", func() {
+			credentials, warnings, err := client.GetServiceInstanceCredentials(guid)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf("one", "two"))
+			Expect(credentials).To(Equal(types.JSONObject{"username": "admin"}))
+		})
+
+		When("there is an error getting the credentials", func() {
+			BeforeEach(func() {
+				requester.MakeRequestReturns("", Warnings{"one", "two"}, errors.New("boom"))
+			})
+
+			It("returns warnings and an error", func() {
+				credentials, warnings, err := client.GetServiceInstanceCredentials(guid)
+				Expect(err).To(MatchError("boom"))
+				Expect(warnings).To(ConsistOf("one", "two"))
+				Expect(credentials).To(BeEmpty())
+			})
+		})
+	})
+
 	Describe("CreateServiceInstance", func() {
 		Context("synchronous response", func() {
 			When("the request succeeds", func() {
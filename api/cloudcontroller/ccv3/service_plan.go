@@ -102,6 +102,14 @@ type ServiceOfferingWithPlans struct {
 	Description string
 	// ServiceBrokerName is the name of the service broker
 	ServiceBrokerName string
+	// DocumentationURL of the service offering
+	DocumentationURL string
+	// Tags are used by apps to identify service instances
+	Tags []string
+	// AllowsInstanceSharing if the offering support service instance sharing
+	AllowsInstanceSharing bool
+	// Bindable if service instances of the offering can be bound
+	Bindable bool
 
 	// List of service plans that this service offering provides
 	Plans []resources.ServicePlan
@@ -151,6 +159,10 @@ func (client *Client) GetServicePlansWithOfferings(query ...Query) ([]ServiceOff
 		offeringsWithPlans[i].Name = o.Name
 		offeringsWithPlans[i].Description = o.Description
 		offeringsWithPlans[i].ServiceBrokerName = brokerNameLookup[o.ServiceBrokerGUID]
+		offeringsWithPlans[i].DocumentationURL = o.DocumentationURL
+		offeringsWithPlans[i].Tags = o.Tags.Value
+		offeringsWithPlans[i].AllowsInstanceSharing = o.AllowsInstanceSharing
+		offeringsWithPlans[i].Bindable = o.Bindable
 	}
 
 	return offeringsWithPlans, warnings, nil
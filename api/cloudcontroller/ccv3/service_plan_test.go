@@ -1,6 +1,7 @@
 package ccv3_test
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/http"
 
@@ -596,6 +597,14 @@ var _ = Describe("Service Plan", func() {
 									"name": "service-offering-2",
 									"guid": "69d428b9-75b4-44db-addf-19c85c7f0f1e",
 									"description": "something about service offering 2",
+									"documentation_url": "https://docs.example.com",
+									"tags": ["db", "sql"],
+									"shareable": true,
+									"broker_catalog": {
+										"features": {
+											"bindable": true
+										}
+									},
 									"relationships": {
 										"service_broker": {
 											"data": {
@@ -718,10 +727,14 @@ var _ = Describe("Service Plan", func() {
 						},
 					},
 					{
-						GUID:              "69d428b9-75b4-44db-addf-19c85c7f0f1e",
-						Name:              "service-offering-2",
-						Description:       "something about service offering 2",
-						ServiceBrokerName: "service-broker-2",
+						GUID:                  "69d428b9-75b4-44db-addf-19c85c7f0f1e",
+						Name:                  "service-offering-2",
+						Description:           "something about service offering 2",
+						ServiceBrokerName:     "service-broker-2",
+						DocumentationURL:      "https://docs.example.com",
+						Tags:                  []string{"db", "sql"},
+						AllowsInstanceSharing: true,
+						Bindable:              true,
 						Plans: []resources.ServicePlan{
 							{
 								GUID:        "service-plan-2-guid",
@@ -741,6 +754,14 @@ var _ = Describe("Service Plan", func() {
 										Unit:     "MONTHLY",
 									},
 								},
+								BrokerCatalogMetadata: map[string]interface{}{
+									"costs": []interface{}{
+										map[string]interface{}{
+											"amount": map[string]interface{}{"usd": json.Number("649.0"), "gpb": json.Number("649.0")},
+											"unit":   "MONTHLY",
+										},
+									},
+								},
 								ServiceOfferingGUID: "69d428b9-75b4-44db-addf-19c85c7f0f1e",
 							},
 						},
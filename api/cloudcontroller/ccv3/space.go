@@ -43,6 +43,20 @@ func (client *Client) GetSpaces(query ...Query) ([]resources.Space, IncludedReso
 	return returnedResources, includedResources, warnings, err
 }
 
+// GetSpaceUsageSummary gets the usage of the space that counts toward its
+// quota.
+func (client *Client) GetSpaceUsageSummary(spaceGUID string) (resources.UsageSummary, Warnings, error) {
+	var responseBody resources.UsageSummary
+
+	_, warnings, err := client.MakeRequest(RequestParams{
+		RequestName:  internal.GetSpaceUsageSummaryRequest,
+		URIParams:    internal.Params{"space_guid": spaceGUID},
+		ResponseBody: &responseBody,
+	})
+
+	return responseBody, warnings, err
+}
+
 func (client *Client) UpdateSpace(space resources.Space) (resources.Space, Warnings, error) {
 	spaceGUID := space.GUID
 	space.GUID = ""
@@ -370,6 +370,83 @@ var _ = Describe("Spaces", func() {
 		})
 	})
 
+	Describe("GetSpaceUsageSummary", func() {
+		var (
+			summary    UsageSummary
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			summary, warnings, executeErr = client.GetSpaceUsageSummary("some-space-guid")
+		})
+
+		When("the cloud controller returns the summary", func() {
+			BeforeEach(func() {
+				response := `{
+					"usage_summary": {
+						"started_instances": 3,
+						"memory_in_mb": 1536,
+						"routes": 4,
+						"service_instances": 2,
+						"reserved_ports": 1,
+						"domains": 1,
+						"per_app_tasks": 5,
+						"service_keys": 6
+					}
+				}`
+
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/spaces/some-space-guid/usage_summary"),
+						RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the usage summary and all warnings", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(summary).To(Equal(UsageSummary{
+					StartedInstances: 3,
+					MemoryInMB:       1536,
+					Routes:           4,
+					ServiceInstances: 2,
+					ReservedPorts:    1,
+					Domains:          1,
+					PerAppTasks:      5,
+					ServiceKeys:      6,
+				}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+
+		When("the cloud controller returns an error", func() {
+			BeforeEach(func() {
+				response := `{
+					"errors": [
+						{
+							"code": 10010,
+							"detail": "Space not found",
+							"title": "CF-ResourceNotFound"
+						}
+					]
+				}`
+
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/spaces/some-space-guid/usage_summary"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError(ccerror.ResourceNotFoundError{Message: "Space not found"}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+	})
+
 	Describe("UpdateSpace", func() {
 		var (
 			spaceToUpdate Space
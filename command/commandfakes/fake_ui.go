@@ -11,6 +11,10 @@ import (
 )
 
 type FakeUI struct {
+	ClearScreenStub        func()
+	clearScreenMutex       sync.RWMutex
+	clearScreenArgsForCall []struct {
+	}
 	DeferTextStub        func(string, ...map[string]interface{})
 	deferTextMutex       sync.RWMutex
 	deferTextArgsForCall []struct {
@@ -319,6 +323,30 @@ type FakeUI struct {
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeUI) ClearScreen() {
+	fake.clearScreenMutex.Lock()
+	fake.clearScreenArgsForCall = append(fake.clearScreenArgsForCall, struct {
+	}{})
+	stub := fake.ClearScreenStub
+	fake.recordInvocation("ClearScreen", []interface{}{})
+	fake.clearScreenMutex.Unlock()
+	if stub != nil {
+		fake.ClearScreenStub()
+	}
+}
+
+func (fake *FakeUI) ClearScreenCallCount() int {
+	fake.clearScreenMutex.RLock()
+	defer fake.clearScreenMutex.RUnlock()
+	return len(fake.clearScreenArgsForCall)
+}
+
+func (fake *FakeUI) ClearScreenCalls(stub func()) {
+	fake.clearScreenMutex.Lock()
+	defer fake.clearScreenMutex.Unlock()
+	fake.ClearScreenStub = stub
+}
+
 func (fake *FakeUI) DeferText(arg1 string, arg2 ...map[string]interface{}) {
 	fake.deferTextMutex.Lock()
 	fake.deferTextArgsForCall = append(fake.deferTextArgsForCall, struct {
@@ -742,15 +770,16 @@ func (fake *FakeUI) DisplayJSON(arg1 string, arg2 interface{}) error {
 		arg1 string
 		arg2 interface{}
 	}{arg1, arg2})
+	stub := fake.DisplayJSONStub
+	fakeReturns := fake.displayJSONReturns
 	fake.recordInvocation("DisplayJSON", []interface{}{arg1, arg2})
 	fake.displayJSONMutex.Unlock()
-	if fake.DisplayJSONStub != nil {
-		return fake.DisplayJSONStub(arg1, arg2)
+	if stub != nil {
+		return stub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.displayJSONReturns
 	return fakeReturns.result1
 }
 
@@ -1956,6 +1985,8 @@ func (fake *FakeUI) WriterReturnsOnCall(i int, result1 io.Writer) {
 func (fake *FakeUI) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.clearScreenMutex.RLock()
+	defer fake.clearScreenMutex.RUnlock()
 	fake.deferTextMutex.RLock()
 	defer fake.deferTextMutex.RUnlock()
 	fake.displayBoolPromptMutex.RLock()
@@ -20,20 +20,28 @@ type commandList struct {
 	AddPluginRepo                      plugin.AddPluginRepoCommand                  `command:"add-plugin-repo" description:"Add a new plugin repository"`
 	AllowSpaceSSH                      v7.AllowSpaceSSHCommand                      `command:"allow-space-ssh" description:"Allow SSH access for the space"`
 	App                                v7.AppCommand                                `command:"app" description:"Display health and status for an app"`
+	AppFeatures                        v7.AppFeaturesCommand                        `command:"app-features" description:"List the features of an app and whether they are enabled"`
 	ApplyManifest                      v7.ApplyManifestCommand                      `command:"apply-manifest" description:"Apply manifest properties to a space"`
+	ApplyNetworkPolicies               v7.ApplyNetworkPoliciesCommand               `command:"apply-network-policies" description:"Add and remove network policies as listed in a policies file"`
+	ApplyRoles                         v7.ApplyRolesCommand                         `command:"apply-roles" description:"Add and remove org and space roles of users as listed in a roles file"`
 	Apps                               v7.AppsCommand                               `command:"apps" alias:"a" description:"List all apps in the target space"`
 	Auth                               v7.AuthCommand                               `command:"auth" description:"Authenticate non-interactively"`
 	BindRouteService                   v7.BindRouteServiceCommand                   `command:"bind-route-service" alias:"brs" description:"Bind a service instance to an HTTP route"`
 	BindRunningSecurityGroup           v7.BindRunningSecurityGroupCommand           `command:"bind-running-security-group" description:"Bind a security group to the list of security groups to be used for running applications"`
 	BindSecurityGroup                  v7.BindSecurityGroupCommand                  `command:"bind-security-group" description:"Bind a security group to a particular space, or all existing spaces of an org"`
 	BindService                        v7.BindServiceCommand                        `command:"bind-service" alias:"bs" description:"Bind a service instance to an app"`
+	BindServiceToApps                  v7.BindServiceToAppsCommand                  `command:"bind-service-to-apps" description:"Bind a service instance to several apps"`
 	BindStagingSecurityGroup           v7.BindStagingSecurityGroupCommand           `command:"bind-staging-security-group" description:"Bind a security group to the list of security groups to be used for staging applications globally"`
 	Buildpacks                         v7.BuildpacksCommand                         `command:"buildpacks" description:"List all buildpacks"`
+	BuildpackUsage                     v7.BuildpackUsageCommand                     `command:"buildpack-usage" description:"List the buildpacks used by the current droplets of apps"`
 	CancelDeployment                   v7.CancelDeploymentCommand                   `command:"cancel-deployment" description:"Cancel the most recent deployment for an app. Resets the current droplet to the previous deployment's droplet."`
+	ChangeStack                        v7.ChangeStackCommand                        `command:"change-stack" description:"Move an app to another stack and restage it, rolling back if staging or starting fails"`
+	CheckEgress                        v7.CheckEgressCommand                        `command:"check-egress" description:"Check whether security groups allow traffic from the targeted space to a destination"`
 	CheckRoute                         v7.CheckRouteCommand                         `command:"check-route" description:"Perform a check to determine whether a route currently exists or not"`
 	Config                             v7.ConfigCommand                             `command:"config" description:"Write default values to the config"`
 	ContinueDeployment                 v7.ContinueDeploymentCommand                 `command:"continue-deployment" description:"Continue the most recent deployment for an app."`
 	CopySource                         v7.CopySourceCommand                         `command:"copy-source" description:"Copies the source code of an application to another existing application and restages that application"`
+	Crashes                            v7.CrashesCommand                            `command:"crashes" description:"Summarize the recent crashes of an app with their causes and preceding logs"`
 	CreateApp                          v7.CreateAppCommand                          `command:"create-app" description:"Create an Application in the target space"`
 	CreateAppManifest                  v7.CreateAppManifestCommand                  `command:"create-app-manifest" description:"Create an app manifest for an app that has been pushed successfully"`
 	CreateBuildpack                    v7.CreateBuildpackCommand                    `command:"create-buildpack" description:"Create a buildpack"`
@@ -70,6 +78,7 @@ type commandList struct {
 	DeleteSpace                        v7.DeleteSpaceCommand                        `command:"delete-space" description:"Delete a space"`
 	DeleteSpaceQuota                   v7.DeleteSpaceQuotaCommand                   `command:"delete-space-quota" description:"Delete a space quota"`
 	DeleteUser                         v7.DeleteUserCommand                         `command:"delete-user" description:"Delete a user"`
+	DisableAppFeature                  v7.DisableAppFeatureCommand                  `command:"disable-app-feature" description:"Disable a feature of an app"`
 	DisableFeatureFlag                 v7.DisableFeatureFlagCommand                 `command:"disable-feature-flag" description:"Prevent use of a feature"`
 	DisableOrgIsolation                v7.DisableOrgIsolationCommand                `command:"disable-org-isolation" description:"Revoke an organization's entitlement to an isolation segment"`
 	DisableSSH                         v7.DisableSSHCommand                         `command:"disable-ssh" description:"Disable ssh for the application"`
@@ -78,17 +87,20 @@ type commandList struct {
 	Domains                            v7.DomainsCommand                            `command:"domains" description:"List domains in the target org"`
 	DownloadDroplet                    v7.DownloadDropletCommand                    `command:"download-droplet" description:"Download an application droplet"`
 	Droplets                           v7.DropletsCommand                           `command:"droplets" description:"List droplets of an app"`
+	EnableAppFeature                   v7.EnableAppFeatureCommand                   `command:"enable-app-feature" description:"Enable a feature of an app"`
 	EnableFeatureFlag                  v7.EnableFeatureFlagCommand                  `command:"enable-feature-flag" description:"Allow use of a feature"`
 	EnableOrgIsolation                 v7.EnableOrgIsolationCommand                 `command:"enable-org-isolation" description:"Entitle an organization to an isolation segment"`
 	EnableSSH                          v7.EnableSSHCommand                          `command:"enable-ssh" description:"Enable ssh for the application"`
 	EnableServiceAccess                v7.EnableServiceAccessCommand                `command:"enable-service-access" description:"Enable access to a service offering or service plan for one or all orgs"`
 	Env                                v7.EnvCommand                                `command:"env" alias:"e" description:"Show all env variables for an app"`
-	Events                             v7.EventsCommand                             `command:"events" description:"Show recent app events"`
+	Events                             v7.EventsCommand                             `command:"events" description:"Show recent app events, or search audit events in a space, org or foundation"`
+	ExportSpace                        v7.ExportSpaceCommand                        `command:"export-space" description:"Export the apps, routes, services, policies, roles and settings of a space to a file"`
 	FeatureFlag                        v7.FeatureFlagCommand                        `command:"feature-flag" description:"Retrieve an individual feature flag with status"`
 	FeatureFlags                       v7.FeatureFlagsCommand                       `command:"feature-flags" description:"Retrieve list of feature flags with status"`
 	GetHealthCheck                     v7.GetHealthCheckCommand                     `command:"get-health-check" description:"Show the type of health check performed on an app"`
 	GetReadinessHealthCheck            v7.GetReadinessHealthCheckCommand            `command:"get-readiness-health-check" description:"Show the type of readiness health check performed on an app"`
 	Help                               HelpCommand                                  `command:"help" alias:"h" description:"Show help"`
+	ImportSpace                        v7.ImportSpaceCommand                        `command:"import-space" description:"Bring a space in line with a file created by export-space"`
 	InstallPlugin                      InstallPluginCommand                         `command:"install-plugin" description:"Install CLI plugin"`
 	IsolationSegments                  v7.IsolationSegmentsCommand                  `command:"isolation-segments" description:"List all isolation segments"`
 	Labels                             v7.LabelsCommand                             `command:"labels" description:"List all labels (key-value pairs) for an API resource"`
@@ -111,6 +123,7 @@ type commandList struct {
 	PurgeServiceInstance               v7.PurgeServiceInstanceCommand               `command:"purge-service-instance" description:"Recursively remove a service instance and child objects from Cloud Foundry database without making requests to a service broker"`
 	PurgeServiceOffering               v7.PurgeServiceOfferingCommand               `command:"purge-service-offering" description:"Recursively remove a service offering and child objects from Cloud Foundry database without making requests to a service broker"`
 	Push                               v7.PushCommand                               `command:"push" alias:"p" description:"Push a new app or sync changes to an existing app"`
+	QuotaUsage                         v7.QuotaUsageCommand                         `command:"quota-usage" description:"Report the usage of org and space quotas"`
 	RemoveNetworkPolicy                v7.RemoveNetworkPolicyCommand                `command:"remove-network-policy" description:"Remove network traffic policy of an app"`
 	RemovePluginRepo                   plugin.RemovePluginRepoCommand               `command:"remove-plugin-repo" description:"Remove a plugin repository"`
 	Rename                             v7.RenameCommand                             `command:"rename" description:"Rename an app"`
@@ -122,14 +135,17 @@ type commandList struct {
 	ResetOrgDefaultIsolationSegment    v7.ResetOrgDefaultIsolationSegmentCommand    `command:"reset-org-default-isolation-segment" description:"Reset the default isolation segment used for apps in spaces of an org"`
 	ResetSpaceIsolationSegment         v7.ResetSpaceIsolationSegmentCommand         `command:"reset-space-isolation-segment" description:"Reset the space's isolation segment to the org default"`
 	Restage                            v7.RestageCommand                            `command:"restage" alias:"rg" description:"Stage the app's latest package into a droplet and restart the app with this new droplet and updated configuration (environment variables, service bindings, buildpack, stack, etc.)."`
+	RestageApps                        v7.RestageAppsCommand                        `command:"restage-apps" description:"Restage the apps using a buildpack, for example after it was updated"`
 	Revision                           v7.RevisionCommand                           `command:"revision" description:"Show details for a specific app revision"`
 	Revisions                          v7.RevisionsCommand                          `command:"revisions" description:"List revisions of an app"`
 	Rollback                           v7.RollbackCommand                           `command:"rollback" description:"Rollback to the specified revision of an app"`
 	StagePackage                       v7.StagePackageCommand                       `command:"stage-package" alias:"stage" description:"Stage a package into a droplet"`
 	Restart                            v7.RestartCommand                            `command:"restart" alias:"rs" description:"Stop all instances of the app, then start them again."`
 	RestartAppInstance                 v7.RestartAppInstanceCommand                 `command:"restart-app-instance" description:"Stop, then start application instance without updating application environment"`
+	RotateServiceKey                   v7.RotateServiceKeyCommand                   `command:"rotate-service-key" description:"Replace the credentials of a service key or of the app bindings of a service instance"`
 	RouterGroups                       v7.RouterGroupsCommand                       `command:"router-groups" description:"List router groups"`
 	Route                              v7.RouteCommand                              `command:"route" alias:"ro" description:"Display route details and mapped destinations"`
+	RouteServiceBindings               v7.RouteServiceBindingsCommand               `command:"route-service-bindings" description:"List route service bindings in the current organization or space"`
 	Routes                             v7.RoutesCommand                             `command:"routes" alias:"r" description:"List all routes in the current space or the current organization"`
 	RunTask                            v7.RunTaskCommand                            `command:"run-task" alias:"rt" description:"Run a one-off task on an app"`
 	RunningEnvironmentVariableGroup    v7.RunningEnvironmentVariableGroupCommand    `command:"running-environment-variable-group" alias:"revg" description:"Retrieve the contents of the running environment variable group"`
@@ -142,9 +158,11 @@ type commandList struct {
 	SecurityGroups                     v7.SecurityGroupsCommand                     `command:"security-groups" description:"List all security groups"`
 	Service                            v7.ServiceCommand                            `command:"service" description:"Show service instance info"`
 	ServiceAccess                      v7.ServiceAccessCommand                      `command:"service-access" description:"List service access settings"`
+	ServiceBrokerCatalog               v7.ServiceBrokerCatalogCommand               `command:"service-broker-catalog" description:"Show the catalog of a service broker as ingested by Cloud Foundry, or compare it with a local catalog"`
 	ServiceBrokers                     v7.ServiceBrokersCommand                     `command:"service-brokers" description:"List service brokers"`
 	ServiceKey                         v7.ServiceKeyCommand                         `command:"service-key" description:"Show service key info"`
 	ServiceKeys                        v7.ServiceKeysCommand                        `command:"service-keys" alias:"sk" description:"List keys for a service instance"`
+	ServicePlanSchema                  v7.ServicePlanSchemaCommand                  `command:"service-plan-schema" description:"Show the parameter schemas of a service plan"`
 	Services                           v7.ServicesCommand                           `command:"services" alias:"s" description:"List all service instances in the target space"`
 	SetDroplet                         v7.SetDropletCommand                         `command:"set-droplet" description:"Set the droplet used to run an app"`
 	SetEnv                             v7.SetEnvCommand                             `command:"set-env" alias:"se" description:"Set an env variable for an app"`
@@ -160,6 +178,7 @@ type commandList struct {
 	SetStagingEnvironmentVariableGroup v7.SetStagingEnvironmentVariableGroupCommand `command:"set-staging-environment-variable-group" alias:"ssevg" description:"Pass parameters as JSON to create a staging environment variable group"`
 	SharePrivateDomain                 v7.SharePrivateDomainCommand                 `command:"share-private-domain" description:"Share a private domain with a specific org"`
 	ShareService                       v7.ShareServiceCommand                       `command:"share-service" description:"Share a service instance with another space"`
+	SharedServices                     v7.SharedServicesCommand                     `command:"shared-services" description:"List service instances in the targeted org that are shared into other spaces"`
 	ShareRoute                         v7.ShareRouteCommand                         `command:"share-route" description:"Share a route in between spaces"`
 	Space                              v7.SpaceCommand                              `command:"space" description:"Show space info"`
 	SpaceQuota                         v7.SpaceQuotaCommand                         `command:"space-quota" description:"Show space quota info"`
@@ -168,13 +187,16 @@ type commandList struct {
 	SpaceUsers                         v7.SpaceUsersCommand                         `command:"space-users" description:"Show space users by role"`
 	Spaces                             v7.SpacesCommand                             `command:"spaces" description:"List all spaces in an org"`
 	Stack                              v7.StackCommand                              `command:"stack" description:"Show information for a stack (a stack is a pre-built file system, including an operating system, that can run apps)"`
+	StackMigrationReport               v7.StackMigrationReportCommand               `command:"stack-migration-report" description:"List the apps on a stack and whether their buildpacks are available on another stack"`
 	Stacks                             v7.StacksCommand                             `command:"stacks" description:"List all stacks (a stack is a pre-built file system, including an operating system, that can run apps)"`
 	StagingEnvironmentVariableGroup    v7.StagingEnvironmentVariableGroupCommand    `command:"staging-environment-variable-group" alias:"sevg" description:"Retrieve the contents of the staging environment variable group"`
 	StagingSecurityGroups              v7.StagingSecurityGroupsCommand              `command:"staging-security-groups" description:"List security groups globally configured for staging applications"`
+	StaleResources                     v7.StaleResourcesCommand                     `command:"stale-resources" description:"Report and optionally delete resources that are likely no longer needed"`
 	Start                              v7.StartCommand                              `command:"start" alias:"st" description:"Start an app"`
 	Stop                               v7.StopCommand                               `command:"stop" alias:"sp" description:"Stop an app"`
 	Target                             v7.TargetCommand                             `command:"target" alias:"t" description:"Set or view the targeted org or space"`
 	Task                               v7.TaskCommand                               `command:"task" description:"Display a task of an app"`
+	TaskLogs                           v7.TaskLogsCommand                           `command:"task-logs" description:"Display the logs of a task of an app"`
 	Tasks                              v7.TasksCommand                              `command:"tasks" description:"List tasks of an app"`
 	TerminateTask                      v7.TerminateTaskCommand                      `command:"terminate-task" description:"Terminate a running task of an app"`
 	MoveRoute                          v7.MoveRouteCommand                          `command:"move-route" description:"Assign a route to a different space"`
@@ -182,6 +204,7 @@ type commandList struct {
 	UnbindRunningSecurityGroup         v7.UnbindRunningSecurityGroupCommand         `command:"unbind-running-security-group" description:"Unbind a security group from the set of security groups for running applications globally"`
 	UnbindSecurityGroup                v7.UnbindSecurityGroupCommand                `command:"unbind-security-group" description:"Unbind a security group from a space"`
 	UnbindService                      v7.UnbindServiceCommand                      `command:"unbind-service" alias:"us" description:"Unbind a service instance from an app"`
+	UnbindServiceFromApps              v7.UnbindServiceFromAppsCommand              `command:"unbind-service-from-apps" description:"Unbind a service instance from several apps"`
 	UnbindStagingSecurityGroup         v7.UnbindStagingSecurityGroupCommand         `command:"unbind-staging-security-group" description:"Unbind a security group from the set of security groups for staging applications globally"`
 	UninstallPlugin                    plugin.UninstallPluginCommand                `command:"uninstall-plugin" description:"Uninstall CLI plugin"`
 	UnmapRoute                         v7.UnmapRouteCommand                         `command:"unmap-route" description:"Remove a route from an app"`
@@ -199,9 +222,12 @@ type commandList struct {
 	UpdateSecurityGroup                v7.UpdateSecurityGroupCommand                `command:"update-security-group" description:"Update a security group"`
 	UpdateService                      v7.UpdateServiceCommand                      `command:"update-service" description:"Update a service instance"`
 	UpgradeService                     v7.UpgradeServiceCommand                     `command:"upgrade-service" description:"Upgrade a service instance to the latest available version of its current service plan"`
+	UpgradeServices                    v7.UpgradeServicesCommand                    `command:"upgrade-services" description:"Upgrade all service instances in a space, org or offering that have an upgrade available"`
 	UpdateServiceBroker                v7.UpdateServiceBrokerCommand                `command:"update-service-broker" description:"Update a service broker"`
 	UpdateSpaceQuota                   v7.UpdateSpaceQuotaCommand                   `command:"update-space-quota" description:"Update an existing space quota"`
 	UpdateUserProvidedService          v7.UpdateUserProvidedServiceCommand          `command:"update-user-provided-service" alias:"uups" description:"Update user-provided service instance"`
+	UserRoles                          v7.UserRolesCommand                          `command:"user-roles" description:"List the org and space roles of a user in all orgs"`
+	WaitService                        v7.WaitServiceCommand                        `command:"wait-service" description:"Wait for the last operation of a service instance to complete"`
 	Version                            VersionCommand                               `command:"version" description:"Print the version"`
 }
 
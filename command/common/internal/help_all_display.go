@@ -15,28 +15,29 @@ var HelpCategoryList = []HelpCategory{
 			{"push", "scale", "delete", "rename"},
 			{"cancel-deployment", "continue-deployment"},
 			{"start", "stop", "restart", "stage-package", "restage", "restart-app-instance"},
-			{"run-task", "task", "tasks", "terminate-task"},
+			{"run-task", "task", "tasks", "task-logs", "terminate-task"},
 			{"packages", "create-package"},
 			{"revision", "revisions", "rollback"},
 			{"droplets", "set-droplet", "download-droplet"},
-			{"events", "logs"},
+			{"events", "logs", "crashes"},
 			{"env", "set-env", "unset-env"},
-			{"stacks", "stack"},
+			{"stacks", "stack", "stack-migration-report", "change-stack"},
 			{"copy-source", "create-app-manifest"},
 			{"get-health-check", "set-health-check", "get-readiness-health-check"},
 			{"enable-ssh", "disable-ssh", "ssh-enabled", "ssh"},
+			{"app-features", "enable-app-feature", "disable-app-feature"},
 		},
 	},
 	{
 		CategoryName: "SERVICES:",
 		CommandList: [][]string{
-			{"marketplace", "services", "service"},
-			{"create-service", "update-service", "upgrade-service", "delete-service", "rename-service"},
-			{"create-service-key", "service-keys", "service-key", "delete-service-key"},
-			{"bind-service", "unbind-service"},
-			{"bind-route-service", "unbind-route-service"},
+			{"marketplace", "service-plan-schema", "services", "service"},
+			{"create-service", "update-service", "upgrade-service", "upgrade-services", "delete-service", "rename-service", "wait-service"},
+			{"create-service-key", "service-keys", "service-key", "delete-service-key", "rotate-service-key"},
+			{"bind-service", "unbind-service", "bind-service-to-apps", "unbind-service-from-apps"},
+			{"bind-route-service", "unbind-route-service", "route-service-bindings"},
 			{"create-user-provided-service", "update-user-provided-service"},
-			{"share-service", "unshare-service"},
+			{"share-service", "unshare-service", "shared-services"},
 		},
 	},
 	{
@@ -51,6 +52,7 @@ var HelpCategoryList = []HelpCategory{
 		CommandList: [][]string{
 			{"spaces", "space"},
 			{"create-space", "delete-space", "rename-space", "apply-manifest"},
+			{"export-space", "import-space"},
 			{"allow-space-ssh", "disallow-space-ssh", "space-ssh-allowed"},
 		},
 	},
@@ -78,12 +80,14 @@ var HelpCategoryList = []HelpCategory{
 		CategoryName: "NETWORK POLICIES:",
 		CommandList: [][]string{
 			{"network-policies", "add-network-policy", "remove-network-policy"},
+			{"apply-network-policies"},
 		},
 	},
 	{
 		CategoryName: "BUILDPACKS:",
 		CommandList: [][]string{
 			{"buildpacks", "create-buildpack", "update-buildpack", "rename-buildpack", "delete-buildpack"},
+			{"buildpack-usage", "restage-apps"},
 		},
 	},
 	{
@@ -92,12 +96,13 @@ var HelpCategoryList = []HelpCategory{
 			{"create-user", "delete-user"},
 			{"org-users", "set-org-role", "unset-org-role"},
 			{"space-users", "set-space-role", "unset-space-role"},
+			{"apply-roles", "user-roles"},
 		},
 	},
 	{
 		CategoryName: "ORG ADMIN:",
 		CommandList: [][]string{
-			{"org-quotas", "org-quota", "set-org-quota"},
+			{"org-quotas", "org-quota", "set-org-quota", "quota-usage"},
 			{"create-org-quota", "delete-org-quota", "update-org-quota"},
 			{"share-private-domain", "unshare-private-domain"},
 		},
@@ -108,12 +113,13 @@ var HelpCategoryList = []HelpCategory{
 			{"space-quotas", "space-quota"},
 			{"create-space-quota", "update-space-quota", "delete-space-quota"},
 			{"set-space-quota", "unset-space-quota"},
+			{"stale-resources"},
 		},
 	},
 	{
 		CategoryName: "SERVICE ADMIN:",
 		CommandList: [][]string{
-			{"service-brokers", "create-service-broker", "update-service-broker", "delete-service-broker", "rename-service-broker"},
+			{"service-brokers", "service-broker-catalog", "create-service-broker", "update-service-broker", "delete-service-broker", "rename-service-broker"},
 			{"purge-service-offering", "purge-service-instance"},
 			{"service-access", "enable-service-access", "disable-service-access"},
 		},
@@ -124,6 +130,7 @@ var HelpCategoryList = []HelpCategory{
 			{"security-group", "security-groups", "create-security-group", "update-security-group", "delete-security-group", "bind-security-group", "unbind-security-group"},
 			{"bind-staging-security-group", "staging-security-groups", "unbind-staging-security-group"},
 			{"bind-running-security-group", "running-security-groups", "unbind-running-security-group"},
+			{"check-egress"},
 		},
 	},
 	{
@@ -17,6 +17,10 @@ type BuildpackName struct {
 	Buildpack string `positional-arg-name:"BUILDPACK" required:"true" description:"The buildpack"`
 }
 
+type OptionalBuildpackName struct {
+	Buildpack string `positional-arg-name:"BUILDPACK" description:"The buildpack"`
+}
+
 type CommandName struct {
 	CommandName string `positional-arg-name:"COMMAND_NAME" description:"The command name"`
 }
@@ -109,6 +113,11 @@ type CreateUser struct {
 	Password *string `positional-arg-name:"PASSWORD" description:"The password"`
 }
 
+type AppStack struct {
+	AppName   string `positional-arg-name:"APP_NAME" required:"true" description:"The application name"`
+	StackName string `positional-arg-name:"STACK_NAME" required:"true" description:"The stack name"`
+}
+
 type AppInstance struct {
 	AppName string `positional-arg-name:"APP_NAME" required:"true" description:"The application name"`
 	Index   int    `positional-arg-name:"INDEX" required:"true" description:"The index of the application instance"`
@@ -124,6 +133,11 @@ type ServiceInstanceKey struct {
 	ServiceKey      string `positional-arg-name:"SERVICE_KEY" required:"true" description:"The service key"`
 }
 
+type OptionalServiceInstanceKey struct {
+	ServiceInstance string `positional-arg-name:"SERVICE_INSTANCE" required:"true" description:"The service instance"`
+	ServiceKey      string `positional-arg-name:"SERVICE_KEY" description:"The service key"`
+}
+
 type AppDomain struct {
 	App    string `positional-arg-name:"APP_NAME" required:"true" description:"The application name"`
 	Domain string `positional-arg-name:"DOMAIN" required:"true" description:"The domain"`
@@ -155,6 +169,10 @@ type BindSecurityGroupV7Args struct {
 	OrganizationName  string `positional-arg-name:"ORG" required:"true" description:"The organization group name"`
 }
 
+type CheckEgressArgs struct {
+	Destination string `positional-arg-name:"DESTINATION" required:"true" description:"The IP address or CIDR to check"`
+}
+
 type UnbindSecurityGroupArgs struct {
 	SecurityGroupName string `positional-arg-name:"SECURITY_GROUP" required:"true" description:"The security group name"`
 	OrganizationName  string `positional-arg-name:"ORG" description:"The organization group name"`
@@ -198,6 +216,11 @@ type CreateServiceArgs struct {
 	ServiceInstance string `positional-arg-name:"SERVICE_INSTANCE" required:"true" description:"The service instance"`
 }
 
+type ServiceOfferingAndPlanArgs struct {
+	ServiceOffering string `positional-arg-name:"SERVICE_OFFERING" required:"true" description:"The service offering"`
+	ServicePlan     string `positional-arg-name:"SERVICE_PLAN" required:"true" description:"The service plan"`
+}
+
 type RenameServiceArgs struct {
 	ServiceInstance        string `positional-arg-name:"SERVICE_INSTANCE" required:"true" description:"The service instance to rename"`
 	NewServiceInstanceName string `positional-arg-name:"NEW_SERVICE_INSTANCE" required:"true" description:"The new name of the service instance"`
@@ -212,6 +235,11 @@ type BindServiceArgs struct {
 	ServiceInstanceName string `positional-arg-name:"SERVICE_INSTANCE" required:"true" description:"The service instance"`
 }
 
+type ServiceInstanceAndAppNames struct {
+	ServiceInstanceName string   `positional-arg-name:"SERVICE_INSTANCE" required:"true" description:"The service instance"`
+	AppNames            []string `positional-arg-name:"APP_NAME" description:"The application names"`
+}
+
 type RouteServiceArgs struct {
 	Domain          string `positional-arg-name:"DOMAIN" required:"true" description:"The domain of the route"`
 	ServiceInstance string `positional-arg-name:"SERVICE_INSTANCE" required:"true" description:"The service instance"`
@@ -409,6 +437,11 @@ type RemoveNetworkPolicyArgsV7 struct {
 	DestApp   string `positional-arg-name:"DESTINATION_APP" required:"true" description:"The destination app"`
 }
 
+type AppFeatureArgs struct {
+	AppName string `positional-arg-name:"APP_NAME" required:"true" description:"The application name"`
+	Feature string `positional-arg-name:"FEATURE_NAME" required:"true" description:"The app feature name"`
+}
+
 type TaskArgs struct {
 	AppName string `positional-arg-name:"APP_NAME" required:"true" description:"The application name"`
 	TaskID  int    `positional-arg-name:"TASK_ID" required:"true" description:"The Task ID for the application"`
@@ -0,0 +1,42 @@
+package flag
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+// Duration is a length of time given either as a Go duration (e.g. 90m,
+// 12h) or as a whole number of days (e.g. 30d).
+type Duration struct {
+	Value time.Duration
+	IsSet bool
+}
+
+func (d *Duration) UnmarshalFlag(rawValue string) error {
+	var (
+		value time.Duration
+		err   error
+	)
+
+	if days, ok := strings.CutSuffix(rawValue, "d"); ok {
+		var n int64
+		n, err = strconv.ParseInt(days, 10, 64)
+		value = time.Duration(n) * 24 * time.Hour
+	} else {
+		value, err = time.ParseDuration(rawValue)
+	}
+
+	if err != nil || value <= 0 {
+		return &flags.Error{
+			Type:    flags.ErrRequired,
+			Message: `Duration must be a positive length of time (e.g. 30m, 12h, 7d)`,
+		}
+	}
+
+	d.Value = value
+	d.IsSet = true
+	return nil
+}
@@ -0,0 +1,49 @@
+package flag_test
+
+import (
+	"time"
+
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "code.cloudfoundry.org/cli/command/flag"
+)
+
+var _ = Describe("Duration", func() {
+	var duration Duration
+
+	Describe("UnmarshalFlag", func() {
+		BeforeEach(func() {
+			duration = Duration{}
+		})
+
+		DescribeTable("valid durations",
+			func(input string, expected time.Duration) {
+				err := duration.UnmarshalFlag(input)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(duration.Value).To(Equal(expected))
+				Expect(duration.IsSet).To(BeTrue())
+			},
+			Entry("minutes", "90m", 90*time.Minute),
+			Entry("hours", "12h", 12*time.Hour),
+			Entry("days", "7d", 7*24*time.Hour),
+		)
+
+		DescribeTable("invalid durations",
+			func(input string) {
+				err := duration.UnmarshalFlag(input)
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrRequired,
+					Message: `Duration must be a positive length of time (e.g. 30m, 12h, 7d)`,
+				}))
+				Expect(duration.IsSet).To(BeFalse())
+			},
+			Entry("no unit", "12"),
+			Entry("garbage", "yesterday"),
+			Entry("garbage days", "xd"),
+			Entry("zero", "0d"),
+			Entry("negative", "-1h"),
+		)
+	})
+})
@@ -0,0 +1,45 @@
+package flag
+
+import (
+	"time"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+var timestampLayouts = []string{
+	time.RFC3339,
+	"2006-01-02T15:04:05",
+	"2006-01-02 15:04",
+	"2006-01-02",
+}
+
+// Timestamp is a point in time given either as a date or time (e.g.
+// 2024-03-05, 2024-03-05T14:00:00Z) or as a Duration that long ago (e.g.
+// 12h, 7d). Dates and times without a zone are in local time.
+type Timestamp struct {
+	Value time.Time
+	IsSet bool
+}
+
+func (t *Timestamp) UnmarshalFlag(rawValue string) error {
+	for _, layout := range timestampLayouts {
+		value, err := time.ParseInLocation(layout, rawValue, time.Local)
+		if err == nil {
+			t.Value = value
+			t.IsSet = true
+			return nil
+		}
+	}
+
+	var ago Duration
+	if err := ago.UnmarshalFlag(rawValue); err != nil {
+		return &flags.Error{
+			Type:    flags.ErrRequired,
+			Message: `Timestamp must be a date, a time, or a length of time ago (e.g. 2024-03-05, 2024-03-05T14:00:00Z, 12h, 7d)`,
+		}
+	}
+
+	t.Value = time.Now().Add(-ago.Value)
+	t.IsSet = true
+	return nil
+}
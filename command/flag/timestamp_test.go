@@ -0,0 +1,55 @@
+package flag_test
+
+import (
+	"time"
+
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "code.cloudfoundry.org/cli/command/flag"
+)
+
+var _ = Describe("Timestamp", func() {
+	var timestamp Timestamp
+
+	Describe("UnmarshalFlag", func() {
+		BeforeEach(func() {
+			timestamp = Timestamp{}
+		})
+
+		DescribeTable("absolute timestamps",
+			func(input string, expected time.Time) {
+				err := timestamp.UnmarshalFlag(input)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(timestamp.Value).To(BeTemporally("==", expected))
+				Expect(timestamp.IsSet).To(BeTrue())
+			},
+			Entry("RFC3339", "2024-03-05T14:00:00Z", time.Date(2024, 3, 5, 14, 0, 0, 0, time.UTC)),
+			Entry("time without zone", "2024-03-05T14:00:00", time.Date(2024, 3, 5, 14, 0, 0, 0, time.Local)),
+			Entry("date and minutes", "2024-03-05 14:30", time.Date(2024, 3, 5, 14, 30, 0, 0, time.Local)),
+			Entry("date", "2024-03-05", time.Date(2024, 3, 5, 0, 0, 0, 0, time.Local)),
+		)
+
+		It("accepts a length of time ago", func() {
+			err := timestamp.UnmarshalFlag("2d")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(timestamp.Value).To(BeTemporally("~", time.Now().Add(-48*time.Hour), time.Minute))
+			Expect(timestamp.IsSet).To(BeTrue())
+		})
+
+		DescribeTable("invalid timestamps",
+			func(input string) {
+				err := timestamp.UnmarshalFlag(input)
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrRequired,
+					Message: `Timestamp must be a date, a time, or a length of time ago (e.g. 2024-03-05, 2024-03-05T14:00:00Z, 12h, 7d)`,
+				}))
+				Expect(timestamp.IsSet).To(BeFalse())
+			},
+			Entry("garbage", "last tuesday"),
+			Entry("invalid date", "2024-13-45"),
+			Entry("negative duration", "-1h"),
+		)
+	})
+})
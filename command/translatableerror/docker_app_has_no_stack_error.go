@@ -0,0 +1,17 @@
+package translatableerror
+
+// DockerAppHasNoStackError is returned when changing the stack of a Docker
+// app, which does not run on a stack.
+type DockerAppHasNoStackError struct {
+	AppName string
+}
+
+func (DockerAppHasNoStackError) Error() string {
+	return "App '{{.AppName}}' is a Docker app and does not run on a stack."
+}
+
+func (e DockerAppHasNoStackError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"AppName": e.AppName,
+	})
+}
@@ -0,0 +1,17 @@
+package translatableerror
+
+type InvalidNetworkPoliciesFileError struct {
+	Path   string
+	Reason string
+}
+
+func (InvalidNetworkPoliciesFileError) Error() string {
+	return "Invalid network policies file {{.Path}}: {{.Reason}}"
+}
+
+func (e InvalidNetworkPoliciesFileError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Path":   e.Path,
+		"Reason": e.Reason,
+	})
+}
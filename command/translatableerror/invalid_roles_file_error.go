@@ -0,0 +1,17 @@
+package translatableerror
+
+type InvalidRolesFileError struct {
+	Path   string
+	Reason string
+}
+
+func (InvalidRolesFileError) Error() string {
+	return "Invalid roles file {{.Path}}: {{.Reason}}"
+}
+
+func (e InvalidRolesFileError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Path":   e.Path,
+		"Reason": e.Reason,
+	})
+}
@@ -0,0 +1,20 @@
+package translatableerror
+
+// ServiceBindingRotationUnsupportedError is returned when an app binding
+// cannot be rotated because the Cloud Controller does not allow the app to be
+// bound to the service instance a second time.
+type ServiceBindingRotationUnsupportedError struct {
+	AppName             string
+	ServiceInstanceName string
+}
+
+func (ServiceBindingRotationUnsupportedError) Error() string {
+	return "App '{{.AppName}}' cannot be bound to service instance '{{.ServiceInstanceName}}' a second time, so its binding cannot be rotated. The existing binding was not changed."
+}
+
+func (e ServiceBindingRotationUnsupportedError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"AppName":             e.AppName,
+		"ServiceInstanceName": e.ServiceInstanceName,
+	})
+}
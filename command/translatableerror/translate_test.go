@@ -48,6 +48,7 @@ var _ = Describe("Translatable Errors", func() {
 		Entry("CFNetworkingEndpointNotFoundError", CFNetworkingEndpointNotFoundError{}),
 		Entry("CommandLineArgsWithMultipleAppsError", CommandLineArgsWithMultipleAppsError{}),
 		Entry("CommandLineOptionsAndManifestConflictError", CommandLineOptionsAndManifestConflictError{}),
+		Entry("DockerAppHasNoStackError", DockerAppHasNoStackError{}),
 		Entry("DockerPasswordNotSetError", DockerPasswordNotSetError{}),
 		Entry("DownloadPluginHTTPError", DownloadPluginHTTPError{}),
 		Entry("EmptyDirectoryError", EmptyDirectoryError{}),
@@ -62,6 +63,7 @@ var _ = Describe("Translatable Errors", func() {
 		Entry("HTTPHealthCheckInvalidError", HTTPHealthCheckInvalidError{}),
 		Entry("HTTPStatusError", HTTPStatusError{Status: "some status"}),
 		Entry("InvalidChecksumError", InvalidChecksumError{}),
+		Entry("InvalidRolesFileError", InvalidRolesFileError{}),
 		Entry("InvalidRouteError", InvalidRouteError{}),
 		Entry("InvalidSSLCertError", InvalidSSLCertError{}),
 		Entry("IsolationSegmentNotFoundError", IsolationSegmentNotFoundError{}),
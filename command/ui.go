@@ -11,6 +11,7 @@ import (
 //
 //go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . UI
 type UI interface {
+	ClearScreen()
 	DeferText(template string, data ...map[string]interface{})
 	DisplayBoolPrompt(defaultResponse bool, template string, templateValues ...map[string]interface{}) (bool, error)
 	DisplayChangesForPush(changeSet []ui.Change) error
@@ -22,11 +22,14 @@ import (
 
 type Actor interface {
 	ApplyOrganizationQuotaByName(quotaName string, orgGUID string) (v7action.Warnings, error)
+	ApplyRoleChanges(changes []v7action.RoleChange) (v7action.Warnings, error)
+	ApplySpaceImport(plan v7action.SpaceImportPlan) (v7action.Warnings, error)
 	ApplySpaceQuotaByName(quotaName string, spaceGUID string, orgGUID string) (v7action.Warnings, error)
 	AssignIsolationSegmentToSpaceByNameAndSpace(isolationSegmentName string, spaceGUID string) (v7action.Warnings, error)
 	Authenticate(credentials map[string]string, origin string, grantType uaa.GrantType) error
 	BindSecurityGroupToSpaces(securityGroupGUID string, spaces []resources.Space, lifecycle constant.SecurityGroupLifecycle) (v7action.Warnings, error)
 	CancelDeployment(deploymentGUID string) (v7action.Warnings, error)
+	CheckSpaceEgress(spaceGUID string, check v7action.EgressCheck, lifecycles []constant.SecurityGroupLifecycle) ([]v7action.EgressCheckResult, v7action.Warnings, error)
 	ContinueDeployment(deploymentGUID string) (v7action.Warnings, error)
 	CheckRoute(domainName string, hostname string, path string, port int) (bool, v7action.Warnings, error)
 	ClearTarget()
@@ -49,6 +52,7 @@ type Actor interface {
 	CreateRouteBinding(params v7action.CreateRouteBindingParams) (chan v7action.PollJobEvent, v7action.Warnings, error)
 	CreateSecurityGroup(name, filePath string) (v7action.Warnings, error)
 	CreateServiceAppBinding(params v7action.CreateServiceAppBindingParams) (chan v7action.PollJobEvent, v7action.Warnings, error)
+	CreateServiceAppBindings(params v7action.CreateServiceAppBindingsParams) ([]v7action.ServiceAppBindingJob, v7action.Warnings, error)
 	CreateServiceBroker(model resources.ServiceBroker) (v7action.Warnings, error)
 	CreateServiceKey(params v7action.CreateServiceKeyParams) (chan v7action.PollJobEvent, v7action.Warnings, error)
 	CreateSharedDomain(domainName string, internal bool, routerGroupName string) (v7action.Warnings, error)
@@ -69,15 +73,20 @@ type Actor interface {
 	DeleteRouteBinding(params v7action.DeleteRouteBindingParams) (chan v7action.PollJobEvent, v7action.Warnings, error)
 	DeleteSecurityGroup(securityGroupName string) (v7action.Warnings, error)
 	DeleteServiceAppBinding(params v7action.DeleteServiceAppBindingParams) (chan v7action.PollJobEvent, v7action.Warnings, error)
+	DeleteServiceAppBindingByGUID(bindingGUID string) (chan v7action.PollJobEvent, v7action.Warnings, error)
+	DeleteServiceAppBindings(params v7action.DeleteServiceAppBindingsParams) ([]v7action.ServiceAppBindingJob, v7action.Warnings, error)
 	DeleteServiceBroker(serviceBrokerGUID string) (v7action.Warnings, error)
 	DeleteServiceInstance(serviceInstanceName, spaceGUID string) (chan v7action.PollJobEvent, v7action.Warnings, error)
 	DeleteServiceKeyByServiceInstanceAndName(serviceInstanceName, serviceKeyName, spaceGUID string) (chan v7action.PollJobEvent, v7action.Warnings, error)
 	DeleteSpaceByNameAndOrganizationName(spaceName string, orgName string) (v7action.Warnings, error)
 	DeleteSpaceQuotaByName(quotaName string, orgGUID string) (v7action.Warnings, error)
 	DeleteSpaceRole(roleType constant.RoleType, spaceGUID string, userNameOrGUID string, userOrigin string, isClient bool) (v7action.Warnings, error)
+	DeleteStaleResources(staleResources []v7action.StaleResource) (v7action.Warnings, error)
 	DeleteUser(userGuid string) (v7action.Warnings, error)
 	DeleteIsolationSegmentByName(name string) (v7action.Warnings, error)
 	DeleteIsolationSegmentOrganizationByName(isolationSegmentName string, orgName string) (v7action.Warnings, error)
+	DeleteUserRoles(roles []v7action.UserRole) (v7action.Warnings, error)
+	DiffServiceBrokerCatalog(catalog v7action.ServiceBrokerCatalog, localCatalogPath string) ([]v7action.ServiceBrokerCatalogDifference, error)
 	DiffSpaceManifest(spaceGUID string, rawManifest []byte) (resources.ManifestDiff, v7action.Warnings, error)
 	DisableFeatureFlag(flagName string) (v7action.Warnings, error)
 	DisableServiceAccess(offeringName, brokerName, orgName, planName string) (v7action.SkippedPlans, v7action.Warnings, error)
@@ -87,9 +96,11 @@ type Actor interface {
 	EnableServiceAccess(offeringName, brokerName, orgName, planName string) (v7action.SkippedPlans, v7action.Warnings, error)
 	EntitleIsolationSegmentToOrganizationByName(isolationSegmentName string, orgName string) (v7action.Warnings, error)
 	GetAppFeature(appGUID string, featureName string) (resources.ApplicationFeature, v7action.Warnings, error)
+	GetAppFeatures(appGUID string) ([]resources.ApplicationFeature, v7action.Warnings, error)
 	GetAppSummariesForSpace(spaceGUID string, labels string, omitStats bool) ([]v7action.ApplicationSummary, v7action.Warnings, error)
 	GetApplicationByNameAndSpace(appName string, spaceGUID string) (resources.Application, v7action.Warnings, error)
 	GetApplicationMapForRoute(route resources.Route) (map[string]resources.Application, v7action.Warnings, error)
+	GetApplicationCrashes(appGUID string, since time.Time, logLines int, client sharedaction.LogCacheClient) ([]v7action.AppCrash, v7action.Warnings, error)
 	GetApplicationDroplets(appName string, spaceGUID string) ([]resources.Droplet, v7action.Warnings, error)
 	GetApplicationLabels(appName string, spaceGUID string) (map[string]types.NullString, v7action.Warnings, error)
 	GetApplicationPackages(appName string, spaceGUID string) ([]resources.Package, v7action.Warnings, error)
@@ -98,9 +109,13 @@ type Actor interface {
 	GetApplicationRevisionsDeployed(appGUID string) ([]resources.Revision, v7action.Warnings, error)
 	GetApplicationRoutes(appGUID string) ([]resources.Route, v7action.Warnings, error)
 	GetApplicationTasks(appName string, sortOrder v7action.SortOrder) ([]resources.Task, v7action.Warnings, error)
+	GetApplicationTasksWithFilter(appGUID string, sortOrder v7action.SortOrder, filter v7action.TaskFilter) ([]resources.Task, v7action.Warnings, error)
 	GetApplicationsByNamesAndSpace(appNames []string, spaceGUID string) ([]resources.Application, v7action.Warnings, error)
+	GetApplicationsBySpaceAndLabelSelector(spaceGUID string, labelSelector string) ([]resources.Application, v7action.Warnings, error)
 	GetBuildpackLabels(buildpackName string, buildpackStack string) (map[string]types.NullString, v7action.Warnings, error)
+	GetBuildpackUsage(buildpackName string) ([]v7action.BuildpackUsage, v7action.Warnings, error)
 	GetBuildpacks(labelSelector string) ([]resources.Buildpack, v7action.Warnings, error)
+	GetCurrentDropletByApplication(appGUID string) (resources.Droplet, v7action.Warnings, error)
 	GetCurrentUser() (configv3.User, error)
 	GetDefaultDomain(orgGUID string) (resources.Domain, v7action.Warnings, error)
 	GetDetailedAppSummary(appName string, spaceGUID string, withObfuscatedValues bool) (v7action.DetailedApplicationSummary, v7action.Warnings, error)
@@ -111,6 +126,7 @@ type Actor interface {
 	GetEnvironmentVariableGroup(group constant.EnvironmentVariableGroupName) (v7action.EnvironmentVariableGroup, v7action.Warnings, error)
 	GetEnvironmentVariableGroupByRevision(revision resources.Revision) (v7action.EnvironmentVariableGroup, bool, v7action.Warnings, error)
 	GetEnvironmentVariablesByApplicationNameAndSpace(appName string, spaceGUID string) (v7action.EnvironmentVariableGroups, v7action.Warnings, error)
+	GetEvents(filter v7action.EventFilter) ([]v7action.Event, v7action.Warnings, error)
 	GetFeatureFlagByName(featureFlagName string) (resources.FeatureFlag, v7action.Warnings, error)
 	GetFeatureFlags() ([]resources.FeatureFlag, v7action.Warnings, error)
 	GetGlobalRunningSecurityGroups() ([]resources.SecurityGroup, v7action.Warnings, error)
@@ -133,12 +149,18 @@ type Actor interface {
 	GetOrganizationSummaryByName(orgName string) (v7action.OrganizationSummary, v7action.Warnings, error)
 	GetOrganizations(labelSelector string) ([]resources.Organization, v7action.Warnings, error)
 	GetProcessByTypeAndApplication(processType string, appGUID string) (resources.Process, v7action.Warnings, error)
+	GetProcessInstances(processGUID string) ([]v7action.ProcessInstance, v7action.Warnings, error)
+	GetQuotaUsage(orgName string, includeSpaces bool) ([]v7action.QuotaUsage, v7action.Warnings, error)
 	GetRawApplicationManifestByNameAndSpace(appName string, spaceGUID string) ([]byte, v7action.Warnings, error)
 	GetRecentEventsByApplicationNameAndSpace(appName string, spaceGUID string) ([]v7action.Event, v7action.Warnings, error)
 	GetRecentLogsForApplicationByNameAndSpace(appName string, spaceGUID string, client sharedaction.LogCacheClient) ([]sharedaction.LogMessage, v7action.Warnings, error)
+	GetRecentLogsForTask(appGUID string, task resources.Task, client sharedaction.LogCacheClient) ([]sharedaction.LogMessage, error)
+	GetRoleChanges(desired []v7action.RoleAssignment, prune bool) ([]v7action.RoleChange, v7action.Warnings, error)
 	GetRootResponse() (v7action.Root, v7action.Warnings, error)
 	GetRevisionByApplicationAndVersion(appGUID string, revisionVersion int) (resources.Revision, v7action.Warnings, error)
 	GetRevisionsByApplicationNameAndSpace(appName string, spaceGUID string) ([]resources.Revision, v7action.Warnings, error)
+	GetRouteBindingSummaries(params v7action.GetRouteBindingSummariesParams) ([]v7action.RouteBindingSummary, v7action.Warnings, error)
+	GetRouteBindingSummaryForRoute(route resources.Route) (v7action.RouteBindingSummary, v7action.Warnings, error)
 	GetRouteByAttributes(domain resources.Domain, hostname string, path string, port int) (resources.Route, v7action.Warnings, error)
 	GetRouteDestinationByAppGUID(route resources.Route, appGUID string) (resources.RouteDestination, error)
 	GetRouteLabels(routeName string, spaceGUID string) (map[string]types.NullString, v7action.Warnings, error)
@@ -155,20 +177,25 @@ type Actor interface {
 	GetSecurityGroups() ([]v7action.SecurityGroupSummary, v7action.Warnings, error)
 	GetServiceAccess(offeringName, brokerName, orgName string) ([]v7action.ServicePlanAccess, v7action.Warnings, error)
 	GetServiceBrokerByName(serviceBrokerName string) (resources.ServiceBroker, v7action.Warnings, error)
+	GetServiceBrokerCatalog(serviceBrokerName string) (v7action.ServiceBrokerCatalog, v7action.Warnings, error)
 	GetServiceBrokerLabels(serviceBrokerName string) (map[string]types.NullString, v7action.Warnings, error)
 	GetServiceBrokers() ([]resources.ServiceBroker, v7action.Warnings, error)
+	GetServiceAppBindingsByServiceInstance(serviceInstanceName, spaceGUID string) ([]resources.ServiceCredentialBinding, v7action.Warnings, error)
 	GetServiceKeyByServiceInstanceAndName(serviceInstanceName, serviceKeyName, spaceGUID string) (resources.ServiceCredentialBinding, v7action.Warnings, error)
 	GetServiceKeyDetailsByServiceInstanceAndName(serviceInstanceName, serviceKeyName, spaceGUID string) (resources.ServiceCredentialBindingDetails, v7action.Warnings, error)
 	GetServiceInstanceByNameAndSpace(serviceInstanceName, spaceGUID string) (resources.ServiceInstance, v7action.Warnings, error)
 	GetServiceInstanceDetails(serviceInstanceName, spaceGUID string, omitApps bool) (v7action.ServiceInstanceDetails, v7action.Warnings, error)
 	GetServiceInstanceParameters(serviceInstanceName, spaceGUID string) (v7action.ServiceInstanceParameters, v7action.Warnings, error)
 	GetServiceInstanceLabels(serviceInstanceName, spaceGUID string) (map[string]types.NullString, v7action.Warnings, error)
+	GetServiceInstanceUpgradeCandidates(filter v7action.ServiceInstanceUpgradeFilter) ([]v7action.ServiceInstanceUpgradeCandidate, v7action.Warnings, error)
 	GetServiceInstancesForSpace(spaceGUID string, omitApps bool) ([]v7action.ServiceInstance, v7action.Warnings, error)
 	GetServiceKeysByServiceInstance(serviceInstanceName, spaceGUID string) ([]resources.ServiceCredentialBinding, v7action.Warnings, error)
 	GetServiceOfferingLabels(serviceOfferingName, serviceBrokerName string) (map[string]types.NullString, v7action.Warnings, error)
 	GetServicePlanLabels(servicePlanName, serviceOfferingName, serviceBrokerName string) (map[string]types.NullString, v7action.Warnings, error)
 	GetServicePlanByNameOfferingAndBroker(servicePlanName, serviceOfferingName, serviceBrokerName string) (resources.ServicePlan, v7action.Warnings, error)
+	GetSharedServiceInstanceSummaries(orgGUID string) ([]v7action.SharedServiceInstanceSummary, v7action.Warnings, error)
 	GetSpaceByNameAndOrganization(spaceName string, orgGUID string) (resources.Space, v7action.Warnings, error)
+	GetSpaceExport(spaceGUID string, orgName string, includeCredentials bool, networking v7action.SpaceExportNetworkingActor) (v7action.SpaceExport, v7action.Warnings, error)
 	GetSpaceFeature(spaceName string, orgGUID string, feature string) (bool, v7action.Warnings, error)
 	GetSpaceLabels(spaceName string, orgGUID string) (map[string]types.NullString, v7action.Warnings, error)
 	GetSpaceQuotaByName(spaceQuotaName string, orgGUID string) (resources.SpaceQuota, v7action.Warnings, error)
@@ -177,19 +204,25 @@ type Actor interface {
 	GetSpaceUsersByRoleType(spaceGuid string) (map[constant.RoleType][]resources.User, v7action.Warnings, error)
 	GetStackByName(stackName string) (resources.Stack, v7action.Warnings, error)
 	GetStackLabels(stackName string) (map[string]types.NullString, v7action.Warnings, error)
+	GetStackMigrationReport(fromStack string, toStack string) ([]v7action.StackMigrationApp, v7action.Warnings, error)
 	GetStacks(string) ([]resources.Stack, v7action.Warnings, error)
+	GetStaleResources(orgGUID string, spaceGUID string, olderThan time.Time) ([]v7action.StaleResource, v7action.Warnings, error)
 	GetStreamingLogsForApplicationByNameAndSpace(appName string, spaceGUID string, client sharedaction.LogCacheClient) (<-chan sharedaction.LogMessage, <-chan error, context.CancelFunc, v7action.Warnings, error)
+	GetStreamingLogsForTask(appGUID string, task resources.Task, client sharedaction.LogCacheClient) (<-chan sharedaction.LogMessage, <-chan error, context.CancelFunc, error)
 	GetTaskBySequenceIDAndApplication(sequenceID int, appGUID string) (resources.Task, v7action.Warnings, error)
 	GetUAAAPIVersion() (string, error)
 	GetUnstagedNewestPackageGUID(appGuid string) (string, v7action.Warnings, error)
 	GetUser(username, origin string) (resources.User, error)
+	GetUserRoles(username string, origin string) (resources.User, []v7action.UserRole, v7action.Warnings, error)
 	MakeCurlRequest(httpMethod string, path string, customHeaders []string, httpData string, failOnHTTPError bool) ([]byte, *http.Response, error)
 	MapRoute(routeGUID string, appGUID string, destinationProtocol string) (v7action.Warnings, error)
 	Marketplace(filter v7action.MarketplaceFilter) ([]v7action.ServiceOfferingWithPlans, v7action.Warnings, error)
 	MoveRoute(routeGUID string, spaceGUID string) (v7action.Warnings, error)
 	ParseAccessToken(accessToken string) (jwt.JWT, error)
+	PlanSpaceImport(export v7action.SpaceExport, spaceGUID string, orgGUID string, networking v7action.SpaceImportNetworkingActor) (v7action.SpaceImportPlan, v7action.Warnings, error)
 	PollBuild(buildGUID string, appName string) (resources.Droplet, v7action.Warnings, error)
 	PollPackage(pkg resources.Package) (resources.Package, v7action.Warnings, error)
+	PollServiceInstanceLastOperation(serviceInstanceName, spaceGUID string, timeout time.Duration, handleLastOperation func(resources.LastOperation)) (v7action.Warnings, error)
 	PollStart(app resources.Application, noWait bool, handleProcessStats func(string)) (v7action.Warnings, error)
 	PollStartForDeployment(app resources.Application, deploymentGUID string, noWait bool, handleProcessStats func(string)) (v7action.Warnings, error)
 	PollTask(task resources.Task) (resources.Task, v7action.Warnings, error)
@@ -222,6 +255,7 @@ type Actor interface {
 	SharePrivateDomain(domainName string, orgName string) (v7action.Warnings, error)
 	ShareServiceInstanceToSpaceAndOrg(serviceInstanceName, targetedSpaceGUID, targetedOrgGUID string, sharedToDetails v7action.ServiceInstanceSharingParams) (v7action.Warnings, error)
 	ShareRoute(routeGUID string, spaceGUID string) (v7action.Warnings, error)
+	ShareServiceInstanceToSpaces(serviceInstanceName, targetedSpaceGUID, targetedOrgGUID string, sharedToDetails v7action.BulkServiceInstanceSharingParams) ([]resources.Space, v7action.Warnings, error)
 	StageApplicationPackage(pkgGUID string) (resources.Build, v7action.Warnings, error)
 	StagePackage(packageGUID, appName, spaceGUID string) (<-chan resources.Droplet, <-chan v7action.Warnings, <-chan error)
 	StartApplication(appGUID string) (v7action.Warnings, error)
@@ -233,6 +267,7 @@ type Actor interface {
 	UnsetSpaceQuota(spaceQuotaName, spaceName, orgGUID string) (v7action.Warnings, error)
 	UnsharePrivateDomain(domainName string, orgName string) (v7action.Warnings, error)
 	UnshareRoute(routeGUID string, spaceGUID string) (v7action.Warnings, error)
+	UnshareServiceInstanceFromAllSpaces(serviceInstanceName, targetedSpaceGUID string) ([]ccv3.SpaceWithOrganization, v7action.Warnings, error)
 	UnshareServiceInstanceFromSpaceAndOrg(serviceInstanceName, targetedSpaceGUID, targetedOrgGUID string, unshareFromDetails v7action.ServiceInstanceSharingParams) (v7action.Warnings, error)
 	UpdateAppFeature(app resources.Application, enabled bool, featureName string) (v7action.Warnings, error)
 	UpdateApplication(app resources.Application) (resources.Application, v7action.Warnings, error)
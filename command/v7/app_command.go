@@ -1,21 +1,41 @@
 package v7
 
 import (
+	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
 	"code.cloudfoundry.org/cli/command/v7/shared"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
 )
 
+const defaultAppWatchInterval = 5 * time.Second
+
 type AppCommand struct {
 	BaseCommand
 
-	RequiredArgs    flag.AppName `positional-args:"yes"`
-	GUID            bool         `long:"guid" description:"Retrieve and display the given app's guid.  All other health and status output for the app is suppressed."`
-	usage           interface{}  `usage:"CF_NAME app APP_NAME [--guid]"`
-	relatedCommands interface{}  `related_commands:"apps, events, logs, map-route, unmap-route, push"`
+	RequiredArgs    flag.AppName  `positional-args:"yes"`
+	GUID            bool          `long:"guid" description:"Retrieve and display the given app's guid.  All other health and status output for the app is suppressed."`
+	Watch           bool          `long:"watch" description:"Continuously refresh the app's instance stats until interrupted"`
+	Interval        flag.Duration `long:"interval" description:"Time between refreshes when using --watch (e.g. 10s, 1m). Default: 5s"`
+	usage           interface{}   `usage:"CF_NAME app APP_NAME [--guid | --watch [--interval INTERVAL]]"`
+	relatedCommands interface{}   `related_commands:"apps, events, logs, map-route, unmap-route, push"`
 }
 
 func (cmd AppCommand) Execute(args []string) error {
-	err := cmd.SharedActor.CheckTarget(true, true)
+	err := cmd.validateFlags()
+	if err != nil {
+		return err
+	}
+
+	err = cmd.SharedActor.CheckTarget(true, true)
 	if err != nil {
 		return err
 	}
@@ -29,6 +49,10 @@ func (cmd AppCommand) Execute(args []string) error {
 		return err
 	}
 
+	if cmd.Watch {
+		return cmd.watchApp(user)
+	}
+
 	cmd.UI.DisplayTextWithFlavor("Showing health and status for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
 		"AppName":   cmd.RequiredArgs.AppName,
 		"OrgName":   cmd.Config.TargetedOrganization().Name,
@@ -48,6 +72,23 @@ func (cmd AppCommand) Execute(args []string) error {
 	return nil
 }
 
+func (cmd AppCommand) validateFlags() error {
+	if cmd.GUID && cmd.Watch {
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{"--guid", "--watch"},
+		}
+	}
+
+	if cmd.Interval.IsSet && !cmd.Watch {
+		return translatableerror.RequiredFlagsError{
+			Arg1: "--interval",
+			Arg2: "--watch",
+		}
+	}
+
+	return nil
+}
+
 func (cmd AppCommand) displayAppGUID() error {
 	app, warnings, err := cmd.Actor.GetApplicationByNameAndSpace(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID)
 	cmd.UI.DisplayWarnings(warnings)
@@ -58,3 +99,201 @@ func (cmd AppCommand) displayAppGUID() error {
 	cmd.UI.DisplayText(app.GUID)
 	return nil
 }
+
+// instanceChange describes how a single instance differs between two
+// refreshes of the watch dashboard.
+type instanceChange struct {
+	ProcessType string
+	Index       int64
+	From        constant.ProcessInstanceState
+	To          constant.ProcessInstanceState
+	Restarted   bool
+}
+
+func (change instanceChange) crashed() bool {
+	return change.To == constant.ProcessInstanceCrashed || change.Restarted
+}
+
+func (cmd AppCommand) watchApp(user configv3.User) error {
+	interval := defaultAppWatchInterval
+	if cmd.Interval.IsSet {
+		interval = cmd.Interval.Value
+	}
+
+	summary, warnings, err := cmd.Actor.GetDetailedAppSummary(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID, false)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	interrupt := make(chan os.Signal, 1)
+	signal.Notify(interrupt, os.Interrupt)
+	defer signal.Stop(interrupt)
+
+	var (
+		previous     v7action.ProcessSummaries
+		totalCrashes int
+	)
+	current := summary.ProcessSummaries
+
+	for {
+		changes := instanceChanges(previous, current)
+		for _, change := range changes {
+			if change.crashed() {
+				totalCrashes++
+			}
+		}
+
+		cmd.displayWatchFrame(user, summary, current, changes, totalCrashes, interval)
+
+		select {
+		case <-interrupt:
+			return nil
+		case <-time.After(interval):
+		}
+
+		previous = current
+		current, err = cmd.refreshProcessInstances(previous)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func (cmd AppCommand) refreshProcessInstances(processes v7action.ProcessSummaries) (v7action.ProcessSummaries, error) {
+	var refreshed v7action.ProcessSummaries
+	for _, process := range processes {
+		instances, warnings, err := cmd.Actor.GetProcessInstances(process.GUID)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return nil, err
+		}
+
+		process.InstanceDetails = instances
+		refreshed = append(refreshed, process)
+	}
+
+	return refreshed, nil
+}
+
+func (cmd AppCommand) displayWatchFrame(user configv3.User, summary v7action.DetailedApplicationSummary, processes v7action.ProcessSummaries, changes []instanceChange, totalCrashes int, interval time.Duration) {
+	cmd.UI.ClearScreen()
+	cmd.UI.DisplayTextWithFlavor("Watching app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+		"AppName":   cmd.RequiredArgs.AppName,
+		"OrgName":   cmd.Config.TargetedOrganization().Name,
+		"SpaceName": cmd.Config.TargetedSpace().Name,
+		"Username":  user.Name,
+	})
+	cmd.UI.DisplayText("Refreshing every {{.Interval}}. Press Ctrl-C to stop.", map[string]interface{}{
+		"Interval": interval,
+	})
+	cmd.UI.DisplayNewline()
+
+	cmd.UI.DisplayKeyValueTable("", [][]string{
+		{cmd.UI.TranslateText("name:"), summary.Name},
+		{cmd.UI.TranslateText("requested state:"), strings.ToLower(string(summary.State))},
+		{cmd.UI.TranslateText("last refresh:"), time.Now().UTC().Format(time.RFC3339)},
+		{cmd.UI.TranslateText("crashes observed:"), fmt.Sprint(totalCrashes)},
+	}, ui.DefaultTableSpacePadding)
+
+	appSummaryDisplayer := shared.NewAppSummaryDisplayer(cmd.UI)
+	for _, process := range processes {
+		cmd.UI.DisplayNewline()
+		cmd.UI.DisplayKeyValueTable("", [][]string{
+			{cmd.UI.TranslateText("type:"), process.Type},
+			{cmd.UI.TranslateText("instances:"), fmt.Sprintf("%d/%d", process.HealthyInstanceCount(), process.TotalInstanceCount())},
+		}, ui.DefaultTableSpacePadding)
+		appSummaryDisplayer.ProcessInstancesDisplay(process)
+	}
+
+	if len(changes) == 0 {
+		return
+	}
+
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayText("Changes since last refresh:")
+	for _, change := range changes {
+		var line string
+		switch {
+		case change.Restarted:
+			line = cmd.UI.TranslateText("{{.Type}} #{{.Index}}: restarted", map[string]interface{}{
+				"Type":  change.ProcessType,
+				"Index": change.Index,
+			})
+		case change.From == "":
+			line = cmd.UI.TranslateText("{{.Type}} #{{.Index}}: added ({{.To}})", map[string]interface{}{
+				"Type":  change.ProcessType,
+				"Index": change.Index,
+				"To":    strings.ToLower(string(change.To)),
+			})
+		case change.To == "":
+			line = cmd.UI.TranslateText("{{.Type}} #{{.Index}}: removed", map[string]interface{}{
+				"Type":  change.ProcessType,
+				"Index": change.Index,
+			})
+		default:
+			line = cmd.UI.TranslateText("{{.Type}} #{{.Index}}: {{.From}} -> {{.To}}", map[string]interface{}{
+				"Type":  change.ProcessType,
+				"Index": change.Index,
+				"From":  strings.ToLower(string(change.From)),
+				"To":    strings.ToLower(string(change.To)),
+			})
+		}
+
+		if change.crashed() {
+			cmd.UI.DisplayTextWithBold("   " + line)
+		} else {
+			cmd.UI.DisplayText("   " + line)
+		}
+	}
+}
+
+// instanceChanges compares the instances of each process between two
+// refreshes. An instance whose uptime went down while staying in the same
+// state is reported as restarted, as it crashed and came back up in between.
+func instanceChanges(previous v7action.ProcessSummaries, current v7action.ProcessSummaries) []instanceChange {
+	if previous == nil {
+		return nil
+	}
+
+	previousInstances := map[string]map[int64]v7action.ProcessInstance{}
+	for _, process := range previous {
+		previousInstances[process.GUID] = map[int64]v7action.ProcessInstance{}
+		for _, instance := range process.InstanceDetails {
+			previousInstances[process.GUID][instance.Index] = instance
+		}
+	}
+
+	var changes []instanceChange
+	for _, process := range current {
+		before := previousInstances[process.GUID]
+		seen := map[int64]bool{}
+
+		for _, instance := range process.InstanceDetails {
+			seen[instance.Index] = true
+			old, existed := before[instance.Index]
+
+			switch {
+			case !existed:
+				changes = append(changes, instanceChange{ProcessType: process.Type, Index: instance.Index, To: instance.State})
+			case old.State != instance.State:
+				changes = append(changes, instanceChange{ProcessType: process.Type, Index: instance.Index, From: old.State, To: instance.State})
+			case instance.State == constant.ProcessInstanceRunning && instance.Uptime < old.Uptime:
+				changes = append(changes, instanceChange{ProcessType: process.Type, Index: instance.Index, From: old.State, To: instance.State, Restarted: true})
+			}
+		}
+
+		for _, old := range previous {
+			if old.GUID != process.GUID {
+				continue
+			}
+			for _, instance := range old.InstanceDetails {
+				if !seen[instance.Index] {
+					changes = append(changes, instanceChange{ProcessType: process.Type, Index: instance.Index, From: instance.State})
+				}
+			}
+		}
+	}
+
+	return changes
+}
@@ -2,12 +2,14 @@ package v7_test
 
 import (
 	"errors"
+	"time"
 
 	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/actor/v7action"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
 	"code.cloudfoundry.org/cli/command/commandfakes"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
 	v7 "code.cloudfoundry.org/cli/command/v7"
 	"code.cloudfoundry.org/cli/command/v7/v7fakes"
 	"code.cloudfoundry.org/cli/resources"
@@ -238,4 +240,106 @@ var _ = Describe("app Command", func() {
 			})
 		})
 	})
+
+	When("--guid and --watch are both provided", func() {
+		BeforeEach(func() {
+			cmd.GUID = true
+			cmd.Watch = true
+		})
+
+		It("returns an argument combination error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{
+				Args: []string{"--guid", "--watch"},
+			}))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+		})
+	})
+
+	When("--interval is provided without --watch", func() {
+		BeforeEach(func() {
+			cmd.Interval = flag.Duration{Value: time.Second, IsSet: true}
+		})
+
+		It("returns a required flags error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.RequiredFlagsError{
+				Arg1: "--interval",
+				Arg2: "--watch",
+			}))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+		})
+	})
+
+	When("--watch is provided", func() {
+		BeforeEach(func() {
+			cmd.Watch = true
+			cmd.Interval = flag.Duration{Value: time.Millisecond, IsSet: true}
+
+			fakeActor.GetDetailedAppSummaryReturns(v7action.DetailedApplicationSummary{
+				ApplicationSummary: v7action.ApplicationSummary{
+					Application: resources.Application{
+						Name:  "some-app",
+						State: constant.ApplicationStarted,
+					},
+					ProcessSummaries: v7action.ProcessSummaries{
+						{
+							Process: resources.Process{
+								GUID:      "web-process-guid",
+								Type:      constant.ProcessTypeWeb,
+								Instances: types.NullInt{Value: 2, IsSet: true},
+							},
+							InstanceDetails: []v7action.ProcessInstance{
+								{Index: 0, State: constant.ProcessInstanceRunning, Uptime: 100 * time.Second},
+								{Index: 1, State: constant.ProcessInstanceRunning, Uptime: 100 * time.Second},
+							},
+						},
+					},
+				},
+			}, v7action.Warnings{"summary-warning"}, nil)
+
+			fakeActor.GetProcessInstancesReturnsOnCall(0, []v7action.ProcessInstance{
+				{Index: 0, State: constant.ProcessInstanceRunning, Uptime: 5 * time.Second},
+				{Index: 1, State: constant.ProcessInstanceCrashed},
+				{Index: 2, State: constant.ProcessInstanceStarting},
+			}, v7action.Warnings{"instances-warning"}, nil)
+			fakeActor.GetProcessInstancesReturnsOnCall(1, nil, nil, errors.New("refresh-error"))
+		})
+
+		It("refreshes the instances of each process until an error occurs", func() {
+			Expect(executeErr).To(MatchError("refresh-error"))
+
+			Expect(testUI.Out).To(Say(`Watching app some-app in org some-org / space some-space as steve\.\.\.`))
+			Expect(testUI.Out).To(Say(`Refreshing every 1ms\. Press Ctrl-C to stop\.`))
+			Expect(testUI.Out).To(Say(`crashes observed:\s+0`))
+			Expect(testUI.Out).To(Say(`type:\s+web`))
+			Expect(testUI.Out).To(Say(`instances:\s+2/2`))
+			Expect(testUI.Out).To(Say(`#0\s+running`))
+			Expect(testUI.Out).To(Say(`#1\s+running`))
+
+			Expect(testUI.Out).To(Say(`crashes observed:\s+2`))
+			Expect(testUI.Out).To(Say(`instances:\s+1/3`))
+			Expect(testUI.Out).To(Say(`#1\s+crashed`))
+			Expect(testUI.Out).To(Say("Changes since last refresh:"))
+			Expect(testUI.Out).To(Say(`web #0: restarted`))
+			Expect(testUI.Out).To(Say(`web #1: running -> crashed`))
+			Expect(testUI.Out).To(Say(`web #2: added \(starting\)`))
+
+			Expect(testUI.Err).To(Say("summary-warning"))
+			Expect(testUI.Err).To(Say("instances-warning"))
+
+			Expect(fakeActor.GetDetailedAppSummaryCallCount()).To(Equal(1))
+			Expect(fakeActor.GetProcessInstancesCallCount()).To(Equal(2))
+			Expect(fakeActor.GetProcessInstancesArgsForCall(0)).To(Equal("web-process-guid"))
+		})
+
+		When("getting the application summary fails", func() {
+			BeforeEach(func() {
+				fakeActor.GetDetailedAppSummaryReturns(v7action.DetailedApplicationSummary{}, nil, actionerror.ApplicationNotFoundError{Name: app})
+			})
+
+			It("returns the error without refreshing", func() {
+				Expect(executeErr).To(MatchError(actionerror.ApplicationNotFoundError{Name: app}))
+				Expect(fakeActor.GetProcessInstancesCallCount()).To(Equal(0))
+			})
+		})
+	})
 })
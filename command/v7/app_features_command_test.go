@@ -0,0 +1,125 @@
+package v7_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	. "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("app-features Command", func() {
+	var (
+		cmd             AppFeaturesCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+
+		cmd = AppFeaturesCommand{
+			RequiredArgs: flag.AppName{AppName: "some-app"},
+			BaseCommand: BaseCommand{
+				UI:          testUI,
+				Config:      fakeConfig,
+				SharedActor: fakeSharedActor,
+				Actor:       fakeActor,
+			},
+		}
+
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+		fakeActor.GetCurrentUserReturns(configv3.User{Name: "steve"}, nil)
+		fakeActor.GetApplicationByNameAndSpaceReturns(resources.Application{GUID: "some-app-guid"}, v7action.Warnings{"app-warning"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NoOrganizationTargetedError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NoOrganizationTargetedError{BinaryName: binaryName}))
+
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	When("getting the app fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetApplicationByNameAndSpaceReturns(resources.Application{}, v7action.Warnings{"app-warning"}, actionerror.ApplicationNotFoundError{Name: "some-app"})
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError(actionerror.ApplicationNotFoundError{Name: "some-app"}))
+			Expect(testUI.Err).To(Say("app-warning"))
+			Expect(fakeActor.GetAppFeaturesCallCount()).To(Equal(0))
+		})
+	})
+
+	When("getting the features fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetAppFeaturesReturns(nil, v7action.Warnings{"features-warning"}, errors.New("features-error"))
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError("features-error"))
+			Expect(testUI.Err).To(Say("features-warning"))
+		})
+	})
+
+	When("the app has features", func() {
+		BeforeEach(func() {
+			fakeActor.GetAppFeaturesReturns([]resources.ApplicationFeature{
+				{Name: "revisions", Description: "Enable versioning of an application", Enabled: true},
+				{Name: "ssh", Description: "Enable SSHing into the app.", Enabled: false},
+			}, v7action.Warnings{"features-warning"}, nil)
+		})
+
+		It("displays the features with their state and description", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say(`Getting features for app some-app in org some-org / space some-space as steve\.\.\.`))
+			Expect(testUI.Out).To(Say(`name\s+state\s+description`))
+			Expect(testUI.Out).To(Say(`revisions\s+enabled\s+Enable versioning of an application`))
+			Expect(testUI.Out).To(Say(`ssh\s+disabled\s+Enable SSHing into the app\.`))
+			Expect(testUI.Err).To(Say("app-warning"))
+			Expect(testUI.Err).To(Say("features-warning"))
+
+			Expect(fakeActor.GetAppFeaturesArgsForCall(0)).To(Equal("some-app-guid"))
+		})
+	})
+
+	When("the app has no features", func() {
+		It("says so", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("No app features found."))
+		})
+	})
+})
@@ -0,0 +1,258 @@
+package v7
+
+import (
+	"fmt"
+	"os"
+
+	"code.cloudfoundry.org/cli/actor/cfnetworkingaction"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v7/shared"
+	"code.cloudfoundry.org/cli/util/portrange"
+	"code.cloudfoundry.org/cli/util/ui"
+	"gopkg.in/yaml.v2"
+)
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . ApplyNetworkPoliciesActor
+
+type ApplyNetworkPoliciesActor interface {
+	ApplyPolicyChanges(changes cfnetworkingaction.PolicyChanges) error
+	GetPolicyChanges(specs []cfnetworkingaction.PolicySpec, prune bool) (cfnetworkingaction.PolicyChanges, cfnetworkingaction.Warnings, error)
+}
+
+type ApplyNetworkPoliciesCommand struct {
+	BaseCommand
+
+	PathToPolicies  flag.PathWithExistenceCheck `short:"f" required:"true" description:"Path to a YAML file with the desired network policies"`
+	DryRun          bool                        `long:"dry-run" description:"Only display the changes that would be made"`
+	Prune           bool                        `long:"prune" description:"Remove policies of apps in the source spaces of the file that are not listed in it"`
+	Force           bool                        `long:"force" description:"Remove policies without confirmation"`
+	relatedCommands interface{}                 `related_commands:"add-network-policy, network-policies, remove-network-policy"`
+
+	NetworkingActor ApplyNetworkPoliciesActor
+}
+
+type networkPolicyApp struct {
+	App   string `yaml:"app"`
+	Space string `yaml:"space"`
+	Org   string `yaml:"org"`
+}
+
+type networkPoliciesFile struct {
+	Policies []struct {
+		Source      networkPolicyApp `yaml:"source"`
+		Destination networkPolicyApp `yaml:"destination"`
+		Protocol    string           `yaml:"protocol"`
+		Ports       string           `yaml:"ports"`
+	} `yaml:"policies"`
+}
+
+func (ApplyNetworkPoliciesCommand) Usage() string {
+	return `CF_NAME apply-network-policies -f POLICIES_FILE [--dry-run] [--prune [--force]]
+
+   The policies file lists the policies between apps. The space and org of an app default to the targeted ones:
+
+   policies:
+   - source:
+       app: frontend
+     destination:
+       app: backend
+       space: api
+     ports: 8080
+   - source:
+       app: backend
+       space: api
+     destination:
+       app: db
+       space: data
+       org: other-org
+     protocol: tcp
+     ports: 5432-5433
+
+   The protocol is tcp or udp and defaults to tcp.
+
+   With --prune, policies of apps in the source spaces of the file that are not listed in it are removed.`
+}
+
+func (ApplyNetworkPoliciesCommand) Examples() string {
+	return `CF_NAME apply-network-policies -f policies.yml --dry-run
+CF_NAME apply-network-policies -f policies.yml --prune`
+}
+
+func (cmd *ApplyNetworkPoliciesCommand) Setup(config command.Config, ui command.UI) error {
+	err := cmd.BaseCommand.Setup(config, ui)
+	if err != nil {
+		return err
+	}
+
+	ccClient, uaaClient := cmd.BaseCommand.GetClients()
+
+	networkingClient, err := shared.NewNetworkingClient(config.NetworkPolicyV1Endpoint(), config, uaaClient, ui)
+	if err != nil {
+		return err
+	}
+	cmd.NetworkingActor = cfnetworkingaction.NewActor(networkingClient, ccClient)
+
+	return nil
+}
+
+func (cmd ApplyNetworkPoliciesCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Actor.GetCurrentUser()
+	if err != nil {
+		return err
+	}
+
+	specs, err := cmd.readPoliciesFile()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Applying network policies from {{.Path}} as {{.User}}...", map[string]interface{}{
+		"Path": string(cmd.PathToPolicies),
+		"User": user.Name,
+	})
+	cmd.UI.DisplayNewline()
+
+	changes, warnings, err := cmd.NetworkingActor.GetPolicyChanges(specs, cmd.Prune)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	count := len(changes.Additions) + len(changes.Removals)
+	if count == 0 {
+		cmd.UI.DisplayText("All network policies are already in place.")
+		cmd.UI.DisplayOK()
+		return nil
+	}
+
+	cmd.displayPolicyChanges(changes)
+	cmd.UI.DisplayNewline()
+
+	if cmd.DryRun {
+		cmd.UI.DisplayText("{{.Count}} changes would be made.", map[string]interface{}{"Count": count})
+		return nil
+	}
+
+	if len(changes.Removals) > 0 && !cmd.Force {
+		removePolicies, err := cmd.UI.DisplayBoolPrompt(false, "Really remove {{.Count}} network policies?", map[string]interface{}{
+			"Count": len(changes.Removals),
+		})
+		if err != nil {
+			return err
+		}
+		if !removePolicies {
+			cmd.UI.DisplayText("Network policies have not been changed.")
+			return nil
+		}
+	}
+
+	err = cmd.NetworkingActor.ApplyPolicyChanges(changes)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+	return nil
+}
+
+func (cmd ApplyNetworkPoliciesCommand) readPoliciesFile() ([]cfnetworkingaction.PolicySpec, error) {
+	path := string(cmd.PathToPolicies)
+
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var file networkPoliciesFile
+	if err := yaml.UnmarshalStrict(raw, &file); err != nil {
+		return nil, translatableerror.InvalidNetworkPoliciesFileError{Path: path, Reason: err.Error()}
+	}
+
+	orgName := cmd.Config.TargetedOrganization().Name
+	spaceName := cmd.Config.TargetedSpace().Name
+
+	var specs []cfnetworkingaction.PolicySpec
+	for i, policy := range file.Policies {
+		if policy.Source.App == "" || policy.Destination.App == "" {
+			return nil, translatableerror.InvalidNetworkPoliciesFileError{Path: path, Reason: fmt.Sprintf("policy %d needs a source and a destination app", i)}
+		}
+
+		protocol := policy.Protocol
+		if protocol == "" {
+			protocol = "tcp"
+		}
+		if protocol != "tcp" && protocol != "udp" {
+			return nil, translatableerror.InvalidNetworkPoliciesFileError{Path: path, Reason: fmt.Sprintf("invalid protocol '%s' in policy %d", protocol, i)}
+		}
+
+		if policy.Ports == "" {
+			return nil, translatableerror.InvalidNetworkPoliciesFileError{Path: path, Reason: fmt.Sprintf("policy %d has no ports", i)}
+		}
+		startPort, endPort, err := portrange.Parse(policy.Ports)
+		if err != nil {
+			return nil, translatableerror.InvalidNetworkPoliciesFileError{Path: path, Reason: fmt.Sprintf("invalid ports '%s' in policy %d", policy.Ports, i)}
+		}
+
+		specs = append(specs, cfnetworkingaction.PolicySpec{
+			SourceOrgName:        valueOrDefault(policy.Source.Org, orgName),
+			SourceSpaceName:      valueOrDefault(policy.Source.Space, spaceName),
+			SourceName:           policy.Source.App,
+			DestinationOrgName:   valueOrDefault(policy.Destination.Org, orgName),
+			DestinationSpaceName: valueOrDefault(policy.Destination.Space, spaceName),
+			DestinationName:      policy.Destination.App,
+			Protocol:             protocol,
+			StartPort:            startPort,
+			EndPort:              endPort,
+		})
+	}
+
+	return specs, nil
+}
+
+func (cmd ApplyNetworkPoliciesCommand) displayPolicyChanges(changes cfnetworkingaction.PolicyChanges) {
+	table := [][]string{{
+		cmd.UI.TranslateText("change"),
+		cmd.UI.TranslateText("source"),
+		cmd.UI.TranslateText("destination"),
+		cmd.UI.TranslateText("protocol"),
+		cmd.UI.TranslateText("ports"),
+		cmd.UI.TranslateText("source space"),
+		cmd.UI.TranslateText("source org"),
+		cmd.UI.TranslateText("destination space"),
+		cmd.UI.TranslateText("destination org"),
+	}}
+
+	addRows := func(change string, policies []cfnetworkingaction.Policy) {
+		for _, policy := range policies {
+			table = append(table, []string{
+				cmd.UI.TranslateText(change),
+				policy.SourceName,
+				policy.DestinationName,
+				policy.Protocol,
+				portrange.Format(policy.StartPort, policy.EndPort),
+				policy.SourceSpaceName,
+				policy.SourceOrgName,
+				policy.DestinationSpaceName,
+				policy.DestinationOrgName,
+			})
+		}
+	}
+	addRows("add", changes.Additions)
+	addRows("remove", changes.Removals)
+
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+}
+
+func valueOrDefault(value string, defaultValue string) string {
+	if value == "" {
+		return defaultValue
+	}
+	return value
+}
@@ -0,0 +1,253 @@
+package v7_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/cli/actor/cfnetworkingaction"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	v7 "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("apply-network-policies Command", func() {
+	var (
+		cmd                 v7.ApplyNetworkPoliciesCommand
+		testUI              *ui.UI
+		input               *Buffer
+		fakeConfig          *commandfakes.FakeConfig
+		fakeSharedActor     *commandfakes.FakeSharedActor
+		fakeActor           *v7fakes.FakeActor
+		fakeNetworkingActor *v7fakes.FakeApplyNetworkPoliciesActor
+		policiesPath        string
+		executeErr          error
+
+		changes cfnetworkingaction.PolicyChanges
+	)
+
+	writePolicies := func(content string) {
+		Expect(os.WriteFile(policiesPath, []byte(content), 0600)).To(Succeed())
+	}
+
+	BeforeEach(func() {
+		input = NewBuffer()
+		testUI = ui.NewTestUI(input, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+		fakeNetworkingActor = new(v7fakes.FakeApplyNetworkPoliciesActor)
+
+		cmd = v7.ApplyNetworkPoliciesCommand{
+			BaseCommand: v7.BaseCommand{
+				UI:          testUI,
+				Config:      fakeConfig,
+				SharedActor: fakeSharedActor,
+				Actor:       fakeActor,
+			},
+			NetworkingActor: fakeNetworkingActor,
+		}
+
+		policiesPath = filepath.Join(GinkgoT().TempDir(), "policies.yml")
+		writePolicies(`policies:
+- source:
+    app: frontend
+  destination:
+    app: backend
+    space: api
+  ports: 8080
+- source:
+    app: backend
+    space: api
+  destination:
+    app: db
+    space: data
+    org: other-org
+  protocol: udp
+  ports: 5432-5433
+`)
+		setFlag(&cmd, "-f", flag.PathWithExistenceCheck(policiesPath))
+
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "my-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "web"})
+		fakeActor.GetCurrentUserReturns(configv3.User{Name: "steve"}, nil)
+
+		changes = cfnetworkingaction.PolicyChanges{
+			Additions: []cfnetworkingaction.Policy{{
+				SourceName: "frontend", SourceSpaceName: "web", SourceOrgName: "my-org",
+				DestinationName: "backend", DestinationSpaceName: "api", DestinationOrgName: "my-org",
+				Protocol: "tcp", StartPort: 8080, EndPort: 8080,
+			}},
+			Removals: []cfnetworkingaction.Policy{{
+				SourceName: "admin", SourceSpaceName: "web", SourceOrgName: "my-org",
+				DestinationName: "backend", DestinationSpaceName: "api", DestinationOrgName: "my-org",
+				Protocol: "tcp", StartPort: 9000, EndPort: 9100,
+			}},
+		}
+		fakeNetworkingActor.GetPolicyChangesReturns(changes, cfnetworkingaction.Warnings{"changes warning"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	It("checks the space is targeted", func() {
+		checkOrg, checkSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+		Expect(checkOrg).To(BeTrue())
+		Expect(checkSpace).To(BeTrue())
+	})
+
+	It("resolves the apps against the targeted org and space by default", func() {
+		specs, prune := fakeNetworkingActor.GetPolicyChangesArgsForCall(0)
+		Expect(prune).To(BeFalse())
+		Expect(specs).To(Equal([]cfnetworkingaction.PolicySpec{
+			{
+				SourceOrgName: "my-org", SourceSpaceName: "web", SourceName: "frontend",
+				DestinationOrgName: "my-org", DestinationSpaceName: "api", DestinationName: "backend",
+				Protocol: "tcp", StartPort: 8080, EndPort: 8080,
+			},
+			{
+				SourceOrgName: "my-org", SourceSpaceName: "api", SourceName: "backend",
+				DestinationOrgName: "other-org", DestinationSpaceName: "data", DestinationName: "db",
+				Protocol: "udp", StartPort: 5432, EndPort: 5433,
+			},
+		}))
+	})
+
+	When("the user confirms the removals", func() {
+		BeforeEach(func() {
+			_, err := input.Write([]byte("y\n"))
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("displays and applies the changes", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+
+			Expect(testUI.Out).To(Say(`Applying network policies from .*policies\.yml as steve\.\.\.`))
+			Expect(testUI.Out).To(Say(`change\s+source\s+destination\s+protocol\s+ports\s+source space\s+source org\s+destination space\s+destination org`))
+			Expect(testUI.Out).To(Say(`add\s+frontend\s+backend\s+tcp\s+8080\s+web\s+my-org\s+api\s+my-org`))
+			Expect(testUI.Out).To(Say(`remove\s+admin\s+backend\s+tcp\s+9000-9100\s+web\s+my-org\s+api\s+my-org`))
+			Expect(testUI.Out).To(Say(`Really remove 1 network policies\?`))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Err).To(Say("changes warning"))
+
+			Expect(fakeNetworkingActor.ApplyPolicyChangesCallCount()).To(Equal(1))
+			Expect(fakeNetworkingActor.ApplyPolicyChangesArgsForCall(0)).To(Equal(changes))
+		})
+	})
+
+	When("the user declines the removals", func() {
+		BeforeEach(func() {
+			_, err := input.Write([]byte("n\n"))
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("does not change any policies", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(testUI.Out).To(Say("Network policies have not been changed."))
+			Expect(fakeNetworkingActor.ApplyPolicyChangesCallCount()).To(Equal(0))
+		})
+	})
+
+	When("--prune and --force are given", func() {
+		BeforeEach(func() {
+			setFlag(&cmd, "--prune")
+			setFlag(&cmd, "--force")
+		})
+
+		It("prunes without prompting", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			_, prune := fakeNetworkingActor.GetPolicyChangesArgsForCall(0)
+			Expect(prune).To(BeTrue())
+			Expect(testUI.Out).NotTo(Say("Really remove"))
+			Expect(fakeNetworkingActor.ApplyPolicyChangesCallCount()).To(Equal(1))
+		})
+	})
+
+	When("--dry-run is given", func() {
+		BeforeEach(func() {
+			setFlag(&cmd, "--dry-run")
+		})
+
+		It("only displays the changes", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(testUI.Out).To(Say("2 changes would be made."))
+			Expect(fakeNetworkingActor.ApplyPolicyChangesCallCount()).To(Equal(0))
+		})
+	})
+
+	When("all policies are in place", func() {
+		BeforeEach(func() {
+			fakeNetworkingActor.GetPolicyChangesReturns(cfnetworkingaction.PolicyChanges{}, nil, nil)
+		})
+
+		It("says so", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(testUI.Out).To(Say("All network policies are already in place."))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(fakeNetworkingActor.ApplyPolicyChangesCallCount()).To(Equal(0))
+		})
+	})
+
+	DescribeTable("invalid policies files",
+		func(content string, reason string) {
+			writePolicies(content)
+			Expect(cmd.Execute(nil)).To(MatchError(translatableerror.InvalidNetworkPoliciesFileError{Path: policiesPath, Reason: reason}))
+		},
+		Entry("a missing destination", "policies:\n- source: {app: a}\n  ports: 80\n", "policy 0 needs a source and a destination app"),
+		Entry("an unknown protocol", "policies:\n- source: {app: a}\n  destination: {app: b}\n  protocol: icmp\n  ports: 80\n", "invalid protocol 'icmp' in policy 0"),
+		Entry("missing ports", "policies:\n- source: {app: a}\n  destination: {app: b}\n", "policy 0 has no ports"),
+		Entry("invalid ports", "policies:\n- source: {app: a}\n  destination: {app: b}\n  ports: 90-80\n", "invalid ports '90-80' in policy 0"),
+	)
+
+	When("the file has unknown fields", func() {
+		BeforeEach(func() {
+			writePolicies("policies:\n- source: {app: a}\n  destination: {app: b}\n  port: 80\n")
+		})
+
+		It("returns an invalid file error", func() {
+			Expect(executeErr).To(BeAssignableToTypeOf(translatableerror.InvalidNetworkPoliciesFileError{}))
+			Expect(fakeNetworkingActor.GetPolicyChangesCallCount()).To(Equal(0))
+		})
+	})
+
+	When("applying the changes fails", func() {
+		BeforeEach(func() {
+			setFlag(&cmd, "--force")
+			fakeNetworkingActor.ApplyPolicyChangesReturns(errors.New("policy server down"))
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError("policy server down"))
+		})
+	})
+
+	When("getting the changes fails", func() {
+		BeforeEach(func() {
+			fakeNetworkingActor.GetPolicyChangesReturns(cfnetworkingaction.PolicyChanges{}, cfnetworkingaction.Warnings{"changes warning"}, errors.New("app not found"))
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError("app not found"))
+			Expect(testUI.Err).To(Say("changes warning"))
+		})
+	})
+
+	When("checking the target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(errors.New("not targeted"))
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError("not targeted"))
+			Expect(fakeNetworkingActor.GetPolicyChangesCallCount()).To(Equal(0))
+		})
+	})
+})
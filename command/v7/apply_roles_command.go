@@ -0,0 +1,246 @@
+package v7
+
+import (
+	"fmt"
+	"os"
+
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/util/ui"
+	"gopkg.in/yaml.v2"
+)
+
+type ApplyRolesCommand struct {
+	BaseCommand
+
+	PathToRoles     flag.PathWithExistenceCheck `short:"f" required:"true" description:"Path to a YAML file with the desired org and space roles of users"`
+	DryRun          bool                        `long:"dry-run" description:"Only display the changes that would be made"`
+	Prune           bool                        `long:"prune" description:"Remove roles that are not listed in the file from its spaces, and from orgs that have org_roles entries in it"`
+	Force           bool                        `long:"force" description:"Remove roles without confirmation"`
+	relatedCommands interface{}                 `related_commands:"org-users, set-org-role, set-space-role, space-users, unset-org-role, unset-space-role"`
+}
+
+type rolesFile struct {
+	Users []struct {
+		Username string `yaml:"username"`
+		Origin   string `yaml:"origin"`
+		Client   bool   `yaml:"client"`
+		OrgRoles []struct {
+			Org  string `yaml:"org"`
+			Role string `yaml:"role"`
+		} `yaml:"org_roles"`
+		SpaceRoles []struct {
+			Org   string `yaml:"org"`
+			Space string `yaml:"space"`
+			Role  string `yaml:"role"`
+		} `yaml:"space_roles"`
+	} `yaml:"users"`
+}
+
+func (ApplyRolesCommand) Usage() string {
+	return `CF_NAME apply-roles -f ROLES_FILE [--dry-run] [--prune [--force]]
+
+   The roles file lists users with their org and space roles:
+
+   users:
+   - username: alice
+     origin: ldap
+     org_roles:
+     - org: my-org
+       role: OrgManager
+     space_roles:
+     - org: my-org
+       space: dev
+       role: SpaceDeveloper
+   - username: my-client-id
+     client: true
+     space_roles:
+     - org: my-org
+       space: dev
+       role: SpaceAuditor
+
+   Org roles are OrgManager, BillingManager and OrgAuditor. Space roles are SpaceManager, SpaceDeveloper, SpaceAuditor and SpaceSupporter.
+
+   With --prune, space roles that are not listed in the file are removed from the spaces it mentions. Org roles are only removed from orgs that have at least one org_roles entry in the file, so an org that is only mentioned for its spaces keeps its org roles. Membership of the orgs themselves is kept.`
+}
+
+func (ApplyRolesCommand) Examples() string {
+	return `CF_NAME apply-roles -f roles.yml --dry-run
+CF_NAME apply-roles -f roles.yml --prune`
+}
+
+func (cmd ApplyRolesCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(false, false)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Actor.GetCurrentUser()
+	if err != nil {
+		return err
+	}
+
+	desired, err := cmd.readRolesFile()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Applying roles from {{.Path}} as {{.User}}...", map[string]interface{}{
+		"Path": string(cmd.PathToRoles),
+		"User": user.Name,
+	})
+	cmd.UI.DisplayNewline()
+
+	changes, warnings, err := cmd.Actor.GetRoleChanges(desired, cmd.Prune)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	if len(changes) == 0 {
+		cmd.UI.DisplayText("All roles are already in place.")
+		cmd.UI.DisplayOK()
+		return nil
+	}
+
+	cmd.displayRoleChanges(changes)
+	cmd.UI.DisplayNewline()
+
+	if cmd.DryRun {
+		cmd.UI.DisplayText("{{.Count}} changes would be made.", map[string]interface{}{"Count": len(changes)})
+		return nil
+	}
+
+	removals := 0
+	for _, change := range changes {
+		if change.Action == v7action.RoleRemoved {
+			removals++
+		}
+	}
+	if removals > 0 && !cmd.Force {
+		removeRoles, err := cmd.UI.DisplayBoolPrompt(false, "Really remove {{.Count}} roles?", map[string]interface{}{
+			"Count": removals,
+		})
+		if err != nil {
+			return err
+		}
+		if !removeRoles {
+			cmd.UI.DisplayText("Roles have not been changed.")
+			return nil
+		}
+	}
+
+	warnings, err = cmd.Actor.ApplyRoleChanges(changes)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+	return nil
+}
+
+func (cmd ApplyRolesCommand) readRolesFile() ([]v7action.RoleAssignment, error) {
+	path := string(cmd.PathToRoles)
+
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var file rolesFile
+	if err := yaml.UnmarshalStrict(raw, &file); err != nil {
+		return nil, translatableerror.InvalidRolesFileError{Path: path, Reason: err.Error()}
+	}
+
+	var desired []v7action.RoleAssignment
+	for i, user := range file.Users {
+		if user.Username == "" {
+			return nil, translatableerror.InvalidRolesFileError{Path: path, Reason: fmt.Sprintf("user %d has no username", i)}
+		}
+
+		assignment := v7action.RoleAssignment{Username: user.Username, Origin: user.Origin, IsClient: user.Client}
+
+		for _, orgRole := range user.OrgRoles {
+			var role flag.OrgRole
+			if err := role.UnmarshalFlag(orgRole.Role); err != nil {
+				return nil, translatableerror.InvalidRolesFileError{Path: path, Reason: fmt.Sprintf("invalid org role '%s' for user %s", orgRole.Role, user.Username)}
+			}
+			if orgRole.Org == "" {
+				return nil, translatableerror.InvalidRolesFileError{Path: path, Reason: fmt.Sprintf("org role of user %s has no org", user.Username)}
+			}
+
+			assignment.Type, _ = convertRoleType(role)
+			assignment.OrgName, assignment.SpaceName = orgRole.Org, ""
+			desired = append(desired, assignment)
+		}
+
+		for _, spaceRole := range user.SpaceRoles {
+			var role flag.SpaceRole
+			if err := role.UnmarshalFlag(spaceRole.Role); err != nil {
+				return nil, translatableerror.InvalidRolesFileError{Path: path, Reason: fmt.Sprintf("invalid space role '%s' for user %s", spaceRole.Role, user.Username)}
+			}
+			if spaceRole.Org == "" || spaceRole.Space == "" {
+				return nil, translatableerror.InvalidRolesFileError{Path: path, Reason: fmt.Sprintf("space role of user %s needs an org and a space", user.Username)}
+			}
+
+			assignment.Type, _ = convertSpaceRoleType(role)
+			assignment.OrgName, assignment.SpaceName = spaceRole.Org, spaceRole.Space
+			desired = append(desired, assignment)
+		}
+	}
+
+	return desired, nil
+}
+
+func (cmd ApplyRolesCommand) displayRoleChanges(changes []v7action.RoleChange) {
+	table := [][]string{{
+		cmd.UI.TranslateText("change"),
+		cmd.UI.TranslateText("user"),
+		cmd.UI.TranslateText("origin"),
+		cmd.UI.TranslateText("org"),
+		cmd.UI.TranslateText("space"),
+		cmd.UI.TranslateText("role"),
+	}}
+	for _, change := range changes {
+		origin := change.Origin
+		if change.IsClient {
+			origin = cmd.UI.TranslateText("client")
+		}
+		table = append(table, []string{
+			cmd.UI.TranslateText(string(change.Action)),
+			change.Username,
+			origin,
+			change.OrgName,
+			change.SpaceName,
+			roleTypeName(change.Type),
+		})
+	}
+
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+}
+
+func roleTypeName(roleType constant.RoleType) string {
+	switch roleType {
+	case constant.OrgUserRole:
+		return "OrgUser"
+	case constant.OrgManagerRole:
+		return "OrgManager"
+	case constant.OrgBillingManagerRole:
+		return "BillingManager"
+	case constant.OrgAuditorRole:
+		return "OrgAuditor"
+	case constant.SpaceManagerRole:
+		return "SpaceManager"
+	case constant.SpaceDeveloperRole:
+		return "SpaceDeveloper"
+	case constant.SpaceAuditorRole:
+		return "SpaceAuditor"
+	case constant.SpaceSupporterRole:
+		return "SpaceSupporter"
+	default:
+		return string(roleType)
+	}
+}
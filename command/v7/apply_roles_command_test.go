@@ -0,0 +1,271 @@
+package v7_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	v7 "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("apply-roles Command", func() {
+	var (
+		cmd             v7.ApplyRolesCommand
+		testUI          *ui.UI
+		input           *Buffer
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeActor
+		rolesPath       string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		input = NewBuffer()
+		testUI = ui.NewTestUI(input, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+
+		cmd = v7.ApplyRolesCommand{
+			BaseCommand: v7.BaseCommand{
+				UI:          testUI,
+				Config:      fakeConfig,
+				SharedActor: fakeSharedActor,
+				Actor:       fakeActor,
+			},
+		}
+
+		rolesPath = filepath.Join(GinkgoT().TempDir(), "roles.yml")
+		Expect(os.WriteFile(rolesPath, []byte(`users:
+- username: alice
+  org_roles:
+  - org: my-org
+    role: orgmanager
+- username: bob
+  origin: ldap
+  space_roles:
+  - org: my-org
+    space: dev
+    role: SpaceDeveloper
+- username: my-client
+  client: true
+  org_roles:
+  - org: my-org
+    role: BillingManager
+`), 0600)).To(Succeed())
+		setFlag(&cmd, "-f", flag.PathWithExistenceCheck(rolesPath))
+
+		fakeActor.GetCurrentUserReturns(configv3.User{Name: "steve"}, nil)
+		fakeActor.GetRoleChangesReturns(
+			[]v7action.RoleChange{
+				{
+					RoleAssignment: v7action.RoleAssignment{Type: constant.SpaceDeveloperRole, Username: "bob", Origin: "ldap", OrgName: "my-org", SpaceName: "dev"},
+					Action:         v7action.RoleAdded,
+				},
+				{
+					RoleAssignment: v7action.RoleAssignment{Type: constant.OrgAuditorRole, Username: "carol", Origin: "uaa", OrgName: "my-org"},
+					Action:         v7action.RoleRemoved,
+					RoleGUID:       "role-guid",
+				},
+			},
+			v7action.Warnings{"changes warning"},
+			nil,
+		)
+		fakeActor.ApplyRoleChangesReturns(v7action.Warnings{"apply warning"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	It("checks the user is logged in", func() {
+		Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+		checkOrg, checkSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+		Expect(checkOrg).To(BeFalse())
+		Expect(checkSpace).To(BeFalse())
+	})
+
+	It("reads the desired roles from the file", func() {
+		Expect(fakeActor.GetRoleChangesCallCount()).To(Equal(1))
+		desired, prune := fakeActor.GetRoleChangesArgsForCall(0)
+		Expect(prune).To(BeFalse())
+		Expect(desired).To(Equal([]v7action.RoleAssignment{
+			{Type: constant.OrgManagerRole, Username: "alice", OrgName: "my-org"},
+			{Type: constant.SpaceDeveloperRole, Username: "bob", Origin: "ldap", OrgName: "my-org", SpaceName: "dev"},
+			{Type: constant.OrgBillingManagerRole, Username: "my-client", IsClient: true, OrgName: "my-org"},
+		}))
+	})
+
+	It("displays the changes and asks before removing roles", func() {
+		Expect(testUI.Out).To(Say(`Applying roles from %s as steve\.\.\.`, rolesPath))
+		Expect(testUI.Out).To(Say(`change\s+user\s+origin\s+org\s+space\s+role`))
+		Expect(testUI.Out).To(Say(`add\s+bob\s+ldap\s+my-org\s+dev\s+SpaceDeveloper`))
+		Expect(testUI.Out).To(Say(`remove\s+carol\s+uaa\s+my-org\s+OrgAuditor`))
+		Expect(testUI.Out).To(Say(`Really remove 1 roles\?`))
+		Expect(testUI.Err).To(Say("changes warning"))
+	})
+
+	When("the user confirms", func() {
+		BeforeEach(func() {
+			_, err := input.Write([]byte("y\n"))
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("applies the changes", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(fakeActor.ApplyRoleChangesCallCount()).To(Equal(1))
+			Expect(fakeActor.ApplyRoleChangesArgsForCall(0)).To(HaveLen(2))
+			Expect(testUI.Err).To(Say("apply warning"))
+			Expect(testUI.Out).To(Say("OK"))
+		})
+
+		When("applying the changes fails", func() {
+			BeforeEach(func() {
+				fakeActor.ApplyRoleChangesReturns(v7action.Warnings{"apply warning"}, errors.New("boom"))
+			})
+
+			It("returns the error and displays warnings", func() {
+				Expect(executeErr).To(MatchError("boom"))
+				Expect(testUI.Err).To(Say("apply warning"))
+			})
+		})
+	})
+
+	When("the user declines", func() {
+		BeforeEach(func() {
+			_, err := input.Write([]byte("n\n"))
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("does not change any roles", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(testUI.Out).To(Say(`Roles have not been changed\.`))
+			Expect(fakeActor.ApplyRoleChangesCallCount()).To(Equal(0))
+		})
+	})
+
+	When("no roles are removed", func() {
+		BeforeEach(func() {
+			fakeActor.GetRoleChangesReturns(
+				[]v7action.RoleChange{{
+					RoleAssignment: v7action.RoleAssignment{Type: constant.OrgManagerRole, Username: "alice", OrgName: "my-org"},
+					Action:         v7action.RoleAdded,
+				}},
+				nil,
+				nil,
+			)
+		})
+
+		It("applies the changes without prompting", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(testUI.Out).NotTo(Say("Really remove"))
+			Expect(fakeActor.ApplyRoleChangesCallCount()).To(Equal(1))
+		})
+	})
+
+	When("--prune and --force are given", func() {
+		BeforeEach(func() {
+			setFlag(&cmd, "--prune")
+			setFlag(&cmd, "--force")
+		})
+
+		It("prunes roles without prompting", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			_, prune := fakeActor.GetRoleChangesArgsForCall(0)
+			Expect(prune).To(BeTrue())
+			Expect(testUI.Out).NotTo(Say("Really remove"))
+			Expect(fakeActor.ApplyRoleChangesCallCount()).To(Equal(1))
+		})
+	})
+
+	When("--dry-run is given", func() {
+		BeforeEach(func() {
+			setFlag(&cmd, "--dry-run")
+		})
+
+		It("only displays the changes", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(testUI.Out).To(Say(`2 changes would be made\.`))
+			Expect(testUI.Out).NotTo(Say("Really remove"))
+			Expect(fakeActor.ApplyRoleChangesCallCount()).To(Equal(0))
+		})
+	})
+
+	When("all roles are already in place", func() {
+		BeforeEach(func() {
+			fakeActor.GetRoleChangesReturns(nil, nil, nil)
+		})
+
+		It("says so", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(testUI.Out).To(Say(`All roles are already in place\.`))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(fakeActor.ApplyRoleChangesCallCount()).To(Equal(0))
+		})
+	})
+
+	When("the file has an invalid role", func() {
+		BeforeEach(func() {
+			Expect(os.WriteFile(rolesPath, []byte(`users:
+- username: alice
+  space_roles:
+  - org: my-org
+    space: dev
+    role: SpaceOwner
+`), 0600)).To(Succeed())
+		})
+
+		It("returns an invalid roles file error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.InvalidRolesFileError{
+				Path:   rolesPath,
+				Reason: "invalid space role 'SpaceOwner' for user alice",
+			}))
+			Expect(fakeActor.GetRoleChangesCallCount()).To(Equal(0))
+		})
+	})
+
+	When("the file has unknown fields", func() {
+		BeforeEach(func() {
+			Expect(os.WriteFile(rolesPath, []byte("users:\n- name: alice\n"), 0600)).To(Succeed())
+		})
+
+		It("returns an invalid roles file error", func() {
+			Expect(executeErr).To(BeAssignableToTypeOf(translatableerror.InvalidRolesFileError{}))
+			Expect(fakeActor.GetRoleChangesCallCount()).To(Equal(0))
+		})
+	})
+
+	When("computing the changes fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetRoleChangesReturns(nil, v7action.Warnings{"changes warning"}, errors.New("boom"))
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError("boom"))
+			Expect(testUI.Err).To(Say("changes warning"))
+		})
+	})
+
+	When("checking the target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(errors.New("not logged in"))
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError("not logged in"))
+			Expect(fakeActor.GetRoleChangesCallCount()).To(Equal(0))
+		})
+	})
+})
@@ -0,0 +1,260 @@
+package v7
+
+import (
+	"fmt"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/api/logcache"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v7/shared"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+type BindServiceToAppsCommand struct {
+	BaseCommand
+
+	RequiredArgs        flag.ServiceInstanceAndAppNames `positional-args:"yes"`
+	Labels              string                          `long:"labels" description:"Selector to bind all apps in the space that match the labels"`
+	BindingName         flag.BindingName                `long:"binding-name" description:"Name to expose service instance to app process with (Default: service instance name)"`
+	ParametersAsJSON    flag.JSONOrFileWithValidation   `short:"c" description:"Valid JSON object containing service-specific configuration parameters, provided either in-line or in a file. For a list of supported configuration parameters, see documentation for the particular service offering."`
+	Restage             bool                            `long:"restage" description:"Restage the started apps once they are bound"`
+	Strategy            flag.DeploymentStrategy         `long:"strategy" description:"Deployment strategy used to restage the apps, can be canary, rolling or null. Requires --restage"`
+	usage               interface{}                     `usage:"CF_NAME bind-service-to-apps SERVICE_INSTANCE (APP_NAME... | --labels SELECTOR) [-c PARAMETERS_AS_JSON] [--binding-name BINDING_NAME] [--restage [--strategy STRATEGY]]\n\n   The service instance is bound to all apps at the same time, and the command waits until every binding is complete.\n\nEXAMPLES:\n   CF_NAME bind-service-to-apps mydb app1 app2 app3\n   CF_NAME bind-service-to-apps config-server --labels tier=backend --restage --strategy rolling\n   CF_NAME bind-service-to-apps mydb app1 app2 -c '{\"permissions\":\"read-only\"}'"`
+	relatedCommands     interface{}                     `related_commands:"bind-service, restage, services, unbind-service-from-apps"`
+	envCFStagingTimeout interface{}                     `environmentName:"CF_STAGING_TIMEOUT" environmentDescription:"Max wait time for staging, in minutes" environmentDefault:"15"`
+	envCFStartupTimeout interface{}                     `environmentName:"CF_STARTUP_TIMEOUT" environmentDescription:"Max wait time for app instance startup, in minutes" environmentDefault:"5"`
+
+	Stager shared.AppStager
+}
+
+// serviceAppBindingResult is the outcome of binding or unbinding one app,
+// once its job has finished.
+type serviceAppBindingResult struct {
+	app      resources.Application
+	warnings v7action.Warnings
+	err      error
+}
+
+func (cmd *BindServiceToAppsCommand) Setup(config command.Config, ui command.UI) error {
+	err := cmd.BaseCommand.Setup(config, ui)
+	if err != nil {
+		return err
+	}
+
+	logCacheClient, err := logcache.NewClient(config.LogCacheEndpoint(), config, ui, v7action.NewDefaultKubernetesConfigGetter())
+	if err != nil {
+		return err
+	}
+
+	cmd.Stager = shared.NewAppStager(cmd.Actor, cmd.UI, cmd.Config, logCacheClient)
+
+	return nil
+}
+
+func (cmd BindServiceToAppsCommand) Execute(args []string) error {
+	err := validateServiceAppBindingsFlags(cmd.RequiredArgs.AppNames, cmd.Labels, cmd.Restage, cmd.Strategy)
+	if err != nil {
+		return err
+	}
+
+	if err := cmd.SharedActor.CheckTarget(true, true); err != nil {
+		return err
+	}
+
+	user, err := cmd.Actor.GetCurrentUser()
+	if err != nil {
+		return err
+	}
+
+	apps, err := getAppsByNamesOrLabels(cmd.BaseCommand, cmd.RequiredArgs.AppNames, cmd.Labels)
+	if err != nil {
+		return err
+	}
+	if len(apps) == 0 {
+		cmd.UI.DisplayText("No apps match the label selector {{.Labels}}.", map[string]interface{}{"Labels": cmd.Labels})
+		cmd.UI.DisplayOK()
+		return nil
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Binding service instance {{.ServiceInstance}} to {{.Count}} apps in org {{.Org}} / space {{.Space}} as {{.User}}...", map[string]interface{}{
+		"ServiceInstance": cmd.RequiredArgs.ServiceInstanceName,
+		"Count":           len(apps),
+		"Org":             cmd.Config.TargetedOrganization().Name,
+		"Space":           cmd.Config.TargetedSpace().Name,
+		"User":            user.Name,
+	})
+	cmd.UI.DisplayNewline()
+
+	jobs, warnings, err := cmd.Actor.CreateServiceAppBindings(v7action.CreateServiceAppBindingsParams{
+		SpaceGUID:           cmd.Config.TargetedSpace().GUID,
+		ServiceInstanceName: cmd.RequiredArgs.ServiceInstanceName,
+		Apps:                apps,
+		BindingName:         cmd.BindingName.Value,
+		Parameters:          types.OptionalObject(cmd.ParametersAsJSON),
+	})
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	var (
+		bound    []resources.Application
+		failures []string
+	)
+	table := [][]string{{cmd.UI.TranslateText("app"), cmd.UI.TranslateText("result"), cmd.UI.TranslateText("details")}}
+	for _, result := range waitForServiceAppBindingJobs(jobs) {
+		cmd.UI.DisplayWarnings(result.warnings)
+
+		switch result.err.(type) {
+		case nil:
+			bound = append(bound, result.app)
+			table = append(table, []string{result.app.Name, cmd.UI.TranslateText("bound"), ""})
+		case actionerror.ResourceAlreadyExistsError:
+			table = append(table, []string{result.app.Name, cmd.UI.TranslateText("already bound"), ""})
+		default:
+			failures = append(failures, fmt.Sprintf("%s: %s", result.app.Name, result.err))
+			table = append(table, []string{result.app.Name, cmd.UI.TranslateText("failed"), result.err.Error()})
+		}
+	}
+
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayText("{{.Bound}} bound, {{.AlreadyBound}} already bound, {{.Failed}} failed", map[string]interface{}{
+		"Bound":        len(bound),
+		"AlreadyBound": len(jobs) - len(bound) - len(failures),
+		"Failed":       len(failures),
+	})
+
+	if cmd.Restage {
+		failures = append(failures, restageAppsForServiceAppBindings(cmd.BaseCommand, cmd.Stager, bound, cmd.Strategy.Name)...)
+	}
+
+	if len(failures) > 0 {
+		return translatableerror.MultiError{Messages: failures}
+	}
+
+	cmd.UI.DisplayOK()
+	if !cmd.Restage && len(bound) > 0 {
+		cmd.UI.DisplayText("TIP: Restage the bound apps, or use --restage, to ensure your env variable changes take effect")
+	}
+	return nil
+}
+
+func validateServiceAppBindingsFlags(appNames []string, labels string, restage bool, strategy flag.DeploymentStrategy) error {
+	switch {
+	case len(appNames) > 0 && labels != "":
+		return translatableerror.ArgumentCombinationError{Args: []string{"APP_NAME", "--labels"}}
+	case len(appNames) == 0 && labels == "":
+		return translatableerror.IncorrectUsageError{Message: "provide at least one APP_NAME or --labels"}
+	case strategy.Name != constant.DeploymentStrategyDefault && !restage:
+		return translatableerror.RequiredFlagsError{Arg1: "--strategy", Arg2: "--restage"}
+	}
+
+	return nil
+}
+
+// getAppsByNamesOrLabels gets the apps in the targeted space, either by name
+// or by label selector.
+func getAppsByNamesOrLabels(cmd BaseCommand, appNames []string, labels string) ([]resources.Application, error) {
+	var (
+		apps     []resources.Application
+		warnings v7action.Warnings
+		err      error
+	)
+	if labels != "" {
+		apps, warnings, err = cmd.Actor.GetApplicationsBySpaceAndLabelSelector(cmd.Config.TargetedSpace().GUID, labels)
+	} else {
+		apps, warnings, err = cmd.Actor.GetApplicationsByNamesAndSpace(appNames, cmd.Config.TargetedSpace().GUID)
+	}
+	cmd.UI.DisplayWarnings(warnings)
+
+	return apps, err
+}
+
+// waitForServiceAppBindingJobs waits for all the jobs to finish at the same
+// time, and returns their results in the order of the jobs.
+func waitForServiceAppBindingJobs(jobs []v7action.ServiceAppBindingJob) []serviceAppBindingResult {
+	results := make([]serviceAppBindingResult, len(jobs))
+	done := make(chan bool)
+
+	for i, job := range jobs {
+		go func(i int, job v7action.ServiceAppBindingJob) {
+			result := serviceAppBindingResult{app: job.App, warnings: job.Warnings, err: job.Err}
+			if job.Stream != nil {
+				for event := range job.Stream {
+					result.warnings = append(result.warnings, event.Warnings...)
+					if event.Err != nil {
+						result.err = event.Err
+					}
+				}
+			}
+			results[i] = result
+			done <- true
+		}(i, job)
+	}
+
+	for range jobs {
+		<-done
+	}
+
+	return results
+}
+
+// restageAppsForServiceAppBindings restages the started apps one at a time so
+// that their environment reflects the changed bindings. It returns a message
+// for each app that failed to restage.
+func restageAppsForServiceAppBindings(cmd BaseCommand, stager shared.AppStager, apps []resources.Application, strategy constant.DeploymentStrategy) []string {
+	if len(apps) == 0 {
+		return nil
+	}
+
+	cmd.UI.DisplayNewline()
+	if strategy == constant.DeploymentStrategyDefault {
+		cmd.UI.DisplayWarning("Restaging without --strategy will cause app downtime.")
+	}
+
+	var failures []string
+	for _, app := range apps {
+		if !app.Started() {
+			cmd.UI.DisplayText("App {{.AppName}} is stopped; the change takes effect when it is started.", map[string]interface{}{
+				"AppName": app.Name,
+			})
+			continue
+		}
+
+		cmd.UI.DisplayNewline()
+		cmd.UI.DisplayText("Restaging app {{.AppName}}...", map[string]interface{}{"AppName": app.Name})
+
+		err := restageAppForServiceAppBinding(cmd, stager, app, strategy)
+		if err != nil {
+			cmd.UI.DisplayWarning("Restage of app {{.AppName}} failed: {{.Error}}", map[string]interface{}{
+				"AppName": app.Name,
+				"Error":   err.Error(),
+			})
+			failures = append(failures, fmt.Sprintf("%s: %s", app.Name, err))
+		}
+	}
+
+	return failures
+}
+
+func restageAppForServiceAppBinding(cmd BaseCommand, stager shared.AppStager, app resources.Application, strategy constant.DeploymentStrategy) error {
+	pkg, warnings, err := cmd.Actor.GetNewestReadyPackageForApplication(app)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	opts := shared.AppStartOpts{
+		AppAction: constant.ApplicationRestarting,
+		Strategy:  strategy,
+	}
+
+	return stager.StageAndStart(app, cmd.Config.TargetedSpace(), cmd.Config.TargetedOrganization(), pkg.GUID, opts)
+}
@@ -0,0 +1,114 @@
+package v7
+
+import (
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+type BuildpackUsageCommand struct {
+	BaseCommand
+
+	RequiredArgs    flag.OptionalBuildpackName `positional-args:"yes"`
+	Outdated        bool                       `long:"outdated" description:"Only list apps staged before their buildpack was last updated"`
+	usage           interface{}                `usage:"CF_NAME buildpack-usage [BUILDPACK] [--outdated]\n\n   Lists the buildpacks used by the current droplets of all apps you can see. An app is outdated when it was staged before the admin buildpack it uses was last updated.\n\nEXAMPLES:\n   CF_NAME buildpack-usage\n   CF_NAME buildpack-usage java_buildpack --outdated"`
+	relatedCommands interface{}                `related_commands:"buildpacks, restage, restage-apps, update-buildpack"`
+}
+
+func (cmd BuildpackUsageCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(false, false)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Actor.GetCurrentUser()
+	if err != nil {
+		return err
+	}
+
+	if cmd.RequiredArgs.Buildpack != "" {
+		cmd.UI.DisplayTextWithFlavor("Getting usage of buildpack {{.BuildpackName}} as {{.Username}}...", map[string]interface{}{
+			"BuildpackName": cmd.RequiredArgs.Buildpack,
+			"Username":      user.Name,
+		})
+	} else {
+		cmd.UI.DisplayTextWithFlavor("Getting buildpack usage as {{.Username}}...", map[string]interface{}{
+			"Username": user.Name,
+		})
+	}
+	cmd.UI.DisplayNewline()
+
+	usages, warnings, err := cmd.Actor.GetBuildpackUsage(cmd.RequiredArgs.Buildpack)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	apps := map[string]bool{}
+	outdatedApps := map[string]bool{}
+	for _, usage := range usages {
+		apps[usage.App.GUID] = true
+		if usage.Outdated {
+			outdatedApps[usage.App.GUID] = true
+		}
+	}
+
+	if len(usages) == 0 || (cmd.Outdated && len(outdatedApps) == 0) {
+		cmd.UI.DisplayText("No apps found.")
+		return nil
+	}
+
+	table := [][]string{{
+		cmd.UI.TranslateText("org"),
+		cmd.UI.TranslateText("space"),
+		cmd.UI.TranslateText("app"),
+		cmd.UI.TranslateText("state"),
+		cmd.UI.TranslateText("buildpack"),
+		cmd.UI.TranslateText("version"),
+		cmd.UI.TranslateText("stack"),
+		cmd.UI.TranslateText("staged"),
+		cmd.UI.TranslateText("status"),
+	}}
+	for _, usage := range usages {
+		if cmd.Outdated && !usage.Outdated {
+			continue
+		}
+		table = append(table, []string{
+			usage.OrgName,
+			usage.SpaceName,
+			usage.App.Name,
+			cmd.UI.TranslateText(strings.ToLower(string(usage.App.State))),
+			usage.BuildpackName,
+			usage.Version,
+			usage.Stack,
+			formatStagedAt(usage.StagedAt),
+			cmd.UI.TranslateText(buildpackUsageStatus(usage)),
+		})
+	}
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+	cmd.UI.DisplayNewline()
+
+	cmd.UI.DisplayText("{{.Outdated}} of {{.Count}} apps were staged before their buildpack was last updated.", map[string]interface{}{
+		"Outdated": len(outdatedApps),
+		"Count":    len(apps),
+	})
+
+	return nil
+}
+
+func buildpackUsageStatus(usage v7action.BuildpackUsage) string {
+	if usage.Outdated {
+		return "outdated"
+	}
+	return "up to date"
+}
+
+func formatStagedAt(stagedAt time.Time) string {
+	if stagedAt.IsZero() {
+		return ""
+	}
+	return stagedAt.Format(time.RFC1123)
+}
@@ -0,0 +1,146 @@
+package v7_test
+
+import (
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	v7 "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("buildpack-usage Command", func() {
+	var (
+		cmd             v7.BuildpackUsageCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeActor
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+
+		cmd = v7.BuildpackUsageCommand{
+			BaseCommand: v7.BaseCommand{
+				UI:          testUI,
+				Config:      fakeConfig,
+				SharedActor: fakeSharedActor,
+				Actor:       fakeActor,
+			},
+		}
+
+		fakeActor.GetCurrentUserReturns(configv3.User{Name: "steve"}, nil)
+		fakeActor.GetBuildpackUsageReturns(
+			[]v7action.BuildpackUsage{
+				{
+					App:           resources.Application{GUID: "api-guid", Name: "api", State: constant.ApplicationStarted},
+					OrgName:       "my-org",
+					SpaceName:     "dev",
+					Stack:         "cflinuxfs4",
+					BuildpackName: "java_buildpack",
+					Version:       "4.1",
+					StagedAt:      time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC),
+					Outdated:      true,
+				},
+				{
+					App:           resources.Application{GUID: "web-guid", Name: "web", State: constant.ApplicationStopped},
+					OrgName:       "my-org",
+					SpaceName:     "dev",
+					Stack:         "cflinuxfs4",
+					BuildpackName: "java_buildpack",
+					Version:       "4.2",
+					StagedAt:      time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC),
+				},
+			},
+			v7action.Warnings{"usage warning"},
+			nil,
+		)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	It("checks the user is logged in", func() {
+		checkOrg, checkSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+		Expect(checkOrg).To(BeFalse())
+		Expect(checkSpace).To(BeFalse())
+	})
+
+	It("lists the buildpack usage of all apps", func() {
+		Expect(executeErr).NotTo(HaveOccurred())
+		Expect(fakeActor.GetBuildpackUsageArgsForCall(0)).To(BeEmpty())
+
+		Expect(testUI.Out).To(Say(`Getting buildpack usage as steve\.\.\.`))
+		Expect(testUI.Out).To(Say(`org\s+space\s+app\s+state\s+buildpack\s+version\s+stack\s+staged\s+status`))
+		Expect(testUI.Out).To(Say(`my-org\s+dev\s+api\s+started\s+java_buildpack\s+4\.1\s+cflinuxfs4\s+Sun, 01 Feb 2026 00:00:00 UTC\s+outdated`))
+		Expect(testUI.Out).To(Say(`my-org\s+dev\s+web\s+stopped\s+java_buildpack\s+4\.2\s+cflinuxfs4\s+Wed, 01 Apr 2026 00:00:00 UTC\s+up to date`))
+		Expect(testUI.Out).To(Say(`1 of 2 apps were staged before their buildpack was last updated\.`))
+		Expect(testUI.Err).To(Say("usage warning"))
+	})
+
+	When("a buildpack and --outdated are given", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs = flag.OptionalBuildpackName{Buildpack: "java_buildpack"}
+			setFlag(&cmd, "--outdated")
+		})
+
+		It("only lists the outdated apps using the buildpack", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(fakeActor.GetBuildpackUsageArgsForCall(0)).To(Equal("java_buildpack"))
+
+			Expect(testUI.Out).To(Say(`Getting usage of buildpack java_buildpack as steve\.\.\.`))
+			Expect(testUI.Out).To(Say(`api\s+started`))
+			Expect(testUI.Out).NotTo(Say(`web\s+stopped`))
+		})
+	})
+
+	When("no apps are outdated", func() {
+		BeforeEach(func() {
+			setFlag(&cmd, "--outdated")
+			fakeActor.GetBuildpackUsageReturns([]v7action.BuildpackUsage{{App: resources.Application{Name: "web"}}}, nil, nil)
+		})
+
+		It("says so", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(testUI.Out).To(Say("No apps found."))
+		})
+	})
+
+	When("the buildpack does not exist", func() {
+		BeforeEach(func() {
+			fakeActor.GetBuildpackUsageReturns(nil, v7action.Warnings{"usage warning"}, actionerror.BuildpackNotFoundError{BuildpackName: "missing"})
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError(actionerror.BuildpackNotFoundError{BuildpackName: "missing"}))
+			Expect(testUI.Err).To(Say("usage warning"))
+		})
+	})
+
+	When("checking the target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(errors.New("not logged in"))
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError("not logged in"))
+			Expect(fakeActor.GetBuildpackUsageCallCount()).To(Equal(0))
+		})
+	})
+})
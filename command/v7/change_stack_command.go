@@ -0,0 +1,205 @@
+package v7
+
+import (
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/api/logcache"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v7/shared"
+	"code.cloudfoundry.org/cli/resources"
+)
+
+type ChangeStackCommand struct {
+	BaseCommand
+
+	RequiredArgs    flag.AppStack           `positional-args:"yes"`
+	Strategy        flag.DeploymentStrategy `long:"strategy" description:"Deployment strategy can be rolling or null."`
+	usage           interface{}             `usage:"CF_NAME change-stack APP_NAME STACK_NAME [--strategy rolling]\n\n   Moves the app to another stack and restages it. If staging or starting on the new stack fails, the app is moved back to its previous stack and droplet. This command will cause downtime unless you use '--strategy rolling'.\n\nEXAMPLES:\n   CF_NAME change-stack my-app cflinuxfs4 --strategy rolling"`
+	relatedCommands interface{}             `related_commands:"app, restage, stack-migration-report, stacks"`
+
+	Stager shared.AppStager
+}
+
+func (cmd *ChangeStackCommand) Setup(config command.Config, ui command.UI) error {
+	err := cmd.BaseCommand.Setup(config, ui)
+	if err != nil {
+		return err
+	}
+
+	logCacheClient, err := logcache.NewClient(config.LogCacheEndpoint(), config, ui, v7action.NewDefaultKubernetesConfigGetter())
+	if err != nil {
+		return err
+	}
+
+	cmd.Stager = shared.NewAppStager(cmd.Actor, cmd.UI, cmd.Config, logCacheClient)
+
+	return nil
+}
+
+func (cmd ChangeStackCommand) Execute(args []string) error {
+	if cmd.Strategy.Name == constant.DeploymentStrategyCanary {
+		return translatableerror.IncorrectUsageError{Message: "--strategy canary is not supported when changing the stack of an app"}
+	}
+
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Actor.GetCurrentUser()
+	if err != nil {
+		return err
+	}
+
+	appName := cmd.RequiredArgs.AppName
+	stackName := cmd.RequiredArgs.StackName
+
+	cmd.UI.DisplayTextWithFlavor("Changing stack of app {{.AppName}} to {{.StackName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+		"AppName":   appName,
+		"StackName": stackName,
+		"OrgName":   cmd.Config.TargetedOrganization().Name,
+		"SpaceName": cmd.Config.TargetedSpace().Name,
+		"Username":  user.Name,
+	})
+	cmd.UI.DisplayNewline()
+
+	app, warnings, err := cmd.Actor.GetApplicationByNameAndSpace(appName, cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	if app.LifecycleType == constant.AppLifecycleTypeDocker {
+		return translatableerror.DockerAppHasNoStackError{AppName: appName}
+	}
+
+	if app.StackName == stackName {
+		cmd.UI.DisplayText("App {{.AppName}} is already on stack {{.StackName}}.", map[string]interface{}{
+			"AppName":   appName,
+			"StackName": stackName,
+		})
+		cmd.UI.DisplayOK()
+		return nil
+	}
+
+	_, warnings, err = cmd.Actor.GetStackByName(stackName)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	previousDroplet, warnings, err := cmd.Actor.GetCurrentDropletByApplication(app.GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if _, ok := err.(actionerror.DropletNotFoundError); !ok && err != nil {
+		return err
+	}
+
+	pkg, warnings, err := cmd.Actor.GetNewestReadyPackageForApplication(app)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	if app.Started() && cmd.Strategy.Name == constant.DeploymentStrategyDefault {
+		cmd.UI.DisplayWarning("This action will cause app downtime.")
+	}
+
+	_, warnings, err = cmd.Actor.UpdateApplication(cmd.withStack(app, stackName))
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayText("Staging app {{.AppName}} on stack {{.StackName}}...", map[string]interface{}{
+		"AppName":   appName,
+		"StackName": stackName,
+	})
+
+	droplet, err := cmd.Stager.StageApp(app, pkg.GUID, cmd.Config.TargetedSpace())
+	if err != nil {
+		cmd.rollBack(app, previousDroplet, false)
+		return err
+	}
+
+	if app.Started() {
+		cmd.UI.DisplayNewline()
+		err = cmd.startApp(app, droplet.GUID)
+		if err != nil {
+			cmd.rollBack(app, previousDroplet, true)
+		}
+		return err
+	}
+
+	warnings, err = cmd.Actor.SetApplicationDroplet(app.GUID, droplet.GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+	return nil
+}
+
+func (cmd ChangeStackCommand) startApp(app resources.Application, dropletGUID string) error {
+	return cmd.Stager.StartApp(app, cmd.Config.TargetedSpace(), cmd.Config.TargetedOrganization(), dropletGUID, shared.AppStartOpts{
+		AppAction: constant.ApplicationRestarting,
+		Strategy:  cmd.Strategy.Name,
+	})
+}
+
+// rollBack moves the app back to its previous stack and droplet after staging
+// or starting on the new stack failed. When the start failed, the previous
+// droplet is run again. Failures are displayed as warnings so that the
+// original error is still returned.
+func (cmd ChangeStackCommand) rollBack(app resources.Application, previousDroplet resources.Droplet, restart bool) {
+	failure := "Staging failed."
+	if restart {
+		failure = "Starting the app failed."
+	}
+	cmd.UI.DisplayWarning(failure+" Rolling back app {{.AppName}} to stack {{.StackName}}...", map[string]interface{}{
+		"AppName":   app.Name,
+		"StackName": app.StackName,
+	})
+
+	steps := []func() (v7action.Warnings, error){
+		func() (v7action.Warnings, error) {
+			_, warnings, err := cmd.Actor.UpdateApplication(cmd.withStack(app, app.StackName))
+			return warnings, err
+		},
+	}
+	if previousDroplet.GUID != "" {
+		if restart {
+			steps = append(steps, func() (v7action.Warnings, error) {
+				cmd.UI.DisplayNewline()
+				return nil, cmd.startApp(app, previousDroplet.GUID)
+			})
+		} else {
+			steps = append(steps, func() (v7action.Warnings, error) {
+				return cmd.Actor.SetApplicationDroplet(app.GUID, previousDroplet.GUID)
+			})
+		}
+	}
+
+	for _, step := range steps {
+		warnings, err := step()
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			cmd.UI.DisplayWarning("Rollback failed: {{.Error}}", map[string]interface{}{
+				"Error": err.Error(),
+			})
+			return
+		}
+	}
+}
+
+func (cmd ChangeStackCommand) withStack(app resources.Application, stackName string) resources.Application {
+	return resources.Application{
+		GUID:                app.GUID,
+		StackName:           stackName,
+		LifecycleType:       app.LifecycleType,
+		LifecycleBuildpacks: app.LifecycleBuildpacks,
+	}
+}
@@ -0,0 +1,274 @@
+package v7_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	v7 "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/shared"
+	"code.cloudfoundry.org/cli/command/v7/shared/sharedfakes"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("change-stack Command", func() {
+	var (
+		cmd             v7.ChangeStackCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeActor
+		fakeAppStager   *sharedfakes.FakeAppStager
+		executeErr      error
+
+		app resources.Application
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+		fakeAppStager = new(sharedfakes.FakeAppStager)
+
+		cmd = v7.ChangeStackCommand{
+			BaseCommand: v7.BaseCommand{
+				UI:          testUI,
+				Config:      fakeConfig,
+				SharedActor: fakeSharedActor,
+				Actor:       fakeActor,
+			},
+			RequiredArgs: flag.AppStack{AppName: "my-app", StackName: "cflinuxfs4"},
+			Stager:       fakeAppStager,
+		}
+
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "my-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "dev", GUID: "dev-guid"})
+		fakeActor.GetCurrentUserReturns(configv3.User{Name: "steve"}, nil)
+
+		app = resources.Application{
+			GUID:                "app-guid",
+			Name:                "my-app",
+			SpaceGUID:           "dev-guid",
+			State:               constant.ApplicationStarted,
+			StackName:           "cflinuxfs3",
+			LifecycleType:       constant.AppLifecycleTypeBuildpack,
+			LifecycleBuildpacks: []string{"java_buildpack"},
+		}
+		fakeActor.GetApplicationByNameAndSpaceReturns(app, v7action.Warnings{"app warning"}, nil)
+		fakeActor.GetCurrentDropletByApplicationReturns(resources.Droplet{GUID: "old-droplet"}, nil, nil)
+		fakeActor.GetNewestReadyPackageForApplicationReturns(resources.Package{GUID: "package-guid"}, nil, nil)
+		fakeAppStager.StageAppReturns(resources.Droplet{GUID: "new-droplet"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	It("checks the targeted space", func() {
+		checkOrg, checkSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+		Expect(checkOrg).To(BeTrue())
+		Expect(checkSpace).To(BeTrue())
+	})
+
+	When("the rolling strategy is given", func() {
+		BeforeEach(func() {
+			setFlag(&cmd, "--strategy", flag.DeploymentStrategy{Name: constant.DeploymentStrategyRolling})
+		})
+
+		It("updates the stack, stages the app and deploys the new droplet", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(testUI.Out).To(Say(`Changing stack of app my-app to cflinuxfs4 in org my-org / space dev as steve\.\.\.`))
+			Expect(testUI.Out).To(Say(`Staging app my-app on stack cflinuxfs4\.\.\.`))
+			Expect(testUI.Err).To(Say("app warning"))
+			Expect(testUI.Err).NotTo(Say("downtime"))
+
+			Expect(fakeActor.GetStackByNameArgsForCall(0)).To(Equal("cflinuxfs4"))
+			Expect(fakeActor.UpdateApplicationCallCount()).To(Equal(1))
+			Expect(fakeActor.UpdateApplicationArgsForCall(0)).To(Equal(resources.Application{
+				GUID:                "app-guid",
+				StackName:           "cflinuxfs4",
+				LifecycleType:       constant.AppLifecycleTypeBuildpack,
+				LifecycleBuildpacks: []string{"java_buildpack"},
+			}))
+
+			stagedApp, packageGUID, space := fakeAppStager.StageAppArgsForCall(0)
+			Expect(stagedApp).To(Equal(app))
+			Expect(packageGUID).To(Equal("package-guid"))
+			Expect(space).To(Equal(configv3.Space{Name: "dev", GUID: "dev-guid"}))
+
+			Expect(fakeAppStager.StartAppCallCount()).To(Equal(1))
+			startedApp, space, org, dropletGUID, opts := fakeAppStager.StartAppArgsForCall(0)
+			Expect(startedApp).To(Equal(app))
+			Expect(space).To(Equal(configv3.Space{Name: "dev", GUID: "dev-guid"}))
+			Expect(org).To(Equal(configv3.Organization{Name: "my-org"}))
+			Expect(dropletGUID).To(Equal("new-droplet"))
+			Expect(opts).To(Equal(shared.AppStartOpts{
+				AppAction: constant.ApplicationRestarting,
+				Strategy:  constant.DeploymentStrategyRolling,
+			}))
+			Expect(fakeActor.SetApplicationDropletCallCount()).To(Equal(0))
+		})
+	})
+
+	When("no strategy is given", func() {
+		It("warns about downtime and restarts the app with the new droplet", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(testUI.Err).To(Say("This action will cause app downtime."))
+
+			_, _, _, dropletGUID, opts := fakeAppStager.StartAppArgsForCall(0)
+			Expect(dropletGUID).To(Equal("new-droplet"))
+			Expect(opts).To(Equal(shared.AppStartOpts{AppAction: constant.ApplicationRestarting}))
+		})
+	})
+
+	When("the app is stopped", func() {
+		BeforeEach(func() {
+			app.State = constant.ApplicationStopped
+			fakeActor.GetApplicationByNameAndSpaceReturns(app, nil, nil)
+		})
+
+		It("only sets the new droplet", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Err).NotTo(Say("downtime"))
+			appGUID, dropletGUID := fakeActor.SetApplicationDropletArgsForCall(0)
+			Expect(appGUID).To(Equal("app-guid"))
+			Expect(dropletGUID).To(Equal("new-droplet"))
+			Expect(fakeAppStager.StartAppCallCount()).To(Equal(0))
+		})
+	})
+
+	When("staging fails", func() {
+		BeforeEach(func() {
+			fakeAppStager.StageAppReturns(resources.Droplet{}, errors.New("staging failed"))
+		})
+
+		It("moves the app back to its previous stack and droplet", func() {
+			Expect(executeErr).To(MatchError("staging failed"))
+			Expect(testUI.Err).To(Say(`Staging failed\. Rolling back app my-app to stack cflinuxfs3\.\.\.`))
+
+			Expect(fakeActor.UpdateApplicationCallCount()).To(Equal(2))
+			Expect(fakeActor.UpdateApplicationArgsForCall(1).StackName).To(Equal("cflinuxfs3"))
+			appGUID, dropletGUID := fakeActor.SetApplicationDropletArgsForCall(0)
+			Expect(appGUID).To(Equal("app-guid"))
+			Expect(dropletGUID).To(Equal("old-droplet"))
+			Expect(fakeAppStager.StartAppCallCount()).To(Equal(0))
+		})
+
+		When("the app has no previous droplet", func() {
+			BeforeEach(func() {
+				fakeActor.GetCurrentDropletByApplicationReturns(resources.Droplet{}, nil, actionerror.DropletNotFoundError{AppGUID: "app-guid"})
+			})
+
+			It("only moves the app back to its previous stack", func() {
+				Expect(executeErr).To(MatchError("staging failed"))
+				Expect(fakeActor.UpdateApplicationCallCount()).To(Equal(2))
+				Expect(fakeActor.SetApplicationDropletCallCount()).To(Equal(0))
+			})
+		})
+
+		When("rolling back fails", func() {
+			BeforeEach(func() {
+				fakeActor.UpdateApplicationReturnsOnCall(1, resources.Application{}, nil, errors.New("update failed"))
+			})
+
+			It("warns about it and returns the staging error", func() {
+				Expect(executeErr).To(MatchError("staging failed"))
+				Expect(testUI.Err).To(Say("Rollback failed: update failed"))
+				Expect(fakeActor.SetApplicationDropletCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	When("starting the app fails", func() {
+		BeforeEach(func() {
+			fakeAppStager.StartAppReturnsOnCall(0, errors.New("instances crashed"))
+		})
+
+		It("moves the app back to its previous stack and runs its previous droplet", func() {
+			Expect(executeErr).To(MatchError("instances crashed"))
+			Expect(testUI.Err).To(Say(`Starting the app failed\. Rolling back app my-app to stack cflinuxfs3\.\.\.`))
+
+			Expect(fakeActor.UpdateApplicationCallCount()).To(Equal(2))
+			Expect(fakeActor.UpdateApplicationArgsForCall(1).StackName).To(Equal("cflinuxfs3"))
+			Expect(fakeAppStager.StartAppCallCount()).To(Equal(2))
+			_, _, _, dropletGUID, opts := fakeAppStager.StartAppArgsForCall(1)
+			Expect(dropletGUID).To(Equal("old-droplet"))
+			Expect(opts).To(Equal(shared.AppStartOpts{AppAction: constant.ApplicationRestarting}))
+			Expect(fakeActor.SetApplicationDropletCallCount()).To(Equal(0))
+		})
+
+		When("running the previous droplet fails too", func() {
+			BeforeEach(func() {
+				fakeAppStager.StartAppReturnsOnCall(1, errors.New("still crashing"))
+			})
+
+			It("warns about it and returns the start error", func() {
+				Expect(executeErr).To(MatchError("instances crashed"))
+				Expect(testUI.Err).To(Say("Rollback failed: still crashing"))
+			})
+		})
+	})
+
+	When("the app is already on the stack", func() {
+		BeforeEach(func() {
+			app.StackName = "cflinuxfs4"
+			fakeActor.GetApplicationByNameAndSpaceReturns(app, nil, nil)
+		})
+
+		It("does nothing", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(testUI.Out).To(Say(`App my-app is already on stack cflinuxfs4\.`))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(fakeActor.UpdateApplicationCallCount()).To(Equal(0))
+		})
+	})
+
+	When("the app is a Docker app", func() {
+		BeforeEach(func() {
+			app.LifecycleType = constant.AppLifecycleTypeDocker
+			fakeActor.GetApplicationByNameAndSpaceReturns(app, nil, nil)
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.DockerAppHasNoStackError{AppName: "my-app"}))
+			Expect(fakeActor.UpdateApplicationCallCount()).To(Equal(0))
+		})
+	})
+
+	When("the stack does not exist", func() {
+		BeforeEach(func() {
+			fakeActor.GetStackByNameReturns(resources.Stack{}, v7action.Warnings{"stack warning"}, actionerror.StackNotFoundError{Name: "cflinuxfs4"})
+		})
+
+		It("returns the error without changing the app", func() {
+			Expect(executeErr).To(MatchError(actionerror.StackNotFoundError{Name: "cflinuxfs4"}))
+			Expect(testUI.Err).To(Say("stack warning"))
+			Expect(fakeActor.UpdateApplicationCallCount()).To(Equal(0))
+		})
+	})
+
+	When("the canary strategy is given", func() {
+		BeforeEach(func() {
+			setFlag(&cmd, "--strategy", flag.DeploymentStrategy{Name: constant.DeploymentStrategyCanary})
+		})
+
+		It("returns an incorrect usage error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.IncorrectUsageError{
+				Message: "--strategy canary is not supported when changing the stack of an app",
+			}))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+		})
+	})
+})
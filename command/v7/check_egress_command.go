@@ -0,0 +1,179 @@
+package v7
+
+import (
+	"net/netip"
+
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/util/portrange"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+type CheckEgressCommand struct {
+	BaseCommand
+
+	RequiredArgs    flag.CheckEgressArgs        `positional-args:"yes"`
+	Protocol        string                      `long:"protocol" choice:"tcp" choice:"udp" choice:"icmp" choice:"all" default:"tcp" description:"Protocol of the traffic"`
+	Port            string                      `long:"port" description:"Port or port range of the traffic, such as 5432 or 8080-8090. Required for tcp and udp"`
+	Lifecycle       flag.SecurityGroupLifecycle `long:"lifecycle" choice:"running" choice:"staging" description:"Only check this lifecycle phase. (Default: running and staging)"`
+	usage           interface{}                 `usage:"CF_NAME check-egress DESTINATION --port PORT [--protocol (tcp | udp)] [--lifecycle (running | staging)]\n   CF_NAME check-egress DESTINATION --protocol (icmp | all) [--lifecycle (running | staging)]\n\n   Checks whether the security groups of the targeted space, including the globally enabled ones, allow apps to send traffic to DESTINATION, an IP address or CIDR. A CIDR is only allowed when every address in it is.\n\nEXAMPLES:\n   CF_NAME check-egress 10.0.1.5 --port 5432\n   CF_NAME check-egress 10.0.0.0/24 --port 8080-8090 --lifecycle running\n   CF_NAME check-egress 8.8.8.8 --protocol udp --port 53"`
+	relatedCommands interface{}                 `related_commands:"bind-security-group, running-security-groups, security-group, space, staging-security-groups"`
+}
+
+func (cmd CheckEgressCommand) Execute(args []string) error {
+	check, err := cmd.egressCheck()
+	if err != nil {
+		return err
+	}
+
+	err = cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Actor.GetCurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Checking egress to {{.Destination}} ({{.Traffic}}) from org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+		"Destination": cmd.RequiredArgs.Destination,
+		"Traffic":     cmd.trafficDescription(),
+		"OrgName":     cmd.Config.TargetedOrganization().Name,
+		"SpaceName":   cmd.Config.TargetedSpace().Name,
+		"Username":    user.Name,
+	})
+	cmd.UI.DisplayNewline()
+
+	lifecycles := []constant.SecurityGroupLifecycle{constant.SecurityGroupLifecycleRunning, constant.SecurityGroupLifecycleStaging}
+	if cmd.Lifecycle != "" {
+		lifecycles = []constant.SecurityGroupLifecycle{constant.SecurityGroupLifecycle(cmd.Lifecycle)}
+	}
+
+	results, warnings, err := cmd.Actor.CheckSpaceEgress(cmd.Config.TargetedSpace().GUID, check, lifecycles)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	for i, result := range results {
+		if i > 0 {
+			cmd.UI.DisplayNewline()
+		}
+		cmd.displayEgressCheckResult(result)
+	}
+
+	return nil
+}
+
+func (cmd CheckEgressCommand) egressCheck() (v7action.EgressCheck, error) {
+	check := v7action.EgressCheck{Protocol: cmd.Protocol}
+	if check.Protocol == "" {
+		check.Protocol = "tcp"
+	}
+
+	destination, err := parseEgressDestination(cmd.RequiredArgs.Destination)
+	if err != nil {
+		return v7action.EgressCheck{}, translatableerror.ParseArgumentError{
+			ArgumentName: "DESTINATION",
+			ExpectedType: "an IP address or CIDR",
+		}
+	}
+	check.Destination = destination
+
+	switch check.Protocol {
+	case "tcp", "udp":
+		if cmd.Port == "" {
+			return v7action.EgressCheck{}, translatableerror.RequiredArgumentError{ArgumentName: "--port"}
+		}
+		check.StartPort, check.EndPort, err = portrange.Parse(cmd.Port)
+		if err != nil {
+			return v7action.EgressCheck{}, translatableerror.ParseArgumentError{
+				ArgumentName: "--port",
+				ExpectedType: "a port or port range such as 8080-8090",
+			}
+		}
+	default:
+		if cmd.Port != "" {
+			return v7action.EgressCheck{}, translatableerror.ArgumentCombinationError{
+				Args: []string{"--protocol " + check.Protocol, "--port"},
+			}
+		}
+	}
+
+	return check, nil
+}
+
+func (cmd CheckEgressCommand) trafficDescription() string {
+	switch cmd.Protocol {
+	case "icmp":
+		return "icmp"
+	case "all":
+		return cmd.UI.TranslateText("all protocols")
+	default:
+		protocol := cmd.Protocol
+		if protocol == "" {
+			protocol = "tcp"
+		}
+		return cmd.UI.TranslateText("{{.Protocol}} port {{.Port}}", map[string]interface{}{
+			"Protocol": protocol,
+			"Port":     cmd.Port,
+		})
+	}
+}
+
+func (cmd CheckEgressCommand) displayEgressCheckResult(result v7action.EgressCheckResult) {
+	if !result.Allowed() {
+		cmd.UI.DisplayText("{{.Lifecycle}}: denied", map[string]interface{}{"Lifecycle": result.Lifecycle})
+		cmd.UI.DisplayText("   No security group rule allows this traffic.")
+		return
+	}
+
+	cmd.UI.DisplayText("{{.Lifecycle}}: allowed", map[string]interface{}{"Lifecycle": result.Lifecycle})
+
+	table := [][]string{{
+		cmd.UI.TranslateText("security group"),
+		cmd.UI.TranslateText("protocol"),
+		cmd.UI.TranslateText("destination"),
+		cmd.UI.TranslateText("ports"),
+		cmd.UI.TranslateText("description"),
+	}}
+	for _, match := range result.Matches {
+		rule := match.Rule
+
+		ports := ""
+		switch {
+		case rule.Ports != nil:
+			ports = *rule.Ports
+		case rule.Type != nil && rule.Code != nil:
+			ports = cmd.UI.TranslateText("type {{.Type}} code {{.Code}}", map[string]interface{}{
+				"Type": *rule.Type,
+				"Code": *rule.Code,
+			})
+		}
+
+		description := ""
+		if rule.Description != nil {
+			description = *rule.Description
+		}
+
+		table = append(table, []string{match.SecurityGroupName, rule.Protocol, rule.Destination, ports, description})
+	}
+
+	cmd.UI.DisplayTableWithHeader("   ", table, ui.DefaultTableSpacePadding)
+}
+
+func parseEgressDestination(destination string) (netip.Prefix, error) {
+	if addr, err := netip.ParseAddr(destination); err == nil {
+		addr = addr.Unmap()
+		return netip.PrefixFrom(addr, addr.BitLen()), nil
+	}
+
+	prefix, err := netip.ParsePrefix(destination)
+	if err != nil {
+		return netip.Prefix{}, err
+	}
+	return prefix.Masked(), nil
+}
@@ -0,0 +1,191 @@
+package v7_test
+
+import (
+	"errors"
+	"net/netip"
+
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	v7 "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("check-egress Command", func() {
+	var (
+		cmd             v7.CheckEgressCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeActor
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+
+		cmd = v7.CheckEgressCommand{
+			BaseCommand: v7.BaseCommand{
+				UI:          testUI,
+				Config:      fakeConfig,
+				SharedActor: fakeSharedActor,
+				Actor:       fakeActor,
+			},
+			RequiredArgs: flag.CheckEgressArgs{Destination: "10.0.1.5"},
+		}
+		setFlag(&cmd, "--protocol", "tcp")
+		setFlag(&cmd, "--port", "5432")
+
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+		fakeActor.GetCurrentUserReturns(configv3.User{Name: "steve"}, nil)
+
+		ports := "5000-6000"
+		description := "database access"
+		fakeActor.CheckSpaceEgressReturns(
+			[]v7action.EgressCheckResult{
+				{
+					Lifecycle: constant.SecurityGroupLifecycleRunning,
+					Matches: []v7action.EgressRuleMatch{{
+						SecurityGroupName: "db",
+						Rule:              resources.Rule{Protocol: "tcp", Destination: "10.0.0.0/16", Ports: &ports, Description: &description},
+					}},
+				},
+				{Lifecycle: constant.SecurityGroupLifecycleStaging},
+			},
+			v7action.Warnings{"egress warning"},
+			nil,
+		)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	It("checks the space is targeted", func() {
+		Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+		checkOrg, checkSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+		Expect(checkOrg).To(BeTrue())
+		Expect(checkSpace).To(BeTrue())
+	})
+
+	It("checks the egress in both lifecycles and displays the matching rules", func() {
+		Expect(executeErr).NotTo(HaveOccurred())
+
+		Expect(fakeActor.CheckSpaceEgressCallCount()).To(Equal(1))
+		spaceGUID, check, lifecycles := fakeActor.CheckSpaceEgressArgsForCall(0)
+		Expect(spaceGUID).To(Equal("some-space-guid"))
+		Expect(check).To(Equal(v7action.EgressCheck{
+			Destination: netip.MustParsePrefix("10.0.1.5/32"),
+			Protocol:    "tcp",
+			StartPort:   5432,
+			EndPort:     5432,
+		}))
+		Expect(lifecycles).To(Equal([]constant.SecurityGroupLifecycle{constant.SecurityGroupLifecycleRunning, constant.SecurityGroupLifecycleStaging}))
+
+		Expect(testUI.Out).To(Say(`Checking egress to 10\.0\.1\.5 \(tcp port 5432\) from org some-org / space some-space as steve\.\.\.`))
+		Expect(testUI.Out).To(Say(`running: allowed`))
+		Expect(testUI.Out).To(Say(`security group\s+protocol\s+destination\s+ports\s+description`))
+		Expect(testUI.Out).To(Say(`db\s+tcp\s+10\.0\.0\.0/16\s+5000-6000\s+database access`))
+		Expect(testUI.Out).To(Say(`staging: denied`))
+		Expect(testUI.Out).To(Say(`No security group rule allows this traffic\.`))
+		Expect(testUI.Err).To(Say("egress warning"))
+	})
+
+	When("a CIDR, port range and lifecycle are given", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.Destination = "10.0.1.7/24"
+			setFlag(&cmd, "--port", "8080-8090")
+			setFlag(&cmd, "--lifecycle", flag.SecurityGroupLifecycle("staging"))
+		})
+
+		It("checks the whole range in that lifecycle", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			_, check, lifecycles := fakeActor.CheckSpaceEgressArgsForCall(0)
+			Expect(check.Destination).To(Equal(netip.MustParsePrefix("10.0.1.0/24")))
+			Expect(check.StartPort).To(Equal(8080))
+			Expect(check.EndPort).To(Equal(8090))
+			Expect(lifecycles).To(Equal([]constant.SecurityGroupLifecycle{constant.SecurityGroupLifecycleStaging}))
+		})
+	})
+
+	When("the protocol is icmp", func() {
+		BeforeEach(func() {
+			setFlag(&cmd, "--protocol", "icmp")
+			setFlag(&cmd, "--port", "")
+		})
+
+		It("checks without ports", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			_, check, _ := fakeActor.CheckSpaceEgressArgsForCall(0)
+			Expect(check.Protocol).To(Equal("icmp"))
+			Expect(check.StartPort).To(Equal(0))
+			Expect(testUI.Out).To(Say(`Checking egress to 10\.0\.1\.5 \(icmp\)`))
+		})
+
+		When("a port is given as well", func() {
+			BeforeEach(func() {
+				setFlag(&cmd, "--port", "80")
+			})
+
+			It("returns an argument combination error", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{Args: []string{"--protocol icmp", "--port"}}))
+				Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	When("no port is given for tcp", func() {
+		BeforeEach(func() {
+			setFlag(&cmd, "--port", "")
+		})
+
+		It("returns a required argument error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.RequiredArgumentError{ArgumentName: "--port"}))
+		})
+	})
+
+	DescribeTable("invalid arguments",
+		func(destination string, port string, expectedErr error) {
+			cmd.RequiredArgs.Destination = destination
+			setFlag(&cmd, "--port", port)
+			Expect(cmd.Execute(nil)).To(MatchError(expectedErr))
+		},
+		Entry("a host name", "db.example.com", "5432", translatableerror.ParseArgumentError{ArgumentName: "DESTINATION", ExpectedType: "an IP address or CIDR"}),
+		Entry("a port out of range", "10.0.0.1", "70000", translatableerror.ParseArgumentError{ArgumentName: "--port", ExpectedType: "a port or port range such as 8080-8090"}),
+		Entry("a reversed port range", "10.0.0.1", "90-80", translatableerror.ParseArgumentError{ArgumentName: "--port", ExpectedType: "a port or port range such as 8080-8090"}),
+	)
+
+	When("checking the egress fails", func() {
+		BeforeEach(func() {
+			fakeActor.CheckSpaceEgressReturns(nil, v7action.Warnings{"egress warning"}, errors.New("boom"))
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError("boom"))
+			Expect(testUI.Err).To(Say("egress warning"))
+		})
+	})
+
+	When("checking the target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(errors.New("not targeted"))
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError("not targeted"))
+			Expect(fakeActor.CheckSpaceEgressCallCount()).To(Equal(0))
+		})
+	})
+})
@@ -0,0 +1,212 @@
+package v7
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/api/logcache"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+const defaultCrashesWindow = 24 * time.Hour
+
+type CrashesCommand struct {
+	BaseCommand
+
+	RequiredArgs    flag.AppName   `positional-args:"yes"`
+	Since           flag.Timestamp `long:"since" description:"Only analyze crashes after this time, given as a date, a time or a length of time ago (e.g. 2024-03-05, 2024-03-05T14:00:00Z, 7d). Default: 24h"`
+	Lines           int            `long:"lines" short:"n" default:"10" description:"Number of log lines to display before each crash; 0 to skip logs"`
+	usage           interface{}    `usage:"CF_NAME crashes APP_NAME [--since TIME] [-n LINES]\n\nEXAMPLES:\n   CF_NAME crashes my-app\n   CF_NAME crashes my-app --since 7d -n 20"`
+	relatedCommands interface{}    `related_commands:"app, events, logs, revisions"`
+
+	LogCacheClient sharedaction.LogCacheClient
+}
+
+type crashGroup struct {
+	instance        string
+	category        v7action.CrashCategory
+	count           int
+	lastCrash       time.Time
+	exitDescription string
+}
+
+func (cmd *CrashesCommand) Setup(config command.Config, ui command.UI) error {
+	err := cmd.BaseCommand.Setup(config, ui)
+	if err != nil {
+		return err
+	}
+
+	cmd.LogCacheClient, err = logcache.NewClient(config.LogCacheEndpoint(), config, ui, v7action.NewDefaultKubernetesConfigGetter())
+	return err
+}
+
+func (cmd CrashesCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Actor.GetCurrentUser()
+	if err != nil {
+		return err
+	}
+
+	since := time.Now().Add(-defaultCrashesWindow)
+	if cmd.Since.IsSet {
+		since = cmd.Since.Value
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Analyzing crashes of app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+		"AppName":   cmd.RequiredArgs.AppName,
+		"OrgName":   cmd.Config.TargetedOrganization().Name,
+		"SpaceName": cmd.Config.TargetedSpace().Name,
+		"Username":  user.Name,
+	})
+	cmd.UI.DisplayNewline()
+
+	app, warnings, err := cmd.Actor.GetApplicationByNameAndSpace(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	crashes, warnings, err := cmd.Actor.GetApplicationCrashes(app.GUID, since, cmd.Lines, cmd.LogCacheClient)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	if len(crashes) == 0 {
+		cmd.UI.DisplayText("No crashes found since {{.Since}}.", map[string]interface{}{
+			"Since": formatCrashTime(since),
+		})
+		return nil
+	}
+
+	cmd.UI.DisplayText("{{.Count}} crashes since {{.Since}}", map[string]interface{}{
+		"Count": len(crashes),
+		"Since": formatCrashTime(since),
+	})
+	cmd.UI.DisplayNewline()
+
+	cmd.displayCrashGroups(crashes)
+	cmd.UI.DisplayNewline()
+	cmd.displayCrashesByRevision(crashes)
+
+	for _, crash := range crashes {
+		cmd.UI.DisplayNewline()
+		cmd.displayCrash(crash)
+	}
+
+	return nil
+}
+
+func (cmd CrashesCommand) displayCrashGroups(crashes []v7action.AppCrash) {
+	var groups []*crashGroup
+	groupsByKey := map[string]*crashGroup{}
+
+	for _, crash := range crashes {
+		instance := crashInstanceName(crash)
+		key := instance + "/" + string(crash.Category)
+
+		group, ok := groupsByKey[key]
+		if !ok {
+			group = &crashGroup{instance: instance, category: crash.Category}
+			groupsByKey[key] = group
+			groups = append(groups, group)
+		}
+
+		group.count++
+		group.lastCrash = crash.Time
+		group.exitDescription = crash.ExitDescription
+	}
+
+	table := [][]string{
+		{
+			cmd.UI.TranslateText("instance"),
+			cmd.UI.TranslateText("reason"),
+			cmd.UI.TranslateText("crashes"),
+			cmd.UI.TranslateText("last crash"),
+			cmd.UI.TranslateText("last exit description"),
+		},
+	}
+	for _, group := range groups {
+		table = append(table, []string{
+			group.instance,
+			string(group.category),
+			strconv.Itoa(group.count),
+			formatCrashTime(group.lastCrash),
+			group.exitDescription,
+		})
+	}
+
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+}
+
+func (cmd CrashesCommand) displayCrashesByRevision(crashes []v7action.AppCrash) {
+	var versions []int
+	counts := map[int]int{}
+	for _, crash := range crashes {
+		if _, ok := counts[crash.RevisionVersion]; !ok {
+			versions = append(versions, crash.RevisionVersion)
+		}
+		counts[crash.RevisionVersion]++
+	}
+
+	table := [][]string{
+		{
+			cmd.UI.TranslateText("revision"),
+			cmd.UI.TranslateText("crashes"),
+		},
+	}
+	for _, version := range versions {
+		table = append(table, []string{
+			formatCrashRevision(version),
+			strconv.Itoa(counts[version]),
+		})
+	}
+
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+}
+
+func (cmd CrashesCommand) displayCrash(crash v7action.AppCrash) {
+	cmd.UI.DisplayTextWithBold("{{.Time}}   {{.Instance}}   {{.Category}}   revision {{.Revision}}", map[string]interface{}{
+		"Time":     formatCrashTime(crash.Time),
+		"Instance": crashInstanceName(crash),
+		"Category": crash.Category,
+		"Revision": formatCrashRevision(crash.RevisionVersion),
+	})
+
+	if crash.ExitDescription != "" {
+		cmd.UI.DisplayText("   {{.ExitDescription}}", map[string]interface{}{
+			"ExitDescription": crash.ExitDescription,
+		})
+	}
+
+	for _, log := range crash.Logs {
+		cmd.UI.DisplayLogMessage(log, true)
+	}
+}
+
+func crashInstanceName(crash v7action.AppCrash) string {
+	if crash.ProcessType == "" {
+		return fmt.Sprintf("#%d", crash.Index)
+	}
+	return fmt.Sprintf("%s #%d", crash.ProcessType, crash.Index)
+}
+
+func formatCrashRevision(version int) string {
+	if version == 0 {
+		return "unknown"
+	}
+	return strconv.Itoa(version)
+}
+
+func formatCrashTime(t time.Time) string {
+	return t.Local().Format("2006-01-02T15:04:05.00-0700")
+}
@@ -0,0 +1,185 @@
+package v7_test
+
+import (
+	"errors"
+	"regexp"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/sharedaction/sharedactionfakes"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	. "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("crashes Command", func() {
+	var (
+		cmd                CrashesCommand
+		testUI             *ui.UI
+		fakeConfig         *commandfakes.FakeConfig
+		fakeSharedActor    *commandfakes.FakeSharedActor
+		fakeActor          *v7fakes.FakeActor
+		fakeLogCacheClient *sharedactionfakes.FakeLogCacheClient
+		binaryName         string
+		executeErr         error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+		fakeLogCacheClient = new(sharedactionfakes.FakeLogCacheClient)
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+
+		cmd = CrashesCommand{
+			RequiredArgs: flag.AppName{AppName: "some-app"},
+			Lines:        10,
+			BaseCommand: BaseCommand{
+				UI:          testUI,
+				Config:      fakeConfig,
+				SharedActor: fakeSharedActor,
+				Actor:       fakeActor,
+			},
+			LogCacheClient: fakeLogCacheClient,
+		}
+
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+		fakeActor.GetCurrentUserReturns(configv3.User{Name: "steve"}, nil)
+		fakeActor.GetApplicationByNameAndSpaceReturns(resources.Application{GUID: "some-app-guid"}, v7action.Warnings{"app-warning"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NoOrganizationTargetedError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NoOrganizationTargetedError{BinaryName: binaryName}))
+
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	When("getting the app fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetApplicationByNameAndSpaceReturns(resources.Application{}, v7action.Warnings{"app-warning"}, actionerror.ApplicationNotFoundError{Name: "some-app"})
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError(actionerror.ApplicationNotFoundError{Name: "some-app"}))
+			Expect(testUI.Err).To(Say("app-warning"))
+			Expect(fakeActor.GetApplicationCrashesCallCount()).To(Equal(0))
+		})
+	})
+
+	When("getting the crashes fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetApplicationCrashesReturns(nil, v7action.Warnings{"crashes-warning"}, errors.New("crashes-error"))
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError("crashes-error"))
+			Expect(testUI.Err).To(Say("crashes-warning"))
+		})
+	})
+
+	When("there are no crashes", func() {
+		It("analyzes the last 24 hours and says so", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say(`Analyzing crashes of app some-app in org some-org / space some-space as steve\.\.\.`))
+			Expect(testUI.Out).To(Say(`No crashes found since`))
+
+			Expect(fakeActor.GetApplicationCrashesCallCount()).To(Equal(1))
+			appGUID, since, lines, client := fakeActor.GetApplicationCrashesArgsForCall(0)
+			Expect(appGUID).To(Equal("some-app-guid"))
+			Expect(since).To(BeTemporally("~", time.Now().Add(-24*time.Hour), time.Minute))
+			Expect(lines).To(Equal(10))
+			Expect(client).To(Equal(fakeLogCacheClient))
+		})
+	})
+
+	When("there are crashes", func() {
+		var firstCrash, secondCrash, thirdCrash time.Time
+
+		BeforeEach(func() {
+			cmd.Since = flag.Timestamp{Value: time.Date(2024, 3, 5, 0, 0, 0, 0, time.UTC), IsSet: true}
+
+			firstCrash = time.Date(2024, 3, 5, 10, 0, 0, 0, time.UTC)
+			secondCrash = time.Date(2024, 3, 5, 11, 0, 0, 0, time.UTC)
+			thirdCrash = time.Date(2024, 3, 5, 12, 0, 0, 0, time.UTC)
+
+			fakeActor.GetApplicationCrashesReturns([]v7action.AppCrash{
+				{
+					Time:            firstCrash,
+					ProcessType:     "web",
+					Index:           0,
+					ExitDescription: "APP/PROC/WEB: Exited with status 137 (out of memory)",
+					Category:        v7action.CrashCategoryOutOfMemory,
+					RevisionVersion: 3,
+					Logs: []sharedaction.LogMessage{
+						*sharedaction.NewLogMessage("allocating a lot", "OUT", firstCrash, "APP/PROC/WEB", "0"),
+					},
+				},
+				{
+					Time:            secondCrash,
+					ProcessType:     "web",
+					Index:           0,
+					ExitDescription: "APP/PROC/WEB: Exited with status 137 (out of memory)",
+					Category:        v7action.CrashCategoryOutOfMemory,
+					RevisionVersion: 3,
+				},
+				{
+					Time:            thirdCrash,
+					Index:           1,
+					ExitDescription: "Instance never healthy after 1m0s",
+					Category:        v7action.CrashCategoryHealthCheck,
+					RevisionVersion: 4,
+				},
+			}, v7action.Warnings{"crashes-warning"}, nil)
+		})
+
+		It("displays the crashes grouped by instance, reason and revision", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say(`3 crashes since %s`, regexp.QuoteMeta(time.Date(2024, 3, 5, 0, 0, 0, 0, time.UTC).Local().Format("2006-01-02T15:04:05.00-0700"))))
+
+			Expect(testUI.Out).To(Say(`instance\s+reason\s+crashes\s+last crash\s+last exit description`))
+			Expect(testUI.Out).To(Say(`web #0\s+out of memory\s+2\s+%s\s+APP/PROC/WEB: Exited with status 137 \(out of memory\)`, regexp.QuoteMeta(secondCrash.Local().Format("2006-01-02T15:04:05.00-0700"))))
+			Expect(testUI.Out).To(Say(`#1\s+health check failure\s+1\s+`))
+
+			Expect(testUI.Out).To(Say(`revision\s+crashes`))
+			Expect(testUI.Out).To(Say(`3\s+2`))
+			Expect(testUI.Out).To(Say(`4\s+1`))
+
+			Expect(testUI.Out).To(Say(`%s\s+web #0\s+out of memory\s+revision 3`, regexp.QuoteMeta(firstCrash.Local().Format("2006-01-02T15:04:05.00-0700"))))
+			Expect(testUI.Out).To(Say(`APP/PROC/WEB: Exited with status 137 \(out of memory\)`))
+			Expect(testUI.Out).To(Say(`\[APP/PROC/WEB/0\] OUT allocating a lot`))
+			Expect(testUI.Out).To(Say(`#1\s+health check failure\s+revision 4`))
+
+			Expect(testUI.Err).To(Say("app-warning"))
+			Expect(testUI.Err).To(Say("crashes-warning"))
+
+			_, since, _, _ := fakeActor.GetApplicationCrashesArgsForCall(0)
+			Expect(since).To(Equal(time.Date(2024, 3, 5, 0, 0, 0, 0, time.UTC)))
+		})
+	})
+})
@@ -0,0 +1,17 @@
+package v7
+
+import (
+	"code.cloudfoundry.org/cli/command/flag"
+)
+
+type DisableAppFeatureCommand struct {
+	BaseCommand
+
+	RequiredArgs    flag.AppFeatureArgs `positional-args:"yes"`
+	usage           interface{}         `usage:"CF_NAME disable-app-feature APP_NAME FEATURE_NAME\n\nEXAMPLES:\n   CF_NAME disable-app-feature my-app revisions"`
+	relatedCommands interface{}         `related_commands:"app-features, enable-app-feature, restart"`
+}
+
+func (cmd DisableAppFeatureCommand) Execute(args []string) error {
+	return updateAppFeature(cmd.BaseCommand, cmd.RequiredArgs, false)
+}
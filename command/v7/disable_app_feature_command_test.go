@@ -0,0 +1,97 @@
+package v7_test
+
+import (
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	. "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("disable-app-feature Command", func() {
+	var (
+		cmd             DisableAppFeatureCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+
+		cmd = DisableAppFeatureCommand{
+			RequiredArgs: flag.AppFeatureArgs{AppName: "some-app", Feature: "file-based-vcap-services"},
+			BaseCommand: BaseCommand{
+				UI:          testUI,
+				Config:      fakeConfig,
+				SharedActor: fakeSharedActor,
+				Actor:       fakeActor,
+			},
+		}
+
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+		fakeActor.GetCurrentUserReturns(configv3.User{Name: "steve"}, nil)
+		fakeActor.GetApplicationByNameAndSpaceReturns(resources.Application{GUID: "some-app-guid"}, nil, nil)
+		fakeActor.GetAppFeatureReturns(resources.ApplicationFeature{Name: "file-based-vcap-services", Enabled: true}, nil, nil)
+		fakeActor.UpdateAppFeatureReturns(v7action.Warnings{"update-feature-warning"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: binaryName}))
+		})
+	})
+
+	When("the feature is enabled", func() {
+		It("disables the feature", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say(`Disabling feature file-based-vcap-services for app some-app in org some-org / space some-space as steve\.\.\.`))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Err).To(Say("update-feature-warning"))
+
+			app, enabled, featureName := fakeActor.UpdateAppFeatureArgsForCall(0)
+			Expect(app.GUID).To(Equal("some-app-guid"))
+			Expect(enabled).To(BeFalse())
+			Expect(featureName).To(Equal("file-based-vcap-services"))
+		})
+	})
+
+	When("the feature is already disabled", func() {
+		BeforeEach(func() {
+			fakeActor.GetAppFeatureReturns(resources.ApplicationFeature{Name: "file-based-vcap-services", Enabled: false}, nil, nil)
+		})
+
+		It("does not update the feature", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("Feature file-based-vcap-services is already disabled for app some-app."))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(fakeActor.UpdateAppFeatureCallCount()).To(Equal(0))
+		})
+	})
+})
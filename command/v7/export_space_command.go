@@ -0,0 +1,100 @@
+package v7
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/cli/actor/cfnetworkingaction"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v7/shared"
+	"gopkg.in/yaml.v2"
+)
+
+type ExportSpaceCommand struct {
+	BaseCommand
+
+	FilePath           flag.Path   `short:"p" description:"Specify a path for file creation. If path not specified, export file is created in current working directory."`
+	IncludeCredentials bool        `long:"include-credentials" description:"Include the credentials of user-provided service instances"`
+	usage              interface{} `usage:"CF_NAME export-space [-p /path/to/<space-name>_export.yml] [--include-credentials]\n\n   The export contains the app manifests, routes, service instances, network policies, space roles, space quota, labels and annotations of the targeted space.\n\nEXAMPLES:\n   CF_NAME export-space\n   CF_NAME export-space -p backup.yml --include-credentials"`
+	relatedCommands    interface{} `related_commands:"create-app-manifest, import-space, network-policies"`
+
+	NetworkingActor NetworkPoliciesActor
+	PWD             string
+}
+
+func (cmd *ExportSpaceCommand) Setup(config command.Config, ui command.UI) error {
+	err := cmd.BaseCommand.Setup(config, ui)
+	if err != nil {
+		return err
+	}
+
+	ccClient, uaaClient := cmd.BaseCommand.GetClients()
+
+	networkingClient, err := shared.NewNetworkingClient(config.NetworkPolicyV1Endpoint(), config, uaaClient, ui)
+	if err != nil {
+		return err
+	}
+	cmd.NetworkingActor = cfnetworkingaction.NewActor(networkingClient, ccClient)
+
+	cmd.PWD, err = os.Getwd()
+	return err
+}
+
+func (cmd ExportSpaceCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Actor.GetCurrentUser()
+	if err != nil {
+		return err
+	}
+
+	org := cmd.Config.TargetedOrganization()
+	space := cmd.Config.TargetedSpace()
+	cmd.UI.DisplayTextWithFlavor("Exporting space {{.SpaceName}} in org {{.OrgName}} as {{.Username}}...", map[string]interface{}{
+		"SpaceName": space.Name,
+		"OrgName":   org.Name,
+		"Username":  user.Name,
+	})
+
+	export, warnings, err := cmd.Actor.GetSpaceExport(space.GUID, org.Name, cmd.IncludeCredentials, cmd.NetworkingActor)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	exportBytes, err := yaml.Marshal(export)
+	if err != nil {
+		return err
+	}
+
+	var pathToYAMLFile string
+	if len(cmd.FilePath) > 0 {
+		pathToYAMLFile = cmd.FilePath.String()
+	} else {
+		pathToYAMLFile = filepath.Join(cmd.PWD, fmt.Sprintf("%s_export.yml", space.Name))
+	}
+
+	err = os.WriteFile(pathToYAMLFile, exportBytes, 0600)
+	if err != nil {
+		return translatableerror.FileCreationError{Err: err}
+	}
+
+	cmd.UI.DisplayText("Space export created successfully at {{.FilePath}}", map[string]interface{}{
+		"FilePath": pathToYAMLFile,
+	})
+	for _, instance := range export.ServiceInstances {
+		if instance.CredentialsRedacted {
+			cmd.UI.DisplayWarning("Credentials of user-provided service instances are not included. Use '--include-credentials' to include them.")
+			break
+		}
+	}
+	cmd.UI.DisplayOK()
+
+	return nil
+}
@@ -0,0 +1,183 @@
+package v7_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"regexp"
+
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	v7 "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("export-space Command", func() {
+	var (
+		cmd                 v7.ExportSpaceCommand
+		testUI              *ui.UI
+		fakeConfig          *commandfakes.FakeConfig
+		fakeSharedActor     *commandfakes.FakeSharedActor
+		fakeActor           *v7fakes.FakeActor
+		fakeNetworkingActor *v7fakes.FakeNetworkPoliciesActor
+		tempDir             string
+		executeErr          error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+		fakeNetworkingActor = new(v7fakes.FakeNetworkPoliciesActor)
+		tempDir = GinkgoT().TempDir()
+
+		cmd = v7.ExportSpaceCommand{
+			BaseCommand: v7.BaseCommand{
+				UI:          testUI,
+				Config:      fakeConfig,
+				SharedActor: fakeSharedActor,
+				Actor:       fakeActor,
+			},
+			NetworkingActor: fakeNetworkingActor,
+			PWD:             tempDir,
+		}
+
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org", GUID: "some-org-guid"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+		fakeActor.GetCurrentUserReturns(configv3.User{Name: "steve"}, nil)
+
+		fakeActor.GetSpaceExportReturns(
+			v7action.SpaceExport{
+				Org:   "some-org",
+				Space: "some-space",
+				Quota: "big",
+				ServiceInstances: []v7action.SpaceExportServiceInstance{
+					{Name: "creds", Type: "user-provided", CredentialsRedacted: true},
+				},
+				NetworkPolicies: []v7action.SpaceExportNetworkPolicy{
+					{Source: "web", Destination: "api", Protocol: "tcp", StartPort: 8080, EndPort: 8080},
+					{Source: "web", Destination: "db", DestinationSpace: "data", DestinationOrg: "some-org", Protocol: "tcp", StartPort: 5432, EndPort: 5432},
+				},
+			},
+			v7action.Warnings{"export warning"},
+			nil,
+		)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	It("checks the space is targeted", func() {
+		Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+		checkOrg, checkSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+		Expect(checkOrg).To(BeTrue())
+		Expect(checkSpace).To(BeTrue())
+	})
+
+	It("exports the space", func() {
+		Expect(fakeActor.GetSpaceExportCallCount()).To(Equal(1))
+		spaceGUID, orgName, includeCredentials, networking := fakeActor.GetSpaceExportArgsForCall(0)
+		Expect(spaceGUID).To(Equal("some-space-guid"))
+		Expect(orgName).To(Equal("some-org"))
+		Expect(includeCredentials).To(BeFalse())
+		Expect(networking).To(Equal(fakeNetworkingActor))
+	})
+
+	It("writes the export to a file in the current directory", func() {
+		Expect(executeErr).NotTo(HaveOccurred())
+
+		exportPath := filepath.Join(tempDir, "some-space_export.yml")
+		Expect(testUI.Out).To(Say(`Exporting space some-space in org some-org as steve\.\.\.`))
+		Expect(testUI.Out).To(Say(`Space export created successfully at %s`, regexp.QuoteMeta(exportPath)))
+		Expect(testUI.Out).To(Say("OK"))
+		Expect(testUI.Err).To(Say("export warning"))
+		Expect(testUI.Err).To(Say(`Credentials of user-provided service instances are not included\. Use '--include-credentials' to include them\.`))
+
+		contents, err := os.ReadFile(exportPath)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(contents)).To(Equal(`org: some-org
+space: some-space
+quota: big
+service_instances:
+- name: creds
+  type: user-provided
+  credentials_redacted: true
+network_policies:
+- source: web
+  destination: api
+  protocol: tcp
+  start_port: 8080
+  end_port: 8080
+- source: web
+  destination: db
+  destination_space: data
+  destination_org: some-org
+  protocol: tcp
+  start_port: 5432
+  end_port: 5432
+`))
+	})
+
+	When("a path and --include-credentials are given", func() {
+		var exportPath string
+
+		BeforeEach(func() {
+			exportPath = filepath.Join(tempDir, "backup.yml")
+			setFlag(&cmd, "-p", flag.Path(exportPath))
+			setFlag(&cmd, "--include-credentials")
+
+			fakeActor.GetSpaceExportReturns(v7action.SpaceExport{Space: "some-space"}, nil, nil)
+		})
+
+		It("writes the export including credentials to the path", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+
+			_, _, includeCredentials, _ := fakeActor.GetSpaceExportArgsForCall(0)
+			Expect(includeCredentials).To(BeTrue())
+
+			Expect(exportPath).To(BeAnExistingFile())
+			Expect(testUI.Err).NotTo(Say("Credentials of user-provided service instances"))
+		})
+	})
+
+	When("the file cannot be written", func() {
+		BeforeEach(func() {
+			setFlag(&cmd, "-p", flag.Path(filepath.Join(tempDir, "missing", "backup.yml")))
+		})
+
+		It("returns a file creation error", func() {
+			Expect(executeErr).To(BeAssignableToTypeOf(translatableerror.FileCreationError{}))
+		})
+	})
+
+	When("exporting the space fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetSpaceExportReturns(v7action.SpaceExport{}, v7action.Warnings{"export warning"}, errors.New("boom"))
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError("boom"))
+			Expect(testUI.Err).To(Say("export warning"))
+		})
+	})
+
+	When("checking the target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(errors.New("not targeted"))
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError("not targeted"))
+			Expect(fakeActor.GetSpaceExportCallCount()).To(Equal(0))
+		})
+	})
+})
@@ -0,0 +1,141 @@
+package v7
+
+import (
+	"os"
+
+	"code.cloudfoundry.org/cli/actor/cfnetworkingaction"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v7/shared"
+	"code.cloudfoundry.org/cli/util/ui"
+	"gopkg.in/yaml.v2"
+)
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . ImportSpaceNetworkingActor
+
+type ImportSpaceNetworkingActor interface {
+	AddNetworkPolicy(srcSpaceGUID string, srcAppName string, destSpaceGUID string, destAppName string, protocol string, startPort int, endPort int) (cfnetworkingaction.Warnings, error)
+	NetworkPoliciesBySpace(spaceGUID string) ([]cfnetworkingaction.Policy, cfnetworkingaction.Warnings, error)
+}
+
+type ImportSpaceCommand struct {
+	BaseCommand
+
+	PathToExport    flag.PathWithExistenceCheck `short:"f" required:"true" description:"Path to a file created by export-space"`
+	DryRun          bool                        `long:"dry-run" description:"Only display the changes the import would make"`
+	Force           bool                        `long:"force" description:"Import without confirmation"`
+	usage           interface{}                 `usage:"CF_NAME import-space -f EXPORT_FILE [--dry-run] [--force]\n\n   Brings the targeted space in line with the export. Missing resources are created and differing ones are updated; nothing is deleted. Apps that did not exist are created without source code and need to be pushed.\n\nEXAMPLES:\n   CF_NAME import-space -f my-space_export.yml --dry-run\n   CF_NAME import-space -f my-space_export.yml --force"`
+	relatedCommands interface{}                 `related_commands:"apply-manifest, export-space, push"`
+
+	NetworkingActor ImportSpaceNetworkingActor
+}
+
+func (cmd *ImportSpaceCommand) Setup(config command.Config, ui command.UI) error {
+	err := cmd.BaseCommand.Setup(config, ui)
+	if err != nil {
+		return err
+	}
+
+	ccClient, uaaClient := cmd.BaseCommand.GetClients()
+
+	networkingClient, err := shared.NewNetworkingClient(config.NetworkPolicyV1Endpoint(), config, uaaClient, ui)
+	if err != nil {
+		return err
+	}
+	cmd.NetworkingActor = cfnetworkingaction.NewActor(networkingClient, ccClient)
+
+	return nil
+}
+
+func (cmd ImportSpaceCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Actor.GetCurrentUser()
+	if err != nil {
+		return err
+	}
+
+	rawExport, err := os.ReadFile(string(cmd.PathToExport))
+	if err != nil {
+		return err
+	}
+	var export v7action.SpaceExport
+	if err := yaml.Unmarshal(rawExport, &export); err != nil {
+		return err
+	}
+
+	org := cmd.Config.TargetedOrganization()
+	space := cmd.Config.TargetedSpace()
+	cmd.UI.DisplayTextWithFlavor("Importing {{.Path}} into org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+		"Path":      string(cmd.PathToExport),
+		"OrgName":   org.Name,
+		"SpaceName": space.Name,
+		"Username":  user.Name,
+	})
+	cmd.UI.DisplayNewline()
+
+	plan, warnings, err := cmd.Actor.PlanSpaceImport(export, space.GUID, org.GUID, cmd.NetworkingActor)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	if len(plan.Changes) == 0 {
+		cmd.UI.DisplayText("The space already matches the export.")
+		cmd.UI.DisplayOK()
+		return nil
+	}
+
+	cmd.displayChanges(plan.Changes)
+	cmd.UI.DisplayNewline()
+
+	if cmd.DryRun {
+		cmd.UI.DisplayText("{{.Count}} changes would be made.", map[string]interface{}{"Count": len(plan.Changes)})
+		return nil
+	}
+
+	if !cmd.Force {
+		importChanges, err := cmd.UI.DisplayBoolPrompt(false, "Really import these changes into space {{.SpaceName}}?", map[string]interface{}{
+			"SpaceName": space.Name,
+		})
+		if err != nil {
+			return err
+		}
+		if !importChanges {
+			cmd.UI.DisplayText("Import cancelled")
+			return nil
+		}
+	}
+
+	warnings, err = cmd.Actor.ApplySpaceImport(plan)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+	return nil
+}
+
+func (cmd ImportSpaceCommand) displayChanges(changes []v7action.SpaceImportChange) {
+	table := [][]string{{
+		cmd.UI.TranslateText("change"),
+		cmd.UI.TranslateText("resource"),
+		cmd.UI.TranslateText("name"),
+		cmd.UI.TranslateText("details"),
+	}}
+	for _, change := range changes {
+		table = append(table, []string{
+			cmd.UI.TranslateText(string(change.Type)),
+			cmd.UI.TranslateText(change.Resource),
+			change.Name,
+			change.Detail,
+		})
+	}
+
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+}
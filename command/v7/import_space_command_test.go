@@ -0,0 +1,221 @@
+package v7_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	v7 "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("import-space Command", func() {
+	var (
+		cmd                 v7.ImportSpaceCommand
+		testUI              *ui.UI
+		input               *Buffer
+		fakeConfig          *commandfakes.FakeConfig
+		fakeSharedActor     *commandfakes.FakeSharedActor
+		fakeActor           *v7fakes.FakeActor
+		fakeNetworkingActor *v7fakes.FakeImportSpaceNetworkingActor
+		exportPath          string
+		plan                v7action.SpaceImportPlan
+		executeErr          error
+	)
+
+	BeforeEach(func() {
+		input = NewBuffer()
+		testUI = ui.NewTestUI(input, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+		fakeNetworkingActor = new(v7fakes.FakeImportSpaceNetworkingActor)
+
+		cmd = v7.ImportSpaceCommand{
+			BaseCommand: v7.BaseCommand{
+				UI:          testUI,
+				Config:      fakeConfig,
+				SharedActor: fakeSharedActor,
+				Actor:       fakeActor,
+			},
+			NetworkingActor: fakeNetworkingActor,
+		}
+
+		exportPath = filepath.Join(GinkgoT().TempDir(), "export.yml")
+		Expect(os.WriteFile(exportPath, []byte(`space: other-space
+quota: big
+network_policies:
+- source: web
+  destination: db
+  destination_space: data
+  destination_org: data-org
+  protocol: tcp
+  start_port: 5432
+  end_port: 5433
+`), 0600)).To(Succeed())
+		setFlag(&cmd, "-f", flag.PathWithExistenceCheck(exportPath))
+
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org", GUID: "some-org-guid"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+		fakeActor.GetCurrentUserReturns(configv3.User{Name: "steve"}, nil)
+
+		plan = v7action.SpaceImportPlan{Changes: []v7action.SpaceImportChange{
+			{Type: v7action.SpaceImportAdded, Resource: "quota", Name: "big"},
+			{Type: v7action.SpaceImportUpdated, Resource: "label", Name: "env", Detail: "dev -> prod"},
+			{Type: v7action.SpaceImportAdded, Resource: "network policy", Name: "web -> db", Detail: "tcp 5432-5433"},
+		}}
+		fakeActor.PlanSpaceImportReturns(plan, v7action.Warnings{"plan warning"}, nil)
+		fakeActor.ApplySpaceImportReturns(v7action.Warnings{"apply warning"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	It("checks the space is targeted", func() {
+		Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+		checkOrg, checkSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+		Expect(checkOrg).To(BeTrue())
+		Expect(checkSpace).To(BeTrue())
+	})
+
+	It("displays the changes the import would make", func() {
+		Expect(testUI.Out).To(Say(`Importing %s into org some-org / space some-space as steve\.\.\.`, exportPath))
+		Expect(testUI.Out).To(Say(`change\s+resource\s+name\s+details`))
+		Expect(testUI.Out).To(Say(`add\s+quota\s+big`))
+		Expect(testUI.Out).To(Say(`update\s+label\s+env\s+dev -> prod`))
+		Expect(testUI.Out).To(Say(`add\s+network policy\s+web -> db\s+tcp 5432-5433`))
+		Expect(testUI.Out).To(Say(`Really import these changes into space some-space\?`))
+		Expect(testUI.Err).To(Say("plan warning"))
+
+		Expect(fakeActor.PlanSpaceImportCallCount()).To(Equal(1))
+		export, spaceGUID, orgGUID, networking := fakeActor.PlanSpaceImportArgsForCall(0)
+		Expect(export.Space).To(Equal("other-space"))
+		Expect(export.Quota).To(Equal("big"))
+		Expect(export.NetworkPolicies).To(HaveLen(1))
+		Expect(spaceGUID).To(Equal("some-space-guid"))
+		Expect(orgGUID).To(Equal("some-org-guid"))
+		Expect(networking).To(Equal(fakeNetworkingActor))
+	})
+
+	When("the user confirms", func() {
+		BeforeEach(func() {
+			_, err := input.Write([]byte("y\n"))
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("applies the plan that was displayed", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+
+			Expect(fakeActor.PlanSpaceImportCallCount()).To(Equal(1))
+			Expect(fakeActor.ApplySpaceImportCallCount()).To(Equal(1))
+			Expect(fakeActor.ApplySpaceImportArgsForCall(0)).To(Equal(plan))
+
+			Expect(testUI.Err).To(Say("apply warning"))
+			Expect(testUI.Out).To(Say("OK"))
+		})
+
+		When("applying the plan fails", func() {
+			BeforeEach(func() {
+				fakeActor.ApplySpaceImportReturns(v7action.Warnings{"apply warning"}, errors.New("boom"))
+			})
+
+			It("returns the error and displays warnings", func() {
+				Expect(executeErr).To(MatchError("boom"))
+				Expect(testUI.Err).To(Say("apply warning"))
+			})
+		})
+	})
+
+	When("the user declines", func() {
+		BeforeEach(func() {
+			_, err := input.Write([]byte("n\n"))
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("does not import", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(testUI.Out).To(Say("Import cancelled"))
+			Expect(fakeActor.ApplySpaceImportCallCount()).To(Equal(0))
+		})
+	})
+
+	When("--force is given", func() {
+		BeforeEach(func() {
+			setFlag(&cmd, "--force")
+		})
+
+		It("imports without prompting", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(testUI.Out).NotTo(Say("Really import"))
+			Expect(fakeActor.ApplySpaceImportCallCount()).To(Equal(1))
+		})
+	})
+
+	When("--dry-run is given", func() {
+		BeforeEach(func() {
+			setFlag(&cmd, "--dry-run")
+		})
+
+		It("only displays the changes", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(testUI.Out).To(Say(`3 changes would be made\.`))
+			Expect(testUI.Out).NotTo(Say("Really import"))
+			Expect(fakeActor.ApplySpaceImportCallCount()).To(Equal(0))
+		})
+	})
+
+	When("the space already matches the export", func() {
+		BeforeEach(func() {
+			fakeActor.PlanSpaceImportReturns(v7action.SpaceImportPlan{}, nil, nil)
+		})
+
+		It("says so", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(testUI.Out).To(Say(`The space already matches the export\.`))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(fakeActor.ApplySpaceImportCallCount()).To(Equal(0))
+		})
+	})
+
+	When("the export file is not valid YAML", func() {
+		BeforeEach(func() {
+			Expect(os.WriteFile(exportPath, []byte("roles: [\n"), 0600)).To(Succeed())
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(HaveOccurred())
+			Expect(fakeActor.PlanSpaceImportCallCount()).To(Equal(0))
+		})
+	})
+
+	When("planning the import fails", func() {
+		BeforeEach(func() {
+			fakeActor.PlanSpaceImportReturns(v7action.SpaceImportPlan{}, v7action.Warnings{"plan warning"}, errors.New("boom"))
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError("boom"))
+			Expect(testUI.Err).To(Say("plan warning"))
+		})
+	})
+
+	When("checking the target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(errors.New("not targeted"))
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError("not targeted"))
+			Expect(fakeActor.PlanSpaceImportCallCount()).To(Equal(0))
+		})
+	})
+})
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strings"
 
+	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/actor/v7action"
 	"code.cloudfoundry.org/cli/command/translatableerror"
 	"code.cloudfoundry.org/cli/resources"
@@ -14,11 +15,16 @@ type MarketplaceCommand struct {
 	BaseCommand
 
 	ServiceOfferingName string      `short:"e" description:"Show plan details for a particular service offering"`
+	ServicePlanName     string      `short:"p" description:"Show all details of a particular plan of the service offering. Requires -e"`
 	ServiceBrokerName   string      `short:"b" description:"Only show details for a particular service broker"`
 	NoPlans             bool        `long:"no-plans" description:"Hide plan information for service offerings"`
 	ShowUnavailable     bool        `long:"show-unavailable" description:"Show plans that are not available for use"`
-	usage               interface{} `usage:"CF_NAME marketplace [-e SERVICE_OFFERING] [-b SERVICE_BROKER] [--no-plans]"`
-	relatedCommands     interface{} `related_commands:"create-service, services"`
+	Search              string      `long:"search" description:"Only show offerings and plans whose name, description or tags contain the text"`
+	Tag                 string      `long:"tag" description:"Only show offerings with the tag"`
+	FreeOnly            bool        `long:"free-only" description:"Only show free plans"`
+	Bindable            bool        `long:"bindable" description:"Only show plans that can be bound to apps"`
+	usage               interface{} `usage:"CF_NAME marketplace [-e SERVICE_OFFERING [-p SERVICE_PLAN]] [-b SERVICE_BROKER] [--no-plans]\n   [--search TEXT] [--tag TAG] [--free-only] [--bindable] [--show-unavailable]\n\nEXAMPLES:\n   CF_NAME marketplace --search postgres --free-only\n   CF_NAME marketplace --tag cache --bindable\n   CF_NAME marketplace -e my-db -p small"`
+	relatedCommands     interface{} `related_commands:"create-service, service-plan-schema, services"`
 }
 
 func (cmd MarketplaceCommand) Execute(args []string) error {
@@ -46,14 +52,24 @@ func (cmd MarketplaceCommand) Execute(args []string) error {
 		return err
 	}
 
+	if len(offerings) == 0 && cmd.ServicePlanName != "" {
+		return actionerror.ServicePlanNotFoundError{
+			PlanName:          cmd.ServicePlanName,
+			OfferingName:      cmd.ServiceOfferingName,
+			ServiceBrokerName: cmd.ServiceBrokerName,
+		}
+	}
+
 	if len(offerings) == 0 {
 		cmd.UI.DisplayNewline()
 		cmd.UI.DisplayText("No service offerings found.")
 		return nil
 	}
 
-	switch cmd.ServiceOfferingName {
-	case "":
+	switch {
+	case cmd.ServicePlanName != "":
+		return cmd.displayPlanDetails(offerings)
+	case cmd.ServiceOfferingName == "":
 		return cmd.displayOfferingsTable(offerings)
 	default:
 		return cmd.displayPlansTable(offerings)
@@ -65,10 +81,19 @@ func (cmd MarketplaceCommand) processFlags() (v7action.MarketplaceFilter, error)
 		return v7action.MarketplaceFilter{}, translatableerror.ArgumentCombinationError{Args: []string{"--no-plans", "-e"}}
 	}
 
+	if cmd.ServicePlanName != "" && cmd.ServiceOfferingName == "" {
+		return v7action.MarketplaceFilter{}, translatableerror.RequiredFlagsError{Arg1: "-p", Arg2: "-e"}
+	}
+
 	return v7action.MarketplaceFilter{
 		ServiceOfferingName: cmd.ServiceOfferingName,
 		ServiceBrokerName:   cmd.ServiceBrokerName,
+		ServicePlanName:     cmd.ServicePlanName,
 		ShowUnavailable:     cmd.ShowUnavailable,
+		SearchText:          cmd.Search,
+		Tag:                 cmd.Tag,
+		FreeOnly:            cmd.FreeOnly,
+		Bindable:            cmd.Bindable,
 	}, nil
 }
 
@@ -87,8 +112,10 @@ func (cmd MarketplaceCommand) processLoginContext() (string, error) {
 func (cmd MarketplaceCommand) displayMessage(username string) {
 	var template string
 
-	switch cmd.ServiceOfferingName {
-	case "":
+	switch {
+	case cmd.ServicePlanName != "":
+		template = "Getting details of service plan {{.ServicePlanName}} for service offering {{.ServiceOfferingName}}"
+	case cmd.ServiceOfferingName == "":
 		template = "Getting all service offerings from marketplace"
 	default:
 		template = "Getting service plan information for service offering {{.ServiceOfferingName}}"
@@ -110,6 +137,7 @@ func (cmd MarketplaceCommand) displayMessage(username string) {
 
 	cmd.UI.DisplayTextWithFlavor(template+"...", map[string]interface{}{
 		"ServiceOfferingName": cmd.ServiceOfferingName,
+		"ServicePlanName":     cmd.ServicePlanName,
 		"ServiceBrokerName":   cmd.ServiceBrokerName,
 		"OrgName":             cmd.Config.TargetedOrganization().Name,
 		"SpaceName":           cmd.Config.TargetedSpace().Name,
@@ -131,6 +159,38 @@ func (cmd MarketplaceCommand) displayPlansTable(offerings []v7action.ServiceOffe
 		cmd.UI.DisplayTableWithHeader("   ", data, ui.DefaultTableSpacePadding)
 	}
 
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayText("TIP: Use 'cf marketplace -e SERVICE_OFFERING -p SERVICE_PLAN' to view all details of a plan.")
+
+	return nil
+}
+
+func (cmd MarketplaceCommand) displayPlanDetails(offerings []v7action.ServiceOfferingWithPlans) error {
+	for _, o := range offerings {
+		for _, p := range o.Plans {
+			cmd.UI.DisplayNewline()
+			cmd.UI.DisplayTextWithFlavor("broker: {{.ServiceBrokerName}}", map[string]interface{}{
+				"ServiceBrokerName": o.ServiceBrokerName,
+			})
+			cmd.UI.DisplayKeyValueTable("   ", [][]string{
+				{cmd.UI.TranslateText("plan:"), p.Name},
+				{cmd.UI.TranslateText("description:"), p.Description},
+				{cmd.UI.TranslateText("offering:"), o.Name},
+				{cmd.UI.TranslateText("free or paid:"), freeOrPaid(p.Free)},
+				{cmd.UI.TranslateText("costs:"), costsList(p.Costs)},
+				{cmd.UI.TranslateText("available:"), available(p.Available)},
+				{cmd.UI.TranslateText("bindable:"), available(p.Bindable)},
+				{cmd.UI.TranslateText("shareable:"), available(o.AllowsInstanceSharing)},
+				{cmd.UI.TranslateText("maintenance info:"), formatMaintenanceInfo(p)},
+				{cmd.UI.TranslateText("tags:"), strings.Join(o.Tags, ", ")},
+				{cmd.UI.TranslateText("documentation:"), o.DocumentationURL},
+			}, 3)
+		}
+	}
+
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayText("TIP: Use 'cf service-plan-schema SERVICE_OFFERING SERVICE_PLAN' to view the configuration parameters of a plan.")
+
 	return nil
 }
 
@@ -1,6 +1,7 @@
 package v7_test
 
 import (
+	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/actor/v7action"
 	"code.cloudfoundry.org/cli/command/commandfakes"
 	"code.cloudfoundry.org/cli/command/translatableerror"
@@ -124,6 +125,17 @@ var _ = Describe("marketplace command", func() {
 				}))
 			})
 		})
+
+		When("the -p flag is specified without -e", func() {
+			BeforeEach(func() {
+				setFlag(&cmd, "-p", "small")
+			})
+
+			It("returns an error", func() {
+				Expect(executeErr).To(MatchError(translatableerror.RequiredFlagsError{Arg1: "-p", Arg2: "-e"}))
+				Expect(fakeActor.MarketplaceCallCount()).To(Equal(0))
+			})
+		})
 	})
 
 	DescribeTable(
@@ -321,6 +333,23 @@ var _ = Describe("marketplace command", func() {
 				ShowUnavailable: true,
 			},
 		),
+		Entry(
+			"logged in with search and filter flags",
+			true,
+			map[string]interface{}{
+				"--search":    "postgres",
+				"--tag":       "sql",
+				"--free-only": true,
+				"--bindable":  true,
+			},
+			v7action.MarketplaceFilter{
+				SpaceGUID:  "fake-space-guid",
+				SearchText: "postgres",
+				Tag:        "sql",
+				FreeOnly:   true,
+				Bindable:   true,
+			},
+		),
 	)
 
 	Describe("handling the result from the actor", func() {
@@ -536,6 +565,7 @@ var _ = Describe("marketplace command", func() {
 					Say(`plan-2\s+just another plan\s+paid\s+USD 100.00/Monthly, USD 1.00/1GB of messages over 20GB`),
 					Say(`plan-3\s+free`),
 					Say(`plan-4\s+paid`),
+					Say(`TIP: Use 'cf marketplace -e SERVICE_OFFERING -p SERVICE_PLAN' to view all details of a plan\.`),
 				))
 
 				Expect(testUI.Err).To(SatisfyAll(
@@ -545,6 +575,81 @@ var _ = Describe("marketplace command", func() {
 			})
 		})
 
+		When("showing the details of a service plan", func() {
+			BeforeEach(func() {
+				setFlag(&cmd, "-e", "my-db")
+				setFlag(&cmd, "-p", "small")
+
+				fakeActor.MarketplaceReturns(
+					[]v7action.ServiceOfferingWithPlans{
+						{
+							Name:                  "my-db",
+							ServiceBrokerName:     "service-broker-1",
+							Tags:                  []string{"sql", "relational"},
+							AllowsInstanceSharing: true,
+							DocumentationURL:      "https://docs.example.com",
+							Plans: []resources.ServicePlan{
+								{
+									Name:                       "small",
+									Description:                "A small database",
+									Available:                  true,
+									Bindable:                   true,
+									Costs:                      []resources.ServicePlanCost{{Currency: "USD", Amount: 10, Unit: "Monthly"}},
+									MaintenanceInfoVersion:     "1.2.0",
+									MaintenanceInfoDescription: "OS upgrade",
+								},
+							},
+						},
+					},
+					v7action.Warnings{"warning 1"},
+					nil,
+				)
+			})
+
+			It("asks the actor for the plan", func() {
+				Expect(fakeActor.MarketplaceArgsForCall(0)).To(Equal(v7action.MarketplaceFilter{
+					ServiceOfferingName: "my-db",
+					ServicePlanName:     "small",
+				}))
+			})
+
+			It("prints the plan details", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+
+				Expect(testUI.Out).To(Say(`Getting details of service plan small for service offering my-db\.\.\.`))
+				Expect(testUI.Out).To(Say(`broker: service-broker-1`))
+				Expect(testUI.Out).To(Say(`plan:\s+small`))
+				Expect(testUI.Out).To(Say(`description:\s+A small database`))
+				Expect(testUI.Out).To(Say(`offering:\s+my-db`))
+				Expect(testUI.Out).To(Say(`free or paid:\s+paid`))
+				Expect(testUI.Out).To(Say(`costs:\s+USD 10.00/Monthly`))
+				Expect(testUI.Out).To(Say(`available:\s+yes`))
+				Expect(testUI.Out).To(Say(`bindable:\s+yes`))
+				Expect(testUI.Out).To(Say(`shareable:\s+yes`))
+				Expect(testUI.Out).To(Say(`maintenance info:\s+1\.2\.0 \(OS upgrade\)`))
+				Expect(testUI.Out).To(Say(`tags:\s+sql, relational`))
+				Expect(testUI.Out).To(Say(`documentation:\s+https://docs\.example\.com`))
+				Expect(testUI.Out).To(Say(`TIP: Use 'cf service-plan-schema SERVICE_OFFERING SERVICE_PLAN' to view the configuration parameters of a plan\.`))
+
+				Expect(testUI.Err).To(Say("warning 1"))
+			})
+
+			When("the plan does not exist", func() {
+				BeforeEach(func() {
+					setFlag(&cmd, "-b", "service-broker-1")
+					fakeActor.MarketplaceReturns(nil, nil, nil)
+				})
+
+				It("returns a plan not found error", func() {
+					Expect(executeErr).To(MatchError(actionerror.ServicePlanNotFoundError{
+						PlanName:          "small",
+						OfferingName:      "my-db",
+						ServiceBrokerName: "service-broker-1",
+					}))
+				})
+			})
+		})
+
 		When("showing the service plans table with availability", func() {
 			BeforeEach(func() {
 				setFlag(&cmd, "-e", "fake-service-offering-name")
@@ -1,18 +1,25 @@
 package v7
 
 import (
+	"encoding/json"
 	"fmt"
+	"sort"
 	"strconv"
+	"strings"
 
 	"code.cloudfoundry.org/cli/actor/cfnetworkingaction"
 	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/translatableerror"
 	"code.cloudfoundry.org/cli/command/v7/shared"
+	"code.cloudfoundry.org/cli/util/portrange"
 	"code.cloudfoundry.org/cli/util/ui"
 )
 
 //go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . NetworkPoliciesActor
 
 type NetworkPoliciesActor interface {
+	NetworkPolicies() ([]cfnetworkingaction.Policy, cfnetworkingaction.Warnings, error)
+	NetworkPoliciesByOrg(orgGUID string) ([]cfnetworkingaction.Policy, cfnetworkingaction.Warnings, error)
 	NetworkPoliciesBySpaceAndAppName(spaceGUID string, srcAppName string) ([]cfnetworkingaction.Policy, cfnetworkingaction.Warnings, error)
 	NetworkPoliciesBySpace(spaceGUID string) ([]cfnetworkingaction.Policy, cfnetworkingaction.Warnings, error)
 }
@@ -21,8 +28,11 @@ type NetworkPoliciesCommand struct {
 	BaseCommand
 
 	SourceApp string `long:"source" required:"false" description:"Source app to filter results by"`
+	Org       bool   `long:"org" description:"List the policies of all apps in the targeted org"`
+	All       bool   `long:"all" description:"List the policies of all apps across the foundation"`
+	Format    string `long:"format" choice:"table" choice:"dot" choice:"mermaid" choice:"json" description:"Output format. dot, mermaid and json render the policies as a graph of apps grouped by org and space. Default: table"`
 
-	usage           interface{} `usage:"CF_NAME network-policies [--source SOURCE_APP]"`
+	usage           interface{} `usage:"CF_NAME network-policies [--source SOURCE_APP] [--format (table | dot | mermaid | json)]\n   CF_NAME network-policies (--org | --all) [--format (table | dot | mermaid | json)]\n\nEXAMPLES:\n   CF_NAME network-policies --source frontend\n   CF_NAME network-policies --org --format dot | dot -Tsvg > policies.svg\n   CF_NAME network-policies --all --format mermaid"`
 	relatedCommands interface{} `related_commands:"add-network-policy, apps, remove-network-policy"`
 
 	NetworkingActor NetworkPoliciesActor
@@ -45,37 +55,79 @@ func (cmd *NetworkPoliciesCommand) Setup(config command.Config, ui command.UI) e
 	return nil
 }
 
+type networkPolicyGraphApp struct {
+	Name  string `json:"name"`
+	Space string `json:"space"`
+	Org   string `json:"org"`
+}
+
+type networkPolicyGraphEdge struct {
+	Source      networkPolicyGraphApp `json:"source"`
+	Destination networkPolicyGraphApp `json:"destination"`
+	Protocol    string                `json:"protocol"`
+	StartPort   int                   `json:"start_port"`
+	EndPort     int                   `json:"end_port"`
+}
+
+// networkPolicyGraph holds the apps of a set of policies, sorted by org, space
+// and name, and the policies between them.
+type networkPolicyGraph struct {
+	Apps     []networkPolicyGraphApp  `json:"apps"`
+	Policies []networkPolicyGraphEdge `json:"policies"`
+}
+
 func (cmd NetworkPoliciesCommand) Execute(args []string) error {
-	err := cmd.SharedActor.CheckTarget(true, true)
+	err := cmd.validateArguments()
 	if err != nil {
 		return err
 	}
 
-	user, err := cmd.Actor.GetCurrentUser()
+	err = cmd.SharedActor.CheckTarget(!cmd.All, !cmd.Org && !cmd.All)
 	if err != nil {
 		return err
 	}
 
-	var policies []cfnetworkingaction.Policy
-	var warnings cfnetworkingaction.Warnings
+	if cmd.Format != "" && cmd.Format != "table" {
+		policies, warnings, err := cmd.getPolicies()
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return err
+		}
 
-	if cmd.SourceApp != "" {
+		return cmd.displayGraph(newNetworkPolicyGraph(policies))
+	}
+
+	user, err := cmd.Actor.GetCurrentUser()
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case cmd.All:
+		cmd.UI.DisplayTextWithFlavor("Listing network policies in all orgs as {{.User}}...", map[string]interface{}{
+			"User": user.Name,
+		})
+	case cmd.Org:
+		cmd.UI.DisplayTextWithFlavor("Listing network policies in org {{.Org}} as {{.User}}...", map[string]interface{}{
+			"Org":  cmd.Config.TargetedOrganization().Name,
+			"User": user.Name,
+		})
+	case cmd.SourceApp != "":
 		cmd.UI.DisplayTextWithFlavor("Listing network policies of app {{.SrcAppName}} in org {{.Org}} / space {{.Space}} as {{.User}}...", map[string]interface{}{
 			"SrcAppName": cmd.SourceApp,
 			"Org":        cmd.Config.TargetedOrganization().Name,
 			"Space":      cmd.Config.TargetedSpace().Name,
 			"User":       user.Name,
 		})
-		policies, warnings, err = cmd.NetworkingActor.NetworkPoliciesBySpaceAndAppName(cmd.Config.TargetedSpace().GUID, cmd.SourceApp)
-	} else {
+	default:
 		cmd.UI.DisplayTextWithFlavor("Listing network policies in org {{.Org}} / space {{.Space}} as {{.User}}...", map[string]interface{}{
 			"Org":   cmd.Config.TargetedOrganization().Name,
 			"Space": cmd.Config.TargetedSpace().Name,
 			"User":  user.Name,
 		})
-		policies, warnings, err = cmd.NetworkingActor.NetworkPoliciesBySpace(cmd.Config.TargetedSpace().GUID)
 	}
 
+	policies, warnings, err := cmd.getPolicies()
 	cmd.UI.DisplayWarnings(warnings)
 	if err != nil {
 		return err
@@ -83,35 +135,194 @@ func (cmd NetworkPoliciesCommand) Execute(args []string) error {
 
 	cmd.UI.DisplayNewline()
 
-	table := [][]string{
-		{
-			cmd.UI.TranslateText("source"),
-			cmd.UI.TranslateText("destination"),
-			cmd.UI.TranslateText("protocol"),
-			cmd.UI.TranslateText("ports"),
-			cmd.UI.TranslateText("destination space"),
-			cmd.UI.TranslateText("destination org"),
-		},
+	crossSpace := cmd.Org || cmd.All
+
+	header := []string{
+		cmd.UI.TranslateText("source"),
+		cmd.UI.TranslateText("destination"),
+		cmd.UI.TranslateText("protocol"),
+		cmd.UI.TranslateText("ports"),
+	}
+	if crossSpace {
+		header = append(header, cmd.UI.TranslateText("source space"), cmd.UI.TranslateText("source org"))
 	}
+	header = append(header, cmd.UI.TranslateText("destination space"), cmd.UI.TranslateText("destination org"))
+	table := [][]string{header}
 
 	for _, policy := range policies {
-		var portEntry string
-		if policy.StartPort == policy.EndPort {
-			portEntry = strconv.Itoa(policy.StartPort)
-		} else {
-			portEntry = fmt.Sprintf("%d-%d", policy.StartPort, policy.EndPort)
-		}
-		table = append(table, []string{
+		row := []string{
 			policy.SourceName,
 			policy.DestinationName,
 			policy.Protocol,
-			portEntry,
-			policy.DestinationSpaceName,
-			policy.DestinationOrgName,
-		})
+			portrange.Format(policy.StartPort, policy.EndPort),
+		}
+		if crossSpace {
+			row = append(row, policy.SourceSpaceName, policy.SourceOrgName)
+		}
+		table = append(table, append(row, policy.DestinationSpaceName, policy.DestinationOrgName))
 	}
 
 	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
 
 	return nil
 }
+
+func (cmd NetworkPoliciesCommand) validateArguments() error {
+	var scopes []string
+	if cmd.SourceApp != "" {
+		scopes = append(scopes, "--source")
+	}
+	if cmd.Org {
+		scopes = append(scopes, "--org")
+	}
+	if cmd.All {
+		scopes = append(scopes, "--all")
+	}
+
+	if len(scopes) > 1 {
+		return translatableerror.ArgumentCombinationError{Args: scopes}
+	}
+	return nil
+}
+
+func (cmd NetworkPoliciesCommand) getPolicies() ([]cfnetworkingaction.Policy, cfnetworkingaction.Warnings, error) {
+	switch {
+	case cmd.All:
+		return cmd.NetworkingActor.NetworkPolicies()
+	case cmd.Org:
+		return cmd.NetworkingActor.NetworkPoliciesByOrg(cmd.Config.TargetedOrganization().GUID)
+	case cmd.SourceApp != "":
+		return cmd.NetworkingActor.NetworkPoliciesBySpaceAndAppName(cmd.Config.TargetedSpace().GUID, cmd.SourceApp)
+	default:
+		return cmd.NetworkingActor.NetworkPoliciesBySpace(cmd.Config.TargetedSpace().GUID)
+	}
+}
+
+func (cmd NetworkPoliciesCommand) displayGraph(graph networkPolicyGraph) error {
+	var output string
+	switch cmd.Format {
+	case "json":
+		bytes, err := json.MarshalIndent(graph, "", "  ")
+		if err != nil {
+			return err
+		}
+		output = string(bytes)
+	case "dot":
+		output = graph.dot()
+	case "mermaid":
+		output = graph.mermaid()
+	}
+
+	_, err := fmt.Fprintln(cmd.UI.Writer(), output)
+	return err
+}
+
+func newNetworkPolicyGraph(policies []cfnetworkingaction.Policy) networkPolicyGraph {
+	graph := networkPolicyGraph{
+		Apps:     []networkPolicyGraphApp{},
+		Policies: []networkPolicyGraphEdge{},
+	}
+
+	seen := map[networkPolicyGraphApp]bool{}
+	addApp := func(app networkPolicyGraphApp) networkPolicyGraphApp {
+		if !seen[app] {
+			seen[app] = true
+			graph.Apps = append(graph.Apps, app)
+		}
+		return app
+	}
+
+	for _, policy := range policies {
+		graph.Policies = append(graph.Policies, networkPolicyGraphEdge{
+			Source:      addApp(networkPolicyGraphApp{Name: policy.SourceName, Space: policy.SourceSpaceName, Org: policy.SourceOrgName}),
+			Destination: addApp(networkPolicyGraphApp{Name: policy.DestinationName, Space: policy.DestinationSpaceName, Org: policy.DestinationOrgName}),
+			Protocol:    policy.Protocol,
+			StartPort:   policy.StartPort,
+			EndPort:     policy.EndPort,
+		})
+	}
+
+	sort.Slice(graph.Apps, func(i, j int) bool {
+		a, b := graph.Apps[i], graph.Apps[j]
+		if a.Org != b.Org {
+			return a.Org < b.Org
+		}
+		if a.Space != b.Space {
+			return a.Space < b.Space
+		}
+		return a.Name < b.Name
+	})
+
+	return graph
+}
+
+// nodeIDs numbers the apps in their sorted order so that the dot and mermaid
+// output does not depend on app names being valid identifiers.
+func (graph networkPolicyGraph) nodeIDs() map[networkPolicyGraphApp]string {
+	ids := make(map[networkPolicyGraphApp]string, len(graph.Apps))
+	for i, app := range graph.Apps {
+		ids[app] = fmt.Sprintf("app%d", i)
+	}
+	return ids
+}
+
+// spaces groups the sorted apps by the org and space they are in.
+func (graph networkPolicyGraph) spaces() [][]networkPolicyGraphApp {
+	var spaces [][]networkPolicyGraphApp
+	for i, app := range graph.Apps {
+		if i == 0 || app.Org != graph.Apps[i-1].Org || app.Space != graph.Apps[i-1].Space {
+			spaces = append(spaces, nil)
+		}
+		spaces[len(spaces)-1] = append(spaces[len(spaces)-1], app)
+	}
+	return spaces
+}
+
+func (graph networkPolicyGraph) dot() string {
+	ids := graph.nodeIDs()
+
+	var builder strings.Builder
+	builder.WriteString("digraph network_policies {\n")
+	builder.WriteString("  rankdir=LR;\n")
+	for i, apps := range graph.spaces() {
+		fmt.Fprintf(&builder, "  subgraph cluster_%d {\n", i)
+		fmt.Fprintf(&builder, "    label=%s;\n", strconv.Quote(apps[0].Org+" / "+apps[0].Space))
+		for _, app := range apps {
+			fmt.Fprintf(&builder, "    %s [label=%s];\n", ids[app], strconv.Quote(app.Name))
+		}
+		builder.WriteString("  }\n")
+	}
+	for _, policy := range graph.Policies {
+		fmt.Fprintf(&builder, "  %s -> %s [label=%s];\n", ids[policy.Source], ids[policy.Destination], strconv.Quote(policy.label()))
+	}
+	builder.WriteString("}")
+
+	return builder.String()
+}
+
+func (graph networkPolicyGraph) mermaid() string {
+	ids := graph.nodeIDs()
+
+	var builder strings.Builder
+	builder.WriteString("flowchart LR\n")
+	for i, apps := range graph.spaces() {
+		fmt.Fprintf(&builder, "  subgraph space%d[%s]\n", i, mermaidLabel(apps[0].Org+" / "+apps[0].Space))
+		for _, app := range apps {
+			fmt.Fprintf(&builder, "    %s[%s]\n", ids[app], mermaidLabel(app.Name))
+		}
+		builder.WriteString("  end\n")
+	}
+	for _, policy := range graph.Policies {
+		fmt.Fprintf(&builder, "  %s -->|%s| %s\n", ids[policy.Source], mermaidLabel(policy.label()), ids[policy.Destination])
+	}
+
+	return strings.TrimSuffix(builder.String(), "\n")
+}
+
+func (edge networkPolicyGraphEdge) label() string {
+	return edge.Protocol + " " + portrange.Format(edge.StartPort, edge.EndPort)
+}
+
+func mermaidLabel(text string) string {
+	return `"` + strings.ReplaceAll(text, `"`, "#quot;") + `"`
+}
@@ -6,6 +6,7 @@ import (
 	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/actor/cfnetworkingaction"
 	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
 	. "code.cloudfoundry.org/cli/command/v7"
 	"code.cloudfoundry.org/cli/command/v7/v7fakes"
 	"code.cloudfoundry.org/cli/util/configv3"
@@ -188,4 +189,189 @@ var _ = Describe("network-policies Command", func() {
 			})
 		})
 	})
+	When("both --org and --all are given", func() {
+		BeforeEach(func() {
+			cmd.Org = true
+			cmd.All = true
+		})
+
+		It("returns an argument combination error before checking the target", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{Args: []string{"--org", "--all"}}))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+		})
+	})
+
+	When("--source and --org are given", func() {
+		BeforeEach(func() {
+			cmd.SourceApp = "some-app"
+			cmd.Org = true
+		})
+
+		It("returns an argument combination error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{Args: []string{"--source", "--org"}}))
+		})
+	})
+
+	When("listing the policies across spaces", func() {
+		BeforeEach(func() {
+			fakeActor.GetCurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+			fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org", GUID: "some-org-guid"})
+
+			policies := []cfnetworkingaction.Policy{
+				{
+					SourceName:           "frontend",
+					SourceSpaceName:      "web",
+					SourceOrgName:        "some-org",
+					DestinationName:      "backend",
+					Protocol:             "tcp",
+					StartPort:            8080,
+					EndPort:              8090,
+					DestinationSpaceName: "api",
+					DestinationOrgName:   "some-org",
+				}, {
+					SourceName:           "backend",
+					SourceSpaceName:      "api",
+					SourceOrgName:        "some-org",
+					DestinationName:      "db",
+					Protocol:             "udp",
+					StartPort:            5432,
+					EndPort:              5432,
+					DestinationSpaceName: "data",
+					DestinationOrgName:   "other-org",
+				},
+			}
+			fakeNetworkPoliciesActor.NetworkPoliciesByOrgReturns(policies, cfnetworkingaction.Warnings{"org-warning"}, nil)
+			fakeNetworkPoliciesActor.NetworkPoliciesReturns(policies, cfnetworkingaction.Warnings{"all-warning"}, nil)
+		})
+
+		When("--org is given", func() {
+			BeforeEach(func() {
+				cmd.Org = true
+			})
+
+			It("lists the policies of the targeted org with the source spaces", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+
+				checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+				Expect(checkTargetedOrg).To(BeTrue())
+				Expect(checkTargetedSpace).To(BeFalse())
+
+				Expect(fakeNetworkPoliciesActor.NetworkPoliciesByOrgArgsForCall(0)).To(Equal("some-org-guid"))
+
+				Expect(testUI.Out).To(Say(`Listing network policies in org some-org as some-user\.\.\.`))
+				Expect(testUI.Out).To(Say(`source\s+destination\s+protocol\s+ports\s+source space\s+source org\s+destination space\s+destination org`))
+				Expect(testUI.Out).To(Say(`frontend\s+backend\s+tcp\s+8080-8090\s+web\s+some-org\s+api\s+some-org`))
+				Expect(testUI.Out).To(Say(`backend\s+db\s+udp\s+5432\s+api\s+some-org\s+data\s+other-org`))
+				Expect(testUI.Err).To(Say("org-warning"))
+			})
+		})
+
+		When("--all is given", func() {
+			BeforeEach(func() {
+				cmd.All = true
+			})
+
+			It("lists the policies of all orgs", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+
+				checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+				Expect(checkTargetedOrg).To(BeFalse())
+				Expect(checkTargetedSpace).To(BeFalse())
+
+				Expect(fakeNetworkPoliciesActor.NetworkPoliciesCallCount()).To(Equal(1))
+				Expect(testUI.Out).To(Say(`Listing network policies in all orgs as some-user\.\.\.`))
+				Expect(testUI.Err).To(Say("all-warning"))
+			})
+
+			When("--format dot is given", func() {
+				BeforeEach(func() {
+					cmd.Format = "dot"
+				})
+
+				It("renders the policies as a graphviz graph with a cluster per space", func() {
+					Expect(executeErr).NotTo(HaveOccurred())
+					Expect(fakeActor.GetCurrentUserCallCount()).To(Equal(0))
+					Expect(testUI.Out).NotTo(Say("Listing network policies"))
+					Expect(testUI.Out).To(Say(`digraph network_policies \{
+  rankdir=LR;
+  subgraph cluster_0 \{
+    label="other-org / data";
+    app0 \[label="db"\];
+  \}
+  subgraph cluster_1 \{
+    label="some-org / api";
+    app1 \[label="backend"\];
+  \}
+  subgraph cluster_2 \{
+    label="some-org / web";
+    app2 \[label="frontend"\];
+  \}
+  app2 -> app1 \[label="tcp 8080-8090"\];
+  app1 -> app0 \[label="udp 5432"\];
+\}
+`))
+					Expect(testUI.Err).To(Say("all-warning"))
+				})
+			})
+
+			When("--format mermaid is given", func() {
+				BeforeEach(func() {
+					cmd.Format = "mermaid"
+				})
+
+				It("renders the policies as a mermaid flowchart with a subgraph per space", func() {
+					Expect(executeErr).NotTo(HaveOccurred())
+					Expect(testUI.Out).To(Say(`flowchart LR
+  subgraph space0\["other-org / data"\]
+    app0\["db"\]
+  end
+  subgraph space1\["some-org / api"\]
+    app1\["backend"\]
+  end
+  subgraph space2\["some-org / web"\]
+    app2\["frontend"\]
+  end
+  app2 -->\|"tcp 8080-8090"\| app1
+  app1 -->\|"udp 5432"\| app0
+`))
+				})
+			})
+
+			When("--format json is given", func() {
+				BeforeEach(func() {
+					cmd.Format = "json"
+				})
+
+				It("renders the apps and policies as JSON", func() {
+					Expect(executeErr).NotTo(HaveOccurred())
+					Expect(testUI.Out).To(Say(`"apps": \[\s+\{\s+"name": "db",\s+"space": "data",\s+"org": "other-org"\s+\}`))
+					Expect(testUI.Out).To(Say(`"policies": \[\s+\{\s+"source": \{\s+"name": "frontend",\s+"space": "web",\s+"org": "some-org"\s+\},\s+"destination": \{\s+"name": "backend",\s+"space": "api",\s+"org": "some-org"\s+\},\s+"protocol": "tcp",\s+"start_port": 8080,\s+"end_port": 8090`))
+				})
+			})
+		})
+
+		When("listing the policies fails", func() {
+			BeforeEach(func() {
+				cmd.Org = true
+				cmd.Format = "json"
+				fakeNetworkPoliciesActor.NetworkPoliciesByOrgReturns(nil, cfnetworkingaction.Warnings{"org-warning"}, errors.New("some-error"))
+			})
+
+			It("displays warnings and returns the error", func() {
+				Expect(executeErr).To(MatchError("some-error"))
+				Expect(testUI.Err).To(Say("org-warning"))
+			})
+		})
+	})
+
+	When("there are no policies and --format json is given", func() {
+		BeforeEach(func() {
+			cmd.Format = "json"
+		})
+
+		It("renders an empty graph", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(testUI.Out).To(Say(`"apps": \[\],\s+"policies": \[\]`))
+		})
+	})
 })
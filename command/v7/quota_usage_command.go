@@ -0,0 +1,232 @@
+package v7
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"code.cloudfoundry.org/bytefmt"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+type QuotaUsageCommand struct {
+	BaseCommand
+
+	Organization    string      `short:"o" description:"Only report the usage of this org"`
+	Spaces          bool        `long:"spaces" description:"Also report the usage of each space against its space quota"`
+	Threshold       int         `long:"threshold" default:"80" description:"Highlight resources using at least this percentage of their limit"`
+	Output          string      `long:"output" choice:"table" choice:"json" choice:"csv" description:"Output format. Default: table"`
+	usage           interface{} `usage:"CF_NAME quota-usage [-o ORG] [--spaces] [--threshold PERCENT] [--output (table | json | csv)]\n\n   Running tasks are counted across all apps. Their limit applies to each app, so no percentage is reported for them.\n\nEXAMPLES:\n   CF_NAME quota-usage\n   CF_NAME quota-usage -o my-org --spaces --threshold 90\n   CF_NAME quota-usage --spaces --output csv > usage.csv"`
+	relatedCommands interface{} `related_commands:"org-quota, org-quotas, space-quota, space-quotas"`
+}
+
+type quotaUsageJSON struct {
+	Org            string   `json:"org"`
+	Space          string   `json:"space,omitempty"`
+	Quota          string   `json:"quota"`
+	Resource       string   `json:"resource"`
+	Unit           string   `json:"unit,omitempty"`
+	Used           int      `json:"used"`
+	Limit          *int     `json:"limit"`
+	Percentage     *float64 `json:"percentage"`
+	AboveThreshold bool     `json:"above_threshold"`
+}
+
+func (cmd QuotaUsageCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(false, false)
+	if err != nil {
+		return err
+	}
+
+	if cmd.Output == "" || cmd.Output == "table" {
+		user, err := cmd.Actor.GetCurrentUser()
+		if err != nil {
+			return err
+		}
+
+		if cmd.Organization != "" {
+			cmd.UI.DisplayTextWithFlavor("Getting quota usage of org {{.OrgName}} as {{.Username}}...", map[string]interface{}{
+				"OrgName":  cmd.Organization,
+				"Username": user.Name,
+			})
+		} else {
+			cmd.UI.DisplayTextWithFlavor("Getting quota usage of all orgs as {{.Username}}...", map[string]interface{}{
+				"Username": user.Name,
+			})
+		}
+		cmd.UI.DisplayNewline()
+	}
+
+	usages, warnings, err := cmd.Actor.GetQuotaUsage(cmd.Organization, cmd.Spaces)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	switch cmd.Output {
+	case "json":
+		return cmd.displayQuotaUsageJSON(usages)
+	case "csv":
+		return cmd.displayQuotaUsageCSV(usages)
+	default:
+		cmd.displayQuotaUsageTable(usages)
+		return nil
+	}
+}
+
+func (cmd QuotaUsageCommand) displayQuotaUsageTable(usages []v7action.QuotaUsage) {
+	if len(usages) == 0 {
+		cmd.UI.DisplayText("No orgs found.")
+		return
+	}
+
+	table := [][]string{{
+		cmd.UI.TranslateText("org"),
+		cmd.UI.TranslateText("space"),
+		cmd.UI.TranslateText("quota"),
+		cmd.UI.TranslateText("resource"),
+		cmd.UI.TranslateText("used"),
+		cmd.UI.TranslateText("limit"),
+		cmd.UI.TranslateText("usage"),
+		cmd.UI.TranslateText("status"),
+	}}
+
+	aboveThreshold := 0
+	for _, usage := range usages {
+		limit := cmd.UI.TranslateText("unlimited")
+		switch {
+		case usage.Limit.IsSet && usage.Resource == v7action.QuotaResourceTasks:
+			limit = cmd.UI.TranslateText("{{.Limit}} per app", map[string]interface{}{"Limit": usage.Limit.Value})
+		case usage.Limit.IsSet:
+			limit = formatQuotaAmount(usage.Resource, usage.Limit.Value)
+		}
+
+		percentage, status := "", ""
+		if value, limited := usage.Percentage(); limited {
+			percentage = fmt.Sprintf("%.0f%%", value)
+			if cmd.isAboveThreshold(usage) {
+				aboveThreshold++
+				status = cmd.UI.TranslateText("at or above {{.Threshold}}%", map[string]interface{}{"Threshold": cmd.Threshold})
+			}
+		}
+
+		table = append(table, []string{
+			usage.OrgName,
+			usage.SpaceName,
+			usage.QuotaName,
+			cmd.UI.TranslateText(string(usage.Resource)),
+			formatQuotaAmount(usage.Resource, usage.Used),
+			limit,
+			percentage,
+			status,
+		})
+	}
+
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+
+	if aboveThreshold > 0 {
+		cmd.UI.DisplayNewline()
+		cmd.UI.DisplayWarning("{{.Count}} quota limits are at or above {{.Threshold}}% usage.", map[string]interface{}{
+			"Count":     aboveThreshold,
+			"Threshold": cmd.Threshold,
+		})
+	}
+}
+
+func (cmd QuotaUsageCommand) displayQuotaUsageJSON(usages []v7action.QuotaUsage) error {
+	output := []quotaUsageJSON{}
+	for _, usage := range usages {
+		entry := quotaUsageJSON{
+			Org:            usage.OrgName,
+			Space:          usage.SpaceName,
+			Quota:          usage.QuotaName,
+			Resource:       string(usage.Resource),
+			Unit:           quotaUnit(usage.Resource),
+			Used:           usage.Used,
+			AboveThreshold: cmd.isAboveThreshold(usage),
+		}
+		if usage.Limit.IsSet {
+			limit := usage.Limit.Value
+			entry.Limit = &limit
+		}
+		if value, limited := usage.Percentage(); limited {
+			entry.Percentage = &value
+		}
+		output = append(output, entry)
+	}
+
+	raw, err := json.MarshalIndent(output, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintln(cmd.UI.Writer(), string(raw))
+	return err
+}
+
+func (cmd QuotaUsageCommand) displayQuotaUsageCSV(usages []v7action.QuotaUsage) error {
+	writer := csv.NewWriter(cmd.UI.Writer())
+	err := writer.Write([]string{"org", "space", "quota", "resource", "unit", "used", "limit", "percentage", "above_threshold"})
+	if err != nil {
+		return err
+	}
+
+	for _, usage := range usages {
+		limit, percentage := "", ""
+		if usage.Limit.IsSet {
+			limit = strconv.Itoa(usage.Limit.Value)
+		}
+		if value, limited := usage.Percentage(); limited {
+			percentage = strconv.FormatFloat(value, 'f', 1, 64)
+		}
+
+		err = writer.Write([]string{
+			usage.OrgName,
+			usage.SpaceName,
+			usage.QuotaName,
+			string(usage.Resource),
+			quotaUnit(usage.Resource),
+			strconv.Itoa(usage.Used),
+			limit,
+			percentage,
+			strconv.FormatBool(cmd.isAboveThreshold(usage)),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+func (cmd QuotaUsageCommand) isAboveThreshold(usage v7action.QuotaUsage) bool {
+	value, limited := usage.Percentage()
+	return limited && value >= float64(cmd.Threshold)
+}
+
+func quotaUnit(resource v7action.QuotaResource) string {
+	switch resource {
+	case v7action.QuotaResourceMemory:
+		return "MB"
+	case v7action.QuotaResourceLogRate:
+		return "B/s"
+	default:
+		return ""
+	}
+}
+
+func formatQuotaAmount(resource v7action.QuotaResource, amount int) string {
+	switch {
+	case amount <= 0:
+		return strconv.Itoa(amount)
+	case resource == v7action.QuotaResourceMemory:
+		return bytefmt.ByteSize(uint64(amount) * bytefmt.MEGABYTE)
+	case resource == v7action.QuotaResourceLogRate:
+		return bytefmt.ByteSize(uint64(amount)) + "/s"
+	default:
+		return strconv.Itoa(amount)
+	}
+}
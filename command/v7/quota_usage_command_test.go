@@ -0,0 +1,164 @@
+package v7_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	v7 "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("quota-usage Command", func() {
+	var (
+		cmd             v7.QuotaUsageCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeActor
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+
+		cmd = v7.QuotaUsageCommand{
+			BaseCommand: v7.BaseCommand{
+				UI:          testUI,
+				Config:      fakeConfig,
+				SharedActor: fakeSharedActor,
+				Actor:       fakeActor,
+			},
+		}
+		setFlag(&cmd, "--threshold", 80)
+
+		fakeActor.GetCurrentUserReturns(configv3.User{Name: "steve"}, nil)
+		fakeActor.GetQuotaUsageReturns(
+			[]v7action.QuotaUsage{
+				{OrgName: "my-org", QuotaName: "big", Resource: v7action.QuotaResourceMemory, Used: 1536, Limit: types.NullInt{IsSet: true, Value: 2048}},
+				{OrgName: "my-org", QuotaName: "big", Resource: v7action.QuotaResourceRoutes, Used: 9, Limit: types.NullInt{IsSet: true, Value: 10}},
+				{OrgName: "my-org", QuotaName: "big", Resource: v7action.QuotaResourceTasks, Used: 12, Limit: types.NullInt{IsSet: true, Value: 5}},
+				{OrgName: "my-org", SpaceName: "dev", Resource: v7action.QuotaResourceLogRate, Used: 2048},
+			},
+			v7action.Warnings{"usage warning"},
+			nil,
+		)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	It("checks the user is logged in", func() {
+		Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+		checkOrg, checkSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+		Expect(checkOrg).To(BeFalse())
+		Expect(checkSpace).To(BeFalse())
+	})
+
+	It("displays the usage of all orgs and highlights limits above the threshold", func() {
+		Expect(executeErr).NotTo(HaveOccurred())
+
+		orgName, includeSpaces := fakeActor.GetQuotaUsageArgsForCall(0)
+		Expect(orgName).To(BeEmpty())
+		Expect(includeSpaces).To(BeFalse())
+
+		Expect(testUI.Out).To(Say(`Getting quota usage of all orgs as steve\.\.\.`))
+		Expect(testUI.Out).To(Say(`org\s+space\s+quota\s+resource\s+used\s+limit\s+usage\s+status`))
+		Expect(testUI.Out).To(Say(`my-org\s+big\s+memory\s+1\.5G\s+2G\s+75%\s*\n`))
+		Expect(testUI.Out).To(Say(`my-org\s+big\s+routes\s+9\s+10\s+90%\s+at or above 80%`))
+		Expect(testUI.Out).To(Say(`my-org\s+big\s+app tasks\s+12\s+5 per app\s*\n`))
+		Expect(testUI.Out).To(Say(`my-org\s+dev\s+log rate\s+2K/s\s+unlimited`))
+		Expect(testUI.Err).To(Say("usage warning"))
+		Expect(testUI.Err).To(Say(`1 quota limits are at or above 80% usage\.`))
+	})
+
+	When("an org and --spaces are given", func() {
+		BeforeEach(func() {
+			setFlag(&cmd, "-o", "my-org")
+			setFlag(&cmd, "--spaces")
+		})
+
+		It("reports the usage of that org and its spaces", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			orgName, includeSpaces := fakeActor.GetQuotaUsageArgsForCall(0)
+			Expect(orgName).To(Equal("my-org"))
+			Expect(includeSpaces).To(BeTrue())
+			Expect(testUI.Out).To(Say(`Getting quota usage of org my-org as steve\.\.\.`))
+		})
+	})
+
+	When("--output json is given", func() {
+		BeforeEach(func() {
+			setFlag(&cmd, "--output", "json")
+		})
+
+		It("displays the usage as JSON", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(fakeActor.GetCurrentUserCallCount()).To(Equal(0))
+			Expect(testUI.Out).NotTo(Say("Getting quota usage"))
+			Expect(testUI.Out).To(Say(`"org": "my-org",\s+"quota": "big",\s+"resource": "memory",\s+"unit": "MB",\s+"used": 1536,\s+"limit": 2048,\s+"percentage": 75,\s+"above_threshold": false`))
+			Expect(testUI.Out).To(Say(`"resource": "routes",\s+"used": 9,\s+"limit": 10,\s+"percentage": 90,\s+"above_threshold": true`))
+			Expect(testUI.Out).To(Say(`"resource": "app tasks",\s+"used": 12,\s+"limit": 5,\s+"percentage": null,\s+"above_threshold": false`))
+			Expect(testUI.Out).To(Say(`"space": "dev",\s+"quota": "",\s+"resource": "log rate",\s+"unit": "B/s",\s+"used": 2048,\s+"limit": null,\s+"percentage": null`))
+		})
+	})
+
+	When("--output csv is given", func() {
+		BeforeEach(func() {
+			setFlag(&cmd, "--output", "csv")
+			setFlag(&cmd, "--threshold", 95)
+		})
+
+		It("displays the usage as CSV", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(testUI.Out).To(Say("org,space,quota,resource,unit,used,limit,percentage,above_threshold\n"))
+			Expect(testUI.Out).To(Say("my-org,,big,memory,MB,1536,2048,75.0,false\n"))
+			Expect(testUI.Out).To(Say("my-org,,big,routes,,9,10,90.0,false\n"))
+			Expect(testUI.Out).To(Say("my-org,,big,app tasks,,12,5,,false\n"))
+			Expect(testUI.Out).To(Say("my-org,dev,,log rate,B/s,2048,,,false\n"))
+		})
+	})
+
+	When("there are no orgs", func() {
+		BeforeEach(func() {
+			fakeActor.GetQuotaUsageReturns(nil, nil, nil)
+		})
+
+		It("says so", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(testUI.Out).To(Say("No orgs found."))
+		})
+	})
+
+	When("getting the usage fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetQuotaUsageReturns(nil, v7action.Warnings{"usage warning"}, errors.New("boom"))
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError("boom"))
+			Expect(testUI.Err).To(Say("usage warning"))
+		})
+	})
+
+	When("checking the target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(errors.New("not logged in"))
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError("not logged in"))
+			Expect(fakeActor.GetQuotaUsageCallCount()).To(Equal(0))
+		})
+	})
+})
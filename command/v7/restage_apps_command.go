@@ -0,0 +1,288 @@
+package v7
+
+import (
+	"fmt"
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/api/logcache"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v7/shared"
+	"code.cloudfoundry.org/cli/util/concurrency"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+type RestageAppsCommand struct {
+	BaseCommand
+
+	Buildpack       string                  `long:"buildpack" required:"true" description:"Restage the apps using this buildpack"`
+	Outdated        bool                    `long:"outdated" description:"Only restage apps staged before the buildpack was last updated"`
+	Strategy        flag.DeploymentStrategy `long:"strategy" description:"Deployment strategy can be rolling or null."`
+	Parallel        int                     `long:"parallel" default:"1" description:"Maximum number of apps to restage at the same time"`
+	Force           bool                    `short:"f" description:"Restage the apps without confirmation"`
+	usage           interface{}             `usage:"CF_NAME restage-apps --buildpack BUILDPACK [--outdated] [--strategy rolling] [--parallel N] [-f]\n\n   Restages the started apps whose current droplet was built with BUILDPACK, for example after it was updated with a security fix. Stopped apps are skipped. This command will cause downtime unless you use '--strategy rolling'.\n\nEXAMPLES:\n   CF_NAME restage-apps --buildpack java_buildpack --outdated\n   CF_NAME restage-apps --buildpack java_buildpack --outdated --strategy rolling --parallel 5 -f"`
+	relatedCommands interface{}             `related_commands:"buildpack-usage, restage, update-buildpack"`
+
+	Stager shared.AppStager
+}
+
+type appRestageResult struct {
+	usage    v7action.BuildpackUsage
+	warnings v7action.Warnings
+	err      error
+	skipped  bool
+}
+
+func (cmd *RestageAppsCommand) Setup(config command.Config, ui command.UI) error {
+	err := cmd.BaseCommand.Setup(config, ui)
+	if err != nil {
+		return err
+	}
+
+	logCacheClient, err := logcache.NewClient(config.LogCacheEndpoint(), config, ui, v7action.NewDefaultKubernetesConfigGetter())
+	if err != nil {
+		return err
+	}
+
+	cmd.Stager = shared.NewAppStager(cmd.Actor, quietUI{UI: cmd.UI}, cmd.Config, logCacheClient)
+
+	return nil
+}
+
+func (cmd RestageAppsCommand) Execute(args []string) error {
+	err := cmd.validateFlags()
+	if err != nil {
+		return err
+	}
+
+	err = cmd.SharedActor.CheckTarget(false, false)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Actor.GetCurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Getting apps using buildpack {{.BuildpackName}} as {{.Username}}...", map[string]interface{}{
+		"BuildpackName": cmd.Buildpack,
+		"Username":      user.Name,
+	})
+	cmd.UI.DisplayNewline()
+
+	usages, warnings, err := cmd.Actor.GetBuildpackUsage(cmd.Buildpack)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	var candidates []v7action.BuildpackUsage
+	for _, usage := range usages {
+		if !cmd.Outdated || usage.Outdated {
+			candidates = append(candidates, usage)
+		}
+	}
+
+	if len(candidates) == 0 {
+		cmd.UI.DisplayText("No apps to restage.")
+		cmd.UI.DisplayOK()
+		return nil
+	}
+
+	table := [][]string{{
+		cmd.UI.TranslateText("org"),
+		cmd.UI.TranslateText("space"),
+		cmd.UI.TranslateText("app"),
+		cmd.UI.TranslateText("state"),
+		cmd.UI.TranslateText("version"),
+		cmd.UI.TranslateText("staged"),
+		cmd.UI.TranslateText("status"),
+	}}
+	var restageable []v7action.BuildpackUsage
+	for _, candidate := range candidates {
+		if candidate.App.Started() {
+			restageable = append(restageable, candidate)
+		}
+		table = append(table, []string{
+			candidate.OrgName,
+			candidate.SpaceName,
+			candidate.App.Name,
+			cmd.UI.TranslateText(strings.ToLower(string(candidate.App.State))),
+			candidate.Version,
+			formatStagedAt(candidate.StagedAt),
+			cmd.UI.TranslateText(buildpackUsageStatus(candidate)),
+		})
+	}
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+	cmd.UI.DisplayNewline()
+
+	if len(restageable) == 0 {
+		cmd.UI.DisplayText("All of these apps are stopped. Stopped apps are not restaged.")
+		cmd.UI.DisplayOK()
+		return nil
+	}
+
+	if cmd.Strategy.Name == constant.DeploymentStrategyDefault {
+		cmd.UI.DisplayWarning("This action will cause app downtime.")
+	}
+
+	if !cmd.Force {
+		restage, err := cmd.UI.DisplayBoolPrompt(false, "Really restage {{.Count}} apps?", map[string]interface{}{
+			"Count": len(restageable),
+		})
+		if err != nil {
+			return err
+		}
+		if !restage {
+			cmd.UI.DisplayText("No apps have been restaged.")
+			return nil
+		}
+	}
+
+	cmd.UI.DisplayText("Restaging {{.Count}} apps, up to {{.Parallel}} at a time...", map[string]interface{}{
+		"Count":    len(restageable),
+		"Parallel": cmd.Parallel,
+	})
+	cmd.UI.DisplayNewline()
+
+	results := cmd.restageApps(restageable)
+	for _, candidate := range candidates {
+		if !candidate.App.Started() {
+			results = append(results, appRestageResult{usage: candidate, skipped: true})
+		}
+	}
+
+	cmd.UI.DisplayNewline()
+	return cmd.displayRestageReport(results)
+}
+
+func (cmd RestageAppsCommand) validateFlags() error {
+	switch {
+	case cmd.Parallel < 1:
+		return translatableerror.IncorrectUsageError{Message: "--parallel must be greater than or equal to 1"}
+	case cmd.Strategy.Name == constant.DeploymentStrategyCanary:
+		return translatableerror.IncorrectUsageError{Message: "--strategy canary is not supported when restaging multiple apps"}
+	}
+
+	return nil
+}
+
+// restageApps restages the apps with at most cmd.Parallel restages in flight,
+// displaying each result as it completes. The results are returned in the
+// order of the usages.
+func (cmd RestageAppsCommand) restageApps(usages []v7action.BuildpackUsage) []appRestageResult {
+	results := make([]appRestageResult, len(usages))
+
+	concurrency.RunBounded(cmd.Parallel, len(usages),
+		func(i int) appRestageResult {
+			return cmd.restageApp(usages[i])
+		},
+		func(i int, result appRestageResult) {
+			results[i] = result
+			cmd.UI.DisplayWarnings(result.warnings)
+			if result.err != nil {
+				cmd.UI.DisplayWarning("Restage of app {{.AppName}} failed: {{.Error}}", map[string]interface{}{
+					"AppName": result.usage.App.Name,
+					"Error":   result.err.Error(),
+				})
+				return
+			}
+			cmd.UI.DisplayText("Restage of app {{.AppName}} complete.", map[string]interface{}{
+				"AppName": result.usage.App.Name,
+			})
+		},
+	)
+
+	return results
+}
+
+// restageApp stages the newest package of the app and runs the new droplet.
+func (cmd RestageAppsCommand) restageApp(usage v7action.BuildpackUsage) appRestageResult {
+	result := appRestageResult{usage: usage}
+	app := usage.App
+
+	pkg, warnings, err := cmd.Actor.GetNewestReadyPackageForApplication(app)
+	result.warnings = warnings
+	if err != nil {
+		result.err = err
+		return result
+	}
+
+	result.err = cmd.Stager.StageAndStart(
+		app,
+		configv3.Space{GUID: app.SpaceGUID, Name: usage.SpaceName},
+		configv3.Organization{Name: usage.OrgName},
+		pkg.GUID,
+		shared.AppStartOpts{AppAction: constant.ApplicationRestarting, Strategy: cmd.Strategy.Name},
+	)
+	return result
+}
+
+func (cmd RestageAppsCommand) displayRestageReport(results []appRestageResult) error {
+	var (
+		restaged, skipped int
+		failures          []string
+	)
+	table := [][]string{{
+		cmd.UI.TranslateText("org"),
+		cmd.UI.TranslateText("space"),
+		cmd.UI.TranslateText("app"),
+		cmd.UI.TranslateText("result"),
+		cmd.UI.TranslateText("details"),
+	}}
+	for _, result := range results {
+		row := []string{result.usage.OrgName, result.usage.SpaceName, result.usage.App.Name}
+
+		switch {
+		case result.skipped:
+			skipped++
+			row = append(row, cmd.UI.TranslateText("skipped"), cmd.UI.TranslateText("app is stopped"))
+		case result.err != nil:
+			failures = append(failures, fmt.Sprintf("%s: %s", result.usage.App.Name, result.err))
+			row = append(row, cmd.UI.TranslateText("failed"), result.err.Error())
+		default:
+			restaged++
+			row = append(row, cmd.UI.TranslateText("restaged"), "")
+		}
+		table = append(table, row)
+	}
+
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayText("{{.Restaged}} restaged, {{.Failed}} failed, {{.Skipped}} skipped", map[string]interface{}{
+		"Restaged": restaged,
+		"Failed":   len(failures),
+		"Skipped":  skipped,
+	})
+
+	if len(failures) > 0 {
+		return translatableerror.MultiError{Messages: failures}
+	}
+
+	cmd.UI.DisplayOK()
+	return nil
+}
+
+// quietUI hides the progress output of the apps restaged in parallel, which
+// would otherwise interleave. Warnings are still displayed.
+type quietUI struct {
+	command.UI
+}
+
+func (quietUI) DisplayInstancesTableForApp([][]string)                  {}
+func (quietUI) DisplayKeyValueTable(string, [][]string, int)            {}
+func (quietUI) DisplayKeyValueTableForApp([][]string)                   {}
+func (quietUI) DisplayLogMessage(ui.LogMessage, bool)                   {}
+func (quietUI) DisplayNewline()                                         {}
+func (quietUI) DisplayNonWrappingTable(string, [][]string, int)         {}
+func (quietUI) DisplayOK()                                              {}
+func (quietUI) DisplayTableWithHeader(string, [][]string, int)          {}
+func (quietUI) DisplayText(string, ...map[string]interface{})           {}
+func (quietUI) DisplayTextLiteral(string)                               {}
+func (quietUI) DisplayTextWithBold(string, ...map[string]interface{})   {}
+func (quietUI) DisplayTextWithFlavor(string, ...map[string]interface{}) {}
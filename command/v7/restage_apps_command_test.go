@@ -0,0 +1,225 @@
+package v7_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	v7 "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/shared"
+	"code.cloudfoundry.org/cli/command/v7/shared/sharedfakes"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("restage-apps Command", func() {
+	var (
+		cmd             v7.RestageAppsCommand
+		testUI          *ui.UI
+		input           *Buffer
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeActor
+		fakeAppStager   *sharedfakes.FakeAppStager
+		executeErr      error
+
+		apiApp, webApp, stoppedApp resources.Application
+	)
+
+	BeforeEach(func() {
+		input = NewBuffer()
+		testUI = ui.NewTestUI(input, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+		fakeAppStager = new(sharedfakes.FakeAppStager)
+
+		cmd = v7.RestageAppsCommand{
+			BaseCommand: v7.BaseCommand{
+				UI:          testUI,
+				Config:      fakeConfig,
+				SharedActor: fakeSharedActor,
+				Actor:       fakeActor,
+			},
+			Buildpack: "java_buildpack",
+			Parallel:  1,
+			Stager:    fakeAppStager,
+		}
+
+		fakeActor.GetCurrentUserReturns(configv3.User{Name: "steve"}, nil)
+
+		apiApp = resources.Application{GUID: "api-guid", Name: "api", SpaceGUID: "dev-guid", State: constant.ApplicationStarted}
+		webApp = resources.Application{GUID: "web-guid", Name: "web", SpaceGUID: "dev-guid", State: constant.ApplicationStarted}
+		stoppedApp = resources.Application{GUID: "stopped-guid", Name: "stopped", SpaceGUID: "dev-guid", State: constant.ApplicationStopped}
+		fakeActor.GetBuildpackUsageReturns(
+			[]v7action.BuildpackUsage{
+				{App: apiApp, OrgName: "my-org", SpaceName: "dev", BuildpackName: "java_buildpack", Version: "4.1", Outdated: true},
+				{App: stoppedApp, OrgName: "my-org", SpaceName: "dev", BuildpackName: "java_buildpack", Version: "4.1", Outdated: true},
+				{App: webApp, OrgName: "my-org", SpaceName: "dev", BuildpackName: "java_buildpack", Version: "4.2"},
+			},
+			v7action.Warnings{"usage warning"},
+			nil,
+		)
+
+		fakeActor.GetNewestReadyPackageForApplicationStub = func(app resources.Application) (resources.Package, v7action.Warnings, error) {
+			return resources.Package{GUID: app.Name + "-package"}, v7action.Warnings{"package warning"}, nil
+		}
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	It("checks the user is logged in", func() {
+		checkOrg, checkSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+		Expect(checkOrg).To(BeFalse())
+		Expect(checkSpace).To(BeFalse())
+	})
+
+	When("--outdated is given and the user confirms", func() {
+		BeforeEach(func() {
+			setFlag(&cmd, "--outdated")
+			_, err := input.Write([]byte("y\n"))
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("restarts the outdated started apps with a new droplet and skips stopped apps", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(fakeActor.GetBuildpackUsageArgsForCall(0)).To(Equal("java_buildpack"))
+
+			Expect(testUI.Out).To(Say(`Getting apps using buildpack java_buildpack as steve\.\.\.`))
+			Expect(testUI.Out).To(Say(`org\s+space\s+app\s+state\s+version\s+staged\s+status`))
+			Expect(testUI.Out).To(Say(`my-org\s+dev\s+api\s+started\s+4\.1\s+outdated`))
+			Expect(testUI.Out).To(Say(`my-org\s+dev\s+stopped\s+stopped\s+4\.1\s+outdated`))
+			Expect(testUI.Err).To(Say("This action will cause app downtime."))
+			Expect(testUI.Out).To(Say(`Really restage 1 apps\?`))
+			Expect(testUI.Out).To(Say(`Restaging 1 apps, up to 1 at a time\.\.\.`))
+			Expect(testUI.Out).To(Say(`Restage of app api complete\.`))
+			Expect(testUI.Out).To(Say(`org\s+space\s+app\s+result\s+details`))
+			Expect(testUI.Out).To(Say(`my-org\s+dev\s+api\s+restaged`))
+			Expect(testUI.Out).To(Say(`my-org\s+dev\s+stopped\s+skipped\s+app is stopped`))
+			Expect(testUI.Out).To(Say("1 restaged, 0 failed, 1 skipped"))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Err).To(Say("package warning"))
+
+			Expect(fakeAppStager.StageAndStartCallCount()).To(Equal(1))
+			app, space, org, packageGUID, opts := fakeAppStager.StageAndStartArgsForCall(0)
+			Expect(app).To(Equal(apiApp))
+			Expect(space).To(Equal(configv3.Space{GUID: "dev-guid", Name: "dev"}))
+			Expect(org).To(Equal(configv3.Organization{Name: "my-org"}))
+			Expect(packageGUID).To(Equal("api-package"))
+			Expect(opts).To(Equal(shared.AppStartOpts{AppAction: constant.ApplicationRestarting}))
+		})
+	})
+
+	When("the rolling strategy is given", func() {
+		BeforeEach(func() {
+			setFlag(&cmd, "--strategy", flag.DeploymentStrategy{Name: constant.DeploymentStrategyRolling})
+			setFlag(&cmd, "-f")
+			setFlag(&cmd, "--parallel", 2)
+		})
+
+		It("deploys the new droplets of all started apps without prompting", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(testUI.Out).NotTo(Say("Really restage"))
+			Expect(testUI.Err).NotTo(Say("downtime"))
+
+			Expect(fakeAppStager.StageAndStartCallCount()).To(Equal(2))
+			var packages []string
+			for i := 0; i < 2; i++ {
+				_, _, _, packageGUID, opts := fakeAppStager.StageAndStartArgsForCall(i)
+				Expect(opts.Strategy).To(Equal(constant.DeploymentStrategyRolling))
+				packages = append(packages, packageGUID)
+			}
+			Expect(packages).To(ConsistOf("api-package", "web-package"))
+
+			Expect(testUI.Out).To(Say("2 restaged, 0 failed, 1 skipped"))
+		})
+	})
+
+	When("the user declines", func() {
+		BeforeEach(func() {
+			_, err := input.Write([]byte("n\n"))
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("does not restage any apps", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(testUI.Out).To(Say("No apps have been restaged."))
+			Expect(fakeAppStager.StageAndStartCallCount()).To(Equal(0))
+		})
+	})
+
+	When("restaging an app fails", func() {
+		BeforeEach(func() {
+			setFlag(&cmd, "-f")
+			fakeAppStager.StageAndStartStub = func(app resources.Application, _ configv3.Space, _ configv3.Organization, _ string, _ shared.AppStartOpts) error {
+				if app.Name == "web" {
+					return errors.New("instances crashed")
+				}
+				return nil
+			}
+		})
+
+		It("restages the other apps and reports the failure", func() {
+			Expect(executeErr).To(MatchError(translatableerror.MultiError{Messages: []string{"web: instances crashed"}}))
+			Expect(testUI.Err).To(Say("Restage of app web failed: instances crashed"))
+			Expect(testUI.Out).To(Say(`my-org\s+dev\s+web\s+failed\s+instances crashed`))
+			Expect(testUI.Out).To(Say("1 restaged, 1 failed, 1 skipped"))
+		})
+	})
+
+	When("getting the newest package of an app fails", func() {
+		BeforeEach(func() {
+			setFlag(&cmd, "-f")
+			setFlag(&cmd, "--outdated")
+			fakeActor.GetNewestReadyPackageForApplicationReturns(resources.Package{}, nil, errors.New("no package"))
+		})
+
+		It("does not stage the app", func() {
+			Expect(executeErr).To(MatchError(translatableerror.MultiError{Messages: []string{"api: no package"}}))
+			Expect(fakeAppStager.StageAndStartCallCount()).To(Equal(0))
+		})
+	})
+
+	When("no apps use the buildpack", func() {
+		BeforeEach(func() {
+			fakeActor.GetBuildpackUsageReturns(nil, nil, nil)
+		})
+
+		It("says so", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(testUI.Out).To(Say("No apps to restage."))
+		})
+	})
+
+	DescribeTable("invalid flags",
+		func(setup func(), message string) {
+			setup()
+			Expect(cmd.Execute(nil)).To(MatchError(translatableerror.IncorrectUsageError{Message: message}))
+		},
+		Entry("--parallel below 1", func() { setFlag(&cmd, "--parallel", 0) }, "--parallel must be greater than or equal to 1"),
+		Entry("the canary strategy", func() {
+			setFlag(&cmd, "--strategy", flag.DeploymentStrategy{Name: constant.DeploymentStrategyCanary})
+		}, "--strategy canary is not supported when restaging multiple apps"),
+	)
+
+	When("getting the buildpack usage fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetBuildpackUsageReturns(nil, v7action.Warnings{"usage warning"}, errors.New("boom"))
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError("boom"))
+			Expect(testUI.Err).To(Say("usage warning"))
+		})
+	})
+})
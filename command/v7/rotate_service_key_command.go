@@ -0,0 +1,441 @@
+package v7
+
+import (
+	"regexp"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/api/logcache"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v7/shared"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/configv3"
+)
+
+// rotatedKeySuffix matches the timestamp appended to the names of rotated
+// service keys, so that rotating a key again replaces the suffix.
+var rotatedKeySuffix = regexp.MustCompile(`-\d{14}$`)
+
+type RotateServiceKeyCommand struct {
+	BaseCommand
+
+	RequiredArgs        flag.OptionalServiceInstanceKey `positional-args:"yes"`
+	NewKeyName          string                          `long:"new-key-name" description:"Name of the new service key. Default: SERVICE_KEY with a timestamp suffix"`
+	Apps                []string                        `long:"app" description:"Rotate the binding of this app; may be repeated"`
+	AllApps             bool                            `long:"all-apps" description:"Rotate the bindings of all apps in the targeted space bound to the service instance"`
+	Restage             bool                            `long:"restage" description:"Restage apps after rebinding them instead of restarting them"`
+	ParametersAsJSON    flag.JSONOrFileWithValidation   `short:"c" description:"Valid JSON object containing service-specific configuration parameters for the new credentials, provided either in-line or in a file"`
+	usage               interface{}                     `usage:"CF_NAME rotate-service-key SERVICE_INSTANCE SERVICE_KEY [--new-key-name NEW_KEY] [-c PARAMETERS_AS_JSON]\n   CF_NAME rotate-service-key SERVICE_INSTANCE (--app APP_NAME... | --all-apps) [--restage] [-c PARAMETERS_AS_JSON]\n\n   A service key is rotated by creating a new key and deleting the old key once the new key is ready. If the new key cannot be created, it is deleted again and the old key is kept.\n\n   Each app is bound to the service instance a second time with new credentials and restarted or restaged with a rolling deployment. Its previous binding is deleted once the app has started with the new credentials. This requires a Cloud Controller that allows an app to be bound to the same service instance more than once. If the new binding cannot be created or the app fails to start with it, the new binding is deleted and the previous binding is kept. Apps are rotated one at a time and rotation stops at the first failure.\n\nEXAMPLES:\n   CF_NAME rotate-service-key mydb mykey\n   CF_NAME rotate-service-key mydb mykey --new-key-name mykey-v2\n   CF_NAME rotate-service-key mydb --app app1 --app app2\n   CF_NAME rotate-service-key mydb --all-apps --restage"`
+	relatedCommands     interface{}                     `related_commands:"bind-service, create-service-key, delete-service-key, restage, service-keys"`
+	envCFStagingTimeout interface{}                     `environmentName:"CF_STAGING_TIMEOUT" environmentDescription:"Max wait time for staging, in minutes" environmentDefault:"15"`
+	envCFStartupTimeout interface{}                     `environmentName:"CF_STARTUP_TIMEOUT" environmentDescription:"Max wait time for app instance startup, in minutes" environmentDefault:"5"`
+
+	Stager shared.AppStager
+}
+
+func (cmd *RotateServiceKeyCommand) Setup(config command.Config, ui command.UI) error {
+	err := cmd.BaseCommand.Setup(config, ui)
+	if err != nil {
+		return err
+	}
+
+	logCacheClient, err := logcache.NewClient(config.LogCacheEndpoint(), config, ui, v7action.NewDefaultKubernetesConfigGetter())
+	if err != nil {
+		return err
+	}
+
+	cmd.Stager = shared.NewAppStager(cmd.Actor, cmd.UI, cmd.Config, logCacheClient)
+
+	return nil
+}
+
+func (cmd RotateServiceKeyCommand) Execute(args []string) error {
+	err := cmd.validateFlags()
+	if err != nil {
+		return err
+	}
+
+	err = cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Actor.GetCurrentUser()
+	if err != nil {
+		return err
+	}
+
+	if cmd.RequiredArgs.ServiceKey != "" {
+		return cmd.rotateServiceKey(user)
+	}
+
+	return cmd.rotateAppBindings(user)
+}
+
+func (cmd RotateServiceKeyCommand) validateFlags() error {
+	appFlag := "--app"
+	if cmd.AllApps {
+		appFlag = "--all-apps"
+	}
+
+	switch {
+	case len(cmd.Apps) > 0 && cmd.AllApps:
+		return translatableerror.ArgumentCombinationError{Args: []string{"--app", "--all-apps"}}
+	case cmd.RequiredArgs.ServiceKey != "" && (len(cmd.Apps) > 0 || cmd.AllApps):
+		return translatableerror.ArgumentCombinationError{Args: []string{"SERVICE_KEY", appFlag}}
+	case cmd.RequiredArgs.ServiceKey != "" && cmd.Restage:
+		return translatableerror.ArgumentCombinationError{Args: []string{"SERVICE_KEY", "--restage"}}
+	case cmd.RequiredArgs.ServiceKey == "" && len(cmd.Apps) == 0 && !cmd.AllApps:
+		return translatableerror.RequiredArgumentError{ArgumentName: "SERVICE_KEY"}
+	case cmd.RequiredArgs.ServiceKey == "" && cmd.NewKeyName != "":
+		return translatableerror.ArgumentCombinationError{Args: []string{appFlag, "--new-key-name"}}
+	case cmd.NewKeyName != "" && cmd.NewKeyName == cmd.RequiredArgs.ServiceKey:
+		return translatableerror.IncorrectUsageError{Message: "--new-key-name must differ from SERVICE_KEY"}
+	}
+
+	return nil
+}
+
+func (cmd RotateServiceKeyCommand) rotateServiceKey(user configv3.User) error {
+	newKeyName := cmd.NewKeyName
+	if newKeyName == "" {
+		newKeyName = rotatedKeyName(cmd.RequiredArgs.ServiceKey, time.Now())
+	}
+
+	names := map[string]interface{}{
+		"ServiceInstance": cmd.RequiredArgs.ServiceInstance,
+		"ServiceKey":      cmd.RequiredArgs.ServiceKey,
+		"NewServiceKey":   newKeyName,
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Rotating service key {{.ServiceKey}} for service instance {{.ServiceInstance}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+		"ServiceKey":      cmd.RequiredArgs.ServiceKey,
+		"ServiceInstance": cmd.RequiredArgs.ServiceInstance,
+		"OrgName":         cmd.Config.TargetedOrganization().Name,
+		"SpaceName":       cmd.Config.TargetedSpace().Name,
+		"Username":        user.Name,
+	})
+	cmd.UI.DisplayNewline()
+
+	_, warnings, err := cmd.Actor.GetServiceKeyByServiceInstanceAndName(cmd.RequiredArgs.ServiceInstance, cmd.RequiredArgs.ServiceKey, cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayText("Creating service key {{.NewServiceKey}}...", names)
+	stream, warnings, err := cmd.Actor.CreateServiceKey(v7action.CreateServiceKeyParams{
+		SpaceGUID:           cmd.Config.TargetedSpace().GUID,
+		ServiceInstanceName: cmd.RequiredArgs.ServiceInstance,
+		ServiceKeyName:      newKeyName,
+		Parameters:          types.OptionalObject(cmd.ParametersAsJSON),
+	})
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	_, err = shared.WaitForResult(stream, cmd.UI, true)
+	if err != nil {
+		cmd.rollBackServiceKey(names)
+		return err
+	}
+
+	cmd.UI.DisplayText("Deleting service key {{.ServiceKey}}...", names)
+	err = cmd.deleteServiceKey(cmd.RequiredArgs.ServiceKey)
+	if err != nil {
+		cmd.UI.DisplayWarning("Service key {{.NewServiceKey}} was created, but service key {{.ServiceKey}} could not be deleted. Delete it with 'cf delete-service-key {{.ServiceInstance}} {{.ServiceKey}}' once the problem is resolved.", names)
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayText("TIP: Use 'cf service-key {{.ServiceInstance}} {{.NewServiceKey}}' to retrieve the new credentials.", names)
+
+	return nil
+}
+
+// rollBackServiceKey deletes a service key that failed to be created so that
+// only the old key remains.
+func (cmd RotateServiceKeyCommand) rollBackServiceKey(names map[string]interface{}) {
+	cmd.UI.DisplayWarning("Service key {{.NewServiceKey}} could not be created. Rolling back; service key {{.ServiceKey}} is unchanged.", names)
+
+	err := cmd.deleteServiceKey(names["NewServiceKey"].(string))
+	if err != nil {
+		cmd.UI.DisplayWarning("Service key {{.NewServiceKey}} could not be deleted: {{.Error}}", map[string]interface{}{
+			"NewServiceKey": names["NewServiceKey"],
+			"Error":         err.Error(),
+		})
+	}
+}
+
+func (cmd RotateServiceKeyCommand) deleteServiceKey(serviceKeyName string) error {
+	stream, warnings, err := cmd.Actor.DeleteServiceKeyByServiceInstanceAndName(cmd.RequiredArgs.ServiceInstance, serviceKeyName, cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	_, err = shared.WaitForResult(stream, cmd.UI, true)
+	return err
+}
+
+func (cmd RotateServiceKeyCommand) rotateAppBindings(user configv3.User) error {
+	cmd.UI.DisplayTextWithFlavor("Rotating app bindings for service instance {{.ServiceInstance}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+		"ServiceInstance": cmd.RequiredArgs.ServiceInstance,
+		"OrgName":         cmd.Config.TargetedOrganization().Name,
+		"SpaceName":       cmd.Config.TargetedSpace().Name,
+		"Username":        user.Name,
+	})
+	cmd.UI.DisplayNewline()
+
+	bindings, warnings, err := cmd.Actor.GetServiceAppBindingsByServiceInstance(cmd.RequiredArgs.ServiceInstance, cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	selected, err := cmd.selectAppBindings(bindings)
+	if err != nil {
+		return err
+	}
+
+	if len(selected) == 0 {
+		cmd.UI.DisplayText("No apps in this space are bound to service instance {{.ServiceInstance}}.", map[string]interface{}{
+			"ServiceInstance": cmd.RequiredArgs.ServiceInstance,
+		})
+		cmd.UI.DisplayOK()
+		return nil
+	}
+
+	for i, binding := range selected {
+		err = cmd.rotateAppBinding(binding)
+		if err != nil {
+			cmd.displayAppRotationProgress(selected[:i], selected[i+1:])
+			return err
+		}
+	}
+
+	cmd.UI.DisplayOK()
+	return nil
+}
+
+// selectAppBindings returns the bindings of the requested apps, or all apps
+// in the targeted space when --all-apps is set. Apps in other spaces that the
+// instance is shared with cannot be restarted from here and are skipped.
+func (cmd RotateServiceKeyCommand) selectAppBindings(bindings []resources.ServiceCredentialBinding) ([]resources.ServiceCredentialBinding, error) {
+	spaceGUID := cmd.Config.TargetedSpace().GUID
+
+	if cmd.AllApps {
+		var selected []resources.ServiceCredentialBinding
+		for _, binding := range bindings {
+			if binding.AppSpaceGUID != "" && binding.AppSpaceGUID != spaceGUID {
+				cmd.UI.DisplayWarning("App {{.AppName}} in another space is bound to service instance {{.ServiceInstance}} and will not be rotated.", map[string]interface{}{
+					"AppName":         binding.AppName,
+					"ServiceInstance": cmd.RequiredArgs.ServiceInstance,
+				})
+				continue
+			}
+			selected = append(selected, binding)
+		}
+		return selected, nil
+	}
+
+	var selected []resources.ServiceCredentialBinding
+	for _, appName := range cmd.Apps {
+		found := false
+		for _, binding := range bindings {
+			if binding.AppName == appName && (binding.AppSpaceGUID == "" || binding.AppSpaceGUID == spaceGUID) {
+				selected = append(selected, binding)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, actionerror.AppNotBoundToServiceInstanceError{
+				AppName:             appName,
+				ServiceInstanceName: cmd.RequiredArgs.ServiceInstance,
+			}
+		}
+	}
+	return selected, nil
+}
+
+func (cmd RotateServiceKeyCommand) rotateAppBinding(binding resources.ServiceCredentialBinding) error {
+	names := map[string]interface{}{
+		"AppName":         binding.AppName,
+		"ServiceInstance": cmd.RequiredArgs.ServiceInstance,
+	}
+
+	cmd.UI.DisplayText("Binding app {{.AppName}} to service instance {{.ServiceInstance}} with new credentials...", names)
+	err := cmd.bindApp(binding)
+	if _, ok := err.(actionerror.ResourceAlreadyExistsError); ok {
+		return translatableerror.ServiceBindingRotationUnsupportedError{
+			AppName:             binding.AppName,
+			ServiceInstanceName: cmd.RequiredArgs.ServiceInstance,
+		}
+	}
+	if err != nil {
+		cmd.UI.DisplayWarning("App {{.AppName}} could not be bound to service instance {{.ServiceInstance}} with new credentials. Its existing binding was not changed.", names)
+		return err
+	}
+
+	newBindingGUID, err := cmd.getNewAppBindingGUID(binding)
+	if err != nil {
+		cmd.UI.DisplayWarning("App {{.AppName}} is bound to service instance {{.ServiceInstance}} twice. Delete the previous binding once the problem is resolved.", names)
+		return err
+	}
+
+	err = cmd.restartApp(binding.AppName, names)
+	if err != nil {
+		cmd.rollBackAppBinding(newBindingGUID, names)
+		return err
+	}
+
+	cmd.UI.DisplayText("Deleting the previous binding of app {{.AppName}}...", names)
+	err = cmd.deleteAppBinding(binding.GUID)
+	if err != nil {
+		cmd.UI.DisplayWarning("App {{.AppName}} is bound to service instance {{.ServiceInstance}} twice. Delete the previous binding once the problem is resolved.", names)
+		return err
+	}
+
+	return nil
+}
+
+// getNewAppBindingGUID returns the GUID of the binding created next to the
+// given binding of the same app.
+func (cmd RotateServiceKeyCommand) getNewAppBindingGUID(previous resources.ServiceCredentialBinding) (string, error) {
+	bindings, warnings, err := cmd.Actor.GetServiceAppBindingsByServiceInstance(cmd.RequiredArgs.ServiceInstance, cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return "", err
+	}
+
+	for _, binding := range bindings {
+		if binding.AppName == previous.AppName && binding.AppSpaceGUID == previous.AppSpaceGUID && binding.GUID != previous.GUID {
+			return binding.GUID, nil
+		}
+	}
+
+	return "", actionerror.ServiceBindingNotFoundError{
+		AppGUID:             previous.AppGUID,
+		ServiceInstanceGUID: previous.ServiceInstanceGUID,
+	}
+}
+
+// restartApp restarts or restages the app with a rolling deployment so that
+// it picks up its new binding. Stopped apps are left stopped.
+func (cmd RotateServiceKeyCommand) restartApp(appName string, names map[string]interface{}) error {
+	app, warnings, err := cmd.Actor.GetApplicationByNameAndSpace(appName, cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	if !app.Started() {
+		cmd.UI.DisplayText("App {{.AppName}} is stopped; the new credentials take effect when it is started.", names)
+		cmd.UI.DisplayNewline()
+		return nil
+	}
+
+	opts := shared.AppStartOpts{
+		AppAction: constant.ApplicationRestarting,
+		Strategy:  constant.DeploymentStrategyRolling,
+	}
+
+	if cmd.Restage {
+		pkg, warnings, err := cmd.Actor.GetNewestReadyPackageForApplication(app)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return mapErr(cmd.Config, app.Name, err)
+		}
+		err = cmd.Stager.StageAndStart(app, cmd.Config.TargetedSpace(), cmd.Config.TargetedOrganization(), pkg.GUID, opts)
+		if err != nil {
+			return mapErr(cmd.Config, app.Name, err)
+		}
+		return nil
+	}
+
+	err = cmd.Stager.StartApp(app, cmd.Config.TargetedSpace(), cmd.Config.TargetedOrganization(), "", opts)
+	if err != nil {
+		return mapErr(cmd.Config, app.Name, err)
+	}
+	return nil
+}
+
+// rollBackAppBinding deletes the new binding of an app that failed to start
+// with it, so that only its previous binding remains.
+func (cmd RotateServiceKeyCommand) rollBackAppBinding(newBindingGUID string, names map[string]interface{}) {
+	cmd.UI.DisplayWarning("App {{.AppName}} could not be started with new credentials. Rolling back; its previous binding is unchanged.", names)
+
+	err := cmd.deleteAppBinding(newBindingGUID)
+	if err != nil {
+		cmd.UI.DisplayWarning("The new binding of app {{.AppName}} could not be deleted: {{.Error}}", map[string]interface{}{
+			"AppName": names["AppName"],
+			"Error":   err.Error(),
+		})
+	}
+}
+
+// bindApp binds the app to the service instance a second time, with the name
+// of its existing binding and the new parameters.
+func (cmd RotateServiceKeyCommand) bindApp(binding resources.ServiceCredentialBinding) error {
+	stream, warnings, err := cmd.Actor.CreateServiceAppBinding(v7action.CreateServiceAppBindingParams{
+		SpaceGUID:           cmd.Config.TargetedSpace().GUID,
+		ServiceInstanceName: cmd.RequiredArgs.ServiceInstance,
+		AppName:             binding.AppName,
+		BindingName:         binding.Name,
+		Parameters:          types.OptionalObject(cmd.ParametersAsJSON),
+	})
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	_, err = shared.WaitForResult(stream, cmd.UI, true)
+	return err
+}
+
+func (cmd RotateServiceKeyCommand) deleteAppBinding(bindingGUID string) error {
+	stream, warnings, err := cmd.Actor.DeleteServiceAppBindingByGUID(bindingGUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	_, err = shared.WaitForResult(stream, cmd.UI, true)
+	return err
+}
+
+func (cmd RotateServiceKeyCommand) displayAppRotationProgress(rotated, remaining []resources.ServiceCredentialBinding) {
+	if len(rotated) > 0 {
+		cmd.UI.DisplayText("Bindings rotated for apps: {{.Apps}}", map[string]interface{}{
+			"Apps": strings.Join(bindingAppNames(rotated), ", "),
+		})
+	}
+	if len(remaining) > 0 {
+		cmd.UI.DisplayWarning("Bindings not rotated for apps: {{.Apps}}", map[string]interface{}{
+			"Apps": strings.Join(bindingAppNames(remaining), ", "),
+		})
+	}
+}
+
+func bindingAppNames(bindings []resources.ServiceCredentialBinding) []string {
+	var names []string
+	for _, binding := range bindings {
+		names = append(names, binding.AppName)
+	}
+	return names
+}
+
+// rotatedKeyName names a new service key after the key it replaces, with the
+// time of the rotation as a suffix.
+func rotatedKeyName(serviceKeyName string, now time.Time) string {
+	return rotatedKeySuffix.ReplaceAllString(serviceKeyName, "") + "-" + now.UTC().Format("20060102150405")
+}
@@ -0,0 +1,519 @@
+package v7_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	v7 "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/shared"
+	"code.cloudfoundry.org/cli/command/v7/shared/sharedfakes"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("rotate-service-key Command", func() {
+	var (
+		cmd             v7.RotateServiceKeyCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeActor
+		fakeAppStager   *sharedfakes.FakeAppStager
+		executeErr      error
+	)
+
+	const (
+		serviceInstanceName = "some-db"
+		serviceKeyName      = "some-key"
+		spaceGUID           = "some-space-guid"
+	)
+
+	failedStream := func(err error) chan v7action.PollJobEvent {
+		stream := make(chan v7action.PollJobEvent, 1)
+		stream <- v7action.PollJobEvent{State: v7action.JobFailed, Err: err}
+		close(stream)
+		return stream
+	}
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+		fakeAppStager = new(sharedfakes.FakeAppStager)
+
+		cmd = v7.RotateServiceKeyCommand{
+			BaseCommand: v7.BaseCommand{
+				UI:          testUI,
+				Config:      fakeConfig,
+				SharedActor: fakeSharedActor,
+				Actor:       fakeActor,
+			},
+			Stager: fakeAppStager,
+		}
+		cmd.RequiredArgs.ServiceInstance = serviceInstanceName
+
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: spaceGUID})
+		fakeActor.GetCurrentUserReturns(configv3.User{Name: "steve"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Describe("flag validation", func() {
+		When("neither a service key nor apps are given", func() {
+			It("returns a required argument error", func() {
+				Expect(executeErr).To(MatchError(translatableerror.RequiredArgumentError{ArgumentName: "SERVICE_KEY"}))
+				Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+			})
+		})
+
+		When("a service key and --app are given", func() {
+			BeforeEach(func() {
+				cmd.RequiredArgs.ServiceKey = serviceKeyName
+				cmd.Apps = []string{"some-app"}
+			})
+
+			It("returns an argument combination error", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{Args: []string{"SERVICE_KEY", "--app"}}))
+			})
+		})
+
+		When("--app and --all-apps are given", func() {
+			BeforeEach(func() {
+				cmd.Apps = []string{"some-app"}
+				cmd.AllApps = true
+			})
+
+			It("returns an argument combination error", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{Args: []string{"--app", "--all-apps"}}))
+			})
+		})
+
+		When("a service key and --restage are given", func() {
+			BeforeEach(func() {
+				cmd.RequiredArgs.ServiceKey = serviceKeyName
+				cmd.Restage = true
+			})
+
+			It("returns an argument combination error", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{Args: []string{"SERVICE_KEY", "--restage"}}))
+			})
+		})
+
+		When("--new-key-name is given with --all-apps", func() {
+			BeforeEach(func() {
+				cmd.AllApps = true
+				cmd.NewKeyName = "new-key"
+			})
+
+			It("returns an argument combination error", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{Args: []string{"--all-apps", "--new-key-name"}}))
+			})
+		})
+
+		When("--new-key-name is the same as the service key", func() {
+			BeforeEach(func() {
+				cmd.RequiredArgs.ServiceKey = serviceKeyName
+				cmd.NewKeyName = serviceKeyName
+			})
+
+			It("returns an incorrect usage error", func() {
+				Expect(executeErr).To(MatchError(translatableerror.IncorrectUsageError{Message: "--new-key-name must differ from SERVICE_KEY"}))
+			})
+		})
+	})
+
+	When("checking the target fails", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.ServiceKey = serviceKeyName
+			fakeSharedActor.CheckTargetReturns(actionerror.NoOrganizationTargetedError{BinaryName: "cf"})
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NoOrganizationTargetedError{BinaryName: "cf"}))
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	Describe("rotating a service key", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.ServiceKey = serviceKeyName
+			cmd.NewKeyName = "new-key"
+			setFlag(&cmd, "-c", `{"foo": "bar"}`)
+
+			fakeActor.GetServiceKeyByServiceInstanceAndNameReturns(resources.ServiceCredentialBinding{GUID: "some-key-guid"}, v7action.Warnings{"get-key-warning"}, nil)
+			fakeActor.CreateServiceKeyReturns(nil, v7action.Warnings{"create-key-warning"}, nil)
+			fakeActor.DeleteServiceKeyByServiceInstanceAndNameReturns(nil, v7action.Warnings{"delete-key-warning"}, nil)
+		})
+
+		It("creates the new key before deleting the old key", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+
+			name, key, space := fakeActor.GetServiceKeyByServiceInstanceAndNameArgsForCall(0)
+			Expect(name).To(Equal(serviceInstanceName))
+			Expect(key).To(Equal(serviceKeyName))
+			Expect(space).To(Equal(spaceGUID))
+
+			Expect(fakeActor.CreateServiceKeyCallCount()).To(Equal(1))
+			Expect(fakeActor.CreateServiceKeyArgsForCall(0)).To(Equal(v7action.CreateServiceKeyParams{
+				SpaceGUID:           spaceGUID,
+				ServiceInstanceName: serviceInstanceName,
+				ServiceKeyName:      "new-key",
+				Parameters:          types.NewOptionalObject(map[string]interface{}{"foo": "bar"}),
+			}))
+
+			Expect(fakeActor.DeleteServiceKeyByServiceInstanceAndNameCallCount()).To(Equal(1))
+			name, key, space = fakeActor.DeleteServiceKeyByServiceInstanceAndNameArgsForCall(0)
+			Expect(name).To(Equal(serviceInstanceName))
+			Expect(key).To(Equal(serviceKeyName))
+			Expect(space).To(Equal(spaceGUID))
+		})
+
+		It("displays progress and warnings", func() {
+			Expect(testUI.Out).To(Say(`Rotating service key some-key for service instance some-db in org some-org / space some-space as steve\.\.\.`))
+			Expect(testUI.Out).To(Say(`Creating service key new-key\.\.\.`))
+			Expect(testUI.Out).To(Say(`Deleting service key some-key\.\.\.`))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Out).To(Say(`TIP: Use 'cf service-key some-db new-key' to retrieve the new credentials\.`))
+
+			Expect(testUI.Err).To(Say("get-key-warning"))
+			Expect(testUI.Err).To(Say("create-key-warning"))
+			Expect(testUI.Err).To(Say("delete-key-warning"))
+		})
+
+		When("no new key name is given", func() {
+			BeforeEach(func() {
+				cmd.RequiredArgs.ServiceKey = "some-key-20240101120000"
+				cmd.NewKeyName = ""
+			})
+
+			It("replaces the timestamp suffix of the old key name", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(fakeActor.CreateServiceKeyArgsForCall(0).ServiceKeyName).To(MatchRegexp(`^some-key-\d{14}$`))
+				Expect(fakeActor.CreateServiceKeyArgsForCall(0).ServiceKeyName).NotTo(Equal("some-key-20240101120000"))
+			})
+		})
+
+		When("the old key does not exist", func() {
+			BeforeEach(func() {
+				fakeActor.GetServiceKeyByServiceInstanceAndNameReturns(resources.ServiceCredentialBinding{}, nil, actionerror.ServiceKeyNotFoundError{KeyName: serviceKeyName, ServiceInstanceName: serviceInstanceName})
+			})
+
+			It("returns the error without creating a key", func() {
+				Expect(executeErr).To(MatchError(actionerror.ServiceKeyNotFoundError{KeyName: serviceKeyName, ServiceInstanceName: serviceInstanceName}))
+				Expect(fakeActor.CreateServiceKeyCallCount()).To(Equal(0))
+			})
+		})
+
+		When("creating the new key fails immediately", func() {
+			BeforeEach(func() {
+				fakeActor.CreateServiceKeyReturns(nil, v7action.Warnings{"create-key-warning"}, actionerror.ResourceAlreadyExistsError{Message: "Service key new-key already exists"})
+			})
+
+			It("returns the error and leaves both keys alone", func() {
+				Expect(executeErr).To(MatchError(actionerror.ResourceAlreadyExistsError{Message: "Service key new-key already exists"}))
+				Expect(fakeActor.DeleteServiceKeyByServiceInstanceAndNameCallCount()).To(Equal(0))
+			})
+		})
+
+		When("the job creating the new key fails", func() {
+			BeforeEach(func() {
+				fakeActor.CreateServiceKeyReturns(failedStream(errors.New("broker error")), nil, nil)
+			})
+
+			It("deletes the new key and keeps the old key", func() {
+				Expect(executeErr).To(MatchError("broker error"))
+
+				Expect(fakeActor.DeleteServiceKeyByServiceInstanceAndNameCallCount()).To(Equal(1))
+				_, key, _ := fakeActor.DeleteServiceKeyByServiceInstanceAndNameArgsForCall(0)
+				Expect(key).To(Equal("new-key"))
+
+				Expect(testUI.Err).To(Say(`Service key new-key could not be created\. Rolling back; service key some-key is unchanged\.`))
+			})
+
+			When("deleting the new key fails too", func() {
+				BeforeEach(func() {
+					fakeActor.DeleteServiceKeyByServiceInstanceAndNameReturns(nil, nil, errors.New("delete error"))
+				})
+
+				It("reports both failures", func() {
+					Expect(executeErr).To(MatchError("broker error"))
+					Expect(testUI.Err).To(Say(`Service key new-key could not be deleted: delete error`))
+				})
+			})
+		})
+
+		When("deleting the old key fails", func() {
+			BeforeEach(func() {
+				fakeActor.DeleteServiceKeyByServiceInstanceAndNameReturns(failedStream(errors.New("delete error")), nil, nil)
+			})
+
+			It("keeps the new key and explains how to finish the rotation", func() {
+				Expect(executeErr).To(MatchError("delete error"))
+				Expect(fakeActor.DeleteServiceKeyByServiceInstanceAndNameCallCount()).To(Equal(1))
+				Expect(testUI.Err).To(Say(`Service key new-key was created, but service key some-key could not be deleted\. Delete it with 'cf delete-service-key some-db some-key' once the problem is resolved\.`))
+			})
+		})
+	})
+
+	Describe("rotating app bindings", func() {
+		BeforeEach(func() {
+			cmd.AllApps = true
+
+			fakeActor.GetServiceAppBindingsByServiceInstanceStub = func(string, string) ([]resources.ServiceCredentialBinding, v7action.Warnings, error) {
+				bindings := []resources.ServiceCredentialBinding{
+					{GUID: "binding-1", Name: "db", AppName: "app-one", AppSpaceGUID: spaceGUID},
+					{GUID: "binding-2", AppName: "app-two", AppSpaceGUID: spaceGUID},
+					{GUID: "binding-3", AppName: "app-elsewhere", AppSpaceGUID: "other-space-guid"},
+				}
+				for i := 0; i < fakeActor.CreateServiceAppBindingCallCount(); i++ {
+					appName := fakeActor.CreateServiceAppBindingArgsForCall(i).AppName
+					bindings = append(bindings, resources.ServiceCredentialBinding{GUID: "new-" + appName, AppName: appName, AppSpaceGUID: spaceGUID})
+				}
+				return bindings, v7action.Warnings{"get-bindings-warning"}, nil
+			}
+			fakeActor.DeleteServiceAppBindingByGUIDReturns(nil, v7action.Warnings{"unbind-warning"}, nil)
+			fakeActor.CreateServiceAppBindingReturns(nil, v7action.Warnings{"bind-warning"}, nil)
+			fakeActor.GetApplicationByNameAndSpaceStub = func(appName, _ string) (resources.Application, v7action.Warnings, error) {
+				return resources.Application{Name: appName, GUID: appName + "-guid", State: constant.ApplicationStarted}, nil, nil
+			}
+		})
+
+		It("binds every app in the space again, restarts it with a rolling deployment and deletes its previous binding", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+
+			name, space := fakeActor.GetServiceAppBindingsByServiceInstanceArgsForCall(0)
+			Expect(name).To(Equal(serviceInstanceName))
+			Expect(space).To(Equal(spaceGUID))
+
+			Expect(fakeActor.CreateServiceAppBindingCallCount()).To(Equal(2))
+			Expect(fakeActor.CreateServiceAppBindingArgsForCall(0)).To(Equal(v7action.CreateServiceAppBindingParams{
+				SpaceGUID:           spaceGUID,
+				ServiceInstanceName: serviceInstanceName,
+				AppName:             "app-one",
+				BindingName:         "db",
+			}))
+			Expect(fakeActor.CreateServiceAppBindingArgsForCall(1).AppName).To(Equal("app-two"))
+
+			Expect(fakeActor.DeleteServiceAppBindingByGUIDCallCount()).To(Equal(2))
+			Expect(fakeActor.DeleteServiceAppBindingByGUIDArgsForCall(0)).To(Equal("binding-1"))
+			Expect(fakeActor.DeleteServiceAppBindingByGUIDArgsForCall(1)).To(Equal("binding-2"))
+
+			Expect(fakeAppStager.StartAppCallCount()).To(Equal(2))
+			app, _, _, resourceGUID, opts := fakeAppStager.StartAppArgsForCall(0)
+			Expect(app.Name).To(Equal("app-one"))
+			Expect(resourceGUID).To(BeEmpty())
+			Expect(opts).To(Equal(shared.AppStartOpts{
+				AppAction: constant.ApplicationRestarting,
+				Strategy:  constant.DeploymentStrategyRolling,
+			}))
+			Expect(fakeAppStager.StageAndStartCallCount()).To(Equal(0))
+		})
+
+		When("checking the order of the rotation", func() {
+			var deletedBeforeStart []int
+
+			BeforeEach(func() {
+				deletedBeforeStart = nil
+				fakeAppStager.StartAppStub = func(resources.Application, configv3.Space, configv3.Organization, string, shared.AppStartOpts) error {
+					deletedBeforeStart = append(deletedBeforeStart, fakeActor.DeleteServiceAppBindingByGUIDCallCount())
+					return nil
+				}
+			})
+
+			It("deletes each previous binding only after the app has restarted", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(deletedBeforeStart).To(Equal([]int{0, 1}))
+			})
+		})
+
+		It("displays progress and skips apps in other spaces", func() {
+			Expect(testUI.Out).To(Say(`Rotating app bindings for service instance some-db in org some-org / space some-space as steve\.\.\.`))
+			Expect(testUI.Out).To(Say(`Binding app app-one to service instance some-db with new credentials\.\.\.`))
+			Expect(testUI.Out).To(Say(`Deleting the previous binding of app app-one\.\.\.`))
+			Expect(testUI.Out).To(Say(`Binding app app-two to service instance some-db with new credentials\.\.\.`))
+			Expect(testUI.Out).To(Say("OK"))
+
+			Expect(testUI.Err).To(Say("get-bindings-warning"))
+			Expect(testUI.Err).To(Say(`App app-elsewhere in another space is bound to service instance some-db and will not be rotated\.`))
+			Expect(testUI.Err).To(Say("bind-warning"))
+			Expect(testUI.Err).To(Say("unbind-warning"))
+		})
+
+		When("specific apps are given", func() {
+			BeforeEach(func() {
+				cmd.AllApps = false
+				cmd.Apps = []string{"app-two"}
+			})
+
+			It("only rotates those apps", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(fakeActor.CreateServiceAppBindingCallCount()).To(Equal(1))
+				Expect(fakeActor.DeleteServiceAppBindingByGUIDArgsForCall(0)).To(Equal("binding-2"))
+			})
+
+			When("an app is not bound to the service instance", func() {
+				BeforeEach(func() {
+					cmd.Apps = []string{"app-two", "app-elsewhere"}
+				})
+
+				It("returns an error before rotating anything", func() {
+					Expect(executeErr).To(MatchError(actionerror.AppNotBoundToServiceInstanceError{AppName: "app-elsewhere", ServiceInstanceName: serviceInstanceName}))
+					Expect(fakeActor.CreateServiceAppBindingCallCount()).To(Equal(0))
+				})
+			})
+		})
+
+		When("--restage is given", func() {
+			BeforeEach(func() {
+				cmd.Restage = true
+				fakeActor.GetNewestReadyPackageForApplicationReturns(resources.Package{GUID: "package-guid"}, v7action.Warnings{"package-warning"}, nil)
+			})
+
+			It("restages the apps with a rolling deployment", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(fakeAppStager.StartAppCallCount()).To(Equal(0))
+				Expect(fakeAppStager.StageAndStartCallCount()).To(Equal(2))
+				app, _, _, packageGUID, opts := fakeAppStager.StageAndStartArgsForCall(0)
+				Expect(app.Name).To(Equal("app-one"))
+				Expect(packageGUID).To(Equal("package-guid"))
+				Expect(opts.Strategy).To(Equal(constant.DeploymentStrategyRolling))
+				Expect(testUI.Err).To(Say("package-warning"))
+			})
+		})
+
+		When("an app is stopped", func() {
+			BeforeEach(func() {
+				fakeActor.GetApplicationByNameAndSpaceStub = func(appName, _ string) (resources.Application, v7action.Warnings, error) {
+					return resources.Application{Name: appName, State: constant.ApplicationStopped}, nil, nil
+				}
+			})
+
+			It("rotates its binding without starting it", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(fakeActor.CreateServiceAppBindingCallCount()).To(Equal(2))
+				Expect(fakeAppStager.StartAppCallCount()).To(Equal(0))
+				Expect(testUI.Out).To(Say(`App app-one is stopped; the new credentials take effect when it is started\.`))
+			})
+		})
+
+		When("no apps in the space are bound", func() {
+			BeforeEach(func() {
+				fakeActor.GetServiceAppBindingsByServiceInstanceReturns(nil, nil, nil)
+			})
+
+			It("says so", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(testUI.Out).To(Say(`No apps in this space are bound to service instance some-db\.`))
+				Expect(testUI.Out).To(Say("OK"))
+			})
+		})
+
+		When("binding an app fails", func() {
+			BeforeEach(func() {
+				setFlag(&cmd, "-c", `{"foo": "bar"}`)
+				fakeActor.CreateServiceAppBindingReturnsOnCall(0, failedStream(errors.New("bind error")), nil, nil)
+			})
+
+			It("keeps the previous binding and stops", func() {
+				Expect(executeErr).To(MatchError("bind error"))
+
+				Expect(fakeActor.CreateServiceAppBindingCallCount()).To(Equal(1))
+				Expect(fakeActor.CreateServiceAppBindingArgsForCall(0).Parameters).To(Equal(types.NewOptionalObject(map[string]interface{}{"foo": "bar"})))
+				Expect(fakeActor.DeleteServiceAppBindingByGUIDCallCount()).To(Equal(0))
+				Expect(fakeAppStager.StartAppCallCount()).To(Equal(0))
+
+				Expect(testUI.Err).To(Say(`App app-one could not be bound to service instance some-db with new credentials\. Its existing binding was not changed\.`))
+				Expect(testUI.Err).To(Say(`Bindings not rotated for apps: app-two`))
+			})
+		})
+
+		When("the Cloud Controller does not allow a second binding", func() {
+			BeforeEach(func() {
+				fakeActor.CreateServiceAppBindingReturns(nil, v7action.Warnings{"bind-warning"}, actionerror.ResourceAlreadyExistsError{Message: "The app is already bound to the service instance."})
+			})
+
+			It("returns an error without changing the binding", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ServiceBindingRotationUnsupportedError{
+					AppName:             "app-one",
+					ServiceInstanceName: serviceInstanceName,
+				}))
+				Expect(fakeActor.DeleteServiceAppBindingByGUIDCallCount()).To(Equal(0))
+				Expect(fakeAppStager.StartAppCallCount()).To(Equal(0))
+			})
+		})
+
+		When("deleting the previous binding fails", func() {
+			BeforeEach(func() {
+				fakeActor.DeleteServiceAppBindingByGUIDReturns(nil, nil, errors.New("unbind error"))
+			})
+
+			It("explains that the app is bound twice and stops", func() {
+				Expect(executeErr).To(MatchError("unbind error"))
+				Expect(testUI.Err).To(Say(`App app-one is bound to service instance some-db twice\. Delete the previous binding once the problem is resolved\.`))
+				Expect(fakeAppStager.StartAppCallCount()).To(Equal(1))
+				Expect(fakeActor.CreateServiceAppBindingCallCount()).To(Equal(1))
+			})
+		})
+
+		When("the new binding cannot be found", func() {
+			BeforeEach(func() {
+				fakeActor.GetServiceAppBindingsByServiceInstanceStub = func(string, string) ([]resources.ServiceCredentialBinding, v7action.Warnings, error) {
+					return []resources.ServiceCredentialBinding{
+						{GUID: "binding-1", Name: "db", AppName: "app-one", AppGUID: "app-one-guid", ServiceInstanceGUID: "some-db-guid", AppSpaceGUID: spaceGUID},
+					}, nil, nil
+				}
+			})
+
+			It("explains that the app is bound twice and stops without restarting it", func() {
+				Expect(executeErr).To(MatchError(actionerror.ServiceBindingNotFoundError{AppGUID: "app-one-guid", ServiceInstanceGUID: "some-db-guid"}))
+				Expect(testUI.Err).To(Say(`App app-one is bound to service instance some-db twice\.`))
+				Expect(fakeAppStager.StartAppCallCount()).To(Equal(0))
+				Expect(fakeActor.DeleteServiceAppBindingByGUIDCallCount()).To(Equal(0))
+			})
+		})
+
+		When("restarting an app fails", func() {
+			BeforeEach(func() {
+				fakeConfig.BinaryNameReturns("cf")
+				fakeAppStager.StartAppReturnsOnCall(1, actionerror.AllInstancesCrashedError{})
+			})
+
+			It("deletes the new binding, keeps the previous one and reports which apps were rotated", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ApplicationUnableToStartError{AppName: "app-two", BinaryName: "cf"}))
+				Expect(testUI.Err).To(Say(`App app-two could not be started with new credentials\. Rolling back; its previous binding is unchanged\.`))
+				Expect(testUI.Out).To(Say(`Bindings rotated for apps: app-one`))
+
+				Expect(fakeActor.DeleteServiceAppBindingByGUIDCallCount()).To(Equal(2))
+				Expect(fakeActor.DeleteServiceAppBindingByGUIDArgsForCall(0)).To(Equal("binding-1"))
+				Expect(fakeActor.DeleteServiceAppBindingByGUIDArgsForCall(1)).To(Equal("new-app-two"))
+			})
+
+			When("deleting the new binding fails", func() {
+				BeforeEach(func() {
+					fakeActor.DeleteServiceAppBindingByGUIDReturnsOnCall(1, nil, nil, errors.New("unbind error"))
+				})
+
+				It("warns about it and returns the start error", func() {
+					Expect(executeErr).To(MatchError(translatableerror.ApplicationUnableToStartError{AppName: "app-two", BinaryName: "cf"}))
+					Expect(testUI.Err).To(Say("The new binding of app app-two could not be deleted: unbind error"))
+				})
+			})
+		})
+	})
+})
@@ -14,7 +14,7 @@ type RouteCommand struct {
 	Hostname        string           `long:"hostname" short:"n" description:"Hostname used to identify the HTTP route"`
 	Path            flag.V7RoutePath `long:"path" description:"Path used to identify the HTTP route"`
 	Port            int              `long:"port" description:"Port used to identify the TCP route"`
-	relatedCommands interface{}      `related_commands:"create-route, delete-route, routes"`
+	relatedCommands interface{}      `related_commands:"create-route, delete-route, route-service-bindings, routes"`
 }
 
 func (cmd RouteCommand) Usage() string {
@@ -91,6 +91,12 @@ func (cmd RouteCommand) Execute(args []string) error {
 		return err
 	}
 
+	routeBinding, warnings, err := cmd.Actor.GetRouteBindingSummaryForRoute(route)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
 	table := [][]string{
 		{cmd.UI.TranslateText("domain:"), domain.Name},
 		{cmd.UI.TranslateText("host:"), route.Host},
@@ -100,6 +106,13 @@ func (cmd RouteCommand) Execute(args []string) error {
 		{cmd.UI.TranslateText("options:"), route.FormattedOptions()},
 	}
 
+	if routeBinding.ServiceInstanceName != "" {
+		table = append(table,
+			[]string{cmd.UI.TranslateText("route service:"), routeBinding.ServiceInstanceName},
+			[]string{cmd.UI.TranslateText("route service url:"), routeBinding.RouteServiceURL},
+		)
+	}
+
 	cmd.UI.DisplayKeyValueTable("", table, 3)
 	cmd.UI.DisplayNewline()
 
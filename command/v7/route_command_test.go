@@ -238,6 +238,49 @@ var _ = Describe("route Command", func() {
 			Expect(givenPath).To(Equal("/some-path"))
 			Expect(givenPort).To(Equal(0))
 		})
+
+		It("does not display a route service", func() {
+			Expect(fakeActor.GetRouteBindingSummaryForRouteCallCount()).To(Equal(1))
+			Expect(fakeActor.GetRouteBindingSummaryForRouteArgsForCall(0).GUID).To(Equal("route-guid"))
+			Expect(testUI.Out).NotTo(Say(`route service:`))
+		})
+
+		When("a route service is bound to the route", func() {
+			BeforeEach(func() {
+				fakeActor.GetRouteBindingSummaryForRouteReturns(
+					v7action.RouteBindingSummary{
+						RouteBinding:        resources.RouteBinding{RouteServiceURL: "https://limiter.example.com"},
+						ServiceInstanceName: "rate-limiter",
+					},
+					v7action.Warnings{"get-route-binding-warnings"},
+					nil,
+				)
+			})
+
+			It("displays the route service", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(testUI.Err).To(Say("get-route-binding-warnings"))
+				Expect(testUI.Out).To(Say(`options:\s+{loadbalancing=%s}`, *options["loadbalancing"]))
+				Expect(testUI.Out).To(Say(`route service:\s+rate-limiter`))
+				Expect(testUI.Out).To(Say(`route service url:\s+https://limiter\.example\.com`))
+				Expect(testUI.Out).To(Say(`Destinations:`))
+			})
+		})
+
+		When("getting the route service binding errors", func() {
+			BeforeEach(func() {
+				fakeActor.GetRouteBindingSummaryForRouteReturns(
+					v7action.RouteBindingSummary{},
+					v7action.Warnings{"get-route-binding-warnings"},
+					errors.New("get-route-binding-error"),
+				)
+			})
+
+			It("returns the error and displays warnings", func() {
+				Expect(testUI.Err).To(Say("get-route-binding-warnings"))
+				Expect(executeErr).To(MatchError("get-route-binding-error"))
+			})
+		})
 	})
 	Describe("RouteRetrieval display logic", func() {
 		When("passing in just a domain", func() {
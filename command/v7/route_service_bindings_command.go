@@ -0,0 +1,99 @@
+package v7
+
+import (
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+type RouteServiceBindingsCommand struct {
+	BaseCommand
+
+	Space           bool        `long:"space" description:"List only the route service bindings in the targeted space"`
+	ServiceInstance string      `long:"service-instance" description:"List only the routes bound to this service instance in the targeted space"`
+	usage           interface{} `usage:"CF_NAME route-service-bindings [--space | --service-instance SERVICE_INSTANCE]\n\nEXAMPLES:\n   CF_NAME route-service-bindings\n   CF_NAME route-service-bindings --space\n   CF_NAME route-service-bindings --service-instance rate-limiter"`
+	relatedCommands interface{} `related_commands:"bind-route-service, route, routes, unbind-route-service"`
+}
+
+func (cmd RouteServiceBindingsCommand) Execute(args []string) error {
+	if cmd.Space && cmd.ServiceInstance != "" {
+		return translatableerror.ArgumentCombinationError{Args: []string{"--space", "--service-instance"}}
+	}
+
+	spaceScoped := cmd.Space || cmd.ServiceInstance != ""
+	if err := cmd.SharedActor.CheckTarget(true, spaceScoped); err != nil {
+		return err
+	}
+
+	user, err := cmd.Actor.GetCurrentUser()
+	if err != nil {
+		return err
+	}
+
+	params := v7action.GetRouteBindingSummariesParams{
+		OrgGUID:             cmd.Config.TargetedOrganization().GUID,
+		ServiceInstanceName: cmd.ServiceInstance,
+	}
+	if spaceScoped {
+		params.SpaceGUID = cmd.Config.TargetedSpace().GUID
+	}
+
+	cmd.displayIntro(user.Name)
+
+	summaries, warnings, err := cmd.Actor.GetRouteBindingSummaries(params)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	if len(summaries) == 0 {
+		cmd.UI.DisplayText("No route service bindings found.")
+		return nil
+	}
+
+	table := [][]string{{
+		cmd.UI.TranslateText("route"),
+		cmd.UI.TranslateText("domain"),
+		cmd.UI.TranslateText("path"),
+		cmd.UI.TranslateText("service instance"),
+		cmd.UI.TranslateText("last operation"),
+		cmd.UI.TranslateText("route service url"),
+	}}
+	for _, summary := range summaries {
+		table = append(table, []string{
+			summary.Route.URL,
+			summary.DomainName,
+			summary.Route.Path,
+			summary.ServiceInstanceName,
+			lastOperation(summary.LastOperation),
+			summary.RouteServiceURL,
+		})
+	}
+
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+	return nil
+}
+
+func (cmd RouteServiceBindingsCommand) displayIntro(userName string) {
+	switch {
+	case cmd.ServiceInstance != "":
+		cmd.UI.DisplayTextWithFlavor("Getting route service bindings for service instance {{.ServiceInstance}} in org {{.Org}} / space {{.Space}} as {{.User}}...", map[string]interface{}{
+			"ServiceInstance": cmd.ServiceInstance,
+			"Org":             cmd.Config.TargetedOrganization().Name,
+			"Space":           cmd.Config.TargetedSpace().Name,
+			"User":            userName,
+		})
+	case cmd.Space:
+		cmd.UI.DisplayTextWithFlavor("Getting route service bindings in org {{.Org}} / space {{.Space}} as {{.User}}...", map[string]interface{}{
+			"Org":   cmd.Config.TargetedOrganization().Name,
+			"Space": cmd.Config.TargetedSpace().Name,
+			"User":  userName,
+		})
+	default:
+		cmd.UI.DisplayTextWithFlavor("Getting route service bindings in org {{.Org}} as {{.User}}...", map[string]interface{}{
+			"Org":  cmd.Config.TargetedOrganization().Name,
+			"User": userName,
+		})
+	}
+	cmd.UI.DisplayNewline()
+}
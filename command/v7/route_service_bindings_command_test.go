@@ -0,0 +1,181 @@
+package v7_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	v7 "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("route-service-bindings Command", func() {
+	var (
+		cmd             v7.RouteServiceBindingsCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeActor
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+
+		cmd = v7.RouteServiceBindingsCommand{
+			BaseCommand: v7.BaseCommand{
+				UI:          testUI,
+				Config:      fakeConfig,
+				SharedActor: fakeSharedActor,
+				Actor:       fakeActor,
+			},
+		}
+
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org", GUID: "some-org-guid"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+		fakeActor.GetCurrentUserReturns(configv3.User{Name: "steve"}, nil)
+
+		fakeActor.GetRouteBindingSummariesReturns(
+			[]v7action.RouteBindingSummary{
+				{
+					RouteBinding: resources.RouteBinding{
+						RouteServiceURL: "https://auth.example.com",
+						LastOperation:   resources.LastOperation{Type: "create", State: "succeeded"},
+					},
+					Route:               resources.Route{URL: "admin.example.com"},
+					DomainName:          "example.com",
+					ServiceInstanceName: "auth",
+				},
+				{
+					RouteBinding: resources.RouteBinding{
+						RouteServiceURL: "https://limiter.example.com",
+						LastOperation:   resources.LastOperation{Type: "create", State: "in progress"},
+					},
+					Route:               resources.Route{URL: "web.example.com/api", Path: "/api"},
+					DomainName:          "example.com",
+					ServiceInstanceName: "rate-limiter",
+				},
+			},
+			v7action.Warnings{"summaries warning"},
+			nil,
+		)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	It("checks the org is targeted", func() {
+		Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+		checkOrg, checkSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+		Expect(checkOrg).To(BeTrue())
+		Expect(checkSpace).To(BeFalse())
+	})
+
+	It("gets the route bindings in the org", func() {
+		Expect(fakeActor.GetRouteBindingSummariesCallCount()).To(Equal(1))
+		Expect(fakeActor.GetRouteBindingSummariesArgsForCall(0)).To(Equal(v7action.GetRouteBindingSummariesParams{
+			OrgGUID: "some-org-guid",
+		}))
+	})
+
+	It("displays the route bindings", func() {
+		Expect(executeErr).NotTo(HaveOccurred())
+
+		Expect(testUI.Out).To(Say(`Getting route service bindings in org some-org as steve\.\.\.`))
+		Expect(testUI.Out).To(Say(`route\s+domain\s+path\s+service instance\s+last operation\s+route service url`))
+		Expect(testUI.Out).To(Say(`admin\.example\.com\s+example\.com\s+auth\s+create succeeded\s+https://auth\.example\.com`))
+		Expect(testUI.Out).To(Say(`web\.example\.com/api\s+example\.com\s+/api\s+rate-limiter\s+create in progress\s+https://limiter\.example\.com`))
+		Expect(testUI.Err).To(Say("summaries warning"))
+	})
+
+	When("--space is given", func() {
+		BeforeEach(func() {
+			setFlag(&cmd, "--space")
+		})
+
+		It("gets the route bindings in the targeted space", func() {
+			_, checkSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkSpace).To(BeTrue())
+
+			Expect(fakeActor.GetRouteBindingSummariesArgsForCall(0)).To(Equal(v7action.GetRouteBindingSummariesParams{
+				OrgGUID:   "some-org-guid",
+				SpaceGUID: "some-space-guid",
+			}))
+			Expect(testUI.Out).To(Say(`Getting route service bindings in org some-org / space some-space as steve\.\.\.`))
+		})
+	})
+
+	When("--service-instance is given", func() {
+		BeforeEach(func() {
+			setFlag(&cmd, "--service-instance", "rate-limiter")
+		})
+
+		It("gets the routes bound to the service instance", func() {
+			_, checkSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkSpace).To(BeTrue())
+
+			Expect(fakeActor.GetRouteBindingSummariesArgsForCall(0)).To(Equal(v7action.GetRouteBindingSummariesParams{
+				OrgGUID:             "some-org-guid",
+				SpaceGUID:           "some-space-guid",
+				ServiceInstanceName: "rate-limiter",
+			}))
+			Expect(testUI.Out).To(Say(`Getting route service bindings for service instance rate-limiter in org some-org / space some-space as steve\.\.\.`))
+		})
+	})
+
+	When("both --space and --service-instance are given", func() {
+		BeforeEach(func() {
+			setFlag(&cmd, "--space")
+			setFlag(&cmd, "--service-instance", "rate-limiter")
+		})
+
+		It("returns an argument combination error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{Args: []string{"--space", "--service-instance"}}))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+		})
+	})
+
+	When("there are no route bindings", func() {
+		BeforeEach(func() {
+			fakeActor.GetRouteBindingSummariesReturns(nil, v7action.Warnings{"summaries warning"}, nil)
+		})
+
+		It("says so", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(testUI.Out).To(Say(`No route service bindings found\.`))
+		})
+	})
+
+	When("checking the target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(errors.New("not targeted"))
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError("not targeted"))
+			Expect(fakeActor.GetRouteBindingSummariesCallCount()).To(Equal(0))
+		})
+	})
+
+	When("getting the route bindings fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetRouteBindingSummariesReturns(nil, v7action.Warnings{"summaries warning"}, errors.New("boom"))
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError("boom"))
+			Expect(testUI.Err).To(Say("summaries warning"))
+		})
+	})
+})
@@ -3,7 +3,12 @@ package v7
 import (
 	"fmt"
 
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/api/logcache"
+	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v7/shared"
 	"code.cloudfoundry.org/cli/resources"
 )
 
@@ -17,9 +22,21 @@ type RunTaskCommand struct {
 	Memory          flag.Megabytes          `short:"m" description:"Memory limit (e.g. 256M, 1024M, 1G)"`
 	Name            string                  `long:"name" description:"Name to give the task (generated if omitted)"`
 	Process         string                  `long:"process" description:"Process type to use as a template for command, memory, and disk for the created task."`
-	Wait            bool                    `long:"wait" short:"w" description:"Wait for the task to complete before exiting"`
-	usage           interface{}             `usage:"CF_NAME run-task APP_NAME [--command COMMAND] [-k DISK] [-m MEMORY] [-l LOG_RATE_LIMIT] [--name TASK_NAME] [--process PROCESS_TYPE]\n\nTIP:\n   Use 'cf logs' to display the logs of the app and all its tasks. If your task name is unique, grep this command's output for the task name to view task-specific logs.\n\nEXAMPLES:\n   CF_NAME run-task my-app --command \"bundle exec rake db:migrate\" --name migrate\n\n   CF_NAME run-task my-app --process batch_job\n\n   CF_NAME run-task my-app"`
-	relatedCommands interface{}             `related_commands:"logs, tasks, task, terminate-task"`
+	Wait            bool                    `long:"wait" short:"w" description:"Wait for the task to complete before exiting, displaying its logs"`
+	usage           interface{}             `usage:"CF_NAME run-task APP_NAME [--command COMMAND] [-k DISK] [-m MEMORY] [-l LOG_RATE_LIMIT] [--name TASK_NAME] [--process PROCESS_TYPE]\n\nTIP:\n   Use 'cf task-logs' to display the logs of a single task, or '--wait' to follow them until the task completes.\n\nEXAMPLES:\n   CF_NAME run-task my-app --command \"bundle exec rake db:migrate\" --name migrate\n\n   CF_NAME run-task my-app --process batch_job\n\n   CF_NAME run-task my-app"`
+	relatedCommands interface{}             `related_commands:"logs, tasks, task, task-logs, terminate-task"`
+
+	LogCacheClient sharedaction.LogCacheClient
+}
+
+func (cmd *RunTaskCommand) Setup(config command.Config, ui command.UI) error {
+	err := cmd.BaseCommand.Setup(config, ui)
+	if err != nil {
+		return err
+	}
+
+	cmd.LogCacheClient, err = logcache.NewClient(config.LogCacheEndpoint(), config, ui, v7action.NewDefaultKubernetesConfigGetter())
+	return err
 }
 
 func (cmd RunTaskCommand) Execute(args []string) error {
@@ -98,9 +115,9 @@ func (cmd RunTaskCommand) Execute(args []string) error {
 	if cmd.Wait {
 		cmd.UI.DisplayNewline()
 		cmd.UI.DisplayText("Waiting for task to complete execution...")
+		cmd.UI.DisplayNewline()
 
-		_, pollWarnings, err := cmd.Actor.PollTask(task)
-		cmd.UI.DisplayWarnings(pollWarnings)
+		_, err = shared.StreamTaskLogsUntilComplete(cmd.Actor, cmd.UI, cmd.LogCacheClient, application.GUID, task)
 		if err != nil {
 			return err
 		}
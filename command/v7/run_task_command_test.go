@@ -1,14 +1,18 @@
 package v7_test
 
 import (
+	"context"
 	"errors"
+	"time"
 
 	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v7action"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/command/commandfakes"
 	"code.cloudfoundry.org/cli/command/flag"
 	. "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/shared"
 	"code.cloudfoundry.org/cli/command/v7/v7fakes"
 	"code.cloudfoundry.org/cli/resources"
 	"code.cloudfoundry.org/cli/types"
@@ -50,6 +54,13 @@ var _ = Describe("run-task Command", func() {
 
 		binaryName = "faceman"
 		fakeConfig.BinaryNameReturns(binaryName)
+
+		shared.TaskLogDrainTimeout = 10 * time.Millisecond
+		fakeActor.GetStreamingLogsForTaskStub = func(appGUID string, task resources.Task, client sharedaction.LogCacheClient) (<-chan sharedaction.LogMessage, <-chan error, context.CancelFunc, error) {
+			logStream := make(chan sharedaction.LogMessage, 1)
+			logStream <- *sharedaction.NewLogMessage("task output", "OUT", time.Now(), "APP/TASK/"+task.Name, "0")
+			return logStream, make(chan error), func() {}, nil
+		}
 	})
 
 	JustBeforeEach(func() {
@@ -374,6 +385,7 @@ var _ = Describe("run-task Command", func() {
 						Expect(testUI.Out).To(Say(`task id:\s+3`))
 
 						Expect(testUI.Out).To(Say(`Waiting for task to complete execution...`))
+						Expect(testUI.Out).To(Say(`\[APP/TASK/some-task-name/0\] OUT task output`))
 
 						Expect(testUI.Out).To(Say(`Task has completed successfully.`))
 						Expect(testUI.Out).To(Say("OK"))
@@ -383,6 +395,35 @@ var _ = Describe("run-task Command", func() {
 						Expect(testUI.Err).To(Say("get-application-warning-3"))
 						Expect(testUI.Err).To(Say("poll-warnings"))
 
+						Expect(fakeActor.GetStreamingLogsForTaskCallCount()).To(Equal(1))
+						appGUID, task, _ := fakeActor.GetStreamingLogsForTaskArgsForCall(0)
+						Expect(appGUID).To(Equal("some-app-guid"))
+						Expect(task.Name).To(Equal("some-task-name"))
+					})
+
+					When("the task fails", func() {
+						BeforeEach(func() {
+							fakeActor.PollTaskReturns(resources.Task{}, nil, actionerror.TaskFailedError{})
+						})
+
+						It("displays the task's logs and returns the error", func() {
+							Expect(executeErr).To(MatchError(actionerror.TaskFailedError{}))
+							Expect(testUI.Out).To(Say(`task output`))
+							Expect(testUI.Out).ToNot(Say(`Task has completed successfully.`))
+						})
+					})
+
+					When("the logs cannot be streamed", func() {
+						BeforeEach(func() {
+							fakeActor.GetStreamingLogsForTaskStub = nil
+							fakeActor.GetStreamingLogsForTaskReturns(nil, nil, nil, errors.New("bad-timestamp"))
+						})
+
+						It("warns and still waits for the task", func() {
+							Expect(executeErr).ToNot(HaveOccurred())
+							Expect(testUI.Err).To(Say("Failed to retrieve logs from Log Cache: bad-timestamp"))
+							Expect(testUI.Out).To(Say(`Task has completed successfully.`))
+						})
 					})
 				})
 			})
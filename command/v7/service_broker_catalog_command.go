@@ -0,0 +1,173 @@
+package v7
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+type ServiceBrokerCatalogCommand struct {
+	BaseCommand
+
+	RequiredArgs    flag.ServiceBroker          `positional-args:"yes"`
+	Diff            flag.PathWithExistenceCheck `long:"diff" description:"Compare the ingested catalog with the Open Service Broker API catalog in this JSON file"`
+	usage           interface{}                 `usage:"CF_NAME service-broker-catalog SERVICE_BROKER [--diff CATALOG_FILE]\n\n   Offerings and plans are compared by catalog id, so a renamed offering or plan is reported as a changed name. 'added' entries are only in the local catalog, 'removed' entries are only in the ingested catalog.\n\nEXAMPLES:\n   CF_NAME service-broker-catalog my-broker\n   CF_NAME service-broker-catalog my-broker --diff catalog.json"`
+	relatedCommands interface{}                 `related_commands:"marketplace, service-access, service-brokers, service-plan-schema, update-service-broker"`
+}
+
+func (cmd ServiceBrokerCatalogCommand) Execute(args []string) error {
+	if err := cmd.SharedActor.CheckTarget(false, false); err != nil {
+		return err
+	}
+
+	user, err := cmd.Actor.GetCurrentUser()
+	if err != nil {
+		return err
+	}
+
+	if cmd.Diff != "" {
+		cmd.UI.DisplayTextWithFlavor("Comparing catalog of service broker {{.ServiceBroker}} with {{.Path}} as {{.User}}...", map[string]interface{}{
+			"ServiceBroker": cmd.RequiredArgs.ServiceBroker,
+			"Path":          string(cmd.Diff),
+			"User":          user.Name,
+		})
+	} else {
+		cmd.UI.DisplayTextWithFlavor("Getting catalog of service broker {{.ServiceBroker}} as {{.User}}...", map[string]interface{}{
+			"ServiceBroker": cmd.RequiredArgs.ServiceBroker,
+			"User":          user.Name,
+		})
+	}
+	cmd.UI.DisplayNewline()
+
+	catalog, warnings, err := cmd.Actor.GetServiceBrokerCatalog(cmd.RequiredArgs.ServiceBroker)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	if cmd.Diff != "" {
+		differences, err := cmd.Actor.DiffServiceBrokerCatalog(catalog, string(cmd.Diff))
+		if err != nil {
+			return err
+		}
+
+		cmd.displayDifferences(differences)
+		return nil
+	}
+
+	cmd.displayCatalog(catalog)
+	return nil
+}
+
+func (cmd ServiceBrokerCatalogCommand) displayCatalog(catalog v7action.ServiceBrokerCatalog) {
+	cmd.UI.DisplayKeyValueTable("", [][]string{
+		{cmd.UI.TranslateText("broker:"), catalog.Broker.Name},
+		{cmd.UI.TranslateText("url:"), catalog.Broker.URL},
+	}, 3)
+	cmd.UI.DisplayNewline()
+
+	if len(catalog.Offerings) == 0 {
+		cmd.UI.DisplayText("No service offerings found.")
+		return
+	}
+
+	for _, offering := range catalog.Offerings {
+		cmd.UI.DisplayKeyValueTable("", [][]string{
+			{cmd.UI.TranslateText("offering:"), offering.Name},
+			{cmd.UI.TranslateText("id:"), offering.BrokerCatalogID},
+			{cmd.UI.TranslateText("description:"), offering.Description},
+			{cmd.UI.TranslateText("bindable:"), strconv.FormatBool(offering.Bindable)},
+			{cmd.UI.TranslateText("plan updateable:"), strconv.FormatBool(offering.PlanUpdateable)},
+			{cmd.UI.TranslateText("shareable:"), strconv.FormatBool(offering.AllowsInstanceSharing)},
+			{cmd.UI.TranslateText("tags:"), strings.Join(offering.Tags.Value, ", ")},
+			{cmd.UI.TranslateText("metadata:"), formatCatalogMetadata(offering.BrokerCatalogMetadata)},
+		}, 3)
+		cmd.UI.DisplayNewline()
+
+		for _, plan := range offering.Plans {
+			cmd.UI.DisplayKeyValueTable("   ", [][]string{
+				{cmd.UI.TranslateText("plan:"), plan.Name},
+				{cmd.UI.TranslateText("id:"), plan.BrokerCatalogID},
+				{cmd.UI.TranslateText("description:"), plan.Description},
+				{cmd.UI.TranslateText("free:"), strconv.FormatBool(plan.Free)},
+				{cmd.UI.TranslateText("bindable:"), strconv.FormatBool(plan.Bindable)},
+				{cmd.UI.TranslateText("maintenance info:"), formatMaintenanceInfo(plan)},
+				{cmd.UI.TranslateText("schemas:"), cmd.formatSchemas(plan)},
+				{cmd.UI.TranslateText("metadata:"), formatCatalogMetadata(plan.BrokerCatalogMetadata)},
+			}, 3)
+			cmd.UI.DisplayNewline()
+		}
+	}
+
+	cmd.UI.DisplayText("TIP: Use 'cf service-plan-schema OFFERING PLAN -b {{.ServiceBroker}}' to view the schemas of a plan.", map[string]interface{}{
+		"ServiceBroker": catalog.Broker.Name,
+	})
+}
+
+func (cmd ServiceBrokerCatalogCommand) displayDifferences(differences []v7action.ServiceBrokerCatalogDifference) {
+	if len(differences) == 0 {
+		cmd.UI.DisplayText("The local catalog matches the ingested catalog.")
+		return
+	}
+
+	table := [][]string{{
+		cmd.UI.TranslateText("change"),
+		cmd.UI.TranslateText("offering"),
+		cmd.UI.TranslateText("plan"),
+		cmd.UI.TranslateText("field"),
+		cmd.UI.TranslateText("ingested"),
+		cmd.UI.TranslateText("local"),
+	}}
+	for _, difference := range differences {
+		table = append(table, []string{
+			cmd.UI.TranslateText(string(difference.Change)),
+			difference.Offering,
+			difference.Plan,
+			difference.Field,
+			difference.Ingested,
+			difference.Local,
+		})
+	}
+
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayText("{{.Count}} differences found.", map[string]interface{}{"Count": len(differences)})
+}
+
+func (cmd ServiceBrokerCatalogCommand) formatSchemas(plan resources.ServicePlan) string {
+	var schemas []string
+	if len(plan.ServiceInstanceCreateSchema) > 0 {
+		schemas = append(schemas, cmd.UI.TranslateText("service instance create"))
+	}
+	if len(plan.ServiceInstanceUpdateSchema) > 0 {
+		schemas = append(schemas, cmd.UI.TranslateText("service instance update"))
+	}
+	if len(plan.ServiceBindingCreateSchema) > 0 {
+		schemas = append(schemas, cmd.UI.TranslateText("service binding create"))
+	}
+	return strings.Join(schemas, ", ")
+}
+
+func formatMaintenanceInfo(plan resources.ServicePlan) string {
+	if plan.MaintenanceInfoDescription == "" {
+		return plan.MaintenanceInfoVersion
+	}
+	return plan.MaintenanceInfoVersion + " (" + plan.MaintenanceInfoDescription + ")"
+}
+
+func formatCatalogMetadata(metadata map[string]interface{}) string {
+	if len(metadata) == 0 {
+		return ""
+	}
+
+	raw, err := json.Marshal(metadata)
+	if err != nil {
+		return ""
+	}
+	return string(raw)
+}
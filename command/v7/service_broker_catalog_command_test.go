@@ -0,0 +1,217 @@
+package v7_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	v7 "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("service-broker-catalog Command", func() {
+	var (
+		cmd             v7.ServiceBrokerCatalogCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeActor
+		executeErr      error
+		catalog         v7action.ServiceBrokerCatalog
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+
+		cmd = v7.ServiceBrokerCatalogCommand{
+			BaseCommand: v7.BaseCommand{
+				UI:          testUI,
+				Config:      fakeConfig,
+				SharedActor: fakeSharedActor,
+				Actor:       fakeActor,
+			},
+		}
+		setPositionalFlags(&cmd, "my-broker")
+
+		fakeActor.GetCurrentUserReturns(configv3.User{Name: "admin"}, nil)
+
+		catalog = v7action.ServiceBrokerCatalog{
+			Broker: resources.ServiceBroker{Name: "my-broker", URL: "https://broker.example.com"},
+			Offerings: []v7action.ServiceBrokerCatalogOffering{{
+				ServiceOffering: resources.ServiceOffering{
+					Name:                  "mysql",
+					BrokerCatalogID:       "mysql-id",
+					Description:           "MySQL databases",
+					Bindable:              true,
+					AllowsInstanceSharing: true,
+					Tags:                  types.NewOptionalStringSlice("sql", "relational"),
+					BrokerCatalogMetadata: map[string]interface{}{"displayName": "MySQL"},
+				},
+				Plans: []resources.ServicePlan{{
+					Name:                        "small",
+					BrokerCatalogID:             "small-id",
+					Description:                 "A small database",
+					Free:                        true,
+					Bindable:                    true,
+					MaintenanceInfoVersion:      "1.2.0",
+					MaintenanceInfoDescription:  "OS upgrade",
+					ServiceInstanceCreateSchema: map[string]interface{}{"type": "object"},
+					ServiceBindingCreateSchema:  map[string]interface{}{"type": "object"},
+					BrokerCatalogMetadata:       map[string]interface{}{"bullets": []interface{}{"1 GB"}},
+				}},
+			}},
+		}
+		fakeActor.GetServiceBrokerCatalogReturns(catalog, v7action.Warnings{"catalog warning"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	It("checks the user is logged in", func() {
+		Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+		checkOrg, checkSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+		Expect(checkOrg).To(BeFalse())
+		Expect(checkSpace).To(BeFalse())
+	})
+
+	It("gets the catalog of the broker", func() {
+		Expect(fakeActor.GetServiceBrokerCatalogCallCount()).To(Equal(1))
+		Expect(fakeActor.GetServiceBrokerCatalogArgsForCall(0)).To(Equal("my-broker"))
+		Expect(fakeActor.DiffServiceBrokerCatalogCallCount()).To(Equal(0))
+	})
+
+	It("displays the catalog", func() {
+		Expect(executeErr).NotTo(HaveOccurred())
+
+		Expect(testUI.Out).To(Say(`Getting catalog of service broker my-broker as admin\.\.\.`))
+		Expect(testUI.Out).To(Say(`broker:\s+my-broker`))
+		Expect(testUI.Out).To(Say(`url:\s+https://broker\.example\.com`))
+
+		Expect(testUI.Out).To(Say(`offering:\s+mysql`))
+		Expect(testUI.Out).To(Say(`id:\s+mysql-id`))
+		Expect(testUI.Out).To(Say(`description:\s+MySQL databases`))
+		Expect(testUI.Out).To(Say(`bindable:\s+true`))
+		Expect(testUI.Out).To(Say(`plan updateable:\s+false`))
+		Expect(testUI.Out).To(Say(`shareable:\s+true`))
+		Expect(testUI.Out).To(Say(`tags:\s+sql, relational`))
+		Expect(testUI.Out).To(Say(`metadata:\s+\{"displayName":"MySQL"\}`))
+
+		Expect(testUI.Out).To(Say(`\s+plan:\s+small`))
+		Expect(testUI.Out).To(Say(`\s+id:\s+small-id`))
+		Expect(testUI.Out).To(Say(`\s+description:\s+A small database`))
+		Expect(testUI.Out).To(Say(`\s+free:\s+true`))
+		Expect(testUI.Out).To(Say(`\s+bindable:\s+true`))
+		Expect(testUI.Out).To(Say(`\s+maintenance info:\s+1\.2\.0 \(OS upgrade\)`))
+		Expect(testUI.Out).To(Say(`\s+schemas:\s+service instance create, service binding create`))
+		Expect(testUI.Out).To(Say(`\s+metadata:\s+\{"bullets":\["1 GB"\]\}`))
+
+		Expect(testUI.Out).To(Say(`TIP: Use 'cf service-plan-schema OFFERING PLAN -b my-broker' to view the schemas of a plan\.`))
+
+		Expect(testUI.Err).To(Say("catalog warning"))
+	})
+
+	When("the broker has no offerings", func() {
+		BeforeEach(func() {
+			fakeActor.GetServiceBrokerCatalogReturns(v7action.ServiceBrokerCatalog{Broker: catalog.Broker}, nil, nil)
+		})
+
+		It("says so", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(testUI.Out).To(Say(`No service offerings found\.`))
+		})
+	})
+
+	When("getting the catalog fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetServiceBrokerCatalogReturns(v7action.ServiceBrokerCatalog{}, v7action.Warnings{"catalog warning"}, actionerror.ServiceBrokerNotFoundError{Name: "my-broker"})
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError(actionerror.ServiceBrokerNotFoundError{Name: "my-broker"}))
+			Expect(testUI.Err).To(Say("catalog warning"))
+		})
+	})
+
+	When("comparing with a local catalog", func() {
+		BeforeEach(func() {
+			setFlag(&cmd, "--diff", flag.PathWithExistenceCheck("catalog.json"))
+
+			fakeActor.DiffServiceBrokerCatalogReturns([]v7action.ServiceBrokerCatalogDifference{
+				{
+					Change:   v7action.ServiceBrokerCatalogChanged,
+					Offering: "mysql",
+					Plan:     "small",
+					Field:    "maintenance_info.version",
+					Ingested: "1.2.0",
+					Local:    "1.3.0",
+				},
+				{Change: v7action.ServiceBrokerCatalogAdded, Offering: "mysql", Plan: "large"},
+				{Change: v7action.ServiceBrokerCatalogRemoved, Offering: "redis"},
+			}, nil)
+		})
+
+		It("compares the ingested catalog with the file", func() {
+			Expect(fakeActor.DiffServiceBrokerCatalogCallCount()).To(Equal(1))
+			actualCatalog, actualPath := fakeActor.DiffServiceBrokerCatalogArgsForCall(0)
+			Expect(actualCatalog).To(Equal(catalog))
+			Expect(actualPath).To(Equal("catalog.json"))
+		})
+
+		It("displays the differences", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+
+			Expect(testUI.Out).To(Say(`Comparing catalog of service broker my-broker with catalog\.json as admin\.\.\.`))
+			Expect(testUI.Out).To(Say(`change\s+offering\s+plan\s+field\s+ingested\s+local`))
+			Expect(testUI.Out).To(Say(`changed\s+mysql\s+small\s+maintenance_info\.version\s+1\.2\.0\s+1\.3\.0`))
+			Expect(testUI.Out).To(Say(`added\s+mysql\s+large`))
+			Expect(testUI.Out).To(Say(`removed\s+redis`))
+			Expect(testUI.Out).To(Say(`3 differences found\.`))
+			Expect(testUI.Out).NotTo(Say(`offering:`))
+		})
+
+		When("the catalogs match", func() {
+			BeforeEach(func() {
+				fakeActor.DiffServiceBrokerCatalogReturns(nil, nil)
+			})
+
+			It("says so", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(testUI.Out).To(Say(`The local catalog matches the ingested catalog\.`))
+			})
+		})
+
+		When("the local catalog is invalid", func() {
+			BeforeEach(func() {
+				fakeActor.DiffServiceBrokerCatalogReturns(nil, actionerror.InvalidServiceBrokerCatalogError{Path: "catalog.json", Reason: "bad"})
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError(actionerror.InvalidServiceBrokerCatalogError{Path: "catalog.json", Reason: "bad"}))
+			})
+		})
+	})
+
+	When("checking the target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(errors.New("not logged in"))
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError("not logged in"))
+			Expect(fakeActor.GetServiceBrokerCatalogCallCount()).To(Equal(0))
+		})
+	})
+})
@@ -6,6 +6,7 @@ import (
 
 	"code.cloudfoundry.org/cli/actor/v7action"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
 	"code.cloudfoundry.org/cli/resources"
 	"code.cloudfoundry.org/cli/util/ui"
 )
@@ -18,11 +19,16 @@ type ServiceCommand struct {
 	RequiredArgs    flag.ServiceInstance `positional-args:"yes"`
 	ShowGUID        bool                 `long:"guid" description:"Retrieve and display the given service instances's guid. All other output is suppressed."`
 	Params          bool                 `long:"params" description:"Retrieve and display the given service instances's parameters. All other output is suppressed."`
-	usage           interface{}          `usage:"CF_NAME service SERVICE_INSTANCE"`
-	relatedCommands interface{}          `related_commands:"bind-service, rename-service, update-service"`
+	Watch           bool                 `long:"watch" description:"Keep displaying the status of an operation in progress until it completes"`
+	usage           interface{}          `usage:"CF_NAME service SERVICE_INSTANCE [--guid | --params | --watch]"`
+	relatedCommands interface{}          `related_commands:"bind-service, rename-service, update-service, wait-service"`
 }
 
 func (cmd ServiceCommand) Execute(args []string) error {
+	if err := cmd.validateFlags(); err != nil {
+		return err
+	}
+
 	if err := cmd.SharedActor.CheckTarget(true, true); err != nil {
 		return err
 	}
@@ -36,6 +42,24 @@ func (cmd ServiceCommand) Execute(args []string) error {
 	}
 }
 
+func (cmd ServiceCommand) validateFlags() error {
+	var exclusive []string
+	if cmd.ShowGUID {
+		exclusive = append(exclusive, "--guid")
+	}
+	if cmd.Params {
+		exclusive = append(exclusive, "--params")
+	}
+	if cmd.Watch {
+		exclusive = append(exclusive, "--watch")
+	}
+
+	if cmd.Watch && len(exclusive) > 1 {
+		return translatableerror.ArgumentCombinationError{Args: exclusive}
+	}
+	return nil
+}
+
 func (cmd ServiceCommand) fetchAndDisplayGUID() error {
 	serviceInstance, _, err := cmd.Actor.GetServiceInstanceByNameAndSpace(
 		string(cmd.RequiredArgs.ServiceInstance),
@@ -94,6 +118,20 @@ func (cmd ServiceCommand) fetchAndDisplayDetails() error {
 		cmd.displayUpgrades(serviceInstanceWithDetails)
 	}
 
+	if cmd.Watch && serviceInstanceWithDetails.LastOperation.State == resources.OperationInProgress {
+		return cmd.watchLastOperation()
+	}
+
+	return nil
+}
+
+func (cmd ServiceCommand) watchLastOperation() error {
+	cmd.UI.DisplayText("Watching last operation until it completes:")
+	if err := watchServiceInstanceLastOperation(cmd.UI, cmd.Actor, string(cmd.RequiredArgs.ServiceInstance), cmd.Config.TargetedSpace().GUID, 0); err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayNewline()
 	return nil
 }
 
@@ -3,9 +3,12 @@ package v7_test
 import (
 	"errors"
 	"strings"
+	"time"
 
+	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/actor/v7action"
 	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
 	. "code.cloudfoundry.org/cli/command/v7"
 	"code.cloudfoundry.org/cli/command/v7/v7fakes"
 	"code.cloudfoundry.org/cli/resources"
@@ -804,6 +807,101 @@ var _ = Describe("service command", func() {
 		})
 	})
 
+	When("the --watch flag is specified", func() {
+		BeforeEach(func() {
+			setFlag(&cmd, "--watch")
+
+			fakeActor.GetServiceInstanceDetailsReturns(
+				v7action.ServiceInstanceDetails{
+					ServiceInstance: resources.ServiceInstance{
+						GUID: serviceInstanceGUID,
+						Name: serviceInstanceName,
+						Type: resources.ManagedServiceInstance,
+						LastOperation: resources.LastOperation{
+							Type:  resources.CreateOperation,
+							State: resources.OperationInProgress,
+						},
+					},
+				},
+				v7action.Warnings{"warning one"},
+				nil,
+			)
+
+			fakeActor.PollServiceInstanceLastOperationStub = func(_, _ string, _ time.Duration, handle func(resources.LastOperation)) (v7action.Warnings, error) {
+				handle(resources.LastOperation{Type: resources.CreateOperation, State: resources.OperationInProgress, Description: "provisioning"})
+				handle(resources.LastOperation{Type: resources.CreateOperation, State: resources.OperationSucceeded})
+				return v7action.Warnings{"poll warning"}, nil
+			}
+		})
+
+		It("displays the details and then watches the last operation", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+
+			Expect(fakeActor.PollServiceInstanceLastOperationCallCount()).To(Equal(1))
+			actualName, actualSpaceGUID, actualTimeout, _ := fakeActor.PollServiceInstanceLastOperationArgsForCall(0)
+			Expect(actualName).To(Equal(serviceInstanceName))
+			Expect(actualSpaceGUID).To(Equal(spaceGUID))
+			Expect(actualTimeout).To(BeZero())
+
+			Expect(testUI.Out).To(Say(`Showing status of last operation:`))
+			Expect(testUI.Out).To(Say(`status:\s+create in progress`))
+			Expect(testUI.Out).To(Say(`Watching last operation until it completes:`))
+			Expect(testUI.Out).To(Say(`\d{2}:\d{2}:\d{2}\s+create in progress: provisioning`))
+			Expect(testUI.Out).To(Say(`\d{2}:\d{2}:\d{2}\s+create succeeded`))
+			Expect(testUI.Err).To(Say("poll warning"))
+		})
+
+		When("the operation fails", func() {
+			BeforeEach(func() {
+				fakeActor.PollServiceInstanceLastOperationStub = nil
+				fakeActor.PollServiceInstanceLastOperationReturns(
+					v7action.Warnings{"poll warning"},
+					actionerror.ServiceInstanceOperationFailedError{Name: serviceInstanceName, Operation: "create"},
+				)
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError(actionerror.ServiceInstanceOperationFailedError{Name: serviceInstanceName, Operation: "create"}))
+				Expect(testUI.Err).To(Say("poll warning"))
+			})
+		})
+
+		When("no operation is in progress", func() {
+			BeforeEach(func() {
+				fakeActor.GetServiceInstanceDetailsReturns(
+					v7action.ServiceInstanceDetails{
+						ServiceInstance: resources.ServiceInstance{
+							Name: serviceInstanceName,
+							LastOperation: resources.LastOperation{
+								Type:  resources.CreateOperation,
+								State: resources.OperationSucceeded,
+							},
+						},
+					},
+					nil,
+					nil,
+				)
+			})
+
+			It("only displays the details", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(fakeActor.PollServiceInstanceLastOperationCallCount()).To(Equal(0))
+				Expect(testUI.Out).NotTo(Say(`Watching last operation`))
+			})
+		})
+
+		When("--guid is also specified", func() {
+			BeforeEach(func() {
+				setFlag(&cmd, "--guid")
+			})
+
+			It("returns an argument combination error", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{Args: []string{"--guid", "--watch"}}))
+				Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+			})
+		})
+	})
+
 	When("there is a problem looking up the service instance", func() {
 		BeforeEach(func() {
 			fakeActor.GetServiceInstanceDetailsReturns(
@@ -0,0 +1,76 @@
+package v7
+
+import (
+	"code.cloudfoundry.org/cli/command/flag"
+)
+
+type ServicePlanSchemaCommand struct {
+	BaseCommand
+
+	RequiredArgs    flag.ServiceOfferingAndPlanArgs `positional-args:"yes"`
+	ServiceBroker   string                          `short:"b" description:"Get the service plan from a particular broker. Required when service offering name is ambiguous"`
+	relatedCommands interface{}                     `related_commands:"bind-service, create-service, create-service-key, marketplace, update-service"`
+}
+
+func (cmd ServicePlanSchemaCommand) Execute(args []string) error {
+	if err := cmd.SharedActor.CheckTarget(false, false); err != nil {
+		return err
+	}
+
+	user, err := cmd.Actor.GetCurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Getting parameter schemas for service plan {{.ServicePlan}} of service offering {{.ServiceOffering}} as {{.Username}}...", map[string]interface{}{
+		"ServicePlan":     cmd.RequiredArgs.ServicePlan,
+		"ServiceOffering": cmd.RequiredArgs.ServiceOffering,
+		"Username":        user.Name,
+	})
+	cmd.UI.DisplayNewline()
+
+	plan, warnings, err := cmd.Actor.GetServicePlanByNameOfferingAndBroker(
+		cmd.RequiredArgs.ServicePlan,
+		cmd.RequiredArgs.ServiceOffering,
+		cmd.ServiceBroker,
+	)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	schemas := []struct {
+		title  string
+		schema map[string]interface{}
+	}{
+		{title: "Service instance create parameters (create-service -c):", schema: plan.ServiceInstanceCreateSchema},
+		{title: "Service instance update parameters (update-service -c):", schema: plan.ServiceInstanceUpdateSchema},
+		{title: "Service binding create parameters (bind-service -c, create-service-key -c):", schema: plan.ServiceBindingCreateSchema},
+	}
+
+	for i, s := range schemas {
+		if i > 0 {
+			cmd.UI.DisplayNewline()
+		}
+		cmd.UI.DisplayText(s.title)
+
+		if len(s.schema) == 0 {
+			cmd.UI.DisplayText("No schema provided.")
+			continue
+		}
+		if err := cmd.UI.DisplayJSON("", s.schema); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (cmd ServicePlanSchemaCommand) Usage() string {
+	return `CF_NAME service-plan-schema SERVICE_OFFERING SERVICE_PLAN [-b SERVICE_BROKER]`
+}
+
+func (cmd ServicePlanSchemaCommand) Examples() string {
+	return `CF_NAME service-plan-schema postgres small
+CF_NAME service-plan-schema postgres small -b my-broker`
+}
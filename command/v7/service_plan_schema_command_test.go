@@ -0,0 +1,138 @@
+package v7_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	v7 "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("service-plan-schema Command", func() {
+	var (
+		cmd             v7.ServicePlanSchemaCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		executeErr      error
+		fakeActor       *v7fakes.FakeActor
+	)
+
+	const (
+		fakeServiceOfferingName = "fake-offering"
+		fakeServicePlanName     = "fake-plan"
+		fakeUserName            = "fake-user-name"
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(NewBuffer(), NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+
+		cmd = v7.ServicePlanSchemaCommand{
+			BaseCommand: v7.BaseCommand{
+				UI:          testUI,
+				Config:      fakeConfig,
+				SharedActor: fakeSharedActor,
+				Actor:       fakeActor,
+			},
+		}
+
+		setPositionalFlags(&cmd, fakeServiceOfferingName, fakeServicePlanName)
+
+		fakeActor.GetCurrentUserReturns(configv3.User{Name: fakeUserName}, nil)
+		fakeActor.GetServicePlanByNameOfferingAndBrokerReturns(
+			resources.ServicePlan{
+				Name: fakeServicePlanName,
+				ServiceInstanceCreateSchema: map[string]interface{}{
+					"type":     "object",
+					"required": []interface{}{"size"},
+				},
+				ServiceBindingCreateSchema: map[string]interface{}{
+					"type": "object",
+				},
+			},
+			v7action.Warnings{"plan warning"},
+			nil,
+		)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	It("checks the user is logged in", func() {
+		Expect(executeErr).NotTo(HaveOccurred())
+
+		Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+		actualOrg, actualSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+		Expect(actualOrg).To(BeFalse())
+		Expect(actualSpace).To(BeFalse())
+	})
+
+	It("gets the plan", func() {
+		Expect(fakeActor.GetServicePlanByNameOfferingAndBrokerCallCount()).To(Equal(1))
+		actualPlan, actualOffering, actualBroker := fakeActor.GetServicePlanByNameOfferingAndBrokerArgsForCall(0)
+		Expect(actualPlan).To(Equal(fakeServicePlanName))
+		Expect(actualOffering).To(Equal(fakeServiceOfferingName))
+		Expect(actualBroker).To(BeEmpty())
+	})
+
+	It("prints each schema, or a message when there is none", func() {
+		Expect(testUI.Out).To(Say(`Getting parameter schemas for service plan fake-plan of service offering fake-offering as fake-user-name\.\.\.`))
+		Expect(testUI.Out).To(Say(`Service instance create parameters \(create-service -c\):`))
+		Expect(testUI.Out).To(Say(`\{\n  "required": \[\n    "size"\n  \],\n  "type": "object"\n\}`))
+		Expect(testUI.Out).To(Say(`Service instance update parameters \(update-service -c\):`))
+		Expect(testUI.Out).To(Say(`No schema provided\.`))
+		Expect(testUI.Out).To(Say(`Service binding create parameters \(bind-service -c, create-service-key -c\):`))
+		Expect(testUI.Out).To(Say(`\{\n  "type": "object"\n\}`))
+
+		Expect(testUI.Err).To(Say("plan warning"))
+	})
+
+	When("a broker is specified", func() {
+		BeforeEach(func() {
+			setFlag(&cmd, "-b", "fake-broker")
+		})
+
+		It("gets the plan from that broker", func() {
+			_, _, actualBroker := fakeActor.GetServicePlanByNameOfferingAndBrokerArgsForCall(0)
+			Expect(actualBroker).To(Equal("fake-broker"))
+		})
+	})
+
+	When("the plan cannot be found", func() {
+		BeforeEach(func() {
+			fakeActor.GetServicePlanByNameOfferingAndBrokerReturns(
+				resources.ServicePlan{},
+				v7action.Warnings{"plan warning"},
+				actionerror.ServicePlanNotFoundError{PlanName: fakeServicePlanName, OfferingName: fakeServiceOfferingName},
+			)
+		})
+
+		It("returns the error and warnings", func() {
+			Expect(executeErr).To(MatchError(actionerror.ServicePlanNotFoundError{PlanName: fakeServicePlanName, OfferingName: fakeServiceOfferingName}))
+			Expect(testUI.Err).To(Say("plan warning"))
+		})
+	})
+
+	When("the user is not logged in", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(errors.New("not logged in"))
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError("not logged in"))
+			Expect(fakeActor.GetServicePlanByNameOfferingAndBrokerCallCount()).To(Equal(0))
+		})
+	})
+})
@@ -1,9 +1,12 @@
 package v7
 
 import (
+	"strings"
+
 	"code.cloudfoundry.org/cli/actor/v7action"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
 	"code.cloudfoundry.org/cli/types"
 )
 
@@ -11,21 +14,44 @@ type ShareServiceCommand struct {
 	BaseCommand
 
 	RequiredArgs    flag.ServiceInstance `positional-args:"yes"`
-	SpaceName       string               `short:"s" required:"true" description:"The space to share the service instance into"`
-	OrgName         flag.OptionalString  `short:"o" required:"false" description:"Org of the other space (Default: targeted org)"`
-	relatedCommands interface{}          `related_commands:"bind-service, service, services, unshare-service"`
+	SpaceName       string               `short:"s" description:"The space to share the service instance into, or a comma-separated list of spaces"`
+	OrgName         flag.OptionalString  `short:"o" long:"to-org" required:"false" description:"Org of the other space (Default: targeted org)"`
+	AllSpaces       bool                 `long:"all-spaces" description:"Share the service instance into every space of the org"`
+	relatedCommands interface{}          `related_commands:"bind-service, service, services, shared-services, unshare-service"`
 }
 
 func (cmd ShareServiceCommand) Usage() string {
-	return "CF_NAME share-service SERVICE_INSTANCE -s OTHER_SPACE [-o OTHER_ORG]"
+	return `CF_NAME share-service SERVICE_INSTANCE -s OTHER_SPACE[,OTHER_SPACE...] [-o OTHER_ORG]
+CF_NAME share-service SERVICE_INSTANCE --all-spaces [-o OTHER_ORG]`
+}
+
+func (cmd ShareServiceCommand) Examples() string {
+	return `CF_NAME share-service mydb -s dev
+CF_NAME share-service mydb -s dev,test,staging
+CF_NAME share-service mydb --to-org analytics --all-spaces`
 }
 
 func (cmd ShareServiceCommand) Execute(args []string) error {
+	spaceNames := cmd.spaceNames()
+	switch {
+	case cmd.AllSpaces && len(spaceNames) > 0:
+		return translatableerror.ArgumentCombinationError{Args: []string{"-s", "--all-spaces"}}
+	case !cmd.AllSpaces && len(spaceNames) == 0:
+		return translatableerror.IncorrectUsageError{Message: "either -s or --all-spaces must be provided"}
+	}
+
 	if err := cmd.SharedActor.CheckTarget(true, true); err != nil {
 		return err
 	}
 
-	if err := cmd.displayIntro(); err != nil {
+	if !cmd.AllSpaces && len(spaceNames) == 1 {
+		return cmd.shareToSpace(spaceNames[0])
+	}
+	return cmd.shareToSpaces(spaceNames)
+}
+
+func (cmd ShareServiceCommand) shareToSpace(spaceName string) error {
+	if err := cmd.displayIntro(spaceName); err != nil {
 		return err
 	}
 
@@ -34,7 +60,7 @@ func (cmd ShareServiceCommand) Execute(args []string) error {
 		cmd.Config.TargetedSpace().GUID,
 		cmd.Config.TargetedOrganization().GUID,
 		v7action.ServiceInstanceSharingParams{
-			SpaceName: cmd.SpaceName,
+			SpaceName: spaceName,
 			OrgName:   types.OptionalString(cmd.OrgName),
 		})
 
@@ -55,26 +81,102 @@ func (cmd ShareServiceCommand) Execute(args []string) error {
 	return nil
 }
 
-func (cmd ShareServiceCommand) displayIntro() error {
+func (cmd ShareServiceCommand) shareToSpaces(spaceNames []string) error {
 	user, err := cmd.Actor.GetCurrentUser()
 	if err != nil {
 		return err
 	}
 
-	orgName := cmd.OrgName.Value
-	if !cmd.OrgName.IsSet {
-		orgName = cmd.Config.TargetedOrganization().Name
+	if cmd.AllSpaces {
+		cmd.UI.DisplayTextWithFlavor(
+			"Sharing service instance {{.ServiceInstanceName}} into all spaces of org {{.OrgName}} as {{.Username}}...",
+			map[string]interface{}{
+				"ServiceInstanceName": cmd.RequiredArgs.ServiceInstance,
+				"OrgName":             cmd.orgName(),
+				"Username":            user.Name,
+			},
+		)
+	} else {
+		cmd.UI.DisplayTextWithFlavor(
+			"Sharing service instance {{.ServiceInstanceName}} into org {{.OrgName}} / spaces {{.SpaceNames}} as {{.Username}}...",
+			map[string]interface{}{
+				"ServiceInstanceName": cmd.RequiredArgs.ServiceInstance,
+				"OrgName":             cmd.orgName(),
+				"SpaceNames":          strings.Join(spaceNames, ", "),
+				"Username":            user.Name,
+			},
+		)
+	}
+
+	sharedSpaces, warnings, err := cmd.Actor.ShareServiceInstanceToSpaces(
+		string(cmd.RequiredArgs.ServiceInstance),
+		cmd.Config.TargetedSpace().GUID,
+		cmd.Config.TargetedOrganization().GUID,
+		v7action.BulkServiceInstanceSharingParams{
+			SpaceNames: spaceNames,
+			OrgName:    types.OptionalString(cmd.OrgName),
+			AllSpaces:  cmd.AllSpaces,
+		})
+
+	cmd.UI.DisplayWarnings(warnings)
+
+	// The spaces are shared in a single request, so a conflict in one of them
+	// means none were shared and the error is returned as is.
+	if err != nil {
+		return err
+	}
+
+	if len(sharedSpaces) == 0 {
+		cmd.UI.DisplayText("There are no other spaces in org {{.OrgName}} to share into.", map[string]interface{}{
+			"OrgName": cmd.orgName(),
+		})
+	} else {
+		var names []string
+		for _, space := range sharedSpaces {
+			names = append(names, space.Name)
+		}
+		cmd.UI.DisplayText("Shared into spaces: {{.SpaceNames}}", map[string]interface{}{
+			"SpaceNames": strings.Join(names, ", "),
+		})
+	}
+
+	cmd.UI.DisplayOK()
+
+	return nil
+}
+
+func (cmd ShareServiceCommand) displayIntro(spaceName string) error {
+	user, err := cmd.Actor.GetCurrentUser()
+	if err != nil {
+		return err
 	}
 
 	cmd.UI.DisplayTextWithFlavor(
 		"Sharing service instance {{.ServiceInstanceName}} into org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...",
 		map[string]interface{}{
 			"ServiceInstanceName": cmd.RequiredArgs.ServiceInstance,
-			"OrgName":             orgName,
-			"SpaceName":           cmd.SpaceName,
+			"OrgName":             cmd.orgName(),
+			"SpaceName":           spaceName,
 			"Username":            user.Name,
 		},
 	)
 
 	return nil
 }
+
+func (cmd ShareServiceCommand) orgName() string {
+	if cmd.OrgName.IsSet {
+		return cmd.OrgName.Value
+	}
+	return cmd.Config.TargetedOrganization().Name
+}
+
+func (cmd ShareServiceCommand) spaceNames() []string {
+	var names []string
+	for _, name := range strings.Split(cmd.SpaceName, ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			names = append(names, name)
+		}
+	}
+	return names
+}
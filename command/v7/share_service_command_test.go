@@ -3,7 +3,10 @@ package v7_test
 import (
 	"errors"
 
+	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/resources"
 	"code.cloudfoundry.org/cli/util/configv3"
 
 	"code.cloudfoundry.org/cli/command/flag"
@@ -45,6 +48,7 @@ var _ = Describe("share-service command", func() {
 				Actor:       fakeActor,
 			},
 		}
+		cmd.SpaceName = "some-space"
 	})
 
 	JustBeforeEach(func() {
@@ -172,6 +176,119 @@ var _ = Describe("share-service command", func() {
 		})
 	})
 
+	Context("sharing into several spaces", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.ServiceInstance = "mydb"
+			setFlag(&cmd, "-s", "dev, test")
+
+			fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "source-space-guid"})
+			fakeConfig.TargetedOrganizationReturns(configv3.Organization{GUID: "org-guid", Name: "some-org"})
+			fakeActor.GetCurrentUserReturns(configv3.User{Name: "steve"}, nil)
+			fakeActor.ShareServiceInstanceToSpacesReturns(
+				[]resources.Space{{Name: "dev"}, {Name: "test"}},
+				v7action.Warnings{"share warning"},
+				nil,
+			)
+		})
+
+		It("shares into all the listed spaces at once", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(fakeActor.ShareServiceInstanceToSpaceAndOrgCallCount()).To(Equal(0))
+			Expect(fakeActor.ShareServiceInstanceToSpacesCallCount()).To(Equal(1))
+
+			instanceName, spaceGUID, orgGUID, params := fakeActor.ShareServiceInstanceToSpacesArgsForCall(0)
+			Expect(instanceName).To(Equal("mydb"))
+			Expect(spaceGUID).To(Equal("source-space-guid"))
+			Expect(orgGUID).To(Equal("org-guid"))
+			Expect(params).To(Equal(v7action.BulkServiceInstanceSharingParams{SpaceNames: []string{"dev", "test"}}))
+
+			Expect(testUI.Out).To(Say(`Sharing service instance mydb into org some-org / spaces dev, test as steve\.\.\.`))
+			Expect(testUI.Out).To(Say(`Shared into spaces: dev, test`))
+			Expect(testUI.Out).To(Say(`OK`))
+			Expect(testUI.Err).To(Say("share warning"))
+		})
+
+		When("sharing into all spaces of another org", func() {
+			BeforeEach(func() {
+				cmd.SpaceName = ""
+				setFlag(&cmd, "--all-spaces")
+				setFlag(&cmd, "-o", flag.OptionalString{IsSet: true, Value: "analytics"})
+			})
+
+			It("shares into every space of that org", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+
+				_, _, _, params := fakeActor.ShareServiceInstanceToSpacesArgsForCall(0)
+				Expect(params).To(Equal(v7action.BulkServiceInstanceSharingParams{
+					OrgName:   types.NewOptionalString("analytics"),
+					AllSpaces: true,
+				}))
+				Expect(testUI.Out).To(Say(`Sharing service instance mydb into all spaces of org analytics as steve\.\.\.`))
+			})
+
+			When("the org has no other spaces", func() {
+				BeforeEach(func() {
+					fakeActor.ShareServiceInstanceToSpacesReturns(nil, nil, nil)
+				})
+
+				It("says so", func() {
+					Expect(executeErr).NotTo(HaveOccurred())
+					Expect(testUI.Out).To(Say(`There are no other spaces in org analytics to share into\.`))
+					Expect(testUI.Out).To(Say(`OK`))
+				})
+			})
+		})
+
+		When("both -s and --all-spaces are given", func() {
+			BeforeEach(func() {
+				setFlag(&cmd, "--all-spaces")
+			})
+
+			It("returns an argument combination error", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{Args: []string{"-s", "--all-spaces"}}))
+				Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+			})
+		})
+
+		When("neither -s nor --all-spaces is given", func() {
+			BeforeEach(func() {
+				cmd.SpaceName = ""
+			})
+
+			It("returns an incorrect usage error", func() {
+				Expect(executeErr).To(MatchError(translatableerror.IncorrectUsageError{Message: "either -s or --all-spaces must be provided"}))
+				Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+			})
+		})
+
+		When("sharing fails", func() {
+			BeforeEach(func() {
+				fakeActor.ShareServiceInstanceToSpacesReturns(nil, v7action.Warnings{"share warning"}, actionerror.SpaceNotFoundError{Name: "test"})
+			})
+
+			It("returns the error and displays warnings", func() {
+				Expect(executeErr).To(MatchError(actionerror.SpaceNotFoundError{Name: "test"}))
+				Expect(testUI.Err).To(Say("share warning"))
+			})
+		})
+
+		When("the service instance is already shared into one of the spaces", func() {
+			BeforeEach(func() {
+				fakeActor.ShareServiceInstanceToSpacesReturns(
+					nil,
+					v7action.Warnings{"share warning"},
+					ccerror.ServiceInstanceAlreadySharedError{Message: "already shared into space-2"},
+				)
+			})
+
+			It("returns the error instead of reporting success", func() {
+				Expect(executeErr).To(MatchError(ccerror.ServiceInstanceAlreadySharedError{Message: "already shared into space-2"}))
+				Expect(testUI.Err).To(Say("share warning"))
+				Expect(testUI.Out).NotTo(Say("OK"))
+			})
+		})
+	})
+
 	Context("pre-share errors", func() {
 		When("checking the target returns an error", func() {
 			BeforeEach(func() {
@@ -87,6 +87,15 @@ func formatRoutable(b *bool) string {
 	return fmt.Sprintf("%t", *b)
 }
 
+// ProcessInstancesDisplay displays the instances table of a single process.
+func (display AppSummaryDisplayer) ProcessInstancesDisplay(processSummary v7action.ProcessSummary) {
+	if len(processSummary.InstanceDetails) == 0 {
+		display.UI.DisplayText("There are no running instances of this process.")
+		return
+	}
+	display.displayAppInstancesTable(processSummary)
+}
+
 func (display AppSummaryDisplayer) displayAppInstancesTable(processSummary v7action.ProcessSummary) {
 	table := [][]string{
 		{
@@ -152,11 +161,7 @@ func (display AppSummaryDisplayer) displayProcessTable(summary v7action.Detailed
 
 		display.UI.DisplayKeyValueTable("", keyValueTable, ui.DefaultTableSpacePadding)
 
-		if len(process.InstanceDetails) == 0 {
-			display.UI.DisplayText("There are no running instances of this process.")
-			continue
-		}
-		display.displayAppInstancesTable(process)
+		display.ProcessInstancesDisplay(process)
 	}
 
 	if summary.Deployment.StatusValue == constant.DeploymentStatusValueActive {
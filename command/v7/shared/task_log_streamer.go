@@ -0,0 +1,88 @@
+package shared
+
+import (
+	"context"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/resources"
+)
+
+// TaskLogDrainTimeout is how long logs keep being displayed after a task has
+// reached a terminal state. Log Cache delivers envelopes with a delay, so the
+// last lines a task writes usually arrive after the task has finished.
+var TaskLogDrainTimeout = 5 * time.Second
+
+type taskLogActor interface {
+	GetStreamingLogsForTask(appGUID string, task resources.Task, client sharedaction.LogCacheClient) (<-chan sharedaction.LogMessage, <-chan error, context.CancelFunc, error)
+	PollTask(task resources.Task) (resources.Task, v7action.Warnings, error)
+}
+
+type polledTask struct {
+	task     resources.Task
+	warnings v7action.Warnings
+	err      error
+}
+
+// StreamTaskLogsUntilComplete displays the logs of the given task while
+// polling it, and returns once the task has succeeded or failed. The returned
+// error is the one from polling the task, so a failed task results in an
+// actionerror.TaskFailedError.
+func StreamTaskLogsUntilComplete(actor taskLogActor, ui command.UI, logCache sharedaction.LogCacheClient, appGUID string, task resources.Task) (resources.Task, error) {
+	logStream, logErrStream, stopLogStream, err := actor.GetStreamingLogsForTask(appGUID, task, logCache)
+	if err != nil {
+		ui.DisplayWarning("Failed to retrieve logs from Log Cache: {{.Error}}", map[string]interface{}{
+			"Error": err,
+		})
+	} else {
+		defer stopLogStream()
+	}
+
+	polled := make(chan polledTask, 1)
+	go func() {
+		task, warnings, err := actor.PollTask(task)
+		polled <- polledTask{task: task, warnings: warnings, err: err}
+	}()
+
+	var (
+		result  polledTask
+		drained <-chan time.Time
+	)
+
+	for {
+		select {
+		case log, ok := <-logStream:
+			if !ok {
+				logStream = nil
+				break
+			}
+			ui.DisplayLogMessage(log, true)
+		case logErr, ok := <-logErrStream:
+			if !ok {
+				logErrStream = nil
+				break
+			}
+
+			switch logErr.(type) {
+			case actionerror.LogCacheTimeoutError:
+				ui.DisplayWarning("timeout connecting to log server, no log will be shown")
+			default:
+				ui.DisplayWarning("Failed to retrieve logs from Log Cache: {{.Error}}", map[string]interface{}{
+					"Error": logErr,
+				})
+			}
+		case result = <-polled:
+			if logStream == nil {
+				ui.DisplayWarnings(result.warnings)
+				return result.task, result.err
+			}
+			drained = time.After(TaskLogDrainTimeout)
+		case <-drained:
+			ui.DisplayWarnings(result.warnings)
+			return result.task, result.err
+		}
+	}
+}
@@ -0,0 +1,64 @@
+package v7
+
+import (
+	"strconv"
+
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+type SharedServicesCommand struct {
+	BaseCommand
+
+	usage           interface{} `usage:"CF_NAME shared-services"`
+	relatedCommands interface{} `related_commands:"service, services, share-service, unshare-service"`
+}
+
+func (cmd SharedServicesCommand) Execute(args []string) error {
+	if err := cmd.SharedActor.CheckTarget(true, false); err != nil {
+		return err
+	}
+
+	user, err := cmd.Actor.GetCurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Getting shared service instances in org {{.OrgName}} as {{.Username}}...", map[string]interface{}{
+		"OrgName":  cmd.Config.TargetedOrganization().Name,
+		"Username": user.Name,
+	})
+	cmd.UI.DisplayNewline()
+
+	summaries, warnings, err := cmd.Actor.GetSharedServiceInstanceSummaries(cmd.Config.TargetedOrganization().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	if len(summaries) == 0 {
+		cmd.UI.DisplayText("No shared service instances found.")
+		return nil
+	}
+
+	table := [][]string{{
+		cmd.UI.TranslateText("name"),
+		cmd.UI.TranslateText("space"),
+		cmd.UI.TranslateText("shared to org"),
+		cmd.UI.TranslateText("shared to space"),
+		cmd.UI.TranslateText("bound apps"),
+	}}
+	for _, summary := range summaries {
+		for _, sharedTo := range summary.SharedTo {
+			table = append(table, []string{
+				summary.Name,
+				summary.SpaceName,
+				sharedTo.OrganizationName,
+				sharedTo.SpaceName,
+				strconv.Itoa(sharedTo.BoundAppCount),
+			})
+		}
+	}
+
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+	return nil
+}
@@ -0,0 +1,128 @@
+package v7_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	v7 "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("shared-services Command", func() {
+	var (
+		cmd             v7.SharedServicesCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeActor
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+
+		cmd = v7.SharedServicesCommand{
+			BaseCommand: v7.BaseCommand{
+				UI:          testUI,
+				Config:      fakeConfig,
+				SharedActor: fakeSharedActor,
+				Actor:       fakeActor,
+			},
+		}
+
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org", GUID: "some-org-guid"})
+		fakeActor.GetCurrentUserReturns(configv3.User{Name: "steve"}, nil)
+
+		fakeActor.GetSharedServiceInstanceSummariesReturns(
+			[]v7action.SharedServiceInstanceSummary{
+				{
+					ServiceInstance: resources.ServiceInstance{Name: "mydb"},
+					SpaceName:       "prod",
+					SharedTo: []v7action.UsageSummaryWithSpaceAndOrg{
+						{OrganizationName: "some-org", SpaceName: "dev", BoundAppCount: 2},
+						{OrganizationName: "analytics", SpaceName: "reports", BoundAppCount: 0},
+					},
+				},
+				{
+					ServiceInstance: resources.ServiceInstance{Name: "queue"},
+					SpaceName:       "dev",
+					SharedTo: []v7action.UsageSummaryWithSpaceAndOrg{
+						{OrganizationName: "some-org", SpaceName: "test", BoundAppCount: 1},
+					},
+				},
+			},
+			v7action.Warnings{"summaries warning"},
+			nil,
+		)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	It("checks the org is targeted", func() {
+		Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+		checkOrg, checkSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+		Expect(checkOrg).To(BeTrue())
+		Expect(checkSpace).To(BeFalse())
+	})
+
+	It("gets the shared instances of the targeted org", func() {
+		Expect(fakeActor.GetSharedServiceInstanceSummariesCallCount()).To(Equal(1))
+		Expect(fakeActor.GetSharedServiceInstanceSummariesArgsForCall(0)).To(Equal("some-org-guid"))
+	})
+
+	It("displays one row per space an instance is shared into", func() {
+		Expect(executeErr).NotTo(HaveOccurred())
+
+		Expect(testUI.Out).To(Say(`Getting shared service instances in org some-org as steve\.\.\.`))
+		Expect(testUI.Out).To(Say(`name\s+space\s+shared to org\s+shared to space\s+bound apps`))
+		Expect(testUI.Out).To(Say(`mydb\s+prod\s+some-org\s+dev\s+2`))
+		Expect(testUI.Out).To(Say(`mydb\s+prod\s+analytics\s+reports\s+0`))
+		Expect(testUI.Out).To(Say(`queue\s+dev\s+some-org\s+test\s+1`))
+		Expect(testUI.Err).To(Say("summaries warning"))
+	})
+
+	When("no instances are shared", func() {
+		BeforeEach(func() {
+			fakeActor.GetSharedServiceInstanceSummariesReturns(nil, nil, nil)
+		})
+
+		It("says so", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(testUI.Out).To(Say(`No shared service instances found\.`))
+		})
+	})
+
+	When("getting the summaries fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetSharedServiceInstanceSummariesReturns(nil, v7action.Warnings{"summaries warning"}, errors.New("boom"))
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError("boom"))
+			Expect(testUI.Err).To(Say("summaries warning"))
+		})
+	})
+
+	When("checking the target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(errors.New("not targeted"))
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError("not targeted"))
+			Expect(fakeActor.GetSharedServiceInstanceSummariesCallCount()).To(Equal(0))
+		})
+	})
+})
@@ -0,0 +1,91 @@
+package v7
+
+import (
+	"fmt"
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+type StackMigrationReportCommand struct {
+	BaseCommand
+
+	From            string      `long:"from" required:"true" description:"Stack the apps are moving off"`
+	To              string      `long:"to" required:"true" description:"Stack the apps are moving to"`
+	usage           interface{} `usage:"CF_NAME stack-migration-report --from STACK_NAME --to STACK_NAME\n\n   Lists the apps you can see on the stack given with '--from' and whether their buildpacks are available as enabled admin buildpacks for the stack given with '--to'. Buildpacks given as URLs cannot be checked and are reported as unknown.\n\nEXAMPLES:\n   CF_NAME stack-migration-report --from cflinuxfs3 --to cflinuxfs4"`
+	relatedCommands interface{} `related_commands:"buildpacks, change-stack, stacks"`
+}
+
+func (cmd StackMigrationReportCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(false, false)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Actor.GetCurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Getting apps on stack {{.FromStack}} as {{.Username}}...", map[string]interface{}{
+		"FromStack": cmd.From,
+		"Username":  user.Name,
+	})
+	cmd.UI.DisplayNewline()
+
+	report, warnings, err := cmd.Actor.GetStackMigrationReport(cmd.From, cmd.To)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	if len(report) == 0 {
+		cmd.UI.DisplayText("No apps found.")
+		return nil
+	}
+
+	ready := 0
+	table := [][]string{{
+		cmd.UI.TranslateText("org"),
+		cmd.UI.TranslateText("space"),
+		cmd.UI.TranslateText("app"),
+		cmd.UI.TranslateText("state"),
+		cmd.UI.TranslateText("buildpacks"),
+		cmd.UI.TranslateText("ready"),
+	}}
+	for _, migrationApp := range report {
+		readiness := "no"
+		if migrationApp.Ready() {
+			ready++
+			readiness = "yes"
+		}
+		table = append(table, []string{
+			migrationApp.OrgName,
+			migrationApp.SpaceName,
+			migrationApp.App.Name,
+			cmd.UI.TranslateText(strings.ToLower(string(migrationApp.App.State))),
+			cmd.formatBuildpackCompatibilities(migrationApp.Buildpacks),
+			cmd.UI.TranslateText(readiness),
+		})
+	}
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+	cmd.UI.DisplayNewline()
+
+	cmd.UI.DisplayText("{{.Count}} apps on stack {{.FromStack}}; {{.Ready}} ready to move to {{.ToStack}}.", map[string]interface{}{
+		"Count":     len(report),
+		"FromStack": cmd.From,
+		"Ready":     ready,
+		"ToStack":   cmd.To,
+	})
+
+	return nil
+}
+
+func (cmd StackMigrationReportCommand) formatBuildpackCompatibilities(buildpacks []v7action.BuildpackCompatibility) string {
+	var formatted []string
+	for _, buildpack := range buildpacks {
+		formatted = append(formatted, fmt.Sprintf("%s (%s)", buildpack.Name, cmd.UI.TranslateText(string(buildpack.Status))))
+	}
+	return strings.Join(formatted, ", ")
+}
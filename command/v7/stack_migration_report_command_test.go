@@ -0,0 +1,129 @@
+package v7_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	v7 "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("stack-migration-report Command", func() {
+	var (
+		cmd             v7.StackMigrationReportCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeActor
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+
+		cmd = v7.StackMigrationReportCommand{
+			BaseCommand: v7.BaseCommand{
+				UI:          testUI,
+				Config:      fakeConfig,
+				SharedActor: fakeSharedActor,
+				Actor:       fakeActor,
+			},
+			From: "cflinuxfs3",
+			To:   "cflinuxfs4",
+		}
+
+		fakeActor.GetCurrentUserReturns(configv3.User{Name: "steve"}, nil)
+		fakeActor.GetStackMigrationReportReturns(
+			[]v7action.StackMigrationApp{
+				{
+					App:       resources.Application{Name: "api", State: constant.ApplicationStarted},
+					OrgName:   "my-org",
+					SpaceName: "dev",
+					Buildpacks: []v7action.BuildpackCompatibility{
+						{Name: "java_buildpack", Status: v7action.BuildpackAvailable},
+					},
+				},
+				{
+					App:       resources.Application{Name: "legacy", State: constant.ApplicationStopped},
+					OrgName:   "my-org",
+					SpaceName: "dev",
+					Buildpacks: []v7action.BuildpackCompatibility{
+						{Name: "php_buildpack", Status: v7action.BuildpackMissing},
+						{Name: "https://example.com/extra.git", Status: v7action.BuildpackUnverified},
+					},
+				},
+			},
+			v7action.Warnings{"report warning"},
+			nil,
+		)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	It("checks the user is logged in", func() {
+		checkOrg, checkSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+		Expect(checkOrg).To(BeFalse())
+		Expect(checkSpace).To(BeFalse())
+	})
+
+	It("lists the apps on the stack with their buildpack compatibility", func() {
+		Expect(executeErr).NotTo(HaveOccurred())
+		fromStack, toStack := fakeActor.GetStackMigrationReportArgsForCall(0)
+		Expect(fromStack).To(Equal("cflinuxfs3"))
+		Expect(toStack).To(Equal("cflinuxfs4"))
+
+		Expect(testUI.Out).To(Say(`Getting apps on stack cflinuxfs3 as steve\.\.\.`))
+		Expect(testUI.Out).To(Say(`org\s+space\s+app\s+state\s+buildpacks\s+ready`))
+		Expect(testUI.Out).To(Say(`my-org\s+dev\s+api\s+started\s+java_buildpack \(available\)\s+yes`))
+		Expect(testUI.Out).To(Say(`my-org\s+dev\s+legacy\s+stopped\s+php_buildpack \(missing\), https://example.com/extra.git \(unknown\)\s+no`))
+		Expect(testUI.Out).To(Say(`2 apps on stack cflinuxfs3; 1 ready to move to cflinuxfs4\.`))
+		Expect(testUI.Err).To(Say("report warning"))
+	})
+
+	When("no apps are on the stack", func() {
+		BeforeEach(func() {
+			fakeActor.GetStackMigrationReportReturns(nil, nil, nil)
+		})
+
+		It("says so", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(testUI.Out).To(Say("No apps found."))
+		})
+	})
+
+	When("a stack does not exist", func() {
+		BeforeEach(func() {
+			fakeActor.GetStackMigrationReportReturns(nil, v7action.Warnings{"report warning"}, actionerror.StackNotFoundError{Name: "cflinuxfs4"})
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError(actionerror.StackNotFoundError{Name: "cflinuxfs4"}))
+			Expect(testUI.Err).To(Say("report warning"))
+		})
+	})
+
+	When("checking the target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(errors.New("not logged in"))
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError("not logged in"))
+			Expect(fakeActor.GetStackMigrationReportCallCount()).To(Equal(0))
+		})
+	})
+})
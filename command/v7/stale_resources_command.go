@@ -0,0 +1,178 @@
+package v7
+
+import (
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+const defaultStaleResourceAge = 30 * 24 * time.Hour
+
+type StaleResourcesCommand struct {
+	BaseCommand
+
+	Space           bool          `long:"space" description:"Report stale resources in the targeted space (Default)"`
+	Org             bool          `long:"org" description:"Report stale resources in all spaces of the targeted org"`
+	OlderThan       flag.Duration `long:"older-than" description:"Minimum age of stopped apps, old droplets and packages, and service keys (e.g. 12h, 7d). Default: 30d"`
+	Delete          bool          `long:"delete" description:"Delete all reported resources"`
+	Interactive     bool          `long:"interactive" description:"Ask which of the reported resources to delete"`
+	Force           bool          `short:"f" description:"Delete the resources without confirmation"`
+	usage           interface{}   `usage:"CF_NAME stale-resources [--space | --org] [--older-than DURATION] [--delete [-f] | --interactive]\n\n   Reports resources that are likely no longer needed: stopped apps that have not been updated in a while, service instances without bindings, routes without destinations, droplets and packages other than the current ones, and old service keys.\n\nEXAMPLES:\n   CF_NAME stale-resources\n   CF_NAME stale-resources --org --older-than 90d\n   CF_NAME stale-resources --interactive\n   CF_NAME stale-resources --delete -f"`
+	relatedCommands interface{}   `related_commands:"apps, delete, delete-orphaned-routes, delete-service, delete-service-key, droplets, packages"`
+}
+
+func (cmd StaleResourcesCommand) Execute(args []string) error {
+	err := cmd.validateArguments()
+	if err != nil {
+		return err
+	}
+
+	err = cmd.SharedActor.CheckTarget(true, !cmd.Org)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Actor.GetCurrentUser()
+	if err != nil {
+		return err
+	}
+
+	spaceGUID := ""
+	if cmd.Org {
+		cmd.UI.DisplayTextWithFlavor("Getting stale resources in org {{.OrgName}} as {{.Username}}...", map[string]interface{}{
+			"OrgName":  cmd.Config.TargetedOrganization().Name,
+			"Username": user.Name,
+		})
+	} else {
+		spaceGUID = cmd.Config.TargetedSpace().GUID
+		cmd.UI.DisplayTextWithFlavor("Getting stale resources in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+			"OrgName":   cmd.Config.TargetedOrganization().Name,
+			"SpaceName": cmd.Config.TargetedSpace().Name,
+			"Username":  user.Name,
+		})
+	}
+	cmd.UI.DisplayNewline()
+
+	age := defaultStaleResourceAge
+	if cmd.OlderThan.IsSet {
+		age = cmd.OlderThan.Value
+	}
+
+	staleResources, warnings, err := cmd.Actor.GetStaleResources(cmd.Config.TargetedOrganization().GUID, spaceGUID, time.Now().Add(-age))
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	if len(staleResources) == 0 {
+		cmd.UI.DisplayText("No stale resources found.")
+		return nil
+	}
+
+	table := [][]string{{
+		cmd.UI.TranslateText("type"),
+		cmd.UI.TranslateText("name"),
+		cmd.UI.TranslateText("parent"),
+		cmd.UI.TranslateText("space"),
+		cmd.UI.TranslateText("last updated"),
+	}}
+	for _, resource := range staleResources {
+		lastUpdated := ""
+		if !resource.Timestamp.IsZero() {
+			lastUpdated = resource.Timestamp.Format(time.RFC1123)
+		}
+		table = append(table, []string{
+			cmd.UI.TranslateText(string(resource.Type)),
+			resource.Name,
+			resource.Parent,
+			resource.SpaceName,
+			lastUpdated,
+		})
+	}
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+
+	switch {
+	case cmd.Delete:
+		return cmd.deleteAll(staleResources, user)
+	case cmd.Interactive:
+		return cmd.deleteSelected(staleResources, user)
+	}
+
+	return nil
+}
+
+func (cmd StaleResourcesCommand) validateArguments() error {
+	switch {
+	case cmd.Space && cmd.Org:
+		return translatableerror.ArgumentCombinationError{Args: []string{"--space", "--org"}}
+	case cmd.Delete && cmd.Interactive:
+		return translatableerror.ArgumentCombinationError{Args: []string{"--delete", "--interactive"}}
+	case cmd.Force && cmd.Interactive:
+		return translatableerror.ArgumentCombinationError{Args: []string{"-f", "--interactive"}}
+	}
+	return nil
+}
+
+func (cmd StaleResourcesCommand) deleteAll(staleResources []v7action.StaleResource, user configv3.User) error {
+	cmd.UI.DisplayNewline()
+
+	if !cmd.Force {
+		deleteResources, err := cmd.UI.DisplayBoolPrompt(false, "Really delete {{.Count}} stale resources?", map[string]interface{}{
+			"Count": len(staleResources),
+		})
+		if err != nil {
+			return err
+		}
+		if !deleteResources {
+			cmd.UI.DisplayText("No resources have been deleted.")
+			return nil
+		}
+	}
+
+	return cmd.deleteResources(staleResources, user)
+}
+
+func (cmd StaleResourcesCommand) deleteSelected(staleResources []v7action.StaleResource, user configv3.User) error {
+	cmd.UI.DisplayNewline()
+
+	var selected []v7action.StaleResource
+	for _, resource := range staleResources {
+		deleteResource, err := cmd.UI.DisplayBoolPrompt(false, "Delete {{.Type}} {{.Name}}?", map[string]interface{}{
+			"Type": cmd.UI.TranslateText(string(resource.Type)),
+			"Name": resource.Name,
+		})
+		if err != nil {
+			return err
+		}
+		if deleteResource {
+			selected = append(selected, resource)
+		}
+	}
+
+	if len(selected) == 0 {
+		cmd.UI.DisplayText("No resources have been deleted.")
+		return nil
+	}
+
+	return cmd.deleteResources(selected, user)
+}
+
+func (cmd StaleResourcesCommand) deleteResources(staleResources []v7action.StaleResource, user configv3.User) error {
+	cmd.UI.DisplayTextWithFlavor("Deleting {{.Count}} stale resources as {{.Username}}...", map[string]interface{}{
+		"Count":    len(staleResources),
+		"Username": user.Name,
+	})
+
+	warnings, err := cmd.Actor.DeleteStaleResources(staleResources)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+	return nil
+}
@@ -0,0 +1,253 @@
+package v7_test
+
+import (
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	v7 "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("stale-resources Command", func() {
+	var (
+		cmd             v7.StaleResourcesCommand
+		testUI          *ui.UI
+		input           *Buffer
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeActor
+		executeErr      error
+
+		staleResources []v7action.StaleResource
+	)
+
+	BeforeEach(func() {
+		input = NewBuffer()
+		testUI = ui.NewTestUI(input, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+
+		cmd = v7.StaleResourcesCommand{
+			BaseCommand: v7.BaseCommand{
+				UI:          testUI,
+				Config:      fakeConfig,
+				SharedActor: fakeSharedActor,
+				Actor:       fakeActor,
+			},
+		}
+
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{GUID: "org-guid", Name: "my-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "space-guid", Name: "dev"})
+		fakeActor.GetCurrentUserReturns(configv3.User{Name: "steve"}, nil)
+
+		staleResources = []v7action.StaleResource{
+			{Type: v7action.StaleApp, GUID: "app-guid", Name: "old-app", SpaceName: "dev", Timestamp: time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)},
+			{Type: v7action.StaleServiceKey, GUID: "key-guid", Name: "old-key", Parent: "my-db", SpaceName: "dev", Timestamp: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)},
+			{Type: v7action.StaleRoute, GUID: "route-guid", Name: "unused.example.com", SpaceName: "dev"},
+		}
+		fakeActor.GetStaleResourcesReturns(staleResources, v7action.Warnings{"stale warning"}, nil)
+		fakeActor.DeleteStaleResourcesReturns(v7action.Warnings{"delete warning"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	It("checks the space is targeted", func() {
+		checkOrg, checkSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+		Expect(checkOrg).To(BeTrue())
+		Expect(checkSpace).To(BeTrue())
+	})
+
+	It("reports the stale resources of the targeted space older than 30 days", func() {
+		Expect(executeErr).NotTo(HaveOccurred())
+
+		orgGUID, spaceGUID, olderThan := fakeActor.GetStaleResourcesArgsForCall(0)
+		Expect(orgGUID).To(Equal("org-guid"))
+		Expect(spaceGUID).To(Equal("space-guid"))
+		Expect(olderThan).To(BeTemporally("~", time.Now().Add(-30*24*time.Hour), time.Minute))
+
+		Expect(testUI.Out).To(Say(`Getting stale resources in org my-org / space dev as steve\.\.\.`))
+		Expect(testUI.Out).To(Say(`type\s+name\s+parent\s+space\s+last updated`))
+		Expect(testUI.Out).To(Say(`app\s+old-app\s+dev\s+Sun, 01 Jun 2025 00:00:00 UTC`))
+		Expect(testUI.Out).To(Say(`service key\s+old-key\s+my-db\s+dev\s+Wed, 01 Jan 2025 00:00:00 UTC`))
+		Expect(testUI.Out).To(Say(`route\s+unused\.example\.com\s+dev`))
+		Expect(testUI.Err).To(Say("stale warning"))
+
+		Expect(fakeActor.DeleteStaleResourcesCallCount()).To(Equal(0))
+	})
+
+	When("--org and --older-than are given", func() {
+		BeforeEach(func() {
+			setFlag(&cmd, "--org")
+			setFlag(&cmd, "--older-than", flag.Duration{Value: 7 * 24 * time.Hour, IsSet: true})
+		})
+
+		It("reports the stale resources of the targeted org", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+
+			checkOrg, checkSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkOrg).To(BeTrue())
+			Expect(checkSpace).To(BeFalse())
+
+			orgGUID, spaceGUID, olderThan := fakeActor.GetStaleResourcesArgsForCall(0)
+			Expect(orgGUID).To(Equal("org-guid"))
+			Expect(spaceGUID).To(BeEmpty())
+			Expect(olderThan).To(BeTemporally("~", time.Now().Add(-7*24*time.Hour), time.Minute))
+
+			Expect(testUI.Out).To(Say(`Getting stale resources in org my-org as steve\.\.\.`))
+		})
+	})
+
+	When("there are no stale resources", func() {
+		BeforeEach(func() {
+			fakeActor.GetStaleResourcesReturns(nil, nil, nil)
+			setFlag(&cmd, "--delete")
+		})
+
+		It("says so", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(testUI.Out).To(Say("No stale resources found."))
+			Expect(fakeActor.DeleteStaleResourcesCallCount()).To(Equal(0))
+		})
+	})
+
+	When("--delete is given", func() {
+		BeforeEach(func() {
+			setFlag(&cmd, "--delete")
+		})
+
+		When("the user confirms", func() {
+			BeforeEach(func() {
+				_, err := input.Write([]byte("y\n"))
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("deletes all stale resources", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(testUI.Out).To(Say(`Really delete 3 stale resources\?`))
+				Expect(testUI.Out).To(Say(`Deleting 3 stale resources as steve\.\.\.`))
+				Expect(testUI.Out).To(Say("OK"))
+				Expect(testUI.Err).To(Say("delete warning"))
+				Expect(fakeActor.DeleteStaleResourcesArgsForCall(0)).To(Equal(staleResources))
+			})
+		})
+
+		When("the user declines", func() {
+			BeforeEach(func() {
+				_, err := input.Write([]byte("n\n"))
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("does not delete anything", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(testUI.Out).To(Say("No resources have been deleted."))
+				Expect(fakeActor.DeleteStaleResourcesCallCount()).To(Equal(0))
+			})
+		})
+
+		When("-f is given", func() {
+			BeforeEach(func() {
+				setFlag(&cmd, "-f")
+			})
+
+			It("deletes without prompting", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(testUI.Out).NotTo(Say("Really delete"))
+				Expect(fakeActor.DeleteStaleResourcesCallCount()).To(Equal(1))
+			})
+		})
+
+		When("deleting fails", func() {
+			BeforeEach(func() {
+				setFlag(&cmd, "-f")
+				fakeActor.DeleteStaleResourcesReturns(v7action.Warnings{"delete warning"}, errors.New("boom"))
+			})
+
+			It("returns the error and displays warnings", func() {
+				Expect(executeErr).To(MatchError("boom"))
+				Expect(testUI.Err).To(Say("delete warning"))
+			})
+		})
+	})
+
+	When("--interactive is given", func() {
+		BeforeEach(func() {
+			setFlag(&cmd, "--interactive")
+		})
+
+		When("the user selects some resources", func() {
+			BeforeEach(func() {
+				_, err := input.Write([]byte("y\nn\ny\n"))
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("deletes the selected resources", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(testUI.Out).To(Say(`Delete app old-app\?`))
+				Expect(testUI.Out).To(Say(`Delete service key old-key\?`))
+				Expect(testUI.Out).To(Say(`Delete route unused\.example\.com\?`))
+				Expect(testUI.Out).To(Say(`Deleting 2 stale resources as steve\.\.\.`))
+				Expect(fakeActor.DeleteStaleResourcesArgsForCall(0)).To(Equal([]v7action.StaleResource{staleResources[0], staleResources[2]}))
+			})
+		})
+
+		When("the user selects no resources", func() {
+			BeforeEach(func() {
+				_, err := input.Write([]byte("n\nn\nn\n"))
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("does not delete anything", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(testUI.Out).To(Say("No resources have been deleted."))
+				Expect(fakeActor.DeleteStaleResourcesCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	DescribeTable("conflicting flags",
+		func(flags []string) {
+			for _, name := range flags {
+				setFlag(&cmd, name)
+			}
+			Expect(cmd.Execute(nil)).To(MatchError(translatableerror.ArgumentCombinationError{Args: flags}))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+		},
+		Entry("--space and --org", []string{"--space", "--org"}),
+		Entry("--delete and --interactive", []string{"--delete", "--interactive"}),
+		Entry("-f and --interactive", []string{"-f", "--interactive"}),
+	)
+
+	When("getting the stale resources fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetStaleResourcesReturns(nil, v7action.Warnings{"stale warning"}, errors.New("boom"))
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError("boom"))
+			Expect(testUI.Err).To(Say("stale warning"))
+		})
+	})
+
+	When("checking the target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(errors.New("not targeted"))
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError("not targeted"))
+			Expect(fakeActor.GetStaleResourcesCallCount()).To(Equal(0))
+		})
+	})
+})
@@ -0,0 +1,82 @@
+package v7
+
+import (
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/api/logcache"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v7/shared"
+)
+
+type TaskLogsCommand struct {
+	BaseCommand
+
+	RequiredArgs    flag.TaskArgs `positional-args:"yes"`
+	Follow          bool          `long:"follow" short:"f" description:"Keep streaming the task's logs until it completes"`
+	usage           interface{}   `usage:"CF_NAME task-logs APP_NAME TASK_ID [--follow]\n\nEXAMPLES:\n   CF_NAME task-logs my-app 3\n\n   CF_NAME task-logs my-app 3 --follow"`
+	relatedCommands interface{}   `related_commands:"logs, run-task, task, tasks"`
+
+	LogCacheClient sharedaction.LogCacheClient
+}
+
+func (cmd *TaskLogsCommand) Setup(config command.Config, ui command.UI) error {
+	err := cmd.BaseCommand.Setup(config, ui)
+	if err != nil {
+		return err
+	}
+
+	cmd.LogCacheClient, err = logcache.NewClient(config.LogCacheEndpoint(), config, ui, v7action.NewDefaultKubernetesConfigGetter())
+	return err
+}
+
+func (cmd TaskLogsCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	space := cmd.Config.TargetedSpace()
+
+	user, err := cmd.Actor.GetCurrentUser()
+	if err != nil {
+		return err
+	}
+
+	application, warnings, err := cmd.Actor.GetApplicationByNameAndSpace(cmd.RequiredArgs.AppName, space.GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Retrieving logs for task {{.TaskID}} of app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.CurrentUser}}...", map[string]interface{}{
+		"TaskID":      cmd.RequiredArgs.TaskID,
+		"AppName":     cmd.RequiredArgs.AppName,
+		"OrgName":     cmd.Config.TargetedOrganization().Name,
+		"SpaceName":   space.Name,
+		"CurrentUser": user.Name,
+	})
+	cmd.UI.DisplayNewline()
+
+	task, warnings, err := cmd.Actor.GetTaskBySequenceIDAndApplication(cmd.RequiredArgs.TaskID, application.GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	if !cmd.Follow || task.State == constant.TaskSucceeded || task.State == constant.TaskFailed {
+		messages, err := cmd.Actor.GetRecentLogsForTask(application.GUID, task, cmd.LogCacheClient)
+		if err != nil {
+			return err
+		}
+
+		for _, message := range messages {
+			cmd.UI.DisplayLogMessage(message, true)
+		}
+		return nil
+	}
+
+	_, err = shared.StreamTaskLogsUntilComplete(cmd.Actor, cmd.UI, cmd.LogCacheClient, application.GUID, task)
+	return err
+}
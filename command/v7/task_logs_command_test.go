@@ -0,0 +1,210 @@
+package v7_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/sharedaction/sharedactionfakes"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/shared"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("task-logs Command", func() {
+	var (
+		cmd                TaskLogsCommand
+		testUI             *ui.UI
+		fakeConfig         *commandfakes.FakeConfig
+		fakeSharedActor    *commandfakes.FakeSharedActor
+		fakeActor          *v7fakes.FakeActor
+		fakeLogCacheClient *sharedactionfakes.FakeLogCacheClient
+		binaryName         string
+		executeErr         error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+		fakeLogCacheClient = new(sharedactionfakes.FakeLogCacheClient)
+
+		cmd = TaskLogsCommand{
+			BaseCommand: BaseCommand{
+				UI:          testUI,
+				Config:      fakeConfig,
+				SharedActor: fakeSharedActor,
+				Actor:       fakeActor,
+			},
+			LogCacheClient: fakeLogCacheClient,
+		}
+
+		cmd.RequiredArgs.AppName = "some-app-name"
+		cmd.RequiredArgs.TaskID = 3
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		shared.TaskLogDrainTimeout = 10 * time.Millisecond
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: binaryName}))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	When("the user is logged in, and a space and org are targeted", func() {
+		var task resources.Task
+
+		BeforeEach(func() {
+			fakeConfig.TargetedOrganizationReturns(configv3.Organization{
+				GUID: "some-org-guid",
+				Name: "some-org",
+			})
+			fakeConfig.TargetedSpaceReturns(configv3.Space{
+				GUID: "some-space-guid",
+				Name: "some-space",
+			})
+			fakeActor.GetCurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+			fakeActor.GetApplicationByNameAndSpaceReturns(
+				resources.Application{GUID: "some-app-guid"},
+				v7action.Warnings{"get-application-warning"},
+				nil)
+
+			task = resources.Task{
+				GUID:       "task-3-guid",
+				SequenceID: 3,
+				Name:       "task-3",
+				State:      constant.TaskSucceeded,
+				CreatedAt:  "2016-11-08T22:26:02Z",
+			}
+			fakeActor.GetTaskBySequenceIDAndApplicationReturns(task, v7action.Warnings{"get-task-warning"}, nil)
+		})
+
+		When("--follow is not provided", func() {
+			BeforeEach(func() {
+				fakeActor.GetRecentLogsForTaskReturns([]sharedaction.LogMessage{
+					*sharedaction.NewLogMessage("message-1", "OUT", time.Now(), "APP/TASK/task-3", "0"),
+					*sharedaction.NewLogMessage("message-2", "ERR", time.Now(), "APP/TASK/task-3", "0"),
+				}, nil)
+			})
+
+			It("displays the recent logs of the task", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).To(Say("Retrieving logs for task 3 of app some-app-name in org some-org / space some-space as some-user..."))
+				Expect(testUI.Out).To(Say(`\[APP/TASK/task-3/0\] OUT message-1`))
+				Expect(testUI.Out).To(Say(`\[APP/TASK/task-3/0\] ERR message-2`))
+				Expect(testUI.Err).To(Say("get-application-warning"))
+				Expect(testUI.Err).To(Say("get-task-warning"))
+
+				Expect(fakeActor.GetTaskBySequenceIDAndApplicationCallCount()).To(Equal(1))
+				taskID, appGUID := fakeActor.GetTaskBySequenceIDAndApplicationArgsForCall(0)
+				Expect(taskID).To(Equal(3))
+				Expect(appGUID).To(Equal("some-app-guid"))
+
+				Expect(fakeActor.GetRecentLogsForTaskCallCount()).To(Equal(1))
+				appGUID, actualTask, client := fakeActor.GetRecentLogsForTaskArgsForCall(0)
+				Expect(appGUID).To(Equal("some-app-guid"))
+				Expect(actualTask).To(Equal(task))
+				Expect(client).To(Equal(fakeLogCacheClient))
+
+				Expect(fakeActor.GetStreamingLogsForTaskCallCount()).To(Equal(0))
+			})
+
+			When("retrieving the logs fails", func() {
+				BeforeEach(func() {
+					fakeActor.GetRecentLogsForTaskReturns(nil, errors.New("log-cache-error"))
+				})
+
+				It("returns the error", func() {
+					Expect(executeErr).To(MatchError("log-cache-error"))
+				})
+			})
+		})
+
+		When("--follow is provided", func() {
+			BeforeEach(func() {
+				cmd.Follow = true
+			})
+
+			When("the task has already completed", func() {
+				It("displays the recent logs instead of streaming", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(fakeActor.GetRecentLogsForTaskCallCount()).To(Equal(1))
+					Expect(fakeActor.GetStreamingLogsForTaskCallCount()).To(Equal(0))
+				})
+			})
+
+			When("the task is still running", func() {
+				BeforeEach(func() {
+					task.State = constant.TaskRunning
+					fakeActor.GetTaskBySequenceIDAndApplicationReturns(task, nil, nil)
+
+					fakeActor.GetStreamingLogsForTaskStub = func(appGUID string, task resources.Task, client sharedaction.LogCacheClient) (<-chan sharedaction.LogMessage, <-chan error, context.CancelFunc, error) {
+						logStream := make(chan sharedaction.LogMessage, 1)
+						logStream <- *sharedaction.NewLogMessage("streamed-message", "OUT", time.Now(), "APP/TASK/task-3", "0")
+						return logStream, make(chan error), func() {}, nil
+					}
+					fakeActor.PollTaskReturns(resources.Task{State: constant.TaskSucceeded}, v7action.Warnings{"poll-warning"}, nil)
+				})
+
+				It("streams the task's logs until it completes", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(testUI.Out).To(Say(`\[APP/TASK/task-3/0\] OUT streamed-message`))
+					Expect(testUI.Err).To(Say("poll-warning"))
+
+					Expect(fakeActor.PollTaskCallCount()).To(Equal(1))
+					Expect(fakeActor.PollTaskArgsForCall(0)).To(Equal(task))
+					Expect(fakeActor.GetRecentLogsForTaskCallCount()).To(Equal(0))
+				})
+
+				When("the task fails", func() {
+					BeforeEach(func() {
+						fakeActor.PollTaskReturns(resources.Task{State: constant.TaskFailed}, nil, actionerror.TaskFailedError{})
+					})
+
+					It("returns the error", func() {
+						Expect(executeErr).To(MatchError(actionerror.TaskFailedError{}))
+					})
+				})
+			})
+		})
+
+		When("the task cannot be found", func() {
+			BeforeEach(func() {
+				fakeActor.GetTaskBySequenceIDAndApplicationReturns(resources.Task{}, nil, actionerror.TaskNotFoundError{SequenceID: 3})
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError(actionerror.TaskNotFoundError{SequenceID: 3}))
+				Expect(fakeActor.GetRecentLogsForTaskCallCount()).To(Equal(0))
+			})
+		})
+	})
+})
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
 	"code.cloudfoundry.org/cli/command/flag"
 	"code.cloudfoundry.org/cli/util/ui"
 )
@@ -12,9 +13,11 @@ import (
 type TasksCommand struct {
 	BaseCommand
 
-	RequiredArgs    flag.AppName `positional-args:"yes"`
-	usage           interface{}  `usage:"CF_NAME tasks APP_NAME"`
-	relatedCommands interface{}  `related_commands:"apps, logs, run-task, task, terminate-task"`
+	RequiredArgs    flag.AppName  `positional-args:"yes"`
+	States          []string      `long:"state" choice:"PENDING" choice:"RUNNING" choice:"CANCELING" choice:"SUCCEEDED" choice:"FAILED" description:"Only list tasks in the given state (can be specified multiple times)"`
+	Since           flag.Duration `long:"since" description:"Only list tasks created within the given duration (e.g. 30m, 12h, 7d)"`
+	usage           interface{}   `usage:"CF_NAME tasks APP_NAME [--state STATE] [--since DURATION]\n\nEXAMPLES:\n   CF_NAME tasks my-app --state RUNNING\n\n   CF_NAME tasks my-app --state FAILED --state SUCCEEDED --since 24h"`
+	relatedCommands interface{}   `related_commands:"apps, logs, run-task, task, task-logs, terminate-task"`
 }
 
 func (cmd TasksCommand) Execute(args []string) error {
@@ -44,7 +47,15 @@ func (cmd TasksCommand) Execute(args []string) error {
 	})
 	cmd.UI.DisplayNewline()
 
-	tasks, warnings, err := cmd.Actor.GetApplicationTasks(application.GUID, v7action.Descending)
+	filter := v7action.TaskFilter{}
+	for _, state := range cmd.States {
+		filter.States = append(filter.States, constant.TaskState(state))
+	}
+	if cmd.Since.IsSet {
+		filter.Since = time.Now().Add(-cmd.Since.Value)
+	}
+
+	tasks, warnings, err := cmd.Actor.GetApplicationTasksWithFilter(application.GUID, v7action.Descending, filter)
 	cmd.UI.DisplayWarnings(warnings)
 	if err != nil {
 		return err
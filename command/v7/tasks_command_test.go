@@ -2,12 +2,14 @@ package v7_test
 
 import (
 	"errors"
+	"time"
 
 	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/actor/v7action"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
 	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
 	. "code.cloudfoundry.org/cli/command/v7"
 	"code.cloudfoundry.org/cli/command/v7/v7fakes"
 	"code.cloudfoundry.org/cli/resources"
@@ -111,7 +113,7 @@ var _ = Describe("tasks Command", func() {
 						resources.Application{GUID: "some-app-guid"},
 						v7action.Warnings{"get-application-warning-1", "get-application-warning-2"},
 						nil)
-					fakeActor.GetApplicationTasksReturns(
+					fakeActor.GetApplicationTasksWithFilterReturns(
 						[]resources.Task{
 							{
 								GUID:       "task-3-guid",
@@ -150,10 +152,11 @@ var _ = Describe("tasks Command", func() {
 					Expect(appName).To(Equal("some-app-name"))
 					Expect(spaceGUID).To(Equal("some-space-guid"))
 
-					Expect(fakeActor.GetApplicationTasksCallCount()).To(Equal(1))
-					guid, order := fakeActor.GetApplicationTasksArgsForCall(0)
+					Expect(fakeActor.GetApplicationTasksWithFilterCallCount()).To(Equal(1))
+					guid, order, filter := fakeActor.GetApplicationTasksWithFilterArgsForCall(0)
 					Expect(guid).To(Equal("some-app-guid"))
 					Expect(order).To(Equal(v7action.Descending))
+					Expect(filter).To(Equal(v7action.TaskFilter{}))
 
 					Expect(testUI.Out).To(Say("Getting tasks for app some-app-name in org some-org / space some-space as some-user..."))
 
@@ -166,9 +169,25 @@ var _ = Describe("tasks Command", func() {
 					Expect(testUI.Err).To(Say("get-tasks-warning-1"))
 				})
 
+				When("the --state and --since flags are provided", func() {
+					BeforeEach(func() {
+						cmd.States = []string{"FAILED", "SUCCEEDED"}
+						cmd.Since = flag.Duration{Value: 2 * time.Hour, IsSet: true}
+					})
+
+					It("filters the tasks by state and creation time", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+
+						Expect(fakeActor.GetApplicationTasksWithFilterCallCount()).To(Equal(1))
+						_, _, filter := fakeActor.GetApplicationTasksWithFilterArgsForCall(0)
+						Expect(filter.States).To(Equal([]constant.TaskState{constant.TaskFailed, constant.TaskSucceeded}))
+						Expect(filter.Since).To(BeTemporally("~", time.Now().Add(-2*time.Hour), time.Minute))
+					})
+				})
+
 				When("the tasks' command fields are returned as empty strings", func() {
 					BeforeEach(func() {
-						fakeActor.GetApplicationTasksReturns(
+						fakeActor.GetApplicationTasksWithFilterReturns(
 							[]resources.Task{
 								{
 									GUID:       "task-2-guid",
@@ -201,7 +220,7 @@ var _ = Describe("tasks Command", func() {
 
 				When("there are no tasks associated with the application", func() {
 					BeforeEach(func() {
-						fakeActor.GetApplicationTasksReturns([]resources.Task{}, nil, nil)
+						fakeActor.GetApplicationTasksWithFilterReturns([]resources.Task{}, nil, nil)
 					})
 
 					It("outputs an empty table", func() {
@@ -244,7 +263,7 @@ var _ = Describe("tasks Command", func() {
 								resources.Application{GUID: "some-app-guid"},
 								nil,
 								nil)
-							fakeActor.GetApplicationTasksReturns(
+							fakeActor.GetApplicationTasksWithFilterReturns(
 								[]resources.Task{},
 								nil,
 								returnedErr)
@@ -285,7 +304,7 @@ var _ = Describe("tasks Command", func() {
 								resources.Application{GUID: "some-app-guid"},
 								v7action.Warnings{"get-application-warning-1", "get-application-warning-2"},
 								nil)
-							fakeActor.GetApplicationTasksReturns(
+							fakeActor.GetApplicationTasksWithFilterReturns(
 								nil,
 								v7action.Warnings{"get-tasks-warning-1", "get-tasks-warning-2"},
 								expectedErr)
@@ -0,0 +1,130 @@
+package v7
+
+import (
+	"fmt"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/api/logcache"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v7/shared"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+type UnbindServiceFromAppsCommand struct {
+	BaseCommand
+
+	RequiredArgs        flag.ServiceInstanceAndAppNames `positional-args:"yes"`
+	Labels              string                          `long:"labels" description:"Selector to unbind all apps in the space that match the labels"`
+	Restage             bool                            `long:"restage" description:"Restage the started apps once they are unbound"`
+	Strategy            flag.DeploymentStrategy         `long:"strategy" description:"Deployment strategy used to restage the apps, can be canary, rolling or null. Requires --restage"`
+	usage               interface{}                     `usage:"CF_NAME unbind-service-from-apps SERVICE_INSTANCE (APP_NAME... | --labels SELECTOR) [--restage [--strategy STRATEGY]]\n\n   The service instance is unbound from all apps at the same time, and the command waits until every unbinding is complete.\n\nEXAMPLES:\n   CF_NAME unbind-service-from-apps mydb app1 app2 app3\n   CF_NAME unbind-service-from-apps config-server --labels tier=backend --restage --strategy rolling"`
+	relatedCommands     interface{}                     `related_commands:"bind-service-to-apps, restage, services, unbind-service"`
+	envCFStagingTimeout interface{}                     `environmentName:"CF_STAGING_TIMEOUT" environmentDescription:"Max wait time for staging, in minutes" environmentDefault:"15"`
+	envCFStartupTimeout interface{}                     `environmentName:"CF_STARTUP_TIMEOUT" environmentDescription:"Max wait time for app instance startup, in minutes" environmentDefault:"5"`
+
+	Stager shared.AppStager
+}
+
+func (cmd *UnbindServiceFromAppsCommand) Setup(config command.Config, ui command.UI) error {
+	err := cmd.BaseCommand.Setup(config, ui)
+	if err != nil {
+		return err
+	}
+
+	logCacheClient, err := logcache.NewClient(config.LogCacheEndpoint(), config, ui, v7action.NewDefaultKubernetesConfigGetter())
+	if err != nil {
+		return err
+	}
+
+	cmd.Stager = shared.NewAppStager(cmd.Actor, cmd.UI, cmd.Config, logCacheClient)
+
+	return nil
+}
+
+func (cmd UnbindServiceFromAppsCommand) Execute(args []string) error {
+	err := validateServiceAppBindingsFlags(cmd.RequiredArgs.AppNames, cmd.Labels, cmd.Restage, cmd.Strategy)
+	if err != nil {
+		return err
+	}
+
+	if err := cmd.SharedActor.CheckTarget(true, true); err != nil {
+		return err
+	}
+
+	user, err := cmd.Actor.GetCurrentUser()
+	if err != nil {
+		return err
+	}
+
+	apps, err := getAppsByNamesOrLabels(cmd.BaseCommand, cmd.RequiredArgs.AppNames, cmd.Labels)
+	if err != nil {
+		return err
+	}
+	if len(apps) == 0 {
+		cmd.UI.DisplayText("No apps match the label selector {{.Labels}}.", map[string]interface{}{"Labels": cmd.Labels})
+		cmd.UI.DisplayOK()
+		return nil
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Unbinding {{.Count}} apps from service instance {{.ServiceInstance}} in org {{.Org}} / space {{.Space}} as {{.User}}...", map[string]interface{}{
+		"ServiceInstance": cmd.RequiredArgs.ServiceInstanceName,
+		"Count":           len(apps),
+		"Org":             cmd.Config.TargetedOrganization().Name,
+		"Space":           cmd.Config.TargetedSpace().Name,
+		"User":            user.Name,
+	})
+	cmd.UI.DisplayNewline()
+
+	jobs, warnings, err := cmd.Actor.DeleteServiceAppBindings(v7action.DeleteServiceAppBindingsParams{
+		SpaceGUID:           cmd.Config.TargetedSpace().GUID,
+		ServiceInstanceName: cmd.RequiredArgs.ServiceInstanceName,
+		Apps:                apps,
+	})
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	var (
+		unbound  []resources.Application
+		failures []string
+	)
+	table := [][]string{{cmd.UI.TranslateText("app"), cmd.UI.TranslateText("result"), cmd.UI.TranslateText("details")}}
+	for _, result := range waitForServiceAppBindingJobs(jobs) {
+		cmd.UI.DisplayWarnings(result.warnings)
+
+		switch result.err.(type) {
+		case nil:
+			unbound = append(unbound, result.app)
+			table = append(table, []string{result.app.Name, cmd.UI.TranslateText("unbound"), ""})
+		case actionerror.ServiceBindingNotFoundError:
+			table = append(table, []string{result.app.Name, cmd.UI.TranslateText("not bound"), ""})
+		default:
+			failures = append(failures, fmt.Sprintf("%s: %s", result.app.Name, result.err))
+			table = append(table, []string{result.app.Name, cmd.UI.TranslateText("failed"), result.err.Error()})
+		}
+	}
+
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayText("{{.Unbound}} unbound, {{.NotBound}} not bound, {{.Failed}} failed", map[string]interface{}{
+		"Unbound":  len(unbound),
+		"NotBound": len(jobs) - len(unbound) - len(failures),
+		"Failed":   len(failures),
+	})
+
+	if cmd.Restage {
+		failures = append(failures, restageAppsForServiceAppBindings(cmd.BaseCommand, cmd.Stager, unbound, cmd.Strategy.Name)...)
+	}
+
+	if len(failures) > 0 {
+		return translatableerror.MultiError{Messages: failures}
+	}
+
+	cmd.UI.DisplayOK()
+	return nil
+}
@@ -0,0 +1,248 @@
+package v7_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	v7 "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/shared"
+	"code.cloudfoundry.org/cli/command/v7/shared/sharedfakes"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("unbind-service-from-apps Command", func() {
+	var (
+		cmd             v7.UnbindServiceFromAppsCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeActor
+		fakeAppStager   *sharedfakes.FakeAppStager
+		executeErr      error
+		apps            []resources.Application
+	)
+
+	const (
+		serviceInstanceName = "some-db"
+		spaceGUID           = "some-space-guid"
+	)
+
+	jobStream := func(events ...v7action.PollJobEvent) chan v7action.PollJobEvent {
+		stream := make(chan v7action.PollJobEvent, len(events))
+		for _, event := range events {
+			stream <- event
+		}
+		close(stream)
+		return stream
+	}
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+		fakeAppStager = new(sharedfakes.FakeAppStager)
+
+		cmd = v7.UnbindServiceFromAppsCommand{
+			BaseCommand: v7.BaseCommand{
+				UI:          testUI,
+				Config:      fakeConfig,
+				SharedActor: fakeSharedActor,
+				Actor:       fakeActor,
+			},
+			Stager: fakeAppStager,
+		}
+		setPositionalFlags(&cmd, serviceInstanceName, []string{"app-1", "app-2", "app-3"})
+
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: spaceGUID})
+		fakeActor.GetCurrentUserReturns(configv3.User{Name: "steve"}, nil)
+
+		apps = []resources.Application{
+			{Name: "app-1", GUID: "app-1-guid", State: constant.ApplicationStarted},
+			{Name: "app-2", GUID: "app-2-guid", State: constant.ApplicationStarted},
+			{Name: "app-3", GUID: "app-3-guid", State: constant.ApplicationStopped},
+		}
+		fakeActor.GetApplicationsByNamesAndSpaceReturns(apps, v7action.Warnings{"get apps warning"}, nil)
+
+		fakeActor.DeleteServiceAppBindingsStub = func(params v7action.DeleteServiceAppBindingsParams) ([]v7action.ServiceAppBindingJob, v7action.Warnings, error) {
+			return []v7action.ServiceAppBindingJob{
+				{App: params.Apps[0], Stream: jobStream(v7action.PollJobEvent{State: v7action.JobComplete, Warnings: v7action.Warnings{"job warning"}})},
+				{App: params.Apps[1], Err: actionerror.ServiceBindingNotFoundError{}},
+				{App: params.Apps[2], Stream: jobStream()},
+			}, v7action.Warnings{"unbind warning"}, nil
+		}
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Describe("flag validation", func() {
+		When("neither app names nor labels are given", func() {
+			BeforeEach(func() {
+				setPositionalFlags(&cmd, serviceInstanceName, []string(nil))
+			})
+
+			It("returns an incorrect usage error", func() {
+				Expect(executeErr).To(MatchError(translatableerror.IncorrectUsageError{Message: "provide at least one APP_NAME or --labels"}))
+				Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+			})
+		})
+
+		When("both app names and labels are given", func() {
+			BeforeEach(func() {
+				setFlag(&cmd, "--labels", "tier=backend")
+			})
+
+			It("returns an argument combination error", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{Args: []string{"APP_NAME", "--labels"}}))
+			})
+		})
+
+		When("a strategy is given without --restage", func() {
+			BeforeEach(func() {
+				setFlag(&cmd, "--strategy", flag.DeploymentStrategy{Name: constant.DeploymentStrategyRolling})
+			})
+
+			It("returns a required flags error", func() {
+				Expect(executeErr).To(MatchError(translatableerror.RequiredFlagsError{Arg1: "--strategy", Arg2: "--restage"}))
+			})
+		})
+	})
+
+	It("checks the target", func() {
+		Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+		checkOrg, checkSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+		Expect(checkOrg).To(BeTrue())
+		Expect(checkSpace).To(BeTrue())
+	})
+
+	It("unbinds the service instance from all the apps at once", func() {
+		Expect(fakeActor.DeleteServiceAppBindingsCallCount()).To(Equal(1))
+		Expect(fakeActor.DeleteServiceAppBindingsArgsForCall(0)).To(Equal(v7action.DeleteServiceAppBindingsParams{
+			SpaceGUID:           spaceGUID,
+			ServiceInstanceName: serviceInstanceName,
+			Apps:                apps,
+		}))
+	})
+
+	It("displays the result for each app", func() {
+		Expect(executeErr).NotTo(HaveOccurred())
+
+		Expect(testUI.Out).To(Say(`Unbinding 3 apps from service instance some-db in org some-org / space some-space as steve\.\.\.`))
+		Expect(testUI.Out).To(Say(`app\s+result\s+details`))
+		Expect(testUI.Out).To(Say(`app-1\s+unbound`))
+		Expect(testUI.Out).To(Say(`app-2\s+not bound`))
+		Expect(testUI.Out).To(Say(`app-3\s+unbound`))
+		Expect(testUI.Out).To(Say(`2 unbound, 1 not bound, 0 failed`))
+		Expect(testUI.Out).To(Say(`OK`))
+
+		Expect(testUI.Err).To(Say("get apps warning"))
+		Expect(testUI.Err).To(Say("unbind warning"))
+		Expect(testUI.Err).To(Say("job warning"))
+	})
+
+	It("does not restage the apps", func() {
+		Expect(fakeAppStager.StageAndStartCallCount()).To(Equal(0))
+	})
+
+	When("selecting the apps by labels", func() {
+		BeforeEach(func() {
+			setPositionalFlags(&cmd, serviceInstanceName, []string(nil))
+			setFlag(&cmd, "--labels", "tier=backend")
+			fakeActor.GetApplicationsBySpaceAndLabelSelectorReturns(apps, v7action.Warnings{"labels warning"}, nil)
+		})
+
+		It("gets the apps matching the labels", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(fakeActor.GetApplicationsBySpaceAndLabelSelectorCallCount()).To(Equal(1))
+			actualSpaceGUID, actualSelector := fakeActor.GetApplicationsBySpaceAndLabelSelectorArgsForCall(0)
+			Expect(actualSpaceGUID).To(Equal(spaceGUID))
+			Expect(actualSelector).To(Equal("tier=backend"))
+			Expect(testUI.Err).To(Say("labels warning"))
+		})
+
+		When("no apps match", func() {
+			BeforeEach(func() {
+				fakeActor.GetApplicationsBySpaceAndLabelSelectorReturns(nil, nil, nil)
+			})
+
+			It("does not unbind anything", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(testUI.Out).To(Say(`No apps match the label selector tier=backend\.`))
+				Expect(fakeActor.DeleteServiceAppBindingsCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	When("the unbindings cannot be started", func() {
+		BeforeEach(func() {
+			fakeActor.DeleteServiceAppBindingsReturns(nil, v7action.Warnings{"unbind warning"}, actionerror.ServiceInstanceNotFoundError{Name: serviceInstanceName})
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError(actionerror.ServiceInstanceNotFoundError{Name: serviceInstanceName}))
+			Expect(testUI.Err).To(Say("unbind warning"))
+		})
+	})
+
+	When("some unbindings fail", func() {
+		BeforeEach(func() {
+			fakeActor.DeleteServiceAppBindingsStub = func(params v7action.DeleteServiceAppBindingsParams) ([]v7action.ServiceAppBindingJob, v7action.Warnings, error) {
+				return []v7action.ServiceAppBindingJob{
+					{App: params.Apps[0], Stream: jobStream(v7action.PollJobEvent{State: v7action.JobFailed, Err: errors.New("broker-error")})},
+					{App: params.Apps[1], Err: errors.New("request-error")},
+					{App: params.Apps[2]},
+				}, nil, nil
+			}
+		})
+
+		It("reports the failures once all jobs are done", func() {
+			Expect(executeErr).To(MatchError(translatableerror.MultiError{Messages: []string{
+				"app-1: broker-error",
+				"app-2: request-error",
+			}}))
+
+			Expect(testUI.Out).To(Say(`app-1\s+failed\s+broker-error`))
+			Expect(testUI.Out).To(Say(`app-2\s+failed\s+request-error`))
+			Expect(testUI.Out).To(Say(`app-3\s+unbound`))
+			Expect(testUI.Out).To(Say(`1 unbound, 0 not bound, 2 failed`))
+		})
+	})
+
+	When("restaging the apps", func() {
+		BeforeEach(func() {
+			setFlag(&cmd, "--restage", true)
+			setFlag(&cmd, "--strategy", flag.DeploymentStrategy{Name: constant.DeploymentStrategyCanary})
+			fakeActor.GetNewestReadyPackageForApplicationReturns(resources.Package{GUID: "package-guid"}, nil, nil)
+		})
+
+		It("restages the unbound started apps with the strategy", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+
+			Expect(fakeAppStager.StageAndStartCallCount()).To(Equal(1))
+			app, _, _, packageGUID, opts := fakeAppStager.StageAndStartArgsForCall(0)
+			Expect(app.Name).To(Equal("app-1"))
+			Expect(packageGUID).To(Equal("package-guid"))
+			Expect(opts).To(Equal(shared.AppStartOpts{
+				AppAction: constant.ApplicationRestarting,
+				Strategy:  constant.DeploymentStrategyCanary,
+			}))
+
+			Expect(testUI.Out).To(Say(`Restaging app app-1\.\.\.`))
+			Expect(testUI.Out).To(Say(`App app-3 is stopped; the change takes effect when it is started\.`))
+		})
+	})
+})
@@ -3,28 +3,43 @@ package v7
 import (
 	"code.cloudfoundry.org/cli/actor/v7action"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
 	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/ui"
 )
 
 type UnshareServiceCommand struct {
 	BaseCommand
 
 	RequiredArgs    flag.ShareServiceArgs `positional-args:"yes"`
-	SpaceName       string                `short:"s" required:"true" description:"Space to unshare the service instance from"`
+	SpaceName       string                `short:"s" description:"Space to unshare the service instance from"`
 	OrgName         flag.OptionalString   `short:"o" required:"false" description:"Org of the other space (Default: targeted org)"`
+	All             bool                  `long:"all" description:"Unshare the service instance from every space it is shared into"`
 	Force           bool                  `short:"f" description:"Force unshare without confirmation"`
-	relatedCommands interface{}           `related_commands:"delete-service, service, services, share-service, unbind-service"`
+	relatedCommands interface{}           `related_commands:"delete-service, service, services, share-service, shared-services, unbind-service"`
 }
 
 func (cmd UnshareServiceCommand) Usage() string {
-	return "CF_NAME unshare-service SERVICE_INSTANCE -s OTHER_SPACE [-o OTHER_ORG] [-f]"
+	return `CF_NAME unshare-service SERVICE_INSTANCE -s OTHER_SPACE [-o OTHER_ORG] [-f]
+CF_NAME unshare-service SERVICE_INSTANCE --all [-f]`
 }
 
 func (cmd UnshareServiceCommand) Execute(args []string) error {
+	switch {
+	case cmd.All && (cmd.SpaceName != "" || cmd.OrgName.IsSet):
+		return translatableerror.ArgumentCombinationError{Args: []string{"--all", "-s", "-o"}}
+	case !cmd.All && cmd.SpaceName == "":
+		return translatableerror.IncorrectUsageError{Message: "either -s or --all must be provided"}
+	}
+
 	if err := cmd.SharedActor.CheckTarget(true, true); err != nil {
 		return err
 	}
 
+	if cmd.All {
+		return cmd.unshareFromAllSpaces()
+	}
+
 	if !cmd.Force {
 		cmd.UI.DisplayWarning(
 			`WARNING: Unsharing this service instance will remove any existing bindings originating from the service instance in the space "{{.SpaceName}}". This could cause apps to stop working.`,
@@ -65,6 +80,63 @@ func (cmd UnshareServiceCommand) Execute(args []string) error {
 	return nil
 }
 
+func (cmd UnshareServiceCommand) unshareFromAllSpaces() error {
+	if !cmd.Force {
+		cmd.UI.DisplayWarning("WARNING: Unsharing this service instance from all spaces will remove any existing bindings originating from the service instance in those spaces. This could cause apps to stop working.")
+		cmd.UI.DisplayNewline()
+
+		unshare, err := cmd.UI.DisplayBoolPrompt(
+			false,
+			"Really unshare the service instance {{.ServiceInstanceName}} from all spaces?",
+			map[string]interface{}{"ServiceInstanceName": cmd.RequiredArgs.ServiceInstance},
+		)
+		if err != nil {
+			return err
+		}
+
+		if !unshare {
+			cmd.UI.DisplayText("Unshare cancelled")
+			return nil
+		}
+	}
+
+	user, err := cmd.Actor.GetCurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor(
+		"Unsharing service instance {{.ServiceInstanceName}} from all spaces as {{.Username}}...",
+		map[string]interface{}{
+			"ServiceInstanceName": cmd.RequiredArgs.ServiceInstance,
+			"Username":            user.Name,
+		},
+	)
+
+	unsharedSpaces, warnings, err := cmd.Actor.UnshareServiceInstanceFromAllSpaces(
+		cmd.RequiredArgs.ServiceInstance,
+		cmd.Config.TargetedSpace().GUID,
+	)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	if len(unsharedSpaces) == 0 {
+		cmd.UI.DisplayText("This service instance is not currently being shared.")
+	} else {
+		table := [][]string{{cmd.UI.TranslateText("org"), cmd.UI.TranslateText("space")}}
+		for _, space := range unsharedSpaces {
+			table = append(table, []string{space.OrganizationName, space.SpaceName})
+		}
+		cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+	}
+
+	cmd.UI.DisplayOK()
+
+	return nil
+}
+
 func (cmd UnshareServiceCommand) displayIntro() error {
 	user, err := cmd.Actor.GetCurrentUser()
 	if err != nil {
@@ -4,6 +4,8 @@ import (
 	"errors"
 
 	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/command/translatableerror"
 	"code.cloudfoundry.org/cli/types"
 	"code.cloudfoundry.org/cli/util/configv3"
 
@@ -124,6 +126,7 @@ var _ = Describe("unshare-service command", func() {
 				Actor:       fakeActor,
 			},
 		}
+		setFlag(&cmd, "-s", expectedSpaceName)
 	})
 
 	JustBeforeEach(func() {
@@ -206,6 +209,121 @@ var _ = Describe("unshare-service command", func() {
 		})
 	})
 
+	Context("unsharing from all spaces", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.ServiceInstance = expectedServiceInstanceName
+			cmd.SpaceName = ""
+			setFlag(&cmd, "--all")
+
+			fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: expectedTargetedSpaceGuid})
+			fakeActor.UnshareServiceInstanceFromAllSpacesReturns(
+				[]ccv3.SpaceWithOrganization{
+					{SpaceName: "dev", OrganizationName: "org-1"},
+					{SpaceName: "test", OrganizationName: "org-2"},
+				},
+				v7action.Warnings{"unshare warning"},
+				nil,
+			)
+		})
+
+		It("prompts the user", func() {
+			Expect(testUI.Err).To(Say(`WARNING: Unsharing this service instance from all spaces will remove any existing bindings originating from the service instance in those spaces\.`))
+			Expect(testUI.Out).To(Say(`Really unshare the service instance %s from all spaces\? \[yN\]:`, expectedServiceInstanceName))
+		})
+
+		When("the user says yes", func() {
+			BeforeEach(func() {
+				_, err := input.Write([]byte("y\n"))
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("unshares from every space and lists them", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+
+				Expect(fakeActor.UnshareServiceInstanceFromAllSpacesCallCount()).To(Equal(1))
+				instanceName, spaceGUID := fakeActor.UnshareServiceInstanceFromAllSpacesArgsForCall(0)
+				Expect(instanceName).To(Equal(expectedServiceInstanceName))
+				Expect(spaceGUID).To(Equal(expectedTargetedSpaceGuid))
+
+				Expect(testUI.Out).To(Say(`Unsharing service instance %s from all spaces as %s\.\.\.`, expectedServiceInstanceName, expectedUser))
+				Expect(testUI.Out).To(Say(`org\s+space`))
+				Expect(testUI.Out).To(Say(`org-1\s+dev`))
+				Expect(testUI.Out).To(Say(`org-2\s+test`))
+				Expect(testUI.Out).To(Say(`OK`))
+				Expect(testUI.Err).To(Say("unshare warning"))
+			})
+		})
+
+		When("the user says no", func() {
+			BeforeEach(func() {
+				_, err := input.Write([]byte("n\n"))
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("does not unshare", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(fakeActor.UnshareServiceInstanceFromAllSpacesCallCount()).To(Equal(0))
+				Expect(testUI.Out).To(Say("Unshare cancelled"))
+			})
+		})
+
+		When("the -f flag is specified", func() {
+			BeforeEach(func() {
+				setFlag(&cmd, "-f")
+			})
+
+			It("does not prompt the user", func() {
+				Expect(testUI.Out).NotTo(Say("Really unshare"))
+				Expect(fakeActor.UnshareServiceInstanceFromAllSpacesCallCount()).To(Equal(1))
+			})
+
+			When("the instance is not shared", func() {
+				BeforeEach(func() {
+					fakeActor.UnshareServiceInstanceFromAllSpacesReturns(nil, nil, nil)
+				})
+
+				It("says so", func() {
+					Expect(executeErr).NotTo(HaveOccurred())
+					Expect(testUI.Out).To(Say(`This service instance is not currently being shared\.`))
+					Expect(testUI.Out).To(Say(`OK`))
+				})
+			})
+
+			When("unsharing fails", func() {
+				BeforeEach(func() {
+					fakeActor.UnshareServiceInstanceFromAllSpacesReturns(nil, v7action.Warnings{"unshare warning"}, errors.New("boom"))
+				})
+
+				It("returns the error and displays warnings", func() {
+					Expect(executeErr).To(MatchError("boom"))
+					Expect(testUI.Err).To(Say("unshare warning"))
+				})
+			})
+		})
+
+		When("-s is also given", func() {
+			BeforeEach(func() {
+				setFlag(&cmd, "-s", "dev")
+			})
+
+			It("returns an argument combination error", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{Args: []string{"--all", "-s", "-o"}}))
+				Expect(fakeActor.UnshareServiceInstanceFromAllSpacesCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	When("neither -s nor --all is given", func() {
+		BeforeEach(func() {
+			cmd.SpaceName = ""
+		})
+
+		It("returns an incorrect usage error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.IncorrectUsageError{Message: "either -s or --all must be provided"}))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+		})
+	})
+
 	Context("pre-unshare errors", func() {
 		When("checking the target returns an error", func() {
 			BeforeEach(func() {
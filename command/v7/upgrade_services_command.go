@@ -0,0 +1,305 @@
+package v7
+
+import (
+	"fmt"
+
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/util/concurrency"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+type UpgradeServicesCommand struct {
+	BaseCommand
+
+	Space           bool        `long:"space" description:"Upgrade service instances in the targeted space"`
+	Org             bool        `long:"org" description:"Upgrade service instances in the targeted org"`
+	Offering        string      `long:"offering" description:"Only upgrade instances of this service offering"`
+	Plan            string      `long:"plan" description:"Only upgrade instances of this service plan; requires --offering"`
+	DryRun          bool        `long:"dry-run" description:"List the service instances that would be upgraded without upgrading them"`
+	Parallel        int         `long:"parallel" default:"4" description:"Maximum number of service instances to upgrade at the same time"`
+	Force           bool        `short:"f" long:"force" description:"Force upgrade without asking for confirmation"`
+	usage           interface{} `usage:"CF_NAME upgrade-services (--space | --org | --offering OFFERING) [--offering OFFERING] [--plan PLAN] [--parallel N] [--dry-run] [-f]\n\n   Without --space or --org, service instances are upgraded in every space you can access.\n\nEXAMPLES:\n   CF_NAME upgrade-services --space --dry-run\n   CF_NAME upgrade-services --org --offering postgres --plan small\n   CF_NAME upgrade-services --offering redis --parallel 10 -f"`
+	relatedCommands interface{} `related_commands:"marketplace, service, services, upgrade-service"`
+}
+
+type serviceInstanceUpgradeResult struct {
+	candidate v7action.ServiceInstanceUpgradeCandidate
+	warnings  v7action.Warnings
+	err       error
+	skipped   bool
+}
+
+func (cmd UpgradeServicesCommand) Execute(args []string) error {
+	err := cmd.validateFlags()
+	if err != nil {
+		return err
+	}
+
+	err = cmd.SharedActor.CheckTarget(cmd.Space || cmd.Org, cmd.Space)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Actor.GetCurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.displayGettingCandidates(user)
+
+	filter := v7action.ServiceInstanceUpgradeFilter{
+		ServiceOfferingName: cmd.Offering,
+		ServicePlanName:     cmd.Plan,
+	}
+	switch {
+	case cmd.Space:
+		filter.SpaceGUID = cmd.Config.TargetedSpace().GUID
+	case cmd.Org:
+		filter.OrganizationGUID = cmd.Config.TargetedOrganization().GUID
+	}
+
+	candidates, warnings, err := cmd.Actor.GetServiceInstanceUpgradeCandidates(filter)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	if len(candidates) == 0 {
+		cmd.UI.DisplayText("No service instances with available upgrades found.")
+		cmd.UI.DisplayOK()
+		return nil
+	}
+
+	cmd.displayCandidates(candidates)
+	cmd.UI.DisplayNewline()
+
+	var upgradeable []v7action.ServiceInstanceUpgradeCandidate
+	for _, candidate := range candidates {
+		if !candidate.OperationInProgress() {
+			upgradeable = append(upgradeable, candidate)
+		}
+	}
+	skipped := len(candidates) - len(upgradeable)
+
+	if cmd.DryRun {
+		cmd.UI.DisplayText("Dry run: {{.Count}} service instances would be upgraded, {{.Skipped}} skipped because an operation is in progress.", map[string]interface{}{
+			"Count":   len(upgradeable),
+			"Skipped": skipped,
+		})
+		cmd.UI.DisplayOK()
+		return nil
+	}
+
+	if len(upgradeable) == 0 {
+		cmd.UI.DisplayText("All service instances with available upgrades have an operation in progress. Try again once it completes.")
+		cmd.UI.DisplayOK()
+		return nil
+	}
+
+	if !cmd.Force {
+		cmd.UI.DisplayText(
+			"Warning: This operation may be long running and will block further operations " +
+				"on the service instances until it's completed",
+		)
+
+		upgrade, err := cmd.UI.DisplayBoolPrompt(false, "Do you really want to upgrade {{.Count}} service instances?", map[string]interface{}{
+			"Count": len(upgradeable),
+		})
+		if err != nil {
+			return err
+		}
+		if !upgrade {
+			cmd.UI.DisplayText("Upgrade cancelled")
+			return nil
+		}
+	}
+
+	cmd.UI.DisplayText("Upgrading {{.Count}} service instances, up to {{.Parallel}} at a time...", map[string]interface{}{
+		"Count":    len(upgradeable),
+		"Parallel": cmd.Parallel,
+	})
+	cmd.UI.DisplayNewline()
+
+	results := cmd.upgradeServiceInstances(upgradeable)
+	for _, candidate := range candidates {
+		if candidate.OperationInProgress() {
+			results = append(results, serviceInstanceUpgradeResult{candidate: candidate, skipped: true})
+		}
+	}
+
+	cmd.UI.DisplayNewline()
+	return cmd.displayUpgradeReport(results)
+}
+
+func (cmd UpgradeServicesCommand) validateFlags() error {
+	switch {
+	case cmd.Space && cmd.Org:
+		return translatableerror.ArgumentCombinationError{Args: []string{"--space", "--org"}}
+	case !cmd.Space && !cmd.Org && cmd.Offering == "":
+		return translatableerror.IncorrectUsageError{Message: "at least one of --space, --org or --offering must be provided"}
+	case cmd.Plan != "" && cmd.Offering == "":
+		return translatableerror.RequiredFlagsError{Arg1: "--plan", Arg2: "--offering"}
+	case cmd.Parallel < 1:
+		return translatableerror.IncorrectUsageError{Message: "--parallel must be greater than or equal to 1"}
+	}
+
+	return nil
+}
+
+func (cmd UpgradeServicesCommand) displayGettingCandidates(user configv3.User) {
+	switch {
+	case cmd.Space:
+		cmd.UI.DisplayTextWithFlavor("Getting service instances with available upgrades in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+			"OrgName":   cmd.Config.TargetedOrganization().Name,
+			"SpaceName": cmd.Config.TargetedSpace().Name,
+			"Username":  user.Name,
+		})
+	case cmd.Org:
+		cmd.UI.DisplayTextWithFlavor("Getting service instances with available upgrades in org {{.OrgName}} as {{.Username}}...", map[string]interface{}{
+			"OrgName":  cmd.Config.TargetedOrganization().Name,
+			"Username": user.Name,
+		})
+	default:
+		cmd.UI.DisplayTextWithFlavor("Getting service instances with available upgrades in all orgs as {{.Username}}...", map[string]interface{}{
+			"Username": user.Name,
+		})
+	}
+	cmd.UI.DisplayNewline()
+}
+
+func (cmd UpgradeServicesCommand) displayCandidates(candidates []v7action.ServiceInstanceUpgradeCandidate) {
+	header := []string{cmd.UI.TranslateText("name")}
+	if !cmd.Space {
+		header = append(header, cmd.UI.TranslateText("org"), cmd.UI.TranslateText("space"))
+	}
+	header = append(header,
+		cmd.UI.TranslateText("offering"),
+		cmd.UI.TranslateText("plan"),
+		cmd.UI.TranslateText("current version"),
+		cmd.UI.TranslateText("available version"),
+		cmd.UI.TranslateText("upgrade description"),
+	)
+
+	table := [][]string{header}
+	for _, candidate := range candidates {
+		row := []string{candidate.Name}
+		if !cmd.Space {
+			row = append(row, candidate.OrganizationName, candidate.SpaceName)
+		}
+		row = append(row,
+			candidate.ServiceOfferingName,
+			candidate.ServicePlanName,
+			candidate.CurrentVersion,
+			candidate.AvailableVersion,
+			candidate.Description,
+		)
+		table = append(table, row)
+	}
+
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+}
+
+// upgradeServiceInstances upgrades the instances with at most cmd.Parallel
+// upgrades in flight, displaying each result as it completes. The results are
+// returned in the order of the candidates.
+func (cmd UpgradeServicesCommand) upgradeServiceInstances(candidates []v7action.ServiceInstanceUpgradeCandidate) []serviceInstanceUpgradeResult {
+	results := make([]serviceInstanceUpgradeResult, len(candidates))
+
+	concurrency.RunBounded(cmd.Parallel, len(candidates),
+		func(i int) serviceInstanceUpgradeResult {
+			return cmd.upgradeServiceInstance(candidates[i])
+		},
+		func(i int, result serviceInstanceUpgradeResult) {
+			results[i] = result
+			cmd.UI.DisplayWarnings(result.warnings)
+			if result.err != nil {
+				cmd.UI.DisplayWarning("Upgrade of service instance {{.ServiceInstanceName}} failed: {{.Error}}", map[string]interface{}{
+					"ServiceInstanceName": result.candidate.Name,
+					"Error":               result.err.Error(),
+				})
+				return
+			}
+			cmd.UI.DisplayText("Upgrade of service instance {{.ServiceInstanceName}} complete.", map[string]interface{}{
+				"ServiceInstanceName": result.candidate.Name,
+			})
+		},
+	)
+
+	return results
+}
+
+// upgradeServiceInstance starts the upgrade of a service instance and waits
+// for its last operation to finish.
+func (cmd UpgradeServicesCommand) upgradeServiceInstance(candidate v7action.ServiceInstanceUpgradeCandidate) serviceInstanceUpgradeResult {
+	result := serviceInstanceUpgradeResult{candidate: candidate}
+
+	stream, warnings, err := cmd.Actor.UpgradeManagedServiceInstance(candidate.Name, candidate.SpaceGUID)
+	result.warnings = append(result.warnings, warnings...)
+	if err != nil || stream == nil {
+		result.err = err
+		return result
+	}
+
+	for event := range stream {
+		result.warnings = append(result.warnings, event.Warnings...)
+		if event.Err != nil {
+			result.err = event.Err
+		}
+	}
+
+	return result
+}
+
+func (cmd UpgradeServicesCommand) displayUpgradeReport(results []serviceInstanceUpgradeResult) error {
+	header := []string{cmd.UI.TranslateText("name")}
+	if !cmd.Space {
+		header = append(header, cmd.UI.TranslateText("org"), cmd.UI.TranslateText("space"))
+	}
+	header = append(header, cmd.UI.TranslateText("result"), cmd.UI.TranslateText("details"))
+
+	var (
+		upgraded, skipped int
+		failures          []string
+	)
+	table := [][]string{header}
+	for _, result := range results {
+		row := []string{result.candidate.Name}
+		if !cmd.Space {
+			row = append(row, result.candidate.OrganizationName, result.candidate.SpaceName)
+		}
+
+		switch {
+		case result.skipped:
+			skipped++
+			row = append(row, cmd.UI.TranslateText("skipped"), cmd.UI.TranslateText("{{.Operation}} in progress", map[string]interface{}{
+				"Operation": result.candidate.LastOperation.Type,
+			}))
+		case result.err != nil:
+			failures = append(failures, fmt.Sprintf("%s: %s", result.candidate.Name, result.err))
+			row = append(row, cmd.UI.TranslateText("failed"), result.err.Error())
+		default:
+			upgraded++
+			row = append(row, cmd.UI.TranslateText("upgraded"), cmd.UI.TranslateText("version {{.Version}}", map[string]interface{}{
+				"Version": result.candidate.AvailableVersion,
+			}))
+		}
+		table = append(table, row)
+	}
+
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayText("{{.Upgraded}} upgraded, {{.Failed}} failed, {{.Skipped}} skipped", map[string]interface{}{
+		"Upgraded": upgraded,
+		"Failed":   len(failures),
+		"Skipped":  skipped,
+	})
+
+	if len(failures) > 0 {
+		return translatableerror.MultiError{Messages: failures}
+	}
+
+	cmd.UI.DisplayOK()
+	return nil
+}
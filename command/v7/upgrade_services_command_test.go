@@ -0,0 +1,346 @@
+package v7_test
+
+import (
+	"errors"
+	"sync"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	v7 "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("upgrade-services Command", func() {
+	var (
+		input           *Buffer
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeActor
+		cmd             v7.UpgradeServicesCommand
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		input = NewBuffer()
+		testUI = ui.NewTestUI(input, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+
+		cmd = v7.UpgradeServicesCommand{
+			BaseCommand: v7.BaseCommand{
+				UI:          testUI,
+				Config:      fakeConfig,
+				SharedActor: fakeSharedActor,
+				Actor:       fakeActor,
+			},
+			Space:    true,
+			Parallel: 2,
+			Force:    true,
+		}
+
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org", GUID: "some-org-guid"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+		fakeActor.GetCurrentUserReturns(configv3.User{Name: "steve"}, nil)
+
+		fakeActor.GetServiceInstanceUpgradeCandidatesReturns(
+			[]v7action.ServiceInstanceUpgradeCandidate{
+				{Name: "db-1", SpaceGUID: "some-space-guid", SpaceName: "some-space", OrganizationName: "some-org", ServiceOfferingName: "postgres", ServicePlanName: "small", CurrentVersion: "1.0.0", AvailableVersion: "1.1.0", Description: "Postgres 14.2"},
+				{Name: "db-2", SpaceGUID: "some-space-guid", SpaceName: "some-space", OrganizationName: "some-org", ServiceOfferingName: "postgres", ServicePlanName: "large", CurrentVersion: "1.0.0", AvailableVersion: "1.1.0", Description: "Postgres 14.2"},
+				{Name: "db-busy", SpaceGUID: "some-space-guid", SpaceName: "some-space", OrganizationName: "some-org", ServiceOfferingName: "postgres", ServicePlanName: "small", CurrentVersion: "1.0.0", AvailableVersion: "1.1.0", LastOperation: resources.LastOperation{Type: resources.UpdateOperation, State: resources.OperationInProgress}},
+			},
+			v7action.Warnings{"candidates-warning"},
+			nil,
+		)
+		fakeActor.UpgradeManagedServiceInstanceReturns(nil, v7action.Warnings{"upgrade-warning"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Describe("flag validation", func() {
+		When("--space and --org are given", func() {
+			BeforeEach(func() {
+				cmd.Org = true
+			})
+
+			It("returns an argument combination error", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{Args: []string{"--space", "--org"}}))
+			})
+		})
+
+		When("no scope is given", func() {
+			BeforeEach(func() {
+				cmd.Space = false
+			})
+
+			It("returns an incorrect usage error", func() {
+				Expect(executeErr).To(MatchError(translatableerror.IncorrectUsageError{Message: "at least one of --space, --org or --offering must be provided"}))
+			})
+		})
+
+		When("--plan is given without --offering", func() {
+			BeforeEach(func() {
+				cmd.Plan = "small"
+			})
+
+			It("returns a required flags error", func() {
+				Expect(executeErr).To(MatchError(translatableerror.RequiredFlagsError{Arg1: "--plan", Arg2: "--offering"}))
+			})
+		})
+
+		When("--parallel is less than 1", func() {
+			BeforeEach(func() {
+				cmd.Parallel = 0
+			})
+
+			It("returns an incorrect usage error", func() {
+				Expect(executeErr).To(MatchError(translatableerror.IncorrectUsageError{Message: "--parallel must be greater than or equal to 1"}))
+			})
+		})
+	})
+
+	When("checking the target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NoSpaceTargetedError{BinaryName: "cf"})
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NoSpaceTargetedError{BinaryName: "cf"}))
+			checkOrg, checkSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkOrg).To(BeTrue())
+			Expect(checkSpace).To(BeTrue())
+		})
+	})
+
+	It("searches the targeted space", func() {
+		Expect(executeErr).NotTo(HaveOccurred())
+		Expect(fakeActor.GetServiceInstanceUpgradeCandidatesArgsForCall(0)).To(Equal(v7action.ServiceInstanceUpgradeFilter{
+			SpaceGUID: "some-space-guid",
+		}))
+		Expect(testUI.Out).To(Say(`Getting service instances with available upgrades in org some-org / space some-space as steve\.\.\.`))
+		Expect(testUI.Err).To(Say("candidates-warning"))
+	})
+
+	It("displays the available upgrades", func() {
+		Expect(testUI.Out).To(Say(`name\s+offering\s+plan\s+current version\s+available version\s+upgrade description`))
+		Expect(testUI.Out).To(Say(`db-1\s+postgres\s+small\s+1\.0\.0\s+1\.1\.0\s+Postgres 14\.2`))
+		Expect(testUI.Out).To(Say(`db-2\s+postgres\s+large\s+1\.0\.0\s+1\.1\.0\s+Postgres 14\.2`))
+		Expect(testUI.Out).To(Say(`db-busy\s+postgres\s+small`))
+	})
+
+	It("upgrades the instances without an operation in progress", func() {
+		Expect(fakeActor.UpgradeManagedServiceInstanceCallCount()).To(Equal(2))
+
+		var upgraded []string
+		for i := 0; i < fakeActor.UpgradeManagedServiceInstanceCallCount(); i++ {
+			name, spaceGUID := fakeActor.UpgradeManagedServiceInstanceArgsForCall(i)
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+			upgraded = append(upgraded, name)
+		}
+		Expect(upgraded).To(ConsistOf("db-1", "db-2"))
+	})
+
+	It("displays progress and a final report", func() {
+		Expect(executeErr).NotTo(HaveOccurred())
+
+		Expect(testUI.Out).To(Say(`Upgrading 2 service instances, up to 2 at a time\.\.\.`))
+		Expect(testUI.Out).To(Say(`Upgrade of service instance db-\d complete\.`))
+		Expect(testUI.Out).To(Say(`Upgrade of service instance db-\d complete\.`))
+		Expect(testUI.Out).To(Say(`name\s+result\s+details`))
+		Expect(testUI.Out).To(Say(`db-1\s+upgraded\s+version 1\.1\.0`))
+		Expect(testUI.Out).To(Say(`db-2\s+upgraded\s+version 1\.1\.0`))
+		Expect(testUI.Out).To(Say(`db-busy\s+skipped\s+update in progress`))
+		Expect(testUI.Out).To(Say(`2 upgraded, 0 failed, 1 skipped`))
+		Expect(testUI.Out).To(Say("OK"))
+
+		Expect(testUI.Err).To(Say("upgrade-warning"))
+	})
+
+	When("the upgrade jobs report progress", func() {
+		BeforeEach(func() {
+			fakeActor.UpgradeManagedServiceInstanceStub = func(name, _ string) (chan v7action.PollJobEvent, v7action.Warnings, error) {
+				stream := make(chan v7action.PollJobEvent, 2)
+				stream <- v7action.PollJobEvent{State: v7action.JobProcessing, Warnings: v7action.Warnings{name + "-polling-warning"}}
+				if name == "db-2" {
+					stream <- v7action.PollJobEvent{State: v7action.JobFailed, Err: errors.New("broker rejected upgrade")}
+				} else {
+					stream <- v7action.PollJobEvent{State: v7action.JobComplete}
+				}
+				close(stream)
+				return stream, nil, nil
+			}
+		})
+
+		It("waits for each job and reports failures", func() {
+			Expect(executeErr).To(MatchError(translatableerror.MultiError{Messages: []string{"db-2: broker rejected upgrade"}}))
+
+			Expect(testUI.Out).To(Say(`db-1\s+upgraded`))
+			Expect(testUI.Out).To(Say(`db-2\s+failed\s+broker rejected upgrade`))
+			Expect(testUI.Out).To(Say(`1 upgraded, 1 failed, 1 skipped`))
+
+			Expect(testUI.Err).To(Say(`Upgrade of service instance db-2 failed: broker rejected upgrade`))
+			Expect(string(testUI.Err.(*Buffer).Contents())).To(SatisfyAll(
+				ContainSubstring("db-1-polling-warning"),
+				ContainSubstring("db-2-polling-warning"),
+			))
+		})
+	})
+
+	When("--parallel is 1", func() {
+		var (
+			mutex     sync.Mutex
+			inFlight  int
+			maxFlight int
+		)
+
+		BeforeEach(func() {
+			cmd.Parallel = 1
+			inFlight, maxFlight = 0, 0
+			fakeActor.UpgradeManagedServiceInstanceStub = func(string, string) (chan v7action.PollJobEvent, v7action.Warnings, error) {
+				mutex.Lock()
+				inFlight++
+				if inFlight > maxFlight {
+					maxFlight = inFlight
+				}
+				mutex.Unlock()
+
+				stream := make(chan v7action.PollJobEvent)
+				go func() {
+					stream <- v7action.PollJobEvent{State: v7action.JobComplete}
+					mutex.Lock()
+					inFlight--
+					mutex.Unlock()
+					close(stream)
+				}()
+				return stream, nil, nil
+			}
+		})
+
+		It("upgrades one instance at a time", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(fakeActor.UpgradeManagedServiceInstanceCallCount()).To(Equal(2))
+			Expect(maxFlight).To(Equal(1))
+		})
+	})
+
+	When("searching an org by offering and plan", func() {
+		BeforeEach(func() {
+			cmd.Space = false
+			cmd.Org = true
+			cmd.Offering = "postgres"
+			cmd.Plan = "small"
+		})
+
+		It("searches the targeted org and shows the space of each instance", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+
+			checkOrg, checkSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkOrg).To(BeTrue())
+			Expect(checkSpace).To(BeFalse())
+
+			Expect(fakeActor.GetServiceInstanceUpgradeCandidatesArgsForCall(0)).To(Equal(v7action.ServiceInstanceUpgradeFilter{
+				OrganizationGUID:    "some-org-guid",
+				ServiceOfferingName: "postgres",
+				ServicePlanName:     "small",
+			}))
+
+			Expect(testUI.Out).To(Say(`Getting service instances with available upgrades in org some-org as steve\.\.\.`))
+			Expect(testUI.Out).To(Say(`name\s+org\s+space\s+offering`))
+			Expect(testUI.Out).To(Say(`db-1\s+some-org\s+some-space\s+postgres`))
+		})
+	})
+
+	When("searching all orgs by offering", func() {
+		BeforeEach(func() {
+			cmd.Space = false
+			cmd.Offering = "postgres"
+		})
+
+		It("does not require a target", func() {
+			checkOrg, checkSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkOrg).To(BeFalse())
+			Expect(checkSpace).To(BeFalse())
+			Expect(testUI.Out).To(Say(`Getting service instances with available upgrades in all orgs as steve\.\.\.`))
+		})
+	})
+
+	When("--dry-run is given", func() {
+		BeforeEach(func() {
+			cmd.DryRun = true
+		})
+
+		It("lists the upgrades without upgrading", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(fakeActor.UpgradeManagedServiceInstanceCallCount()).To(Equal(0))
+			Expect(testUI.Out).To(Say(`db-1`))
+			Expect(testUI.Out).To(Say(`Dry run: 2 service instances would be upgraded, 1 skipped because an operation is in progress\.`))
+			Expect(testUI.Out).To(Say("OK"))
+		})
+	})
+
+	When("no upgrades are available", func() {
+		BeforeEach(func() {
+			fakeActor.GetServiceInstanceUpgradeCandidatesReturns(nil, nil, nil)
+		})
+
+		It("says so", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(testUI.Out).To(Say(`No service instances with available upgrades found\.`))
+			Expect(testUI.Out).To(Say("OK"))
+		})
+	})
+
+	When("getting the candidates fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetServiceInstanceUpgradeCandidatesReturns(nil, v7action.Warnings{"candidates-warning"}, errors.New("boom"))
+		})
+
+		It("returns the error and warnings", func() {
+			Expect(executeErr).To(MatchError("boom"))
+			Expect(testUI.Err).To(Say("candidates-warning"))
+		})
+	})
+
+	Describe("confirmation", func() {
+		BeforeEach(func() {
+			cmd.Force = false
+		})
+
+		When("the user confirms", func() {
+			BeforeEach(func() {
+				_, err := input.Write([]byte("y\n"))
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("upgrades the instances", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(testUI.Out).To(Say(`Do you really want to upgrade 2 service instances\?`))
+				Expect(fakeActor.UpgradeManagedServiceInstanceCallCount()).To(Equal(2))
+			})
+		})
+
+		When("the user declines", func() {
+			BeforeEach(func() {
+				_, err := input.Write([]byte("n\n"))
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("cancels the upgrade", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(testUI.Out).To(Say("Upgrade cancelled"))
+				Expect(fakeActor.UpgradeManagedServiceInstanceCallCount()).To(Equal(0))
+			})
+		})
+	})
+})
@@ -0,0 +1,93 @@
+package v7
+
+import (
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+type UserRolesCommand struct {
+	BaseCommand
+
+	RequiredArgs    flag.Username `positional-args:"yes"`
+	Origin          string        `long:"origin" description:"Origin for mapping a user account to a user in an external identity provider"`
+	RemoveAll       bool          `long:"remove-all" description:"Remove all org and space roles of the user, including their org memberships"`
+	Force           bool          `short:"f" description:"Remove the roles without confirmation"`
+	usage           interface{}   `usage:"CF_NAME user-roles USERNAME [--origin ORIGIN] [--remove-all [-f]]\n\n   Lists the roles of a user in all orgs and spaces. Looking up the user requires admin access to UAA.\n\nEXAMPLES:\n   CF_NAME user-roles jsmith\n   CF_NAME user-roles jsmith --origin ldap --remove-all"`
+	relatedCommands interface{}   `related_commands:"delete-user, org-users, space-users, unset-org-role, unset-space-role"`
+}
+
+func (cmd UserRolesCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(false, false)
+	if err != nil {
+		return err
+	}
+
+	currentUser, err := cmd.Actor.GetCurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Getting roles of user {{.TargetUser}} as {{.CurrentUser}}...", map[string]interface{}{
+		"TargetUser":  cmd.RequiredArgs.Username,
+		"CurrentUser": currentUser.Name,
+	})
+	cmd.UI.DisplayNewline()
+
+	_, roles, warnings, err := cmd.Actor.GetUserRoles(cmd.RequiredArgs.Username, cmd.Origin)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	if len(roles) == 0 {
+		cmd.UI.DisplayText("User {{.TargetUser}} has no org or space roles.", map[string]interface{}{
+			"TargetUser": cmd.RequiredArgs.Username,
+		})
+		return nil
+	}
+
+	table := [][]string{{
+		cmd.UI.TranslateText("org"),
+		cmd.UI.TranslateText("space"),
+		cmd.UI.TranslateText("role"),
+	}}
+	for _, role := range roles {
+		table = append(table, []string{role.OrgName, role.SpaceName, roleTypeName(role.Type)})
+	}
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+
+	if !cmd.RemoveAll {
+		return nil
+	}
+	cmd.UI.DisplayNewline()
+
+	if !cmd.Force {
+		removeRoles, err := cmd.UI.DisplayBoolPrompt(false, "Really remove all {{.Count}} roles of user {{.TargetUser}}?", map[string]interface{}{
+			"Count":      len(roles),
+			"TargetUser": cmd.RequiredArgs.Username,
+		})
+		if err != nil {
+			return err
+		}
+		if !removeRoles {
+			cmd.UI.DisplayText("Roles of user {{.TargetUser}} have not been removed.", map[string]interface{}{
+				"TargetUser": cmd.RequiredArgs.Username,
+			})
+			return nil
+		}
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Removing roles of user {{.TargetUser}} as {{.CurrentUser}}...", map[string]interface{}{
+		"TargetUser":  cmd.RequiredArgs.Username,
+		"CurrentUser": currentUser.Name,
+	})
+
+	warnings, err = cmd.Actor.DeleteUserRoles(roles)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+	return nil
+}
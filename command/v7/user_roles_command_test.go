@@ -0,0 +1,191 @@
+package v7_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	v7 "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("user-roles Command", func() {
+	var (
+		cmd             v7.UserRolesCommand
+		testUI          *ui.UI
+		input           *Buffer
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeActor
+		executeErr      error
+
+		roles []v7action.UserRole
+	)
+
+	BeforeEach(func() {
+		input = NewBuffer()
+		testUI = ui.NewTestUI(input, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+
+		cmd = v7.UserRolesCommand{
+			BaseCommand: v7.BaseCommand{
+				UI:          testUI,
+				Config:      fakeConfig,
+				SharedActor: fakeSharedActor,
+				Actor:       fakeActor,
+			},
+			RequiredArgs: flag.Username{Username: "alice"},
+		}
+
+		fakeActor.GetCurrentUserReturns(configv3.User{Name: "admin"}, nil)
+
+		roles = []v7action.UserRole{
+			{GUID: "role-1", Type: constant.OrgManagerRole, OrgName: "org-a"},
+			{GUID: "role-2", Type: constant.OrgUserRole, OrgName: "org-a"},
+			{GUID: "role-3", Type: constant.SpaceDeveloperRole, OrgName: "org-a", SpaceName: "dev"},
+		}
+		fakeActor.GetUserRolesReturns(resources.User{GUID: "alice-guid", Username: "alice"}, roles, v7action.Warnings{"roles warning"}, nil)
+		fakeActor.DeleteUserRolesReturns(v7action.Warnings{"delete warning"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	It("checks the user is logged in", func() {
+		checkOrg, checkSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+		Expect(checkOrg).To(BeFalse())
+		Expect(checkSpace).To(BeFalse())
+	})
+
+	It("lists the roles of the user in all orgs and spaces", func() {
+		Expect(executeErr).NotTo(HaveOccurred())
+
+		username, origin := fakeActor.GetUserRolesArgsForCall(0)
+		Expect(username).To(Equal("alice"))
+		Expect(origin).To(BeEmpty())
+
+		Expect(testUI.Out).To(Say(`Getting roles of user alice as admin\.\.\.`))
+		Expect(testUI.Out).To(Say(`org\s+space\s+role`))
+		Expect(testUI.Out).To(Say(`org-a\s+OrgManager`))
+		Expect(testUI.Out).To(Say(`org-a\s+OrgUser`))
+		Expect(testUI.Out).To(Say(`org-a\s+dev\s+SpaceDeveloper`))
+		Expect(testUI.Err).To(Say("roles warning"))
+		Expect(fakeActor.DeleteUserRolesCallCount()).To(Equal(0))
+	})
+
+	When("an origin is given", func() {
+		BeforeEach(func() {
+			setFlag(&cmd, "--origin", "ldap")
+		})
+
+		It("looks up the user in that origin", func() {
+			_, origin := fakeActor.GetUserRolesArgsForCall(0)
+			Expect(origin).To(Equal("ldap"))
+		})
+	})
+
+	When("the user has no roles", func() {
+		BeforeEach(func() {
+			fakeActor.GetUserRolesReturns(resources.User{GUID: "alice-guid"}, nil, nil, nil)
+			setFlag(&cmd, "--remove-all")
+		})
+
+		It("says so", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(testUI.Out).To(Say("User alice has no org or space roles."))
+			Expect(fakeActor.DeleteUserRolesCallCount()).To(Equal(0))
+		})
+	})
+
+	When("--remove-all is given", func() {
+		BeforeEach(func() {
+			setFlag(&cmd, "--remove-all")
+		})
+
+		When("the user confirms", func() {
+			BeforeEach(func() {
+				_, err := input.Write([]byte("y\n"))
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("removes all roles of the user", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(testUI.Out).To(Say(`Really remove all 3 roles of user alice\?`))
+				Expect(testUI.Out).To(Say(`Removing roles of user alice as admin\.\.\.`))
+				Expect(testUI.Out).To(Say("OK"))
+				Expect(testUI.Err).To(Say("delete warning"))
+				Expect(fakeActor.DeleteUserRolesArgsForCall(0)).To(Equal(roles))
+			})
+		})
+
+		When("the user declines", func() {
+			BeforeEach(func() {
+				_, err := input.Write([]byte("n\n"))
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("does not remove any roles", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(testUI.Out).To(Say("Roles of user alice have not been removed."))
+				Expect(fakeActor.DeleteUserRolesCallCount()).To(Equal(0))
+			})
+		})
+
+		When("-f is given", func() {
+			BeforeEach(func() {
+				setFlag(&cmd, "-f")
+			})
+
+			It("removes the roles without prompting", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(testUI.Out).NotTo(Say("Really remove"))
+				Expect(fakeActor.DeleteUserRolesCallCount()).To(Equal(1))
+			})
+		})
+
+		When("removing the roles fails", func() {
+			BeforeEach(func() {
+				setFlag(&cmd, "-f")
+				fakeActor.DeleteUserRolesReturns(v7action.Warnings{"delete warning"}, errors.New("boom"))
+			})
+
+			It("returns the error and displays warnings", func() {
+				Expect(executeErr).To(MatchError("boom"))
+				Expect(testUI.Err).To(Say("delete warning"))
+			})
+		})
+	})
+
+	When("the user does not exist", func() {
+		BeforeEach(func() {
+			fakeActor.GetUserRolesReturns(resources.User{}, nil, nil, actionerror.UserNotFoundError{Username: "alice"})
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError(actionerror.UserNotFoundError{Username: "alice"}))
+		})
+	})
+
+	When("checking the target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(errors.New("not logged in"))
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError("not logged in"))
+			Expect(fakeActor.GetUserRolesCallCount()).To(Equal(0))
+		})
+	})
+})
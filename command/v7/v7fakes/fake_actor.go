@@ -36,6 +36,32 @@ type FakeActor struct {
 		result1 v7action.Warnings
 		result2 error
 	}
+	ApplyRoleChangesStub        func([]v7action.RoleChange) (v7action.Warnings, error)
+	applyRoleChangesMutex       sync.RWMutex
+	applyRoleChangesArgsForCall []struct {
+		arg1 []v7action.RoleChange
+	}
+	applyRoleChangesReturns struct {
+		result1 v7action.Warnings
+		result2 error
+	}
+	applyRoleChangesReturnsOnCall map[int]struct {
+		result1 v7action.Warnings
+		result2 error
+	}
+	ApplySpaceImportStub        func(v7action.SpaceImportPlan) (v7action.Warnings, error)
+	applySpaceImportMutex       sync.RWMutex
+	applySpaceImportArgsForCall []struct {
+		arg1 v7action.SpaceImportPlan
+	}
+	applySpaceImportReturns struct {
+		result1 v7action.Warnings
+		result2 error
+	}
+	applySpaceImportReturnsOnCall map[int]struct {
+		result1 v7action.Warnings
+		result2 error
+	}
 	ApplySpaceQuotaByNameStub        func(string, string, string) (v7action.Warnings, error)
 	applySpaceQuotaByNameMutex       sync.RWMutex
 	applySpaceQuotaByNameArgsForCall []struct {
@@ -124,6 +150,23 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	CheckSpaceEgressStub        func(string, v7action.EgressCheck, []constanta.SecurityGroupLifecycle) ([]v7action.EgressCheckResult, v7action.Warnings, error)
+	checkSpaceEgressMutex       sync.RWMutex
+	checkSpaceEgressArgsForCall []struct {
+		arg1 string
+		arg2 v7action.EgressCheck
+		arg3 []constanta.SecurityGroupLifecycle
+	}
+	checkSpaceEgressReturns struct {
+		result1 []v7action.EgressCheckResult
+		result2 v7action.Warnings
+		result3 error
+	}
+	checkSpaceEgressReturnsOnCall map[int]struct {
+		result1 []v7action.EgressCheckResult
+		result2 v7action.Warnings
+		result3 error
+	}
 	ClearTargetStub        func()
 	clearTargetMutex       sync.RWMutex
 	clearTargetArgsForCall []struct {
@@ -435,6 +478,21 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	CreateServiceAppBindingsStub        func(v7action.CreateServiceAppBindingsParams) ([]v7action.ServiceAppBindingJob, v7action.Warnings, error)
+	createServiceAppBindingsMutex       sync.RWMutex
+	createServiceAppBindingsArgsForCall []struct {
+		arg1 v7action.CreateServiceAppBindingsParams
+	}
+	createServiceAppBindingsReturns struct {
+		result1 []v7action.ServiceAppBindingJob
+		result2 v7action.Warnings
+		result3 error
+	}
+	createServiceAppBindingsReturnsOnCall map[int]struct {
+		result1 []v7action.ServiceAppBindingJob
+		result2 v7action.Warnings
+		result3 error
+	}
 	CreateServiceBrokerStub        func(resources.ServiceBroker) (v7action.Warnings, error)
 	createServiceBrokerMutex       sync.RWMutex
 	createServiceBrokerArgsForCall []struct {
@@ -757,6 +815,36 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	DeleteServiceAppBindingByGUIDStub        func(string) (chan v7action.PollJobEvent, v7action.Warnings, error)
+	deleteServiceAppBindingByGUIDMutex       sync.RWMutex
+	deleteServiceAppBindingByGUIDArgsForCall []struct {
+		arg1 string
+	}
+	deleteServiceAppBindingByGUIDReturns struct {
+		result1 chan v7action.PollJobEvent
+		result2 v7action.Warnings
+		result3 error
+	}
+	deleteServiceAppBindingByGUIDReturnsOnCall map[int]struct {
+		result1 chan v7action.PollJobEvent
+		result2 v7action.Warnings
+		result3 error
+	}
+	DeleteServiceAppBindingsStub        func(v7action.DeleteServiceAppBindingsParams) ([]v7action.ServiceAppBindingJob, v7action.Warnings, error)
+	deleteServiceAppBindingsMutex       sync.RWMutex
+	deleteServiceAppBindingsArgsForCall []struct {
+		arg1 v7action.DeleteServiceAppBindingsParams
+	}
+	deleteServiceAppBindingsReturns struct {
+		result1 []v7action.ServiceAppBindingJob
+		result2 v7action.Warnings
+		result3 error
+	}
+	deleteServiceAppBindingsReturnsOnCall map[int]struct {
+		result1 []v7action.ServiceAppBindingJob
+		result2 v7action.Warnings
+		result3 error
+	}
 	DeleteServiceBrokerStub        func(string) (v7action.Warnings, error)
 	deleteServiceBrokerMutex       sync.RWMutex
 	deleteServiceBrokerArgsForCall []struct {
@@ -848,6 +936,19 @@ type FakeActor struct {
 		result1 v7action.Warnings
 		result2 error
 	}
+	DeleteStaleResourcesStub        func([]v7action.StaleResource) (v7action.Warnings, error)
+	deleteStaleResourcesMutex       sync.RWMutex
+	deleteStaleResourcesArgsForCall []struct {
+		arg1 []v7action.StaleResource
+	}
+	deleteStaleResourcesReturns struct {
+		result1 v7action.Warnings
+		result2 error
+	}
+	deleteStaleResourcesReturnsOnCall map[int]struct {
+		result1 v7action.Warnings
+		result2 error
+	}
 	DeleteUserStub        func(string) (v7action.Warnings, error)
 	deleteUserMutex       sync.RWMutex
 	deleteUserArgsForCall []struct {
@@ -861,6 +962,33 @@ type FakeActor struct {
 		result1 v7action.Warnings
 		result2 error
 	}
+	DeleteUserRolesStub        func([]v7action.UserRole) (v7action.Warnings, error)
+	deleteUserRolesMutex       sync.RWMutex
+	deleteUserRolesArgsForCall []struct {
+		arg1 []v7action.UserRole
+	}
+	deleteUserRolesReturns struct {
+		result1 v7action.Warnings
+		result2 error
+	}
+	deleteUserRolesReturnsOnCall map[int]struct {
+		result1 v7action.Warnings
+		result2 error
+	}
+	DiffServiceBrokerCatalogStub        func(v7action.ServiceBrokerCatalog, string) ([]v7action.ServiceBrokerCatalogDifference, error)
+	diffServiceBrokerCatalogMutex       sync.RWMutex
+	diffServiceBrokerCatalogArgsForCall []struct {
+		arg1 v7action.ServiceBrokerCatalog
+		arg2 string
+	}
+	diffServiceBrokerCatalogReturns struct {
+		result1 []v7action.ServiceBrokerCatalogDifference
+		result2 error
+	}
+	diffServiceBrokerCatalogReturnsOnCall map[int]struct {
+		result1 []v7action.ServiceBrokerCatalogDifference
+		result2 error
+	}
 	DiffSpaceManifestStub        func(string, []byte) (resources.ManifestDiff, v7action.Warnings, error)
 	diffSpaceManifestMutex       sync.RWMutex
 	diffSpaceManifestArgsForCall []struct {
@@ -1004,6 +1132,21 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	GetAppFeaturesStub        func(string) ([]resources.ApplicationFeature, v7action.Warnings, error)
+	getAppFeaturesMutex       sync.RWMutex
+	getAppFeaturesArgsForCall []struct {
+		arg1 string
+	}
+	getAppFeaturesReturns struct {
+		result1 []resources.ApplicationFeature
+		result2 v7action.Warnings
+		result3 error
+	}
+	getAppFeaturesReturnsOnCall map[int]struct {
+		result1 []resources.ApplicationFeature
+		result2 v7action.Warnings
+		result3 error
+	}
 	GetAppSummariesForSpaceStub        func(string, string, bool) ([]v7action.ApplicationSummary, v7action.Warnings, error)
 	getAppSummariesForSpaceMutex       sync.RWMutex
 	getAppSummariesForSpaceArgsForCall []struct {
@@ -1037,6 +1180,24 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	GetApplicationCrashesStub        func(string, time.Time, int, sharedaction.LogCacheClient) ([]v7action.AppCrash, v7action.Warnings, error)
+	getApplicationCrashesMutex       sync.RWMutex
+	getApplicationCrashesArgsForCall []struct {
+		arg1 string
+		arg2 time.Time
+		arg3 int
+		arg4 sharedaction.LogCacheClient
+	}
+	getApplicationCrashesReturns struct {
+		result1 []v7action.AppCrash
+		result2 v7action.Warnings
+		result3 error
+	}
+	getApplicationCrashesReturnsOnCall map[int]struct {
+		result1 []v7action.AppCrash
+		result2 v7action.Warnings
+		result3 error
+	}
 	GetApplicationDropletsStub        func(string, string) ([]resources.Droplet, v7action.Warnings, error)
 	getApplicationDropletsMutex       sync.RWMutex
 	getApplicationDropletsArgsForCall []struct {
@@ -1178,6 +1339,23 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	GetApplicationTasksWithFilterStub        func(string, v7action.SortOrder, v7action.TaskFilter) ([]resources.Task, v7action.Warnings, error)
+	getApplicationTasksWithFilterMutex       sync.RWMutex
+	getApplicationTasksWithFilterArgsForCall []struct {
+		arg1 string
+		arg2 v7action.SortOrder
+		arg3 v7action.TaskFilter
+	}
+	getApplicationTasksWithFilterReturns struct {
+		result1 []resources.Task
+		result2 v7action.Warnings
+		result3 error
+	}
+	getApplicationTasksWithFilterReturnsOnCall map[int]struct {
+		result1 []resources.Task
+		result2 v7action.Warnings
+		result3 error
+	}
 	GetApplicationsByNamesAndSpaceStub        func([]string, string) ([]resources.Application, v7action.Warnings, error)
 	getApplicationsByNamesAndSpaceMutex       sync.RWMutex
 	getApplicationsByNamesAndSpaceArgsForCall []struct {
@@ -1194,6 +1372,22 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	GetApplicationsBySpaceAndLabelSelectorStub        func(string, string) ([]resources.Application, v7action.Warnings, error)
+	getApplicationsBySpaceAndLabelSelectorMutex       sync.RWMutex
+	getApplicationsBySpaceAndLabelSelectorArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	getApplicationsBySpaceAndLabelSelectorReturns struct {
+		result1 []resources.Application
+		result2 v7action.Warnings
+		result3 error
+	}
+	getApplicationsBySpaceAndLabelSelectorReturnsOnCall map[int]struct {
+		result1 []resources.Application
+		result2 v7action.Warnings
+		result3 error
+	}
 	GetBuildpackLabelsStub        func(string, string) (map[string]types.NullString, v7action.Warnings, error)
 	getBuildpackLabelsMutex       sync.RWMutex
 	getBuildpackLabelsArgsForCall []struct {
@@ -1210,6 +1404,21 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	GetBuildpackUsageStub        func(string) ([]v7action.BuildpackUsage, v7action.Warnings, error)
+	getBuildpackUsageMutex       sync.RWMutex
+	getBuildpackUsageArgsForCall []struct {
+		arg1 string
+	}
+	getBuildpackUsageReturns struct {
+		result1 []v7action.BuildpackUsage
+		result2 v7action.Warnings
+		result3 error
+	}
+	getBuildpackUsageReturnsOnCall map[int]struct {
+		result1 []v7action.BuildpackUsage
+		result2 v7action.Warnings
+		result3 error
+	}
 	GetBuildpacksStub        func(string) ([]resources.Buildpack, v7action.Warnings, error)
 	getBuildpacksMutex       sync.RWMutex
 	getBuildpacksArgsForCall []struct {
@@ -1225,6 +1434,21 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	GetCurrentDropletByApplicationStub        func(string) (resources.Droplet, v7action.Warnings, error)
+	getCurrentDropletByApplicationMutex       sync.RWMutex
+	getCurrentDropletByApplicationArgsForCall []struct {
+		arg1 string
+	}
+	getCurrentDropletByApplicationReturns struct {
+		result1 resources.Droplet
+		result2 v7action.Warnings
+		result3 error
+	}
+	getCurrentDropletByApplicationReturnsOnCall map[int]struct {
+		result1 resources.Droplet
+		result2 v7action.Warnings
+		result3 error
+	}
 	GetCurrentUserStub        func() (configv3.User, error)
 	getCurrentUserMutex       sync.RWMutex
 	getCurrentUserArgsForCall []struct {
@@ -1378,6 +1602,21 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	GetEventsStub        func(v7action.EventFilter) ([]v7action.Event, v7action.Warnings, error)
+	getEventsMutex       sync.RWMutex
+	getEventsArgsForCall []struct {
+		arg1 v7action.EventFilter
+	}
+	getEventsReturns struct {
+		result1 []v7action.Event
+		result2 v7action.Warnings
+		result3 error
+	}
+	getEventsReturnsOnCall map[int]struct {
+		result1 []v7action.Event
+		result2 v7action.Warnings
+		result3 error
+	}
 	GetFeatureFlagByNameStub        func(string) (resources.FeatureFlag, v7action.Warnings, error)
 	getFeatureFlagByNameMutex       sync.RWMutex
 	getFeatureFlagByNameArgsForCall []struct {
@@ -1702,6 +1941,37 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	GetProcessInstancesStub        func(string) ([]v7action.ProcessInstance, v7action.Warnings, error)
+	getProcessInstancesMutex       sync.RWMutex
+	getProcessInstancesArgsForCall []struct {
+		arg1 string
+	}
+	getProcessInstancesReturns struct {
+		result1 []v7action.ProcessInstance
+		result2 v7action.Warnings
+		result3 error
+	}
+	getProcessInstancesReturnsOnCall map[int]struct {
+		result1 []v7action.ProcessInstance
+		result2 v7action.Warnings
+		result3 error
+	}
+	GetQuotaUsageStub        func(string, bool) ([]v7action.QuotaUsage, v7action.Warnings, error)
+	getQuotaUsageMutex       sync.RWMutex
+	getQuotaUsageArgsForCall []struct {
+		arg1 string
+		arg2 bool
+	}
+	getQuotaUsageReturns struct {
+		result1 []v7action.QuotaUsage
+		result2 v7action.Warnings
+		result3 error
+	}
+	getQuotaUsageReturnsOnCall map[int]struct {
+		result1 []v7action.QuotaUsage
+		result2 v7action.Warnings
+		result3 error
+	}
 	GetRawApplicationManifestByNameAndSpaceStub        func(string, string) ([]byte, v7action.Warnings, error)
 	getRawApplicationManifestByNameAndSpaceMutex       sync.RWMutex
 	getRawApplicationManifestByNameAndSpaceArgsForCall []struct {
@@ -1751,6 +2021,21 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	GetRecentLogsForTaskStub        func(string, resources.Task, sharedaction.LogCacheClient) ([]sharedaction.LogMessage, error)
+	getRecentLogsForTaskMutex       sync.RWMutex
+	getRecentLogsForTaskArgsForCall []struct {
+		arg1 string
+		arg2 resources.Task
+		arg3 sharedaction.LogCacheClient
+	}
+	getRecentLogsForTaskReturns struct {
+		result1 []sharedaction.LogMessage
+		result2 error
+	}
+	getRecentLogsForTaskReturnsOnCall map[int]struct {
+		result1 []sharedaction.LogMessage
+		result2 error
+	}
 	GetRevisionByApplicationAndVersionStub        func(string, int) (resources.Revision, v7action.Warnings, error)
 	getRevisionByApplicationAndVersionMutex       sync.RWMutex
 	getRevisionByApplicationAndVersionArgsForCall []struct {
@@ -1783,6 +2068,22 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	GetRoleChangesStub        func([]v7action.RoleAssignment, bool) ([]v7action.RoleChange, v7action.Warnings, error)
+	getRoleChangesMutex       sync.RWMutex
+	getRoleChangesArgsForCall []struct {
+		arg1 []v7action.RoleAssignment
+		arg2 bool
+	}
+	getRoleChangesReturns struct {
+		result1 []v7action.RoleChange
+		result2 v7action.Warnings
+		result3 error
+	}
+	getRoleChangesReturnsOnCall map[int]struct {
+		result1 []v7action.RoleChange
+		result2 v7action.Warnings
+		result3 error
+	}
 	GetRootResponseStub        func() (v7action.Root, v7action.Warnings, error)
 	getRootResponseMutex       sync.RWMutex
 	getRootResponseArgsForCall []struct {
@@ -1797,6 +2098,36 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	GetRouteBindingSummariesStub        func(v7action.GetRouteBindingSummariesParams) ([]v7action.RouteBindingSummary, v7action.Warnings, error)
+	getRouteBindingSummariesMutex       sync.RWMutex
+	getRouteBindingSummariesArgsForCall []struct {
+		arg1 v7action.GetRouteBindingSummariesParams
+	}
+	getRouteBindingSummariesReturns struct {
+		result1 []v7action.RouteBindingSummary
+		result2 v7action.Warnings
+		result3 error
+	}
+	getRouteBindingSummariesReturnsOnCall map[int]struct {
+		result1 []v7action.RouteBindingSummary
+		result2 v7action.Warnings
+		result3 error
+	}
+	GetRouteBindingSummaryForRouteStub        func(resources.Route) (v7action.RouteBindingSummary, v7action.Warnings, error)
+	getRouteBindingSummaryForRouteMutex       sync.RWMutex
+	getRouteBindingSummaryForRouteArgsForCall []struct {
+		arg1 resources.Route
+	}
+	getRouteBindingSummaryForRouteReturns struct {
+		result1 v7action.RouteBindingSummary
+		result2 v7action.Warnings
+		result3 error
+	}
+	getRouteBindingSummaryForRouteReturnsOnCall map[int]struct {
+		result1 v7action.RouteBindingSummary
+		result2 v7action.Warnings
+		result3 error
+	}
 	GetRouteByAttributesStub        func(resources.Domain, string, string, int) (resources.Route, v7action.Warnings, error)
 	getRouteByAttributesMutex       sync.RWMutex
 	getRouteByAttributesArgsForCall []struct {
@@ -2026,6 +2357,22 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	GetServiceAppBindingsByServiceInstanceStub        func(string, string) ([]resources.ServiceCredentialBinding, v7action.Warnings, error)
+	getServiceAppBindingsByServiceInstanceMutex       sync.RWMutex
+	getServiceAppBindingsByServiceInstanceArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	getServiceAppBindingsByServiceInstanceReturns struct {
+		result1 []resources.ServiceCredentialBinding
+		result2 v7action.Warnings
+		result3 error
+	}
+	getServiceAppBindingsByServiceInstanceReturnsOnCall map[int]struct {
+		result1 []resources.ServiceCredentialBinding
+		result2 v7action.Warnings
+		result3 error
+	}
 	GetServiceBrokerByNameStub        func(string) (resources.ServiceBroker, v7action.Warnings, error)
 	getServiceBrokerByNameMutex       sync.RWMutex
 	getServiceBrokerByNameArgsForCall []struct {
@@ -2041,6 +2388,21 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	GetServiceBrokerCatalogStub        func(string) (v7action.ServiceBrokerCatalog, v7action.Warnings, error)
+	getServiceBrokerCatalogMutex       sync.RWMutex
+	getServiceBrokerCatalogArgsForCall []struct {
+		arg1 string
+	}
+	getServiceBrokerCatalogReturns struct {
+		result1 v7action.ServiceBrokerCatalog
+		result2 v7action.Warnings
+		result3 error
+	}
+	getServiceBrokerCatalogReturnsOnCall map[int]struct {
+		result1 v7action.ServiceBrokerCatalog
+		result2 v7action.Warnings
+		result3 error
+	}
 	GetServiceBrokerLabelsStub        func(string) (map[string]types.NullString, v7action.Warnings, error)
 	getServiceBrokerLabelsMutex       sync.RWMutex
 	getServiceBrokerLabelsArgsForCall []struct {
@@ -2135,6 +2497,21 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	GetServiceInstanceUpgradeCandidatesStub        func(v7action.ServiceInstanceUpgradeFilter) ([]v7action.ServiceInstanceUpgradeCandidate, v7action.Warnings, error)
+	getServiceInstanceUpgradeCandidatesMutex       sync.RWMutex
+	getServiceInstanceUpgradeCandidatesArgsForCall []struct {
+		arg1 v7action.ServiceInstanceUpgradeFilter
+	}
+	getServiceInstanceUpgradeCandidatesReturns struct {
+		result1 []v7action.ServiceInstanceUpgradeCandidate
+		result2 v7action.Warnings
+		result3 error
+	}
+	getServiceInstanceUpgradeCandidatesReturnsOnCall map[int]struct {
+		result1 []v7action.ServiceInstanceUpgradeCandidate
+		result2 v7action.Warnings
+		result3 error
+	}
 	GetServiceInstancesForSpaceStub        func(string, bool) ([]v7action.ServiceInstance, v7action.Warnings, error)
 	getServiceInstancesForSpaceMutex       sync.RWMutex
 	getServiceInstancesForSpaceArgsForCall []struct {
@@ -2251,6 +2628,21 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	GetSharedServiceInstanceSummariesStub        func(string) ([]v7action.SharedServiceInstanceSummary, v7action.Warnings, error)
+	getSharedServiceInstanceSummariesMutex       sync.RWMutex
+	getSharedServiceInstanceSummariesArgsForCall []struct {
+		arg1 string
+	}
+	getSharedServiceInstanceSummariesReturns struct {
+		result1 []v7action.SharedServiceInstanceSummary
+		result2 v7action.Warnings
+		result3 error
+	}
+	getSharedServiceInstanceSummariesReturnsOnCall map[int]struct {
+		result1 []v7action.SharedServiceInstanceSummary
+		result2 v7action.Warnings
+		result3 error
+	}
 	GetSpaceByNameAndOrganizationStub        func(string, string) (resources.Space, v7action.Warnings, error)
 	getSpaceByNameAndOrganizationMutex       sync.RWMutex
 	getSpaceByNameAndOrganizationArgsForCall []struct {
@@ -2267,6 +2659,24 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	GetSpaceExportStub        func(string, string, bool, v7action.SpaceExportNetworkingActor) (v7action.SpaceExport, v7action.Warnings, error)
+	getSpaceExportMutex       sync.RWMutex
+	getSpaceExportArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 bool
+		arg4 v7action.SpaceExportNetworkingActor
+	}
+	getSpaceExportReturns struct {
+		result1 v7action.SpaceExport
+		result2 v7action.Warnings
+		result3 error
+	}
+	getSpaceExportReturnsOnCall map[int]struct {
+		result1 v7action.SpaceExport
+		result2 v7action.Warnings
+		result3 error
+	}
 	GetSpaceFeatureStub        func(string, string, string) (bool, v7action.Warnings, error)
 	getSpaceFeatureMutex       sync.RWMutex
 	getSpaceFeatureArgsForCall []struct {
@@ -2392,6 +2802,22 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	GetStackMigrationReportStub        func(string, string) ([]v7action.StackMigrationApp, v7action.Warnings, error)
+	getStackMigrationReportMutex       sync.RWMutex
+	getStackMigrationReportArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	getStackMigrationReportReturns struct {
+		result1 []v7action.StackMigrationApp
+		result2 v7action.Warnings
+		result3 error
+	}
+	getStackMigrationReportReturnsOnCall map[int]struct {
+		result1 []v7action.StackMigrationApp
+		result2 v7action.Warnings
+		result3 error
+	}
 	GetStacksStub        func(string) ([]resources.Stack, v7action.Warnings, error)
 	getStacksMutex       sync.RWMutex
 	getStacksArgsForCall []struct {
@@ -2407,6 +2833,23 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	GetStaleResourcesStub        func(string, string, time.Time) ([]v7action.StaleResource, v7action.Warnings, error)
+	getStaleResourcesMutex       sync.RWMutex
+	getStaleResourcesArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 time.Time
+	}
+	getStaleResourcesReturns struct {
+		result1 []v7action.StaleResource
+		result2 v7action.Warnings
+		result3 error
+	}
+	getStaleResourcesReturnsOnCall map[int]struct {
+		result1 []v7action.StaleResource
+		result2 v7action.Warnings
+		result3 error
+	}
 	GetStreamingLogsForApplicationByNameAndSpaceStub        func(string, string, sharedaction.LogCacheClient) (<-chan sharedaction.LogMessage, <-chan error, context.CancelFunc, v7action.Warnings, error)
 	getStreamingLogsForApplicationByNameAndSpaceMutex       sync.RWMutex
 	getStreamingLogsForApplicationByNameAndSpaceArgsForCall []struct {
@@ -2428,16 +2871,35 @@ type FakeActor struct {
 		result4 v7action.Warnings
 		result5 error
 	}
-	GetTaskBySequenceIDAndApplicationStub        func(int, string) (resources.Task, v7action.Warnings, error)
-	getTaskBySequenceIDAndApplicationMutex       sync.RWMutex
-	getTaskBySequenceIDAndApplicationArgsForCall []struct {
-		arg1 int
-		arg2 string
-	}
-	getTaskBySequenceIDAndApplicationReturns struct {
-		result1 resources.Task
-		result2 v7action.Warnings
-		result3 error
+	GetStreamingLogsForTaskStub        func(string, resources.Task, sharedaction.LogCacheClient) (<-chan sharedaction.LogMessage, <-chan error, context.CancelFunc, error)
+	getStreamingLogsForTaskMutex       sync.RWMutex
+	getStreamingLogsForTaskArgsForCall []struct {
+		arg1 string
+		arg2 resources.Task
+		arg3 sharedaction.LogCacheClient
+	}
+	getStreamingLogsForTaskReturns struct {
+		result1 <-chan sharedaction.LogMessage
+		result2 <-chan error
+		result3 context.CancelFunc
+		result4 error
+	}
+	getStreamingLogsForTaskReturnsOnCall map[int]struct {
+		result1 <-chan sharedaction.LogMessage
+		result2 <-chan error
+		result3 context.CancelFunc
+		result4 error
+	}
+	GetTaskBySequenceIDAndApplicationStub        func(int, string) (resources.Task, v7action.Warnings, error)
+	getTaskBySequenceIDAndApplicationMutex       sync.RWMutex
+	getTaskBySequenceIDAndApplicationArgsForCall []struct {
+		arg1 int
+		arg2 string
+	}
+	getTaskBySequenceIDAndApplicationReturns struct {
+		result1 resources.Task
+		result2 v7action.Warnings
+		result3 error
 	}
 	getTaskBySequenceIDAndApplicationReturnsOnCall map[int]struct {
 		result1 resources.Task
@@ -2485,6 +2947,24 @@ type FakeActor struct {
 		result1 resources.User
 		result2 error
 	}
+	GetUserRolesStub        func(string, string) (resources.User, []v7action.UserRole, v7action.Warnings, error)
+	getUserRolesMutex       sync.RWMutex
+	getUserRolesArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	getUserRolesReturns struct {
+		result1 resources.User
+		result2 []v7action.UserRole
+		result3 v7action.Warnings
+		result4 error
+	}
+	getUserRolesReturnsOnCall map[int]struct {
+		result1 resources.User
+		result2 []v7action.UserRole
+		result3 v7action.Warnings
+		result4 error
+	}
 	MakeCurlRequestStub        func(string, string, []string, string, bool) ([]byte, *http.Response, error)
 	makeCurlRequestMutex       sync.RWMutex
 	makeCurlRequestArgsForCall []struct {
@@ -2561,6 +3041,24 @@ type FakeActor struct {
 		result1 jwt.JWT
 		result2 error
 	}
+	PlanSpaceImportStub        func(v7action.SpaceExport, string, string, v7action.SpaceImportNetworkingActor) (v7action.SpaceImportPlan, v7action.Warnings, error)
+	planSpaceImportMutex       sync.RWMutex
+	planSpaceImportArgsForCall []struct {
+		arg1 v7action.SpaceExport
+		arg2 string
+		arg3 string
+		arg4 v7action.SpaceImportNetworkingActor
+	}
+	planSpaceImportReturns struct {
+		result1 v7action.SpaceImportPlan
+		result2 v7action.Warnings
+		result3 error
+	}
+	planSpaceImportReturnsOnCall map[int]struct {
+		result1 v7action.SpaceImportPlan
+		result2 v7action.Warnings
+		result3 error
+	}
 	PollBuildStub        func(string, string) (resources.Droplet, v7action.Warnings, error)
 	pollBuildMutex       sync.RWMutex
 	pollBuildArgsForCall []struct {
@@ -2592,6 +3090,22 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	PollServiceInstanceLastOperationStub        func(string, string, time.Duration, func(resources.LastOperation)) (v7action.Warnings, error)
+	pollServiceInstanceLastOperationMutex       sync.RWMutex
+	pollServiceInstanceLastOperationArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 time.Duration
+		arg4 func(resources.LastOperation)
+	}
+	pollServiceInstanceLastOperationReturns struct {
+		result1 v7action.Warnings
+		result2 error
+	}
+	pollServiceInstanceLastOperationReturnsOnCall map[int]struct {
+		result1 v7action.Warnings
+		result2 error
+	}
 	PollStartStub        func(resources.Application, bool, func(string)) (v7action.Warnings, error)
 	pollStartMutex       sync.RWMutex
 	pollStartArgsForCall []struct {
@@ -3061,6 +3575,24 @@ type FakeActor struct {
 		result1 v7action.Warnings
 		result2 error
 	}
+	ShareServiceInstanceToSpacesStub        func(string, string, string, v7action.BulkServiceInstanceSharingParams) ([]resources.Space, v7action.Warnings, error)
+	shareServiceInstanceToSpacesMutex       sync.RWMutex
+	shareServiceInstanceToSpacesArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 string
+		arg4 v7action.BulkServiceInstanceSharingParams
+	}
+	shareServiceInstanceToSpacesReturns struct {
+		result1 []resources.Space
+		result2 v7action.Warnings
+		result3 error
+	}
+	shareServiceInstanceToSpacesReturnsOnCall map[int]struct {
+		result1 []resources.Space
+		result2 v7action.Warnings
+		result3 error
+	}
 	StageApplicationPackageStub        func(string) (resources.Build, v7action.Warnings, error)
 	stageApplicationPackageMutex       sync.RWMutex
 	stageApplicationPackageArgsForCall []struct {
@@ -3222,6 +3754,22 @@ type FakeActor struct {
 		result1 v7action.Warnings
 		result2 error
 	}
+	UnshareServiceInstanceFromAllSpacesStub        func(string, string) ([]ccv3.SpaceWithOrganization, v7action.Warnings, error)
+	unshareServiceInstanceFromAllSpacesMutex       sync.RWMutex
+	unshareServiceInstanceFromAllSpacesArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	unshareServiceInstanceFromAllSpacesReturns struct {
+		result1 []ccv3.SpaceWithOrganization
+		result2 v7action.Warnings
+		result3 error
+	}
+	unshareServiceInstanceFromAllSpacesReturnsOnCall map[int]struct {
+		result1 []ccv3.SpaceWithOrganization
+		result2 v7action.Warnings
+		result3 error
+	}
 	UnshareServiceInstanceFromSpaceAndOrgStub        func(string, string, string, v7action.ServiceInstanceSharingParams) (v7action.Warnings, error)
 	unshareServiceInstanceFromSpaceAndOrgMutex       sync.RWMutex
 	unshareServiceInstanceFromSpaceAndOrgArgsForCall []struct {
@@ -3762,6 +4310,139 @@ func (fake *FakeActor) ApplyOrganizationQuotaByNameReturnsOnCall(i int, result1
 	}{result1, result2}
 }
 
+func (fake *FakeActor) ApplyRoleChanges(arg1 []v7action.RoleChange) (v7action.Warnings, error) {
+	var arg1Copy []v7action.RoleChange
+	if arg1 != nil {
+		arg1Copy = make([]v7action.RoleChange, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.applyRoleChangesMutex.Lock()
+	ret, specificReturn := fake.applyRoleChangesReturnsOnCall[len(fake.applyRoleChangesArgsForCall)]
+	fake.applyRoleChangesArgsForCall = append(fake.applyRoleChangesArgsForCall, struct {
+		arg1 []v7action.RoleChange
+	}{arg1Copy})
+	stub := fake.ApplyRoleChangesStub
+	fakeReturns := fake.applyRoleChangesReturns
+	fake.recordInvocation("ApplyRoleChanges", []interface{}{arg1Copy})
+	fake.applyRoleChangesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeActor) ApplyRoleChangesCallCount() int {
+	fake.applyRoleChangesMutex.RLock()
+	defer fake.applyRoleChangesMutex.RUnlock()
+	return len(fake.applyRoleChangesArgsForCall)
+}
+
+func (fake *FakeActor) ApplyRoleChangesCalls(stub func([]v7action.RoleChange) (v7action.Warnings, error)) {
+	fake.applyRoleChangesMutex.Lock()
+	defer fake.applyRoleChangesMutex.Unlock()
+	fake.ApplyRoleChangesStub = stub
+}
+
+func (fake *FakeActor) ApplyRoleChangesArgsForCall(i int) []v7action.RoleChange {
+	fake.applyRoleChangesMutex.RLock()
+	defer fake.applyRoleChangesMutex.RUnlock()
+	argsForCall := fake.applyRoleChangesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeActor) ApplyRoleChangesReturns(result1 v7action.Warnings, result2 error) {
+	fake.applyRoleChangesMutex.Lock()
+	defer fake.applyRoleChangesMutex.Unlock()
+	fake.ApplyRoleChangesStub = nil
+	fake.applyRoleChangesReturns = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeActor) ApplyRoleChangesReturnsOnCall(i int, result1 v7action.Warnings, result2 error) {
+	fake.applyRoleChangesMutex.Lock()
+	defer fake.applyRoleChangesMutex.Unlock()
+	fake.ApplyRoleChangesStub = nil
+	if fake.applyRoleChangesReturnsOnCall == nil {
+		fake.applyRoleChangesReturnsOnCall = make(map[int]struct {
+			result1 v7action.Warnings
+			result2 error
+		})
+	}
+	fake.applyRoleChangesReturnsOnCall[i] = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeActor) ApplySpaceImport(arg1 v7action.SpaceImportPlan) (v7action.Warnings, error) {
+	fake.applySpaceImportMutex.Lock()
+	ret, specificReturn := fake.applySpaceImportReturnsOnCall[len(fake.applySpaceImportArgsForCall)]
+	fake.applySpaceImportArgsForCall = append(fake.applySpaceImportArgsForCall, struct {
+		arg1 v7action.SpaceImportPlan
+	}{arg1})
+	stub := fake.ApplySpaceImportStub
+	fakeReturns := fake.applySpaceImportReturns
+	fake.recordInvocation("ApplySpaceImport", []interface{}{arg1})
+	fake.applySpaceImportMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeActor) ApplySpaceImportCallCount() int {
+	fake.applySpaceImportMutex.RLock()
+	defer fake.applySpaceImportMutex.RUnlock()
+	return len(fake.applySpaceImportArgsForCall)
+}
+
+func (fake *FakeActor) ApplySpaceImportCalls(stub func(v7action.SpaceImportPlan) (v7action.Warnings, error)) {
+	fake.applySpaceImportMutex.Lock()
+	defer fake.applySpaceImportMutex.Unlock()
+	fake.ApplySpaceImportStub = stub
+}
+
+func (fake *FakeActor) ApplySpaceImportArgsForCall(i int) v7action.SpaceImportPlan {
+	fake.applySpaceImportMutex.RLock()
+	defer fake.applySpaceImportMutex.RUnlock()
+	argsForCall := fake.applySpaceImportArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeActor) ApplySpaceImportReturns(result1 v7action.Warnings, result2 error) {
+	fake.applySpaceImportMutex.Lock()
+	defer fake.applySpaceImportMutex.Unlock()
+	fake.ApplySpaceImportStub = nil
+	fake.applySpaceImportReturns = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeActor) ApplySpaceImportReturnsOnCall(i int, result1 v7action.Warnings, result2 error) {
+	fake.applySpaceImportMutex.Lock()
+	defer fake.applySpaceImportMutex.Unlock()
+	fake.ApplySpaceImportStub = nil
+	if fake.applySpaceImportReturnsOnCall == nil {
+		fake.applySpaceImportReturnsOnCall = make(map[int]struct {
+			result1 v7action.Warnings
+			result2 error
+		})
+	}
+	fake.applySpaceImportReturnsOnCall[i] = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeActor) ApplySpaceQuotaByName(arg1 string, arg2 string, arg3 string) (v7action.Warnings, error) {
 	fake.applySpaceQuotaByNameMutex.Lock()
 	ret, specificReturn := fake.applySpaceQuotaByNameReturnsOnCall[len(fake.applySpaceQuotaByNameArgsForCall)]
@@ -4161,6 +4842,80 @@ func (fake *FakeActor) CheckRouteReturnsOnCall(i int, result1 bool, result2 v7ac
 	}{result1, result2, result3}
 }
 
+func (fake *FakeActor) CheckSpaceEgress(arg1 string, arg2 v7action.EgressCheck, arg3 []constanta.SecurityGroupLifecycle) ([]v7action.EgressCheckResult, v7action.Warnings, error) {
+	var arg3Copy []constanta.SecurityGroupLifecycle
+	if arg3 != nil {
+		arg3Copy = make([]constanta.SecurityGroupLifecycle, len(arg3))
+		copy(arg3Copy, arg3)
+	}
+	fake.checkSpaceEgressMutex.Lock()
+	ret, specificReturn := fake.checkSpaceEgressReturnsOnCall[len(fake.checkSpaceEgressArgsForCall)]
+	fake.checkSpaceEgressArgsForCall = append(fake.checkSpaceEgressArgsForCall, struct {
+		arg1 string
+		arg2 v7action.EgressCheck
+		arg3 []constanta.SecurityGroupLifecycle
+	}{arg1, arg2, arg3Copy})
+	stub := fake.CheckSpaceEgressStub
+	fakeReturns := fake.checkSpaceEgressReturns
+	fake.recordInvocation("CheckSpaceEgress", []interface{}{arg1, arg2, arg3Copy})
+	fake.checkSpaceEgressMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) CheckSpaceEgressCallCount() int {
+	fake.checkSpaceEgressMutex.RLock()
+	defer fake.checkSpaceEgressMutex.RUnlock()
+	return len(fake.checkSpaceEgressArgsForCall)
+}
+
+func (fake *FakeActor) CheckSpaceEgressCalls(stub func(string, v7action.EgressCheck, []constanta.SecurityGroupLifecycle) ([]v7action.EgressCheckResult, v7action.Warnings, error)) {
+	fake.checkSpaceEgressMutex.Lock()
+	defer fake.checkSpaceEgressMutex.Unlock()
+	fake.CheckSpaceEgressStub = stub
+}
+
+func (fake *FakeActor) CheckSpaceEgressArgsForCall(i int) (string, v7action.EgressCheck, []constanta.SecurityGroupLifecycle) {
+	fake.checkSpaceEgressMutex.RLock()
+	defer fake.checkSpaceEgressMutex.RUnlock()
+	argsForCall := fake.checkSpaceEgressArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeActor) CheckSpaceEgressReturns(result1 []v7action.EgressCheckResult, result2 v7action.Warnings, result3 error) {
+	fake.checkSpaceEgressMutex.Lock()
+	defer fake.checkSpaceEgressMutex.Unlock()
+	fake.CheckSpaceEgressStub = nil
+	fake.checkSpaceEgressReturns = struct {
+		result1 []v7action.EgressCheckResult
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) CheckSpaceEgressReturnsOnCall(i int, result1 []v7action.EgressCheckResult, result2 v7action.Warnings, result3 error) {
+	fake.checkSpaceEgressMutex.Lock()
+	defer fake.checkSpaceEgressMutex.Unlock()
+	fake.CheckSpaceEgressStub = nil
+	if fake.checkSpaceEgressReturnsOnCall == nil {
+		fake.checkSpaceEgressReturnsOnCall = make(map[int]struct {
+			result1 []v7action.EgressCheckResult
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.checkSpaceEgressReturnsOnCall[i] = struct {
+		result1 []v7action.EgressCheckResult
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeActor) ClearTarget() {
 	fake.clearTargetMutex.Lock()
 	fake.clearTargetArgsForCall = append(fake.clearTargetArgsForCall, struct {
@@ -5526,6 +6281,73 @@ func (fake *FakeActor) CreateServiceAppBindingReturnsOnCall(i int, result1 chan
 	}{result1, result2, result3}
 }
 
+func (fake *FakeActor) CreateServiceAppBindings(arg1 v7action.CreateServiceAppBindingsParams) ([]v7action.ServiceAppBindingJob, v7action.Warnings, error) {
+	fake.createServiceAppBindingsMutex.Lock()
+	ret, specificReturn := fake.createServiceAppBindingsReturnsOnCall[len(fake.createServiceAppBindingsArgsForCall)]
+	fake.createServiceAppBindingsArgsForCall = append(fake.createServiceAppBindingsArgsForCall, struct {
+		arg1 v7action.CreateServiceAppBindingsParams
+	}{arg1})
+	stub := fake.CreateServiceAppBindingsStub
+	fakeReturns := fake.createServiceAppBindingsReturns
+	fake.recordInvocation("CreateServiceAppBindings", []interface{}{arg1})
+	fake.createServiceAppBindingsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) CreateServiceAppBindingsCallCount() int {
+	fake.createServiceAppBindingsMutex.RLock()
+	defer fake.createServiceAppBindingsMutex.RUnlock()
+	return len(fake.createServiceAppBindingsArgsForCall)
+}
+
+func (fake *FakeActor) CreateServiceAppBindingsCalls(stub func(v7action.CreateServiceAppBindingsParams) ([]v7action.ServiceAppBindingJob, v7action.Warnings, error)) {
+	fake.createServiceAppBindingsMutex.Lock()
+	defer fake.createServiceAppBindingsMutex.Unlock()
+	fake.CreateServiceAppBindingsStub = stub
+}
+
+func (fake *FakeActor) CreateServiceAppBindingsArgsForCall(i int) v7action.CreateServiceAppBindingsParams {
+	fake.createServiceAppBindingsMutex.RLock()
+	defer fake.createServiceAppBindingsMutex.RUnlock()
+	argsForCall := fake.createServiceAppBindingsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeActor) CreateServiceAppBindingsReturns(result1 []v7action.ServiceAppBindingJob, result2 v7action.Warnings, result3 error) {
+	fake.createServiceAppBindingsMutex.Lock()
+	defer fake.createServiceAppBindingsMutex.Unlock()
+	fake.CreateServiceAppBindingsStub = nil
+	fake.createServiceAppBindingsReturns = struct {
+		result1 []v7action.ServiceAppBindingJob
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) CreateServiceAppBindingsReturnsOnCall(i int, result1 []v7action.ServiceAppBindingJob, result2 v7action.Warnings, result3 error) {
+	fake.createServiceAppBindingsMutex.Lock()
+	defer fake.createServiceAppBindingsMutex.Unlock()
+	fake.CreateServiceAppBindingsStub = nil
+	if fake.createServiceAppBindingsReturnsOnCall == nil {
+		fake.createServiceAppBindingsReturnsOnCall = make(map[int]struct {
+			result1 []v7action.ServiceAppBindingJob
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.createServiceAppBindingsReturnsOnCall[i] = struct {
+		result1 []v7action.ServiceAppBindingJob
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeActor) CreateServiceBroker(arg1 resources.ServiceBroker) (v7action.Warnings, error) {
 	fake.createServiceBrokerMutex.Lock()
 	ret, specificReturn := fake.createServiceBrokerReturnsOnCall[len(fake.createServiceBrokerArgsForCall)]
@@ -6975,71 +7797,205 @@ func (fake *FakeActor) DeleteServiceAppBindingReturnsOnCall(i int, result1 chan
 	}{result1, result2, result3}
 }
 
-func (fake *FakeActor) DeleteServiceBroker(arg1 string) (v7action.Warnings, error) {
-	fake.deleteServiceBrokerMutex.Lock()
-	ret, specificReturn := fake.deleteServiceBrokerReturnsOnCall[len(fake.deleteServiceBrokerArgsForCall)]
-	fake.deleteServiceBrokerArgsForCall = append(fake.deleteServiceBrokerArgsForCall, struct {
+func (fake *FakeActor) DeleteServiceAppBindingByGUID(arg1 string) (chan v7action.PollJobEvent, v7action.Warnings, error) {
+	fake.deleteServiceAppBindingByGUIDMutex.Lock()
+	ret, specificReturn := fake.deleteServiceAppBindingByGUIDReturnsOnCall[len(fake.deleteServiceAppBindingByGUIDArgsForCall)]
+	fake.deleteServiceAppBindingByGUIDArgsForCall = append(fake.deleteServiceAppBindingByGUIDArgsForCall, struct {
 		arg1 string
 	}{arg1})
-	stub := fake.DeleteServiceBrokerStub
-	fakeReturns := fake.deleteServiceBrokerReturns
-	fake.recordInvocation("DeleteServiceBroker", []interface{}{arg1})
-	fake.deleteServiceBrokerMutex.Unlock()
+	stub := fake.DeleteServiceAppBindingByGUIDStub
+	fakeReturns := fake.deleteServiceAppBindingByGUIDReturns
+	fake.recordInvocation("DeleteServiceAppBindingByGUID", []interface{}{arg1})
+	fake.deleteServiceAppBindingByGUIDMutex.Unlock()
 	if stub != nil {
 		return stub(arg1)
 	}
 	if specificReturn {
-		return ret.result1, ret.result2
+		return ret.result1, ret.result2, ret.result3
 	}
-	return fakeReturns.result1, fakeReturns.result2
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
 }
 
-func (fake *FakeActor) DeleteServiceBrokerCallCount() int {
-	fake.deleteServiceBrokerMutex.RLock()
-	defer fake.deleteServiceBrokerMutex.RUnlock()
-	return len(fake.deleteServiceBrokerArgsForCall)
+func (fake *FakeActor) DeleteServiceAppBindingByGUIDCallCount() int {
+	fake.deleteServiceAppBindingByGUIDMutex.RLock()
+	defer fake.deleteServiceAppBindingByGUIDMutex.RUnlock()
+	return len(fake.deleteServiceAppBindingByGUIDArgsForCall)
 }
 
-func (fake *FakeActor) DeleteServiceBrokerCalls(stub func(string) (v7action.Warnings, error)) {
-	fake.deleteServiceBrokerMutex.Lock()
-	defer fake.deleteServiceBrokerMutex.Unlock()
-	fake.DeleteServiceBrokerStub = stub
+func (fake *FakeActor) DeleteServiceAppBindingByGUIDCalls(stub func(string) (chan v7action.PollJobEvent, v7action.Warnings, error)) {
+	fake.deleteServiceAppBindingByGUIDMutex.Lock()
+	defer fake.deleteServiceAppBindingByGUIDMutex.Unlock()
+	fake.DeleteServiceAppBindingByGUIDStub = stub
 }
 
-func (fake *FakeActor) DeleteServiceBrokerArgsForCall(i int) string {
-	fake.deleteServiceBrokerMutex.RLock()
-	defer fake.deleteServiceBrokerMutex.RUnlock()
-	argsForCall := fake.deleteServiceBrokerArgsForCall[i]
+func (fake *FakeActor) DeleteServiceAppBindingByGUIDArgsForCall(i int) string {
+	fake.deleteServiceAppBindingByGUIDMutex.RLock()
+	defer fake.deleteServiceAppBindingByGUIDMutex.RUnlock()
+	argsForCall := fake.deleteServiceAppBindingByGUIDArgsForCall[i]
 	return argsForCall.arg1
 }
 
-func (fake *FakeActor) DeleteServiceBrokerReturns(result1 v7action.Warnings, result2 error) {
-	fake.deleteServiceBrokerMutex.Lock()
-	defer fake.deleteServiceBrokerMutex.Unlock()
-	fake.DeleteServiceBrokerStub = nil
-	fake.deleteServiceBrokerReturns = struct {
-		result1 v7action.Warnings
-		result2 error
-	}{result1, result2}
+func (fake *FakeActor) DeleteServiceAppBindingByGUIDReturns(result1 chan v7action.PollJobEvent, result2 v7action.Warnings, result3 error) {
+	fake.deleteServiceAppBindingByGUIDMutex.Lock()
+	defer fake.deleteServiceAppBindingByGUIDMutex.Unlock()
+	fake.DeleteServiceAppBindingByGUIDStub = nil
+	fake.deleteServiceAppBindingByGUIDReturns = struct {
+		result1 chan v7action.PollJobEvent
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
 }
 
-func (fake *FakeActor) DeleteServiceBrokerReturnsOnCall(i int, result1 v7action.Warnings, result2 error) {
-	fake.deleteServiceBrokerMutex.Lock()
-	defer fake.deleteServiceBrokerMutex.Unlock()
-	fake.DeleteServiceBrokerStub = nil
-	if fake.deleteServiceBrokerReturnsOnCall == nil {
-		fake.deleteServiceBrokerReturnsOnCall = make(map[int]struct {
-			result1 v7action.Warnings
-			result2 error
+func (fake *FakeActor) DeleteServiceAppBindingByGUIDReturnsOnCall(i int, result1 chan v7action.PollJobEvent, result2 v7action.Warnings, result3 error) {
+	fake.deleteServiceAppBindingByGUIDMutex.Lock()
+	defer fake.deleteServiceAppBindingByGUIDMutex.Unlock()
+	fake.DeleteServiceAppBindingByGUIDStub = nil
+	if fake.deleteServiceAppBindingByGUIDReturnsOnCall == nil {
+		fake.deleteServiceAppBindingByGUIDReturnsOnCall = make(map[int]struct {
+			result1 chan v7action.PollJobEvent
+			result2 v7action.Warnings
+			result3 error
 		})
 	}
-	fake.deleteServiceBrokerReturnsOnCall[i] = struct {
-		result1 v7action.Warnings
-		result2 error
-	}{result1, result2}
+	fake.deleteServiceAppBindingByGUIDReturnsOnCall[i] = struct {
+		result1 chan v7action.PollJobEvent
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
 }
 
-func (fake *FakeActor) DeleteServiceInstance(arg1 string, arg2 string) (chan v7action.PollJobEvent, v7action.Warnings, error) {
+func (fake *FakeActor) DeleteServiceAppBindings(arg1 v7action.DeleteServiceAppBindingsParams) ([]v7action.ServiceAppBindingJob, v7action.Warnings, error) {
+	fake.deleteServiceAppBindingsMutex.Lock()
+	ret, specificReturn := fake.deleteServiceAppBindingsReturnsOnCall[len(fake.deleteServiceAppBindingsArgsForCall)]
+	fake.deleteServiceAppBindingsArgsForCall = append(fake.deleteServiceAppBindingsArgsForCall, struct {
+		arg1 v7action.DeleteServiceAppBindingsParams
+	}{arg1})
+	stub := fake.DeleteServiceAppBindingsStub
+	fakeReturns := fake.deleteServiceAppBindingsReturns
+	fake.recordInvocation("DeleteServiceAppBindings", []interface{}{arg1})
+	fake.deleteServiceAppBindingsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) DeleteServiceAppBindingsCallCount() int {
+	fake.deleteServiceAppBindingsMutex.RLock()
+	defer fake.deleteServiceAppBindingsMutex.RUnlock()
+	return len(fake.deleteServiceAppBindingsArgsForCall)
+}
+
+func (fake *FakeActor) DeleteServiceAppBindingsCalls(stub func(v7action.DeleteServiceAppBindingsParams) ([]v7action.ServiceAppBindingJob, v7action.Warnings, error)) {
+	fake.deleteServiceAppBindingsMutex.Lock()
+	defer fake.deleteServiceAppBindingsMutex.Unlock()
+	fake.DeleteServiceAppBindingsStub = stub
+}
+
+func (fake *FakeActor) DeleteServiceAppBindingsArgsForCall(i int) v7action.DeleteServiceAppBindingsParams {
+	fake.deleteServiceAppBindingsMutex.RLock()
+	defer fake.deleteServiceAppBindingsMutex.RUnlock()
+	argsForCall := fake.deleteServiceAppBindingsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeActor) DeleteServiceAppBindingsReturns(result1 []v7action.ServiceAppBindingJob, result2 v7action.Warnings, result3 error) {
+	fake.deleteServiceAppBindingsMutex.Lock()
+	defer fake.deleteServiceAppBindingsMutex.Unlock()
+	fake.DeleteServiceAppBindingsStub = nil
+	fake.deleteServiceAppBindingsReturns = struct {
+		result1 []v7action.ServiceAppBindingJob
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) DeleteServiceAppBindingsReturnsOnCall(i int, result1 []v7action.ServiceAppBindingJob, result2 v7action.Warnings, result3 error) {
+	fake.deleteServiceAppBindingsMutex.Lock()
+	defer fake.deleteServiceAppBindingsMutex.Unlock()
+	fake.DeleteServiceAppBindingsStub = nil
+	if fake.deleteServiceAppBindingsReturnsOnCall == nil {
+		fake.deleteServiceAppBindingsReturnsOnCall = make(map[int]struct {
+			result1 []v7action.ServiceAppBindingJob
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.deleteServiceAppBindingsReturnsOnCall[i] = struct {
+		result1 []v7action.ServiceAppBindingJob
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) DeleteServiceBroker(arg1 string) (v7action.Warnings, error) {
+	fake.deleteServiceBrokerMutex.Lock()
+	ret, specificReturn := fake.deleteServiceBrokerReturnsOnCall[len(fake.deleteServiceBrokerArgsForCall)]
+	fake.deleteServiceBrokerArgsForCall = append(fake.deleteServiceBrokerArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.DeleteServiceBrokerStub
+	fakeReturns := fake.deleteServiceBrokerReturns
+	fake.recordInvocation("DeleteServiceBroker", []interface{}{arg1})
+	fake.deleteServiceBrokerMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeActor) DeleteServiceBrokerCallCount() int {
+	fake.deleteServiceBrokerMutex.RLock()
+	defer fake.deleteServiceBrokerMutex.RUnlock()
+	return len(fake.deleteServiceBrokerArgsForCall)
+}
+
+func (fake *FakeActor) DeleteServiceBrokerCalls(stub func(string) (v7action.Warnings, error)) {
+	fake.deleteServiceBrokerMutex.Lock()
+	defer fake.deleteServiceBrokerMutex.Unlock()
+	fake.DeleteServiceBrokerStub = stub
+}
+
+func (fake *FakeActor) DeleteServiceBrokerArgsForCall(i int) string {
+	fake.deleteServiceBrokerMutex.RLock()
+	defer fake.deleteServiceBrokerMutex.RUnlock()
+	argsForCall := fake.deleteServiceBrokerArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeActor) DeleteServiceBrokerReturns(result1 v7action.Warnings, result2 error) {
+	fake.deleteServiceBrokerMutex.Lock()
+	defer fake.deleteServiceBrokerMutex.Unlock()
+	fake.DeleteServiceBrokerStub = nil
+	fake.deleteServiceBrokerReturns = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeActor) DeleteServiceBrokerReturnsOnCall(i int, result1 v7action.Warnings, result2 error) {
+	fake.deleteServiceBrokerMutex.Lock()
+	defer fake.deleteServiceBrokerMutex.Unlock()
+	fake.DeleteServiceBrokerStub = nil
+	if fake.deleteServiceBrokerReturnsOnCall == nil {
+		fake.deleteServiceBrokerReturnsOnCall = make(map[int]struct {
+			result1 v7action.Warnings
+			result2 error
+		})
+	}
+	fake.deleteServiceBrokerReturnsOnCall[i] = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeActor) DeleteServiceInstance(arg1 string, arg2 string) (chan v7action.PollJobEvent, v7action.Warnings, error) {
 	fake.deleteServiceInstanceMutex.Lock()
 	ret, specificReturn := fake.deleteServiceInstanceReturnsOnCall[len(fake.deleteServiceInstanceArgsForCall)]
 	fake.deleteServiceInstanceArgsForCall = append(fake.deleteServiceInstanceArgsForCall, struct {
@@ -7374,6 +8330,75 @@ func (fake *FakeActor) DeleteSpaceRoleReturnsOnCall(i int, result1 v7action.Warn
 	}{result1, result2}
 }
 
+func (fake *FakeActor) DeleteStaleResources(arg1 []v7action.StaleResource) (v7action.Warnings, error) {
+	var arg1Copy []v7action.StaleResource
+	if arg1 != nil {
+		arg1Copy = make([]v7action.StaleResource, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.deleteStaleResourcesMutex.Lock()
+	ret, specificReturn := fake.deleteStaleResourcesReturnsOnCall[len(fake.deleteStaleResourcesArgsForCall)]
+	fake.deleteStaleResourcesArgsForCall = append(fake.deleteStaleResourcesArgsForCall, struct {
+		arg1 []v7action.StaleResource
+	}{arg1Copy})
+	stub := fake.DeleteStaleResourcesStub
+	fakeReturns := fake.deleteStaleResourcesReturns
+	fake.recordInvocation("DeleteStaleResources", []interface{}{arg1Copy})
+	fake.deleteStaleResourcesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeActor) DeleteStaleResourcesCallCount() int {
+	fake.deleteStaleResourcesMutex.RLock()
+	defer fake.deleteStaleResourcesMutex.RUnlock()
+	return len(fake.deleteStaleResourcesArgsForCall)
+}
+
+func (fake *FakeActor) DeleteStaleResourcesCalls(stub func([]v7action.StaleResource) (v7action.Warnings, error)) {
+	fake.deleteStaleResourcesMutex.Lock()
+	defer fake.deleteStaleResourcesMutex.Unlock()
+	fake.DeleteStaleResourcesStub = stub
+}
+
+func (fake *FakeActor) DeleteStaleResourcesArgsForCall(i int) []v7action.StaleResource {
+	fake.deleteStaleResourcesMutex.RLock()
+	defer fake.deleteStaleResourcesMutex.RUnlock()
+	argsForCall := fake.deleteStaleResourcesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeActor) DeleteStaleResourcesReturns(result1 v7action.Warnings, result2 error) {
+	fake.deleteStaleResourcesMutex.Lock()
+	defer fake.deleteStaleResourcesMutex.Unlock()
+	fake.DeleteStaleResourcesStub = nil
+	fake.deleteStaleResourcesReturns = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeActor) DeleteStaleResourcesReturnsOnCall(i int, result1 v7action.Warnings, result2 error) {
+	fake.deleteStaleResourcesMutex.Lock()
+	defer fake.deleteStaleResourcesMutex.Unlock()
+	fake.DeleteStaleResourcesStub = nil
+	if fake.deleteStaleResourcesReturnsOnCall == nil {
+		fake.deleteStaleResourcesReturnsOnCall = make(map[int]struct {
+			result1 v7action.Warnings
+			result2 error
+		})
+	}
+	fake.deleteStaleResourcesReturnsOnCall[i] = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeActor) DeleteUser(arg1 string) (v7action.Warnings, error) {
 	fake.deleteUserMutex.Lock()
 	ret, specificReturn := fake.deleteUserReturnsOnCall[len(fake.deleteUserArgsForCall)]
@@ -7438,6 +8463,140 @@ func (fake *FakeActor) DeleteUserReturnsOnCall(i int, result1 v7action.Warnings,
 	}{result1, result2}
 }
 
+func (fake *FakeActor) DeleteUserRoles(arg1 []v7action.UserRole) (v7action.Warnings, error) {
+	var arg1Copy []v7action.UserRole
+	if arg1 != nil {
+		arg1Copy = make([]v7action.UserRole, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.deleteUserRolesMutex.Lock()
+	ret, specificReturn := fake.deleteUserRolesReturnsOnCall[len(fake.deleteUserRolesArgsForCall)]
+	fake.deleteUserRolesArgsForCall = append(fake.deleteUserRolesArgsForCall, struct {
+		arg1 []v7action.UserRole
+	}{arg1Copy})
+	stub := fake.DeleteUserRolesStub
+	fakeReturns := fake.deleteUserRolesReturns
+	fake.recordInvocation("DeleteUserRoles", []interface{}{arg1Copy})
+	fake.deleteUserRolesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeActor) DeleteUserRolesCallCount() int {
+	fake.deleteUserRolesMutex.RLock()
+	defer fake.deleteUserRolesMutex.RUnlock()
+	return len(fake.deleteUserRolesArgsForCall)
+}
+
+func (fake *FakeActor) DeleteUserRolesCalls(stub func([]v7action.UserRole) (v7action.Warnings, error)) {
+	fake.deleteUserRolesMutex.Lock()
+	defer fake.deleteUserRolesMutex.Unlock()
+	fake.DeleteUserRolesStub = stub
+}
+
+func (fake *FakeActor) DeleteUserRolesArgsForCall(i int) []v7action.UserRole {
+	fake.deleteUserRolesMutex.RLock()
+	defer fake.deleteUserRolesMutex.RUnlock()
+	argsForCall := fake.deleteUserRolesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeActor) DeleteUserRolesReturns(result1 v7action.Warnings, result2 error) {
+	fake.deleteUserRolesMutex.Lock()
+	defer fake.deleteUserRolesMutex.Unlock()
+	fake.DeleteUserRolesStub = nil
+	fake.deleteUserRolesReturns = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeActor) DeleteUserRolesReturnsOnCall(i int, result1 v7action.Warnings, result2 error) {
+	fake.deleteUserRolesMutex.Lock()
+	defer fake.deleteUserRolesMutex.Unlock()
+	fake.DeleteUserRolesStub = nil
+	if fake.deleteUserRolesReturnsOnCall == nil {
+		fake.deleteUserRolesReturnsOnCall = make(map[int]struct {
+			result1 v7action.Warnings
+			result2 error
+		})
+	}
+	fake.deleteUserRolesReturnsOnCall[i] = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeActor) DiffServiceBrokerCatalog(arg1 v7action.ServiceBrokerCatalog, arg2 string) ([]v7action.ServiceBrokerCatalogDifference, error) {
+	fake.diffServiceBrokerCatalogMutex.Lock()
+	ret, specificReturn := fake.diffServiceBrokerCatalogReturnsOnCall[len(fake.diffServiceBrokerCatalogArgsForCall)]
+	fake.diffServiceBrokerCatalogArgsForCall = append(fake.diffServiceBrokerCatalogArgsForCall, struct {
+		arg1 v7action.ServiceBrokerCatalog
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.DiffServiceBrokerCatalogStub
+	fakeReturns := fake.diffServiceBrokerCatalogReturns
+	fake.recordInvocation("DiffServiceBrokerCatalog", []interface{}{arg1, arg2})
+	fake.diffServiceBrokerCatalogMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeActor) DiffServiceBrokerCatalogCallCount() int {
+	fake.diffServiceBrokerCatalogMutex.RLock()
+	defer fake.diffServiceBrokerCatalogMutex.RUnlock()
+	return len(fake.diffServiceBrokerCatalogArgsForCall)
+}
+
+func (fake *FakeActor) DiffServiceBrokerCatalogCalls(stub func(v7action.ServiceBrokerCatalog, string) ([]v7action.ServiceBrokerCatalogDifference, error)) {
+	fake.diffServiceBrokerCatalogMutex.Lock()
+	defer fake.diffServiceBrokerCatalogMutex.Unlock()
+	fake.DiffServiceBrokerCatalogStub = stub
+}
+
+func (fake *FakeActor) DiffServiceBrokerCatalogArgsForCall(i int) (v7action.ServiceBrokerCatalog, string) {
+	fake.diffServiceBrokerCatalogMutex.RLock()
+	defer fake.diffServiceBrokerCatalogMutex.RUnlock()
+	argsForCall := fake.diffServiceBrokerCatalogArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeActor) DiffServiceBrokerCatalogReturns(result1 []v7action.ServiceBrokerCatalogDifference, result2 error) {
+	fake.diffServiceBrokerCatalogMutex.Lock()
+	defer fake.diffServiceBrokerCatalogMutex.Unlock()
+	fake.DiffServiceBrokerCatalogStub = nil
+	fake.diffServiceBrokerCatalogReturns = struct {
+		result1 []v7action.ServiceBrokerCatalogDifference
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeActor) DiffServiceBrokerCatalogReturnsOnCall(i int, result1 []v7action.ServiceBrokerCatalogDifference, result2 error) {
+	fake.diffServiceBrokerCatalogMutex.Lock()
+	defer fake.diffServiceBrokerCatalogMutex.Unlock()
+	fake.DiffServiceBrokerCatalogStub = nil
+	if fake.diffServiceBrokerCatalogReturnsOnCall == nil {
+		fake.diffServiceBrokerCatalogReturnsOnCall = make(map[int]struct {
+			result1 []v7action.ServiceBrokerCatalogDifference
+			result2 error
+		})
+	}
+	fake.diffServiceBrokerCatalogReturnsOnCall[i] = struct {
+		result1 []v7action.ServiceBrokerCatalogDifference
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeActor) DiffSpaceManifest(arg1 string, arg2 []byte) (resources.ManifestDiff, v7action.Warnings, error) {
 	var arg2Copy []byte
 	if arg2 != nil {
@@ -8052,6 +9211,73 @@ func (fake *FakeActor) GetAppFeatureReturnsOnCall(i int, result1 resources.Appli
 	}{result1, result2, result3}
 }
 
+func (fake *FakeActor) GetAppFeatures(arg1 string) ([]resources.ApplicationFeature, v7action.Warnings, error) {
+	fake.getAppFeaturesMutex.Lock()
+	ret, specificReturn := fake.getAppFeaturesReturnsOnCall[len(fake.getAppFeaturesArgsForCall)]
+	fake.getAppFeaturesArgsForCall = append(fake.getAppFeaturesArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.GetAppFeaturesStub
+	fakeReturns := fake.getAppFeaturesReturns
+	fake.recordInvocation("GetAppFeatures", []interface{}{arg1})
+	fake.getAppFeaturesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetAppFeaturesCallCount() int {
+	fake.getAppFeaturesMutex.RLock()
+	defer fake.getAppFeaturesMutex.RUnlock()
+	return len(fake.getAppFeaturesArgsForCall)
+}
+
+func (fake *FakeActor) GetAppFeaturesCalls(stub func(string) ([]resources.ApplicationFeature, v7action.Warnings, error)) {
+	fake.getAppFeaturesMutex.Lock()
+	defer fake.getAppFeaturesMutex.Unlock()
+	fake.GetAppFeaturesStub = stub
+}
+
+func (fake *FakeActor) GetAppFeaturesArgsForCall(i int) string {
+	fake.getAppFeaturesMutex.RLock()
+	defer fake.getAppFeaturesMutex.RUnlock()
+	argsForCall := fake.getAppFeaturesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeActor) GetAppFeaturesReturns(result1 []resources.ApplicationFeature, result2 v7action.Warnings, result3 error) {
+	fake.getAppFeaturesMutex.Lock()
+	defer fake.getAppFeaturesMutex.Unlock()
+	fake.GetAppFeaturesStub = nil
+	fake.getAppFeaturesReturns = struct {
+		result1 []resources.ApplicationFeature
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetAppFeaturesReturnsOnCall(i int, result1 []resources.ApplicationFeature, result2 v7action.Warnings, result3 error) {
+	fake.getAppFeaturesMutex.Lock()
+	defer fake.getAppFeaturesMutex.Unlock()
+	fake.GetAppFeaturesStub = nil
+	if fake.getAppFeaturesReturnsOnCall == nil {
+		fake.getAppFeaturesReturnsOnCall = make(map[int]struct {
+			result1 []resources.ApplicationFeature
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getAppFeaturesReturnsOnCall[i] = struct {
+		result1 []resources.ApplicationFeature
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeActor) GetAppSummariesForSpace(arg1 string, arg2 string, arg3 bool) ([]v7action.ApplicationSummary, v7action.Warnings, error) {
 	fake.getAppSummariesForSpaceMutex.Lock()
 	ret, specificReturn := fake.getAppSummariesForSpaceReturnsOnCall[len(fake.getAppSummariesForSpaceArgsForCall)]
@@ -8189,6 +9415,76 @@ func (fake *FakeActor) GetApplicationByNameAndSpaceReturnsOnCall(i int, result1
 	}{result1, result2, result3}
 }
 
+func (fake *FakeActor) GetApplicationCrashes(arg1 string, arg2 time.Time, arg3 int, arg4 sharedaction.LogCacheClient) ([]v7action.AppCrash, v7action.Warnings, error) {
+	fake.getApplicationCrashesMutex.Lock()
+	ret, specificReturn := fake.getApplicationCrashesReturnsOnCall[len(fake.getApplicationCrashesArgsForCall)]
+	fake.getApplicationCrashesArgsForCall = append(fake.getApplicationCrashesArgsForCall, struct {
+		arg1 string
+		arg2 time.Time
+		arg3 int
+		arg4 sharedaction.LogCacheClient
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.GetApplicationCrashesStub
+	fakeReturns := fake.getApplicationCrashesReturns
+	fake.recordInvocation("GetApplicationCrashes", []interface{}{arg1, arg2, arg3, arg4})
+	fake.getApplicationCrashesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetApplicationCrashesCallCount() int {
+	fake.getApplicationCrashesMutex.RLock()
+	defer fake.getApplicationCrashesMutex.RUnlock()
+	return len(fake.getApplicationCrashesArgsForCall)
+}
+
+func (fake *FakeActor) GetApplicationCrashesCalls(stub func(string, time.Time, int, sharedaction.LogCacheClient) ([]v7action.AppCrash, v7action.Warnings, error)) {
+	fake.getApplicationCrashesMutex.Lock()
+	defer fake.getApplicationCrashesMutex.Unlock()
+	fake.GetApplicationCrashesStub = stub
+}
+
+func (fake *FakeActor) GetApplicationCrashesArgsForCall(i int) (string, time.Time, int, sharedaction.LogCacheClient) {
+	fake.getApplicationCrashesMutex.RLock()
+	defer fake.getApplicationCrashesMutex.RUnlock()
+	argsForCall := fake.getApplicationCrashesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeActor) GetApplicationCrashesReturns(result1 []v7action.AppCrash, result2 v7action.Warnings, result3 error) {
+	fake.getApplicationCrashesMutex.Lock()
+	defer fake.getApplicationCrashesMutex.Unlock()
+	fake.GetApplicationCrashesStub = nil
+	fake.getApplicationCrashesReturns = struct {
+		result1 []v7action.AppCrash
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetApplicationCrashesReturnsOnCall(i int, result1 []v7action.AppCrash, result2 v7action.Warnings, result3 error) {
+	fake.getApplicationCrashesMutex.Lock()
+	defer fake.getApplicationCrashesMutex.Unlock()
+	fake.GetApplicationCrashesStub = nil
+	if fake.getApplicationCrashesReturnsOnCall == nil {
+		fake.getApplicationCrashesReturnsOnCall = make(map[int]struct {
+			result1 []v7action.AppCrash
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getApplicationCrashesReturnsOnCall[i] = struct {
+		result1 []v7action.AppCrash
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeActor) GetApplicationDroplets(arg1 string, arg2 string) ([]resources.Droplet, v7action.Warnings, error) {
 	fake.getApplicationDropletsMutex.Lock()
 	ret, specificReturn := fake.getApplicationDropletsReturnsOnCall[len(fake.getApplicationDropletsArgsForCall)]
@@ -8798,6 +10094,75 @@ func (fake *FakeActor) GetApplicationTasksReturnsOnCall(i int, result1 []resourc
 	}{result1, result2, result3}
 }
 
+func (fake *FakeActor) GetApplicationTasksWithFilter(arg1 string, arg2 v7action.SortOrder, arg3 v7action.TaskFilter) ([]resources.Task, v7action.Warnings, error) {
+	fake.getApplicationTasksWithFilterMutex.Lock()
+	ret, specificReturn := fake.getApplicationTasksWithFilterReturnsOnCall[len(fake.getApplicationTasksWithFilterArgsForCall)]
+	fake.getApplicationTasksWithFilterArgsForCall = append(fake.getApplicationTasksWithFilterArgsForCall, struct {
+		arg1 string
+		arg2 v7action.SortOrder
+		arg3 v7action.TaskFilter
+	}{arg1, arg2, arg3})
+	stub := fake.GetApplicationTasksWithFilterStub
+	fakeReturns := fake.getApplicationTasksWithFilterReturns
+	fake.recordInvocation("GetApplicationTasksWithFilter", []interface{}{arg1, arg2, arg3})
+	fake.getApplicationTasksWithFilterMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetApplicationTasksWithFilterCallCount() int {
+	fake.getApplicationTasksWithFilterMutex.RLock()
+	defer fake.getApplicationTasksWithFilterMutex.RUnlock()
+	return len(fake.getApplicationTasksWithFilterArgsForCall)
+}
+
+func (fake *FakeActor) GetApplicationTasksWithFilterCalls(stub func(string, v7action.SortOrder, v7action.TaskFilter) ([]resources.Task, v7action.Warnings, error)) {
+	fake.getApplicationTasksWithFilterMutex.Lock()
+	defer fake.getApplicationTasksWithFilterMutex.Unlock()
+	fake.GetApplicationTasksWithFilterStub = stub
+}
+
+func (fake *FakeActor) GetApplicationTasksWithFilterArgsForCall(i int) (string, v7action.SortOrder, v7action.TaskFilter) {
+	fake.getApplicationTasksWithFilterMutex.RLock()
+	defer fake.getApplicationTasksWithFilterMutex.RUnlock()
+	argsForCall := fake.getApplicationTasksWithFilterArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeActor) GetApplicationTasksWithFilterReturns(result1 []resources.Task, result2 v7action.Warnings, result3 error) {
+	fake.getApplicationTasksWithFilterMutex.Lock()
+	defer fake.getApplicationTasksWithFilterMutex.Unlock()
+	fake.GetApplicationTasksWithFilterStub = nil
+	fake.getApplicationTasksWithFilterReturns = struct {
+		result1 []resources.Task
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetApplicationTasksWithFilterReturnsOnCall(i int, result1 []resources.Task, result2 v7action.Warnings, result3 error) {
+	fake.getApplicationTasksWithFilterMutex.Lock()
+	defer fake.getApplicationTasksWithFilterMutex.Unlock()
+	fake.GetApplicationTasksWithFilterStub = nil
+	if fake.getApplicationTasksWithFilterReturnsOnCall == nil {
+		fake.getApplicationTasksWithFilterReturnsOnCall = make(map[int]struct {
+			result1 []resources.Task
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getApplicationTasksWithFilterReturnsOnCall[i] = struct {
+		result1 []resources.Task
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeActor) GetApplicationsByNamesAndSpace(arg1 []string, arg2 string) ([]resources.Application, v7action.Warnings, error) {
 	var arg1Copy []string
 	if arg1 != nil {
@@ -8871,6 +10236,74 @@ func (fake *FakeActor) GetApplicationsByNamesAndSpaceReturnsOnCall(i int, result
 	}{result1, result2, result3}
 }
 
+func (fake *FakeActor) GetApplicationsBySpaceAndLabelSelector(arg1 string, arg2 string) ([]resources.Application, v7action.Warnings, error) {
+	fake.getApplicationsBySpaceAndLabelSelectorMutex.Lock()
+	ret, specificReturn := fake.getApplicationsBySpaceAndLabelSelectorReturnsOnCall[len(fake.getApplicationsBySpaceAndLabelSelectorArgsForCall)]
+	fake.getApplicationsBySpaceAndLabelSelectorArgsForCall = append(fake.getApplicationsBySpaceAndLabelSelectorArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.GetApplicationsBySpaceAndLabelSelectorStub
+	fakeReturns := fake.getApplicationsBySpaceAndLabelSelectorReturns
+	fake.recordInvocation("GetApplicationsBySpaceAndLabelSelector", []interface{}{arg1, arg2})
+	fake.getApplicationsBySpaceAndLabelSelectorMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetApplicationsBySpaceAndLabelSelectorCallCount() int {
+	fake.getApplicationsBySpaceAndLabelSelectorMutex.RLock()
+	defer fake.getApplicationsBySpaceAndLabelSelectorMutex.RUnlock()
+	return len(fake.getApplicationsBySpaceAndLabelSelectorArgsForCall)
+}
+
+func (fake *FakeActor) GetApplicationsBySpaceAndLabelSelectorCalls(stub func(string, string) ([]resources.Application, v7action.Warnings, error)) {
+	fake.getApplicationsBySpaceAndLabelSelectorMutex.Lock()
+	defer fake.getApplicationsBySpaceAndLabelSelectorMutex.Unlock()
+	fake.GetApplicationsBySpaceAndLabelSelectorStub = stub
+}
+
+func (fake *FakeActor) GetApplicationsBySpaceAndLabelSelectorArgsForCall(i int) (string, string) {
+	fake.getApplicationsBySpaceAndLabelSelectorMutex.RLock()
+	defer fake.getApplicationsBySpaceAndLabelSelectorMutex.RUnlock()
+	argsForCall := fake.getApplicationsBySpaceAndLabelSelectorArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeActor) GetApplicationsBySpaceAndLabelSelectorReturns(result1 []resources.Application, result2 v7action.Warnings, result3 error) {
+	fake.getApplicationsBySpaceAndLabelSelectorMutex.Lock()
+	defer fake.getApplicationsBySpaceAndLabelSelectorMutex.Unlock()
+	fake.GetApplicationsBySpaceAndLabelSelectorStub = nil
+	fake.getApplicationsBySpaceAndLabelSelectorReturns = struct {
+		result1 []resources.Application
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetApplicationsBySpaceAndLabelSelectorReturnsOnCall(i int, result1 []resources.Application, result2 v7action.Warnings, result3 error) {
+	fake.getApplicationsBySpaceAndLabelSelectorMutex.Lock()
+	defer fake.getApplicationsBySpaceAndLabelSelectorMutex.Unlock()
+	fake.GetApplicationsBySpaceAndLabelSelectorStub = nil
+	if fake.getApplicationsBySpaceAndLabelSelectorReturnsOnCall == nil {
+		fake.getApplicationsBySpaceAndLabelSelectorReturnsOnCall = make(map[int]struct {
+			result1 []resources.Application
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getApplicationsBySpaceAndLabelSelectorReturnsOnCall[i] = struct {
+		result1 []resources.Application
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeActor) GetBuildpackLabels(arg1 string, arg2 string) (map[string]types.NullString, v7action.Warnings, error) {
 	fake.getBuildpackLabelsMutex.Lock()
 	ret, specificReturn := fake.getBuildpackLabelsReturnsOnCall[len(fake.getBuildpackLabelsArgsForCall)]
@@ -8939,6 +10372,73 @@ func (fake *FakeActor) GetBuildpackLabelsReturnsOnCall(i int, result1 map[string
 	}{result1, result2, result3}
 }
 
+func (fake *FakeActor) GetBuildpackUsage(arg1 string) ([]v7action.BuildpackUsage, v7action.Warnings, error) {
+	fake.getBuildpackUsageMutex.Lock()
+	ret, specificReturn := fake.getBuildpackUsageReturnsOnCall[len(fake.getBuildpackUsageArgsForCall)]
+	fake.getBuildpackUsageArgsForCall = append(fake.getBuildpackUsageArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.GetBuildpackUsageStub
+	fakeReturns := fake.getBuildpackUsageReturns
+	fake.recordInvocation("GetBuildpackUsage", []interface{}{arg1})
+	fake.getBuildpackUsageMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetBuildpackUsageCallCount() int {
+	fake.getBuildpackUsageMutex.RLock()
+	defer fake.getBuildpackUsageMutex.RUnlock()
+	return len(fake.getBuildpackUsageArgsForCall)
+}
+
+func (fake *FakeActor) GetBuildpackUsageCalls(stub func(string) ([]v7action.BuildpackUsage, v7action.Warnings, error)) {
+	fake.getBuildpackUsageMutex.Lock()
+	defer fake.getBuildpackUsageMutex.Unlock()
+	fake.GetBuildpackUsageStub = stub
+}
+
+func (fake *FakeActor) GetBuildpackUsageArgsForCall(i int) string {
+	fake.getBuildpackUsageMutex.RLock()
+	defer fake.getBuildpackUsageMutex.RUnlock()
+	argsForCall := fake.getBuildpackUsageArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeActor) GetBuildpackUsageReturns(result1 []v7action.BuildpackUsage, result2 v7action.Warnings, result3 error) {
+	fake.getBuildpackUsageMutex.Lock()
+	defer fake.getBuildpackUsageMutex.Unlock()
+	fake.GetBuildpackUsageStub = nil
+	fake.getBuildpackUsageReturns = struct {
+		result1 []v7action.BuildpackUsage
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetBuildpackUsageReturnsOnCall(i int, result1 []v7action.BuildpackUsage, result2 v7action.Warnings, result3 error) {
+	fake.getBuildpackUsageMutex.Lock()
+	defer fake.getBuildpackUsageMutex.Unlock()
+	fake.GetBuildpackUsageStub = nil
+	if fake.getBuildpackUsageReturnsOnCall == nil {
+		fake.getBuildpackUsageReturnsOnCall = make(map[int]struct {
+			result1 []v7action.BuildpackUsage
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getBuildpackUsageReturnsOnCall[i] = struct {
+		result1 []v7action.BuildpackUsage
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeActor) GetBuildpacks(arg1 string) ([]resources.Buildpack, v7action.Warnings, error) {
 	fake.getBuildpacksMutex.Lock()
 	ret, specificReturn := fake.getBuildpacksReturnsOnCall[len(fake.getBuildpacksArgsForCall)]
@@ -9006,6 +10506,73 @@ func (fake *FakeActor) GetBuildpacksReturnsOnCall(i int, result1 []resources.Bui
 	}{result1, result2, result3}
 }
 
+func (fake *FakeActor) GetCurrentDropletByApplication(arg1 string) (resources.Droplet, v7action.Warnings, error) {
+	fake.getCurrentDropletByApplicationMutex.Lock()
+	ret, specificReturn := fake.getCurrentDropletByApplicationReturnsOnCall[len(fake.getCurrentDropletByApplicationArgsForCall)]
+	fake.getCurrentDropletByApplicationArgsForCall = append(fake.getCurrentDropletByApplicationArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.GetCurrentDropletByApplicationStub
+	fakeReturns := fake.getCurrentDropletByApplicationReturns
+	fake.recordInvocation("GetCurrentDropletByApplication", []interface{}{arg1})
+	fake.getCurrentDropletByApplicationMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetCurrentDropletByApplicationCallCount() int {
+	fake.getCurrentDropletByApplicationMutex.RLock()
+	defer fake.getCurrentDropletByApplicationMutex.RUnlock()
+	return len(fake.getCurrentDropletByApplicationArgsForCall)
+}
+
+func (fake *FakeActor) GetCurrentDropletByApplicationCalls(stub func(string) (resources.Droplet, v7action.Warnings, error)) {
+	fake.getCurrentDropletByApplicationMutex.Lock()
+	defer fake.getCurrentDropletByApplicationMutex.Unlock()
+	fake.GetCurrentDropletByApplicationStub = stub
+}
+
+func (fake *FakeActor) GetCurrentDropletByApplicationArgsForCall(i int) string {
+	fake.getCurrentDropletByApplicationMutex.RLock()
+	defer fake.getCurrentDropletByApplicationMutex.RUnlock()
+	argsForCall := fake.getCurrentDropletByApplicationArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeActor) GetCurrentDropletByApplicationReturns(result1 resources.Droplet, result2 v7action.Warnings, result3 error) {
+	fake.getCurrentDropletByApplicationMutex.Lock()
+	defer fake.getCurrentDropletByApplicationMutex.Unlock()
+	fake.GetCurrentDropletByApplicationStub = nil
+	fake.getCurrentDropletByApplicationReturns = struct {
+		result1 resources.Droplet
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetCurrentDropletByApplicationReturnsOnCall(i int, result1 resources.Droplet, result2 v7action.Warnings, result3 error) {
+	fake.getCurrentDropletByApplicationMutex.Lock()
+	defer fake.getCurrentDropletByApplicationMutex.Unlock()
+	fake.GetCurrentDropletByApplicationStub = nil
+	if fake.getCurrentDropletByApplicationReturnsOnCall == nil {
+		fake.getCurrentDropletByApplicationReturnsOnCall = make(map[int]struct {
+			result1 resources.Droplet
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getCurrentDropletByApplicationReturnsOnCall[i] = struct {
+		result1 resources.Droplet
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeActor) GetCurrentUser() (configv3.User, error) {
 	fake.getCurrentUserMutex.Lock()
 	ret, specificReturn := fake.getCurrentUserReturnsOnCall[len(fake.getCurrentUserArgsForCall)]
@@ -9636,37 +11203,104 @@ func (fake *FakeActor) GetEnvironmentVariablesByApplicationNameAndSpaceCalls(stu
 	fake.GetEnvironmentVariablesByApplicationNameAndSpaceStub = stub
 }
 
-func (fake *FakeActor) GetEnvironmentVariablesByApplicationNameAndSpaceArgsForCall(i int) (string, string) {
-	fake.getEnvironmentVariablesByApplicationNameAndSpaceMutex.RLock()
-	defer fake.getEnvironmentVariablesByApplicationNameAndSpaceMutex.RUnlock()
-	argsForCall := fake.getEnvironmentVariablesByApplicationNameAndSpaceArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
+func (fake *FakeActor) GetEnvironmentVariablesByApplicationNameAndSpaceArgsForCall(i int) (string, string) {
+	fake.getEnvironmentVariablesByApplicationNameAndSpaceMutex.RLock()
+	defer fake.getEnvironmentVariablesByApplicationNameAndSpaceMutex.RUnlock()
+	argsForCall := fake.getEnvironmentVariablesByApplicationNameAndSpaceArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeActor) GetEnvironmentVariablesByApplicationNameAndSpaceReturns(result1 v7action.EnvironmentVariableGroups, result2 v7action.Warnings, result3 error) {
+	fake.getEnvironmentVariablesByApplicationNameAndSpaceMutex.Lock()
+	defer fake.getEnvironmentVariablesByApplicationNameAndSpaceMutex.Unlock()
+	fake.GetEnvironmentVariablesByApplicationNameAndSpaceStub = nil
+	fake.getEnvironmentVariablesByApplicationNameAndSpaceReturns = struct {
+		result1 v7action.EnvironmentVariableGroups
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetEnvironmentVariablesByApplicationNameAndSpaceReturnsOnCall(i int, result1 v7action.EnvironmentVariableGroups, result2 v7action.Warnings, result3 error) {
+	fake.getEnvironmentVariablesByApplicationNameAndSpaceMutex.Lock()
+	defer fake.getEnvironmentVariablesByApplicationNameAndSpaceMutex.Unlock()
+	fake.GetEnvironmentVariablesByApplicationNameAndSpaceStub = nil
+	if fake.getEnvironmentVariablesByApplicationNameAndSpaceReturnsOnCall == nil {
+		fake.getEnvironmentVariablesByApplicationNameAndSpaceReturnsOnCall = make(map[int]struct {
+			result1 v7action.EnvironmentVariableGroups
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getEnvironmentVariablesByApplicationNameAndSpaceReturnsOnCall[i] = struct {
+		result1 v7action.EnvironmentVariableGroups
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetEvents(arg1 v7action.EventFilter) ([]v7action.Event, v7action.Warnings, error) {
+	fake.getEventsMutex.Lock()
+	ret, specificReturn := fake.getEventsReturnsOnCall[len(fake.getEventsArgsForCall)]
+	fake.getEventsArgsForCall = append(fake.getEventsArgsForCall, struct {
+		arg1 v7action.EventFilter
+	}{arg1})
+	stub := fake.GetEventsStub
+	fakeReturns := fake.getEventsReturns
+	fake.recordInvocation("GetEvents", []interface{}{arg1})
+	fake.getEventsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetEventsCallCount() int {
+	fake.getEventsMutex.RLock()
+	defer fake.getEventsMutex.RUnlock()
+	return len(fake.getEventsArgsForCall)
+}
+
+func (fake *FakeActor) GetEventsCalls(stub func(v7action.EventFilter) ([]v7action.Event, v7action.Warnings, error)) {
+	fake.getEventsMutex.Lock()
+	defer fake.getEventsMutex.Unlock()
+	fake.GetEventsStub = stub
 }
 
-func (fake *FakeActor) GetEnvironmentVariablesByApplicationNameAndSpaceReturns(result1 v7action.EnvironmentVariableGroups, result2 v7action.Warnings, result3 error) {
-	fake.getEnvironmentVariablesByApplicationNameAndSpaceMutex.Lock()
-	defer fake.getEnvironmentVariablesByApplicationNameAndSpaceMutex.Unlock()
-	fake.GetEnvironmentVariablesByApplicationNameAndSpaceStub = nil
-	fake.getEnvironmentVariablesByApplicationNameAndSpaceReturns = struct {
-		result1 v7action.EnvironmentVariableGroups
+func (fake *FakeActor) GetEventsArgsForCall(i int) v7action.EventFilter {
+	fake.getEventsMutex.RLock()
+	defer fake.getEventsMutex.RUnlock()
+	argsForCall := fake.getEventsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeActor) GetEventsReturns(result1 []v7action.Event, result2 v7action.Warnings, result3 error) {
+	fake.getEventsMutex.Lock()
+	defer fake.getEventsMutex.Unlock()
+	fake.GetEventsStub = nil
+	fake.getEventsReturns = struct {
+		result1 []v7action.Event
 		result2 v7action.Warnings
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeActor) GetEnvironmentVariablesByApplicationNameAndSpaceReturnsOnCall(i int, result1 v7action.EnvironmentVariableGroups, result2 v7action.Warnings, result3 error) {
-	fake.getEnvironmentVariablesByApplicationNameAndSpaceMutex.Lock()
-	defer fake.getEnvironmentVariablesByApplicationNameAndSpaceMutex.Unlock()
-	fake.GetEnvironmentVariablesByApplicationNameAndSpaceStub = nil
-	if fake.getEnvironmentVariablesByApplicationNameAndSpaceReturnsOnCall == nil {
-		fake.getEnvironmentVariablesByApplicationNameAndSpaceReturnsOnCall = make(map[int]struct {
-			result1 v7action.EnvironmentVariableGroups
+func (fake *FakeActor) GetEventsReturnsOnCall(i int, result1 []v7action.Event, result2 v7action.Warnings, result3 error) {
+	fake.getEventsMutex.Lock()
+	defer fake.getEventsMutex.Unlock()
+	fake.GetEventsStub = nil
+	if fake.getEventsReturnsOnCall == nil {
+		fake.getEventsReturnsOnCall = make(map[int]struct {
+			result1 []v7action.Event
 			result2 v7action.Warnings
 			result3 error
 		})
 	}
-	fake.getEnvironmentVariablesByApplicationNameAndSpaceReturnsOnCall[i] = struct {
-		result1 v7action.EnvironmentVariableGroups
+	fake.getEventsReturnsOnCall[i] = struct {
+		result1 []v7action.Event
 		result2 v7action.Warnings
 		result3 error
 	}{result1, result2, result3}
@@ -11090,6 +12724,141 @@ func (fake *FakeActor) GetProcessByTypeAndApplicationReturnsOnCall(i int, result
 	}{result1, result2, result3}
 }
 
+func (fake *FakeActor) GetProcessInstances(arg1 string) ([]v7action.ProcessInstance, v7action.Warnings, error) {
+	fake.getProcessInstancesMutex.Lock()
+	ret, specificReturn := fake.getProcessInstancesReturnsOnCall[len(fake.getProcessInstancesArgsForCall)]
+	fake.getProcessInstancesArgsForCall = append(fake.getProcessInstancesArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.GetProcessInstancesStub
+	fakeReturns := fake.getProcessInstancesReturns
+	fake.recordInvocation("GetProcessInstances", []interface{}{arg1})
+	fake.getProcessInstancesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetProcessInstancesCallCount() int {
+	fake.getProcessInstancesMutex.RLock()
+	defer fake.getProcessInstancesMutex.RUnlock()
+	return len(fake.getProcessInstancesArgsForCall)
+}
+
+func (fake *FakeActor) GetProcessInstancesCalls(stub func(string) ([]v7action.ProcessInstance, v7action.Warnings, error)) {
+	fake.getProcessInstancesMutex.Lock()
+	defer fake.getProcessInstancesMutex.Unlock()
+	fake.GetProcessInstancesStub = stub
+}
+
+func (fake *FakeActor) GetProcessInstancesArgsForCall(i int) string {
+	fake.getProcessInstancesMutex.RLock()
+	defer fake.getProcessInstancesMutex.RUnlock()
+	argsForCall := fake.getProcessInstancesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeActor) GetProcessInstancesReturns(result1 []v7action.ProcessInstance, result2 v7action.Warnings, result3 error) {
+	fake.getProcessInstancesMutex.Lock()
+	defer fake.getProcessInstancesMutex.Unlock()
+	fake.GetProcessInstancesStub = nil
+	fake.getProcessInstancesReturns = struct {
+		result1 []v7action.ProcessInstance
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetProcessInstancesReturnsOnCall(i int, result1 []v7action.ProcessInstance, result2 v7action.Warnings, result3 error) {
+	fake.getProcessInstancesMutex.Lock()
+	defer fake.getProcessInstancesMutex.Unlock()
+	fake.GetProcessInstancesStub = nil
+	if fake.getProcessInstancesReturnsOnCall == nil {
+		fake.getProcessInstancesReturnsOnCall = make(map[int]struct {
+			result1 []v7action.ProcessInstance
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getProcessInstancesReturnsOnCall[i] = struct {
+		result1 []v7action.ProcessInstance
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetQuotaUsage(arg1 string, arg2 bool) ([]v7action.QuotaUsage, v7action.Warnings, error) {
+	fake.getQuotaUsageMutex.Lock()
+	ret, specificReturn := fake.getQuotaUsageReturnsOnCall[len(fake.getQuotaUsageArgsForCall)]
+	fake.getQuotaUsageArgsForCall = append(fake.getQuotaUsageArgsForCall, struct {
+		arg1 string
+		arg2 bool
+	}{arg1, arg2})
+	stub := fake.GetQuotaUsageStub
+	fakeReturns := fake.getQuotaUsageReturns
+	fake.recordInvocation("GetQuotaUsage", []interface{}{arg1, arg2})
+	fake.getQuotaUsageMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetQuotaUsageCallCount() int {
+	fake.getQuotaUsageMutex.RLock()
+	defer fake.getQuotaUsageMutex.RUnlock()
+	return len(fake.getQuotaUsageArgsForCall)
+}
+
+func (fake *FakeActor) GetQuotaUsageCalls(stub func(string, bool) ([]v7action.QuotaUsage, v7action.Warnings, error)) {
+	fake.getQuotaUsageMutex.Lock()
+	defer fake.getQuotaUsageMutex.Unlock()
+	fake.GetQuotaUsageStub = stub
+}
+
+func (fake *FakeActor) GetQuotaUsageArgsForCall(i int) (string, bool) {
+	fake.getQuotaUsageMutex.RLock()
+	defer fake.getQuotaUsageMutex.RUnlock()
+	argsForCall := fake.getQuotaUsageArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeActor) GetQuotaUsageReturns(result1 []v7action.QuotaUsage, result2 v7action.Warnings, result3 error) {
+	fake.getQuotaUsageMutex.Lock()
+	defer fake.getQuotaUsageMutex.Unlock()
+	fake.GetQuotaUsageStub = nil
+	fake.getQuotaUsageReturns = struct {
+		result1 []v7action.QuotaUsage
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetQuotaUsageReturnsOnCall(i int, result1 []v7action.QuotaUsage, result2 v7action.Warnings, result3 error) {
+	fake.getQuotaUsageMutex.Lock()
+	defer fake.getQuotaUsageMutex.Unlock()
+	fake.GetQuotaUsageStub = nil
+	if fake.getQuotaUsageReturnsOnCall == nil {
+		fake.getQuotaUsageReturnsOnCall = make(map[int]struct {
+			result1 []v7action.QuotaUsage
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getQuotaUsageReturnsOnCall[i] = struct {
+		result1 []v7action.QuotaUsage
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeActor) GetRawApplicationManifestByNameAndSpace(arg1 string, arg2 string) ([]byte, v7action.Warnings, error) {
 	fake.getRawApplicationManifestByNameAndSpaceMutex.Lock()
 	ret, specificReturn := fake.getRawApplicationManifestByNameAndSpaceReturnsOnCall[len(fake.getRawApplicationManifestByNameAndSpaceArgsForCall)]
@@ -11295,6 +13064,72 @@ func (fake *FakeActor) GetRecentLogsForApplicationByNameAndSpaceReturnsOnCall(i
 	}{result1, result2, result3}
 }
 
+func (fake *FakeActor) GetRecentLogsForTask(arg1 string, arg2 resources.Task, arg3 sharedaction.LogCacheClient) ([]sharedaction.LogMessage, error) {
+	fake.getRecentLogsForTaskMutex.Lock()
+	ret, specificReturn := fake.getRecentLogsForTaskReturnsOnCall[len(fake.getRecentLogsForTaskArgsForCall)]
+	fake.getRecentLogsForTaskArgsForCall = append(fake.getRecentLogsForTaskArgsForCall, struct {
+		arg1 string
+		arg2 resources.Task
+		arg3 sharedaction.LogCacheClient
+	}{arg1, arg2, arg3})
+	stub := fake.GetRecentLogsForTaskStub
+	fakeReturns := fake.getRecentLogsForTaskReturns
+	fake.recordInvocation("GetRecentLogsForTask", []interface{}{arg1, arg2, arg3})
+	fake.getRecentLogsForTaskMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeActor) GetRecentLogsForTaskCallCount() int {
+	fake.getRecentLogsForTaskMutex.RLock()
+	defer fake.getRecentLogsForTaskMutex.RUnlock()
+	return len(fake.getRecentLogsForTaskArgsForCall)
+}
+
+func (fake *FakeActor) GetRecentLogsForTaskCalls(stub func(string, resources.Task, sharedaction.LogCacheClient) ([]sharedaction.LogMessage, error)) {
+	fake.getRecentLogsForTaskMutex.Lock()
+	defer fake.getRecentLogsForTaskMutex.Unlock()
+	fake.GetRecentLogsForTaskStub = stub
+}
+
+func (fake *FakeActor) GetRecentLogsForTaskArgsForCall(i int) (string, resources.Task, sharedaction.LogCacheClient) {
+	fake.getRecentLogsForTaskMutex.RLock()
+	defer fake.getRecentLogsForTaskMutex.RUnlock()
+	argsForCall := fake.getRecentLogsForTaskArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeActor) GetRecentLogsForTaskReturns(result1 []sharedaction.LogMessage, result2 error) {
+	fake.getRecentLogsForTaskMutex.Lock()
+	defer fake.getRecentLogsForTaskMutex.Unlock()
+	fake.GetRecentLogsForTaskStub = nil
+	fake.getRecentLogsForTaskReturns = struct {
+		result1 []sharedaction.LogMessage
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeActor) GetRecentLogsForTaskReturnsOnCall(i int, result1 []sharedaction.LogMessage, result2 error) {
+	fake.getRecentLogsForTaskMutex.Lock()
+	defer fake.getRecentLogsForTaskMutex.Unlock()
+	fake.GetRecentLogsForTaskStub = nil
+	if fake.getRecentLogsForTaskReturnsOnCall == nil {
+		fake.getRecentLogsForTaskReturnsOnCall = make(map[int]struct {
+			result1 []sharedaction.LogMessage
+			result2 error
+		})
+	}
+	fake.getRecentLogsForTaskReturnsOnCall[i] = struct {
+		result1 []sharedaction.LogMessage
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeActor) GetRevisionByApplicationAndVersion(arg1 string, arg2 int) (resources.Revision, v7action.Warnings, error) {
 	fake.getRevisionByApplicationAndVersionMutex.Lock()
 	ret, specificReturn := fake.getRevisionByApplicationAndVersionReturnsOnCall[len(fake.getRevisionByApplicationAndVersionArgsForCall)]
@@ -11413,35 +13248,235 @@ func (fake *FakeActor) GetRevisionsByApplicationNameAndSpaceReturns(result1 []re
 	}{result1, result2, result3}
 }
 
-func (fake *FakeActor) GetRevisionsByApplicationNameAndSpaceReturnsOnCall(i int, result1 []resources.Revision, result2 v7action.Warnings, result3 error) {
-	fake.getRevisionsByApplicationNameAndSpaceMutex.Lock()
-	defer fake.getRevisionsByApplicationNameAndSpaceMutex.Unlock()
-	fake.GetRevisionsByApplicationNameAndSpaceStub = nil
-	if fake.getRevisionsByApplicationNameAndSpaceReturnsOnCall == nil {
-		fake.getRevisionsByApplicationNameAndSpaceReturnsOnCall = make(map[int]struct {
-			result1 []resources.Revision
+func (fake *FakeActor) GetRevisionsByApplicationNameAndSpaceReturnsOnCall(i int, result1 []resources.Revision, result2 v7action.Warnings, result3 error) {
+	fake.getRevisionsByApplicationNameAndSpaceMutex.Lock()
+	defer fake.getRevisionsByApplicationNameAndSpaceMutex.Unlock()
+	fake.GetRevisionsByApplicationNameAndSpaceStub = nil
+	if fake.getRevisionsByApplicationNameAndSpaceReturnsOnCall == nil {
+		fake.getRevisionsByApplicationNameAndSpaceReturnsOnCall = make(map[int]struct {
+			result1 []resources.Revision
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getRevisionsByApplicationNameAndSpaceReturnsOnCall[i] = struct {
+		result1 []resources.Revision
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetRoleChanges(arg1 []v7action.RoleAssignment, arg2 bool) ([]v7action.RoleChange, v7action.Warnings, error) {
+	var arg1Copy []v7action.RoleAssignment
+	if arg1 != nil {
+		arg1Copy = make([]v7action.RoleAssignment, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.getRoleChangesMutex.Lock()
+	ret, specificReturn := fake.getRoleChangesReturnsOnCall[len(fake.getRoleChangesArgsForCall)]
+	fake.getRoleChangesArgsForCall = append(fake.getRoleChangesArgsForCall, struct {
+		arg1 []v7action.RoleAssignment
+		arg2 bool
+	}{arg1Copy, arg2})
+	stub := fake.GetRoleChangesStub
+	fakeReturns := fake.getRoleChangesReturns
+	fake.recordInvocation("GetRoleChanges", []interface{}{arg1Copy, arg2})
+	fake.getRoleChangesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetRoleChangesCallCount() int {
+	fake.getRoleChangesMutex.RLock()
+	defer fake.getRoleChangesMutex.RUnlock()
+	return len(fake.getRoleChangesArgsForCall)
+}
+
+func (fake *FakeActor) GetRoleChangesCalls(stub func([]v7action.RoleAssignment, bool) ([]v7action.RoleChange, v7action.Warnings, error)) {
+	fake.getRoleChangesMutex.Lock()
+	defer fake.getRoleChangesMutex.Unlock()
+	fake.GetRoleChangesStub = stub
+}
+
+func (fake *FakeActor) GetRoleChangesArgsForCall(i int) ([]v7action.RoleAssignment, bool) {
+	fake.getRoleChangesMutex.RLock()
+	defer fake.getRoleChangesMutex.RUnlock()
+	argsForCall := fake.getRoleChangesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeActor) GetRoleChangesReturns(result1 []v7action.RoleChange, result2 v7action.Warnings, result3 error) {
+	fake.getRoleChangesMutex.Lock()
+	defer fake.getRoleChangesMutex.Unlock()
+	fake.GetRoleChangesStub = nil
+	fake.getRoleChangesReturns = struct {
+		result1 []v7action.RoleChange
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetRoleChangesReturnsOnCall(i int, result1 []v7action.RoleChange, result2 v7action.Warnings, result3 error) {
+	fake.getRoleChangesMutex.Lock()
+	defer fake.getRoleChangesMutex.Unlock()
+	fake.GetRoleChangesStub = nil
+	if fake.getRoleChangesReturnsOnCall == nil {
+		fake.getRoleChangesReturnsOnCall = make(map[int]struct {
+			result1 []v7action.RoleChange
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getRoleChangesReturnsOnCall[i] = struct {
+		result1 []v7action.RoleChange
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetRootResponse() (v7action.Root, v7action.Warnings, error) {
+	fake.getRootResponseMutex.Lock()
+	ret, specificReturn := fake.getRootResponseReturnsOnCall[len(fake.getRootResponseArgsForCall)]
+	fake.getRootResponseArgsForCall = append(fake.getRootResponseArgsForCall, struct {
+	}{})
+	stub := fake.GetRootResponseStub
+	fakeReturns := fake.getRootResponseReturns
+	fake.recordInvocation("GetRootResponse", []interface{}{})
+	fake.getRootResponseMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetRootResponseCallCount() int {
+	fake.getRootResponseMutex.RLock()
+	defer fake.getRootResponseMutex.RUnlock()
+	return len(fake.getRootResponseArgsForCall)
+}
+
+func (fake *FakeActor) GetRootResponseCalls(stub func() (v7action.Root, v7action.Warnings, error)) {
+	fake.getRootResponseMutex.Lock()
+	defer fake.getRootResponseMutex.Unlock()
+	fake.GetRootResponseStub = stub
+}
+
+func (fake *FakeActor) GetRootResponseReturns(result1 v7action.Root, result2 v7action.Warnings, result3 error) {
+	fake.getRootResponseMutex.Lock()
+	defer fake.getRootResponseMutex.Unlock()
+	fake.GetRootResponseStub = nil
+	fake.getRootResponseReturns = struct {
+		result1 v7action.Root
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetRootResponseReturnsOnCall(i int, result1 v7action.Root, result2 v7action.Warnings, result3 error) {
+	fake.getRootResponseMutex.Lock()
+	defer fake.getRootResponseMutex.Unlock()
+	fake.GetRootResponseStub = nil
+	if fake.getRootResponseReturnsOnCall == nil {
+		fake.getRootResponseReturnsOnCall = make(map[int]struct {
+			result1 v7action.Root
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getRootResponseReturnsOnCall[i] = struct {
+		result1 v7action.Root
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetRouteBindingSummaries(arg1 v7action.GetRouteBindingSummariesParams) ([]v7action.RouteBindingSummary, v7action.Warnings, error) {
+	fake.getRouteBindingSummariesMutex.Lock()
+	ret, specificReturn := fake.getRouteBindingSummariesReturnsOnCall[len(fake.getRouteBindingSummariesArgsForCall)]
+	fake.getRouteBindingSummariesArgsForCall = append(fake.getRouteBindingSummariesArgsForCall, struct {
+		arg1 v7action.GetRouteBindingSummariesParams
+	}{arg1})
+	stub := fake.GetRouteBindingSummariesStub
+	fakeReturns := fake.getRouteBindingSummariesReturns
+	fake.recordInvocation("GetRouteBindingSummaries", []interface{}{arg1})
+	fake.getRouteBindingSummariesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetRouteBindingSummariesCallCount() int {
+	fake.getRouteBindingSummariesMutex.RLock()
+	defer fake.getRouteBindingSummariesMutex.RUnlock()
+	return len(fake.getRouteBindingSummariesArgsForCall)
+}
+
+func (fake *FakeActor) GetRouteBindingSummariesCalls(stub func(v7action.GetRouteBindingSummariesParams) ([]v7action.RouteBindingSummary, v7action.Warnings, error)) {
+	fake.getRouteBindingSummariesMutex.Lock()
+	defer fake.getRouteBindingSummariesMutex.Unlock()
+	fake.GetRouteBindingSummariesStub = stub
+}
+
+func (fake *FakeActor) GetRouteBindingSummariesArgsForCall(i int) v7action.GetRouteBindingSummariesParams {
+	fake.getRouteBindingSummariesMutex.RLock()
+	defer fake.getRouteBindingSummariesMutex.RUnlock()
+	argsForCall := fake.getRouteBindingSummariesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeActor) GetRouteBindingSummariesReturns(result1 []v7action.RouteBindingSummary, result2 v7action.Warnings, result3 error) {
+	fake.getRouteBindingSummariesMutex.Lock()
+	defer fake.getRouteBindingSummariesMutex.Unlock()
+	fake.GetRouteBindingSummariesStub = nil
+	fake.getRouteBindingSummariesReturns = struct {
+		result1 []v7action.RouteBindingSummary
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetRouteBindingSummariesReturnsOnCall(i int, result1 []v7action.RouteBindingSummary, result2 v7action.Warnings, result3 error) {
+	fake.getRouteBindingSummariesMutex.Lock()
+	defer fake.getRouteBindingSummariesMutex.Unlock()
+	fake.GetRouteBindingSummariesStub = nil
+	if fake.getRouteBindingSummariesReturnsOnCall == nil {
+		fake.getRouteBindingSummariesReturnsOnCall = make(map[int]struct {
+			result1 []v7action.RouteBindingSummary
 			result2 v7action.Warnings
 			result3 error
 		})
 	}
-	fake.getRevisionsByApplicationNameAndSpaceReturnsOnCall[i] = struct {
-		result1 []resources.Revision
+	fake.getRouteBindingSummariesReturnsOnCall[i] = struct {
+		result1 []v7action.RouteBindingSummary
 		result2 v7action.Warnings
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeActor) GetRootResponse() (v7action.Root, v7action.Warnings, error) {
-	fake.getRootResponseMutex.Lock()
-	ret, specificReturn := fake.getRootResponseReturnsOnCall[len(fake.getRootResponseArgsForCall)]
-	fake.getRootResponseArgsForCall = append(fake.getRootResponseArgsForCall, struct {
-	}{})
-	stub := fake.GetRootResponseStub
-	fakeReturns := fake.getRootResponseReturns
-	fake.recordInvocation("GetRootResponse", []interface{}{})
-	fake.getRootResponseMutex.Unlock()
+func (fake *FakeActor) GetRouteBindingSummaryForRoute(arg1 resources.Route) (v7action.RouteBindingSummary, v7action.Warnings, error) {
+	fake.getRouteBindingSummaryForRouteMutex.Lock()
+	ret, specificReturn := fake.getRouteBindingSummaryForRouteReturnsOnCall[len(fake.getRouteBindingSummaryForRouteArgsForCall)]
+	fake.getRouteBindingSummaryForRouteArgsForCall = append(fake.getRouteBindingSummaryForRouteArgsForCall, struct {
+		arg1 resources.Route
+	}{arg1})
+	stub := fake.GetRouteBindingSummaryForRouteStub
+	fakeReturns := fake.getRouteBindingSummaryForRouteReturns
+	fake.recordInvocation("GetRouteBindingSummaryForRoute", []interface{}{arg1})
+	fake.getRouteBindingSummaryForRouteMutex.Unlock()
 	if stub != nil {
-		return stub()
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
@@ -11449,42 +13484,49 @@ func (fake *FakeActor) GetRootResponse() (v7action.Root, v7action.Warnings, erro
 	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
 }
 
-func (fake *FakeActor) GetRootResponseCallCount() int {
-	fake.getRootResponseMutex.RLock()
-	defer fake.getRootResponseMutex.RUnlock()
-	return len(fake.getRootResponseArgsForCall)
+func (fake *FakeActor) GetRouteBindingSummaryForRouteCallCount() int {
+	fake.getRouteBindingSummaryForRouteMutex.RLock()
+	defer fake.getRouteBindingSummaryForRouteMutex.RUnlock()
+	return len(fake.getRouteBindingSummaryForRouteArgsForCall)
 }
 
-func (fake *FakeActor) GetRootResponseCalls(stub func() (v7action.Root, v7action.Warnings, error)) {
-	fake.getRootResponseMutex.Lock()
-	defer fake.getRootResponseMutex.Unlock()
-	fake.GetRootResponseStub = stub
+func (fake *FakeActor) GetRouteBindingSummaryForRouteCalls(stub func(resources.Route) (v7action.RouteBindingSummary, v7action.Warnings, error)) {
+	fake.getRouteBindingSummaryForRouteMutex.Lock()
+	defer fake.getRouteBindingSummaryForRouteMutex.Unlock()
+	fake.GetRouteBindingSummaryForRouteStub = stub
 }
 
-func (fake *FakeActor) GetRootResponseReturns(result1 v7action.Root, result2 v7action.Warnings, result3 error) {
-	fake.getRootResponseMutex.Lock()
-	defer fake.getRootResponseMutex.Unlock()
-	fake.GetRootResponseStub = nil
-	fake.getRootResponseReturns = struct {
-		result1 v7action.Root
+func (fake *FakeActor) GetRouteBindingSummaryForRouteArgsForCall(i int) resources.Route {
+	fake.getRouteBindingSummaryForRouteMutex.RLock()
+	defer fake.getRouteBindingSummaryForRouteMutex.RUnlock()
+	argsForCall := fake.getRouteBindingSummaryForRouteArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeActor) GetRouteBindingSummaryForRouteReturns(result1 v7action.RouteBindingSummary, result2 v7action.Warnings, result3 error) {
+	fake.getRouteBindingSummaryForRouteMutex.Lock()
+	defer fake.getRouteBindingSummaryForRouteMutex.Unlock()
+	fake.GetRouteBindingSummaryForRouteStub = nil
+	fake.getRouteBindingSummaryForRouteReturns = struct {
+		result1 v7action.RouteBindingSummary
 		result2 v7action.Warnings
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeActor) GetRootResponseReturnsOnCall(i int, result1 v7action.Root, result2 v7action.Warnings, result3 error) {
-	fake.getRootResponseMutex.Lock()
-	defer fake.getRootResponseMutex.Unlock()
-	fake.GetRootResponseStub = nil
-	if fake.getRootResponseReturnsOnCall == nil {
-		fake.getRootResponseReturnsOnCall = make(map[int]struct {
-			result1 v7action.Root
+func (fake *FakeActor) GetRouteBindingSummaryForRouteReturnsOnCall(i int, result1 v7action.RouteBindingSummary, result2 v7action.Warnings, result3 error) {
+	fake.getRouteBindingSummaryForRouteMutex.Lock()
+	defer fake.getRouteBindingSummaryForRouteMutex.Unlock()
+	fake.GetRouteBindingSummaryForRouteStub = nil
+	if fake.getRouteBindingSummaryForRouteReturnsOnCall == nil {
+		fake.getRouteBindingSummaryForRouteReturnsOnCall = make(map[int]struct {
+			result1 v7action.RouteBindingSummary
 			result2 v7action.Warnings
 			result3 error
 		})
 	}
-	fake.getRootResponseReturnsOnCall[i] = struct {
-		result1 v7action.Root
+	fake.getRouteBindingSummaryForRouteReturnsOnCall[i] = struct {
+		result1 v7action.RouteBindingSummary
 		result2 v7action.Warnings
 		result3 error
 	}{result1, result2, result3}
@@ -12480,6 +14522,74 @@ func (fake *FakeActor) GetServiceAccessReturnsOnCall(i int, result1 []v7action.S
 	}{result1, result2, result3}
 }
 
+func (fake *FakeActor) GetServiceAppBindingsByServiceInstance(arg1 string, arg2 string) ([]resources.ServiceCredentialBinding, v7action.Warnings, error) {
+	fake.getServiceAppBindingsByServiceInstanceMutex.Lock()
+	ret, specificReturn := fake.getServiceAppBindingsByServiceInstanceReturnsOnCall[len(fake.getServiceAppBindingsByServiceInstanceArgsForCall)]
+	fake.getServiceAppBindingsByServiceInstanceArgsForCall = append(fake.getServiceAppBindingsByServiceInstanceArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.GetServiceAppBindingsByServiceInstanceStub
+	fakeReturns := fake.getServiceAppBindingsByServiceInstanceReturns
+	fake.recordInvocation("GetServiceAppBindingsByServiceInstance", []interface{}{arg1, arg2})
+	fake.getServiceAppBindingsByServiceInstanceMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetServiceAppBindingsByServiceInstanceCallCount() int {
+	fake.getServiceAppBindingsByServiceInstanceMutex.RLock()
+	defer fake.getServiceAppBindingsByServiceInstanceMutex.RUnlock()
+	return len(fake.getServiceAppBindingsByServiceInstanceArgsForCall)
+}
+
+func (fake *FakeActor) GetServiceAppBindingsByServiceInstanceCalls(stub func(string, string) ([]resources.ServiceCredentialBinding, v7action.Warnings, error)) {
+	fake.getServiceAppBindingsByServiceInstanceMutex.Lock()
+	defer fake.getServiceAppBindingsByServiceInstanceMutex.Unlock()
+	fake.GetServiceAppBindingsByServiceInstanceStub = stub
+}
+
+func (fake *FakeActor) GetServiceAppBindingsByServiceInstanceArgsForCall(i int) (string, string) {
+	fake.getServiceAppBindingsByServiceInstanceMutex.RLock()
+	defer fake.getServiceAppBindingsByServiceInstanceMutex.RUnlock()
+	argsForCall := fake.getServiceAppBindingsByServiceInstanceArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeActor) GetServiceAppBindingsByServiceInstanceReturns(result1 []resources.ServiceCredentialBinding, result2 v7action.Warnings, result3 error) {
+	fake.getServiceAppBindingsByServiceInstanceMutex.Lock()
+	defer fake.getServiceAppBindingsByServiceInstanceMutex.Unlock()
+	fake.GetServiceAppBindingsByServiceInstanceStub = nil
+	fake.getServiceAppBindingsByServiceInstanceReturns = struct {
+		result1 []resources.ServiceCredentialBinding
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetServiceAppBindingsByServiceInstanceReturnsOnCall(i int, result1 []resources.ServiceCredentialBinding, result2 v7action.Warnings, result3 error) {
+	fake.getServiceAppBindingsByServiceInstanceMutex.Lock()
+	defer fake.getServiceAppBindingsByServiceInstanceMutex.Unlock()
+	fake.GetServiceAppBindingsByServiceInstanceStub = nil
+	if fake.getServiceAppBindingsByServiceInstanceReturnsOnCall == nil {
+		fake.getServiceAppBindingsByServiceInstanceReturnsOnCall = make(map[int]struct {
+			result1 []resources.ServiceCredentialBinding
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getServiceAppBindingsByServiceInstanceReturnsOnCall[i] = struct {
+		result1 []resources.ServiceCredentialBinding
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeActor) GetServiceBrokerByName(arg1 string) (resources.ServiceBroker, v7action.Warnings, error) {
 	fake.getServiceBrokerByNameMutex.Lock()
 	ret, specificReturn := fake.getServiceBrokerByNameReturnsOnCall[len(fake.getServiceBrokerByNameArgsForCall)]
@@ -12547,6 +14657,73 @@ func (fake *FakeActor) GetServiceBrokerByNameReturnsOnCall(i int, result1 resour
 	}{result1, result2, result3}
 }
 
+func (fake *FakeActor) GetServiceBrokerCatalog(arg1 string) (v7action.ServiceBrokerCatalog, v7action.Warnings, error) {
+	fake.getServiceBrokerCatalogMutex.Lock()
+	ret, specificReturn := fake.getServiceBrokerCatalogReturnsOnCall[len(fake.getServiceBrokerCatalogArgsForCall)]
+	fake.getServiceBrokerCatalogArgsForCall = append(fake.getServiceBrokerCatalogArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.GetServiceBrokerCatalogStub
+	fakeReturns := fake.getServiceBrokerCatalogReturns
+	fake.recordInvocation("GetServiceBrokerCatalog", []interface{}{arg1})
+	fake.getServiceBrokerCatalogMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetServiceBrokerCatalogCallCount() int {
+	fake.getServiceBrokerCatalogMutex.RLock()
+	defer fake.getServiceBrokerCatalogMutex.RUnlock()
+	return len(fake.getServiceBrokerCatalogArgsForCall)
+}
+
+func (fake *FakeActor) GetServiceBrokerCatalogCalls(stub func(string) (v7action.ServiceBrokerCatalog, v7action.Warnings, error)) {
+	fake.getServiceBrokerCatalogMutex.Lock()
+	defer fake.getServiceBrokerCatalogMutex.Unlock()
+	fake.GetServiceBrokerCatalogStub = stub
+}
+
+func (fake *FakeActor) GetServiceBrokerCatalogArgsForCall(i int) string {
+	fake.getServiceBrokerCatalogMutex.RLock()
+	defer fake.getServiceBrokerCatalogMutex.RUnlock()
+	argsForCall := fake.getServiceBrokerCatalogArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeActor) GetServiceBrokerCatalogReturns(result1 v7action.ServiceBrokerCatalog, result2 v7action.Warnings, result3 error) {
+	fake.getServiceBrokerCatalogMutex.Lock()
+	defer fake.getServiceBrokerCatalogMutex.Unlock()
+	fake.GetServiceBrokerCatalogStub = nil
+	fake.getServiceBrokerCatalogReturns = struct {
+		result1 v7action.ServiceBrokerCatalog
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetServiceBrokerCatalogReturnsOnCall(i int, result1 v7action.ServiceBrokerCatalog, result2 v7action.Warnings, result3 error) {
+	fake.getServiceBrokerCatalogMutex.Lock()
+	defer fake.getServiceBrokerCatalogMutex.Unlock()
+	fake.GetServiceBrokerCatalogStub = nil
+	if fake.getServiceBrokerCatalogReturnsOnCall == nil {
+		fake.getServiceBrokerCatalogReturnsOnCall = make(map[int]struct {
+			result1 v7action.ServiceBrokerCatalog
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getServiceBrokerCatalogReturnsOnCall[i] = struct {
+		result1 v7action.ServiceBrokerCatalog
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeActor) GetServiceBrokerLabels(arg1 string) (map[string]types.NullString, v7action.Warnings, error) {
 	fake.getServiceBrokerLabelsMutex.Lock()
 	ret, specificReturn := fake.getServiceBrokerLabelsReturnsOnCall[len(fake.getServiceBrokerLabelsArgsForCall)]
@@ -12946,6 +15123,73 @@ func (fake *FakeActor) GetServiceInstanceParametersReturnsOnCall(i int, result1
 	}{result1, result2, result3}
 }
 
+func (fake *FakeActor) GetServiceInstanceUpgradeCandidates(arg1 v7action.ServiceInstanceUpgradeFilter) ([]v7action.ServiceInstanceUpgradeCandidate, v7action.Warnings, error) {
+	fake.getServiceInstanceUpgradeCandidatesMutex.Lock()
+	ret, specificReturn := fake.getServiceInstanceUpgradeCandidatesReturnsOnCall[len(fake.getServiceInstanceUpgradeCandidatesArgsForCall)]
+	fake.getServiceInstanceUpgradeCandidatesArgsForCall = append(fake.getServiceInstanceUpgradeCandidatesArgsForCall, struct {
+		arg1 v7action.ServiceInstanceUpgradeFilter
+	}{arg1})
+	stub := fake.GetServiceInstanceUpgradeCandidatesStub
+	fakeReturns := fake.getServiceInstanceUpgradeCandidatesReturns
+	fake.recordInvocation("GetServiceInstanceUpgradeCandidates", []interface{}{arg1})
+	fake.getServiceInstanceUpgradeCandidatesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetServiceInstanceUpgradeCandidatesCallCount() int {
+	fake.getServiceInstanceUpgradeCandidatesMutex.RLock()
+	defer fake.getServiceInstanceUpgradeCandidatesMutex.RUnlock()
+	return len(fake.getServiceInstanceUpgradeCandidatesArgsForCall)
+}
+
+func (fake *FakeActor) GetServiceInstanceUpgradeCandidatesCalls(stub func(v7action.ServiceInstanceUpgradeFilter) ([]v7action.ServiceInstanceUpgradeCandidate, v7action.Warnings, error)) {
+	fake.getServiceInstanceUpgradeCandidatesMutex.Lock()
+	defer fake.getServiceInstanceUpgradeCandidatesMutex.Unlock()
+	fake.GetServiceInstanceUpgradeCandidatesStub = stub
+}
+
+func (fake *FakeActor) GetServiceInstanceUpgradeCandidatesArgsForCall(i int) v7action.ServiceInstanceUpgradeFilter {
+	fake.getServiceInstanceUpgradeCandidatesMutex.RLock()
+	defer fake.getServiceInstanceUpgradeCandidatesMutex.RUnlock()
+	argsForCall := fake.getServiceInstanceUpgradeCandidatesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeActor) GetServiceInstanceUpgradeCandidatesReturns(result1 []v7action.ServiceInstanceUpgradeCandidate, result2 v7action.Warnings, result3 error) {
+	fake.getServiceInstanceUpgradeCandidatesMutex.Lock()
+	defer fake.getServiceInstanceUpgradeCandidatesMutex.Unlock()
+	fake.GetServiceInstanceUpgradeCandidatesStub = nil
+	fake.getServiceInstanceUpgradeCandidatesReturns = struct {
+		result1 []v7action.ServiceInstanceUpgradeCandidate
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetServiceInstanceUpgradeCandidatesReturnsOnCall(i int, result1 []v7action.ServiceInstanceUpgradeCandidate, result2 v7action.Warnings, result3 error) {
+	fake.getServiceInstanceUpgradeCandidatesMutex.Lock()
+	defer fake.getServiceInstanceUpgradeCandidatesMutex.Unlock()
+	fake.GetServiceInstanceUpgradeCandidatesStub = nil
+	if fake.getServiceInstanceUpgradeCandidatesReturnsOnCall == nil {
+		fake.getServiceInstanceUpgradeCandidatesReturnsOnCall = make(map[int]struct {
+			result1 []v7action.ServiceInstanceUpgradeCandidate
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getServiceInstanceUpgradeCandidatesReturnsOnCall[i] = struct {
+		result1 []v7action.ServiceInstanceUpgradeCandidate
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeActor) GetServiceInstancesForSpace(arg1 string, arg2 bool) ([]v7action.ServiceInstance, v7action.Warnings, error) {
 	fake.getServiceInstancesForSpaceMutex.Lock()
 	ret, specificReturn := fake.getServiceInstancesForSpaceReturnsOnCall[len(fake.getServiceInstancesForSpaceArgsForCall)]
@@ -13426,6 +15670,73 @@ func (fake *FakeActor) GetServicePlanLabelsReturnsOnCall(i int, result1 map[stri
 	}{result1, result2, result3}
 }
 
+func (fake *FakeActor) GetSharedServiceInstanceSummaries(arg1 string) ([]v7action.SharedServiceInstanceSummary, v7action.Warnings, error) {
+	fake.getSharedServiceInstanceSummariesMutex.Lock()
+	ret, specificReturn := fake.getSharedServiceInstanceSummariesReturnsOnCall[len(fake.getSharedServiceInstanceSummariesArgsForCall)]
+	fake.getSharedServiceInstanceSummariesArgsForCall = append(fake.getSharedServiceInstanceSummariesArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.GetSharedServiceInstanceSummariesStub
+	fakeReturns := fake.getSharedServiceInstanceSummariesReturns
+	fake.recordInvocation("GetSharedServiceInstanceSummaries", []interface{}{arg1})
+	fake.getSharedServiceInstanceSummariesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetSharedServiceInstanceSummariesCallCount() int {
+	fake.getSharedServiceInstanceSummariesMutex.RLock()
+	defer fake.getSharedServiceInstanceSummariesMutex.RUnlock()
+	return len(fake.getSharedServiceInstanceSummariesArgsForCall)
+}
+
+func (fake *FakeActor) GetSharedServiceInstanceSummariesCalls(stub func(string) ([]v7action.SharedServiceInstanceSummary, v7action.Warnings, error)) {
+	fake.getSharedServiceInstanceSummariesMutex.Lock()
+	defer fake.getSharedServiceInstanceSummariesMutex.Unlock()
+	fake.GetSharedServiceInstanceSummariesStub = stub
+}
+
+func (fake *FakeActor) GetSharedServiceInstanceSummariesArgsForCall(i int) string {
+	fake.getSharedServiceInstanceSummariesMutex.RLock()
+	defer fake.getSharedServiceInstanceSummariesMutex.RUnlock()
+	argsForCall := fake.getSharedServiceInstanceSummariesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeActor) GetSharedServiceInstanceSummariesReturns(result1 []v7action.SharedServiceInstanceSummary, result2 v7action.Warnings, result3 error) {
+	fake.getSharedServiceInstanceSummariesMutex.Lock()
+	defer fake.getSharedServiceInstanceSummariesMutex.Unlock()
+	fake.GetSharedServiceInstanceSummariesStub = nil
+	fake.getSharedServiceInstanceSummariesReturns = struct {
+		result1 []v7action.SharedServiceInstanceSummary
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetSharedServiceInstanceSummariesReturnsOnCall(i int, result1 []v7action.SharedServiceInstanceSummary, result2 v7action.Warnings, result3 error) {
+	fake.getSharedServiceInstanceSummariesMutex.Lock()
+	defer fake.getSharedServiceInstanceSummariesMutex.Unlock()
+	fake.GetSharedServiceInstanceSummariesStub = nil
+	if fake.getSharedServiceInstanceSummariesReturnsOnCall == nil {
+		fake.getSharedServiceInstanceSummariesReturnsOnCall = make(map[int]struct {
+			result1 []v7action.SharedServiceInstanceSummary
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getSharedServiceInstanceSummariesReturnsOnCall[i] = struct {
+		result1 []v7action.SharedServiceInstanceSummary
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeActor) GetSpaceByNameAndOrganization(arg1 string, arg2 string) (resources.Space, v7action.Warnings, error) {
 	fake.getSpaceByNameAndOrganizationMutex.Lock()
 	ret, specificReturn := fake.getSpaceByNameAndOrganizationReturnsOnCall[len(fake.getSpaceByNameAndOrganizationArgsForCall)]
@@ -13494,6 +15805,76 @@ func (fake *FakeActor) GetSpaceByNameAndOrganizationReturnsOnCall(i int, result1
 	}{result1, result2, result3}
 }
 
+func (fake *FakeActor) GetSpaceExport(arg1 string, arg2 string, arg3 bool, arg4 v7action.SpaceExportNetworkingActor) (v7action.SpaceExport, v7action.Warnings, error) {
+	fake.getSpaceExportMutex.Lock()
+	ret, specificReturn := fake.getSpaceExportReturnsOnCall[len(fake.getSpaceExportArgsForCall)]
+	fake.getSpaceExportArgsForCall = append(fake.getSpaceExportArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 bool
+		arg4 v7action.SpaceExportNetworkingActor
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.GetSpaceExportStub
+	fakeReturns := fake.getSpaceExportReturns
+	fake.recordInvocation("GetSpaceExport", []interface{}{arg1, arg2, arg3, arg4})
+	fake.getSpaceExportMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetSpaceExportCallCount() int {
+	fake.getSpaceExportMutex.RLock()
+	defer fake.getSpaceExportMutex.RUnlock()
+	return len(fake.getSpaceExportArgsForCall)
+}
+
+func (fake *FakeActor) GetSpaceExportCalls(stub func(string, string, bool, v7action.SpaceExportNetworkingActor) (v7action.SpaceExport, v7action.Warnings, error)) {
+	fake.getSpaceExportMutex.Lock()
+	defer fake.getSpaceExportMutex.Unlock()
+	fake.GetSpaceExportStub = stub
+}
+
+func (fake *FakeActor) GetSpaceExportArgsForCall(i int) (string, string, bool, v7action.SpaceExportNetworkingActor) {
+	fake.getSpaceExportMutex.RLock()
+	defer fake.getSpaceExportMutex.RUnlock()
+	argsForCall := fake.getSpaceExportArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeActor) GetSpaceExportReturns(result1 v7action.SpaceExport, result2 v7action.Warnings, result3 error) {
+	fake.getSpaceExportMutex.Lock()
+	defer fake.getSpaceExportMutex.Unlock()
+	fake.GetSpaceExportStub = nil
+	fake.getSpaceExportReturns = struct {
+		result1 v7action.SpaceExport
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetSpaceExportReturnsOnCall(i int, result1 v7action.SpaceExport, result2 v7action.Warnings, result3 error) {
+	fake.getSpaceExportMutex.Lock()
+	defer fake.getSpaceExportMutex.Unlock()
+	fake.GetSpaceExportStub = nil
+	if fake.getSpaceExportReturnsOnCall == nil {
+		fake.getSpaceExportReturnsOnCall = make(map[int]struct {
+			result1 v7action.SpaceExport
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getSpaceExportReturnsOnCall[i] = struct {
+		result1 v7action.SpaceExport
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeActor) GetSpaceFeature(arg1 string, arg2 string, arg3 string) (bool, v7action.Warnings, error) {
 	fake.getSpaceFeatureMutex.Lock()
 	ret, specificReturn := fake.getSpaceFeatureReturnsOnCall[len(fake.getSpaceFeatureArgsForCall)]
@@ -14017,19 +16398,87 @@ func (fake *FakeActor) GetStackLabelsReturns(result1 map[string]types.NullString
 	}{result1, result2, result3}
 }
 
-func (fake *FakeActor) GetStackLabelsReturnsOnCall(i int, result1 map[string]types.NullString, result2 v7action.Warnings, result3 error) {
-	fake.getStackLabelsMutex.Lock()
-	defer fake.getStackLabelsMutex.Unlock()
-	fake.GetStackLabelsStub = nil
-	if fake.getStackLabelsReturnsOnCall == nil {
-		fake.getStackLabelsReturnsOnCall = make(map[int]struct {
-			result1 map[string]types.NullString
+func (fake *FakeActor) GetStackLabelsReturnsOnCall(i int, result1 map[string]types.NullString, result2 v7action.Warnings, result3 error) {
+	fake.getStackLabelsMutex.Lock()
+	defer fake.getStackLabelsMutex.Unlock()
+	fake.GetStackLabelsStub = nil
+	if fake.getStackLabelsReturnsOnCall == nil {
+		fake.getStackLabelsReturnsOnCall = make(map[int]struct {
+			result1 map[string]types.NullString
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getStackLabelsReturnsOnCall[i] = struct {
+		result1 map[string]types.NullString
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetStackMigrationReport(arg1 string, arg2 string) ([]v7action.StackMigrationApp, v7action.Warnings, error) {
+	fake.getStackMigrationReportMutex.Lock()
+	ret, specificReturn := fake.getStackMigrationReportReturnsOnCall[len(fake.getStackMigrationReportArgsForCall)]
+	fake.getStackMigrationReportArgsForCall = append(fake.getStackMigrationReportArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.GetStackMigrationReportStub
+	fakeReturns := fake.getStackMigrationReportReturns
+	fake.recordInvocation("GetStackMigrationReport", []interface{}{arg1, arg2})
+	fake.getStackMigrationReportMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetStackMigrationReportCallCount() int {
+	fake.getStackMigrationReportMutex.RLock()
+	defer fake.getStackMigrationReportMutex.RUnlock()
+	return len(fake.getStackMigrationReportArgsForCall)
+}
+
+func (fake *FakeActor) GetStackMigrationReportCalls(stub func(string, string) ([]v7action.StackMigrationApp, v7action.Warnings, error)) {
+	fake.getStackMigrationReportMutex.Lock()
+	defer fake.getStackMigrationReportMutex.Unlock()
+	fake.GetStackMigrationReportStub = stub
+}
+
+func (fake *FakeActor) GetStackMigrationReportArgsForCall(i int) (string, string) {
+	fake.getStackMigrationReportMutex.RLock()
+	defer fake.getStackMigrationReportMutex.RUnlock()
+	argsForCall := fake.getStackMigrationReportArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeActor) GetStackMigrationReportReturns(result1 []v7action.StackMigrationApp, result2 v7action.Warnings, result3 error) {
+	fake.getStackMigrationReportMutex.Lock()
+	defer fake.getStackMigrationReportMutex.Unlock()
+	fake.GetStackMigrationReportStub = nil
+	fake.getStackMigrationReportReturns = struct {
+		result1 []v7action.StackMigrationApp
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetStackMigrationReportReturnsOnCall(i int, result1 []v7action.StackMigrationApp, result2 v7action.Warnings, result3 error) {
+	fake.getStackMigrationReportMutex.Lock()
+	defer fake.getStackMigrationReportMutex.Unlock()
+	fake.GetStackMigrationReportStub = nil
+	if fake.getStackMigrationReportReturnsOnCall == nil {
+		fake.getStackMigrationReportReturnsOnCall = make(map[int]struct {
+			result1 []v7action.StackMigrationApp
 			result2 v7action.Warnings
 			result3 error
 		})
 	}
-	fake.getStackLabelsReturnsOnCall[i] = struct {
-		result1 map[string]types.NullString
+	fake.getStackMigrationReportReturnsOnCall[i] = struct {
+		result1 []v7action.StackMigrationApp
 		result2 v7action.Warnings
 		result3 error
 	}{result1, result2, result3}
@@ -14102,6 +16551,75 @@ func (fake *FakeActor) GetStacksReturnsOnCall(i int, result1 []resources.Stack,
 	}{result1, result2, result3}
 }
 
+func (fake *FakeActor) GetStaleResources(arg1 string, arg2 string, arg3 time.Time) ([]v7action.StaleResource, v7action.Warnings, error) {
+	fake.getStaleResourcesMutex.Lock()
+	ret, specificReturn := fake.getStaleResourcesReturnsOnCall[len(fake.getStaleResourcesArgsForCall)]
+	fake.getStaleResourcesArgsForCall = append(fake.getStaleResourcesArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 time.Time
+	}{arg1, arg2, arg3})
+	stub := fake.GetStaleResourcesStub
+	fakeReturns := fake.getStaleResourcesReturns
+	fake.recordInvocation("GetStaleResources", []interface{}{arg1, arg2, arg3})
+	fake.getStaleResourcesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetStaleResourcesCallCount() int {
+	fake.getStaleResourcesMutex.RLock()
+	defer fake.getStaleResourcesMutex.RUnlock()
+	return len(fake.getStaleResourcesArgsForCall)
+}
+
+func (fake *FakeActor) GetStaleResourcesCalls(stub func(string, string, time.Time) ([]v7action.StaleResource, v7action.Warnings, error)) {
+	fake.getStaleResourcesMutex.Lock()
+	defer fake.getStaleResourcesMutex.Unlock()
+	fake.GetStaleResourcesStub = stub
+}
+
+func (fake *FakeActor) GetStaleResourcesArgsForCall(i int) (string, string, time.Time) {
+	fake.getStaleResourcesMutex.RLock()
+	defer fake.getStaleResourcesMutex.RUnlock()
+	argsForCall := fake.getStaleResourcesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeActor) GetStaleResourcesReturns(result1 []v7action.StaleResource, result2 v7action.Warnings, result3 error) {
+	fake.getStaleResourcesMutex.Lock()
+	defer fake.getStaleResourcesMutex.Unlock()
+	fake.GetStaleResourcesStub = nil
+	fake.getStaleResourcesReturns = struct {
+		result1 []v7action.StaleResource
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetStaleResourcesReturnsOnCall(i int, result1 []v7action.StaleResource, result2 v7action.Warnings, result3 error) {
+	fake.getStaleResourcesMutex.Lock()
+	defer fake.getStaleResourcesMutex.Unlock()
+	fake.GetStaleResourcesStub = nil
+	if fake.getStaleResourcesReturnsOnCall == nil {
+		fake.getStaleResourcesReturnsOnCall = make(map[int]struct {
+			result1 []v7action.StaleResource
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getStaleResourcesReturnsOnCall[i] = struct {
+		result1 []v7action.StaleResource
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeActor) GetStreamingLogsForApplicationByNameAndSpace(arg1 string, arg2 string, arg3 sharedaction.LogCacheClient) (<-chan sharedaction.LogMessage, <-chan error, context.CancelFunc, v7action.Warnings, error) {
 	fake.getStreamingLogsForApplicationByNameAndSpaceMutex.Lock()
 	ret, specificReturn := fake.getStreamingLogsForApplicationByNameAndSpaceReturnsOnCall[len(fake.getStreamingLogsForApplicationByNameAndSpaceArgsForCall)]
@@ -14177,6 +16695,78 @@ func (fake *FakeActor) GetStreamingLogsForApplicationByNameAndSpaceReturnsOnCall
 	}{result1, result2, result3, result4, result5}
 }
 
+func (fake *FakeActor) GetStreamingLogsForTask(arg1 string, arg2 resources.Task, arg3 sharedaction.LogCacheClient) (<-chan sharedaction.LogMessage, <-chan error, context.CancelFunc, error) {
+	fake.getStreamingLogsForTaskMutex.Lock()
+	ret, specificReturn := fake.getStreamingLogsForTaskReturnsOnCall[len(fake.getStreamingLogsForTaskArgsForCall)]
+	fake.getStreamingLogsForTaskArgsForCall = append(fake.getStreamingLogsForTaskArgsForCall, struct {
+		arg1 string
+		arg2 resources.Task
+		arg3 sharedaction.LogCacheClient
+	}{arg1, arg2, arg3})
+	stub := fake.GetStreamingLogsForTaskStub
+	fakeReturns := fake.getStreamingLogsForTaskReturns
+	fake.recordInvocation("GetStreamingLogsForTask", []interface{}{arg1, arg2, arg3})
+	fake.getStreamingLogsForTaskMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3, ret.result4
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3, fakeReturns.result4
+}
+
+func (fake *FakeActor) GetStreamingLogsForTaskCallCount() int {
+	fake.getStreamingLogsForTaskMutex.RLock()
+	defer fake.getStreamingLogsForTaskMutex.RUnlock()
+	return len(fake.getStreamingLogsForTaskArgsForCall)
+}
+
+func (fake *FakeActor) GetStreamingLogsForTaskCalls(stub func(string, resources.Task, sharedaction.LogCacheClient) (<-chan sharedaction.LogMessage, <-chan error, context.CancelFunc, error)) {
+	fake.getStreamingLogsForTaskMutex.Lock()
+	defer fake.getStreamingLogsForTaskMutex.Unlock()
+	fake.GetStreamingLogsForTaskStub = stub
+}
+
+func (fake *FakeActor) GetStreamingLogsForTaskArgsForCall(i int) (string, resources.Task, sharedaction.LogCacheClient) {
+	fake.getStreamingLogsForTaskMutex.RLock()
+	defer fake.getStreamingLogsForTaskMutex.RUnlock()
+	argsForCall := fake.getStreamingLogsForTaskArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeActor) GetStreamingLogsForTaskReturns(result1 <-chan sharedaction.LogMessage, result2 <-chan error, result3 context.CancelFunc, result4 error) {
+	fake.getStreamingLogsForTaskMutex.Lock()
+	defer fake.getStreamingLogsForTaskMutex.Unlock()
+	fake.GetStreamingLogsForTaskStub = nil
+	fake.getStreamingLogsForTaskReturns = struct {
+		result1 <-chan sharedaction.LogMessage
+		result2 <-chan error
+		result3 context.CancelFunc
+		result4 error
+	}{result1, result2, result3, result4}
+}
+
+func (fake *FakeActor) GetStreamingLogsForTaskReturnsOnCall(i int, result1 <-chan sharedaction.LogMessage, result2 <-chan error, result3 context.CancelFunc, result4 error) {
+	fake.getStreamingLogsForTaskMutex.Lock()
+	defer fake.getStreamingLogsForTaskMutex.Unlock()
+	fake.GetStreamingLogsForTaskStub = nil
+	if fake.getStreamingLogsForTaskReturnsOnCall == nil {
+		fake.getStreamingLogsForTaskReturnsOnCall = make(map[int]struct {
+			result1 <-chan sharedaction.LogMessage
+			result2 <-chan error
+			result3 context.CancelFunc
+			result4 error
+		})
+	}
+	fake.getStreamingLogsForTaskReturnsOnCall[i] = struct {
+		result1 <-chan sharedaction.LogMessage
+		result2 <-chan error
+		result3 context.CancelFunc
+		result4 error
+	}{result1, result2, result3, result4}
+}
+
 func (fake *FakeActor) GetTaskBySequenceIDAndApplication(arg1 int, arg2 string) (resources.Task, v7action.Warnings, error) {
 	fake.getTaskBySequenceIDAndApplicationMutex.Lock()
 	ret, specificReturn := fake.getTaskBySequenceIDAndApplicationReturnsOnCall[len(fake.getTaskBySequenceIDAndApplicationArgsForCall)]
@@ -14433,6 +17023,77 @@ func (fake *FakeActor) GetUserReturnsOnCall(i int, result1 resources.User, resul
 	}{result1, result2}
 }
 
+func (fake *FakeActor) GetUserRoles(arg1 string, arg2 string) (resources.User, []v7action.UserRole, v7action.Warnings, error) {
+	fake.getUserRolesMutex.Lock()
+	ret, specificReturn := fake.getUserRolesReturnsOnCall[len(fake.getUserRolesArgsForCall)]
+	fake.getUserRolesArgsForCall = append(fake.getUserRolesArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.GetUserRolesStub
+	fakeReturns := fake.getUserRolesReturns
+	fake.recordInvocation("GetUserRoles", []interface{}{arg1, arg2})
+	fake.getUserRolesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3, ret.result4
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3, fakeReturns.result4
+}
+
+func (fake *FakeActor) GetUserRolesCallCount() int {
+	fake.getUserRolesMutex.RLock()
+	defer fake.getUserRolesMutex.RUnlock()
+	return len(fake.getUserRolesArgsForCall)
+}
+
+func (fake *FakeActor) GetUserRolesCalls(stub func(string, string) (resources.User, []v7action.UserRole, v7action.Warnings, error)) {
+	fake.getUserRolesMutex.Lock()
+	defer fake.getUserRolesMutex.Unlock()
+	fake.GetUserRolesStub = stub
+}
+
+func (fake *FakeActor) GetUserRolesArgsForCall(i int) (string, string) {
+	fake.getUserRolesMutex.RLock()
+	defer fake.getUserRolesMutex.RUnlock()
+	argsForCall := fake.getUserRolesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeActor) GetUserRolesReturns(result1 resources.User, result2 []v7action.UserRole, result3 v7action.Warnings, result4 error) {
+	fake.getUserRolesMutex.Lock()
+	defer fake.getUserRolesMutex.Unlock()
+	fake.GetUserRolesStub = nil
+	fake.getUserRolesReturns = struct {
+		result1 resources.User
+		result2 []v7action.UserRole
+		result3 v7action.Warnings
+		result4 error
+	}{result1, result2, result3, result4}
+}
+
+func (fake *FakeActor) GetUserRolesReturnsOnCall(i int, result1 resources.User, result2 []v7action.UserRole, result3 v7action.Warnings, result4 error) {
+	fake.getUserRolesMutex.Lock()
+	defer fake.getUserRolesMutex.Unlock()
+	fake.GetUserRolesStub = nil
+	if fake.getUserRolesReturnsOnCall == nil {
+		fake.getUserRolesReturnsOnCall = make(map[int]struct {
+			result1 resources.User
+			result2 []v7action.UserRole
+			result3 v7action.Warnings
+			result4 error
+		})
+	}
+	fake.getUserRolesReturnsOnCall[i] = struct {
+		result1 resources.User
+		result2 []v7action.UserRole
+		result3 v7action.Warnings
+		result4 error
+	}{result1, result2, result3, result4}
+}
+
 func (fake *FakeActor) MakeCurlRequest(arg1 string, arg2 string, arg3 []string, arg4 string, arg5 bool) ([]byte, *http.Response, error) {
 	var arg3Copy []string
 	if arg3 != nil {
@@ -14771,6 +17432,76 @@ func (fake *FakeActor) ParseAccessTokenReturnsOnCall(i int, result1 jwt.JWT, res
 	}{result1, result2}
 }
 
+func (fake *FakeActor) PlanSpaceImport(arg1 v7action.SpaceExport, arg2 string, arg3 string, arg4 v7action.SpaceImportNetworkingActor) (v7action.SpaceImportPlan, v7action.Warnings, error) {
+	fake.planSpaceImportMutex.Lock()
+	ret, specificReturn := fake.planSpaceImportReturnsOnCall[len(fake.planSpaceImportArgsForCall)]
+	fake.planSpaceImportArgsForCall = append(fake.planSpaceImportArgsForCall, struct {
+		arg1 v7action.SpaceExport
+		arg2 string
+		arg3 string
+		arg4 v7action.SpaceImportNetworkingActor
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.PlanSpaceImportStub
+	fakeReturns := fake.planSpaceImportReturns
+	fake.recordInvocation("PlanSpaceImport", []interface{}{arg1, arg2, arg3, arg4})
+	fake.planSpaceImportMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) PlanSpaceImportCallCount() int {
+	fake.planSpaceImportMutex.RLock()
+	defer fake.planSpaceImportMutex.RUnlock()
+	return len(fake.planSpaceImportArgsForCall)
+}
+
+func (fake *FakeActor) PlanSpaceImportCalls(stub func(v7action.SpaceExport, string, string, v7action.SpaceImportNetworkingActor) (v7action.SpaceImportPlan, v7action.Warnings, error)) {
+	fake.planSpaceImportMutex.Lock()
+	defer fake.planSpaceImportMutex.Unlock()
+	fake.PlanSpaceImportStub = stub
+}
+
+func (fake *FakeActor) PlanSpaceImportArgsForCall(i int) (v7action.SpaceExport, string, string, v7action.SpaceImportNetworkingActor) {
+	fake.planSpaceImportMutex.RLock()
+	defer fake.planSpaceImportMutex.RUnlock()
+	argsForCall := fake.planSpaceImportArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeActor) PlanSpaceImportReturns(result1 v7action.SpaceImportPlan, result2 v7action.Warnings, result3 error) {
+	fake.planSpaceImportMutex.Lock()
+	defer fake.planSpaceImportMutex.Unlock()
+	fake.PlanSpaceImportStub = nil
+	fake.planSpaceImportReturns = struct {
+		result1 v7action.SpaceImportPlan
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) PlanSpaceImportReturnsOnCall(i int, result1 v7action.SpaceImportPlan, result2 v7action.Warnings, result3 error) {
+	fake.planSpaceImportMutex.Lock()
+	defer fake.planSpaceImportMutex.Unlock()
+	fake.PlanSpaceImportStub = nil
+	if fake.planSpaceImportReturnsOnCall == nil {
+		fake.planSpaceImportReturnsOnCall = make(map[int]struct {
+			result1 v7action.SpaceImportPlan
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.planSpaceImportReturnsOnCall[i] = struct {
+		result1 v7action.SpaceImportPlan
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeActor) PollBuild(arg1 string, arg2 string) (resources.Droplet, v7action.Warnings, error) {
 	fake.pollBuildMutex.Lock()
 	ret, specificReturn := fake.pollBuildReturnsOnCall[len(fake.pollBuildArgsForCall)]
@@ -14906,6 +17637,73 @@ func (fake *FakeActor) PollPackageReturnsOnCall(i int, result1 resources.Package
 	}{result1, result2, result3}
 }
 
+func (fake *FakeActor) PollServiceInstanceLastOperation(arg1 string, arg2 string, arg3 time.Duration, arg4 func(resources.LastOperation)) (v7action.Warnings, error) {
+	fake.pollServiceInstanceLastOperationMutex.Lock()
+	ret, specificReturn := fake.pollServiceInstanceLastOperationReturnsOnCall[len(fake.pollServiceInstanceLastOperationArgsForCall)]
+	fake.pollServiceInstanceLastOperationArgsForCall = append(fake.pollServiceInstanceLastOperationArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 time.Duration
+		arg4 func(resources.LastOperation)
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.PollServiceInstanceLastOperationStub
+	fakeReturns := fake.pollServiceInstanceLastOperationReturns
+	fake.recordInvocation("PollServiceInstanceLastOperation", []interface{}{arg1, arg2, arg3, arg4})
+	fake.pollServiceInstanceLastOperationMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeActor) PollServiceInstanceLastOperationCallCount() int {
+	fake.pollServiceInstanceLastOperationMutex.RLock()
+	defer fake.pollServiceInstanceLastOperationMutex.RUnlock()
+	return len(fake.pollServiceInstanceLastOperationArgsForCall)
+}
+
+func (fake *FakeActor) PollServiceInstanceLastOperationCalls(stub func(string, string, time.Duration, func(resources.LastOperation)) (v7action.Warnings, error)) {
+	fake.pollServiceInstanceLastOperationMutex.Lock()
+	defer fake.pollServiceInstanceLastOperationMutex.Unlock()
+	fake.PollServiceInstanceLastOperationStub = stub
+}
+
+func (fake *FakeActor) PollServiceInstanceLastOperationArgsForCall(i int) (string, string, time.Duration, func(resources.LastOperation)) {
+	fake.pollServiceInstanceLastOperationMutex.RLock()
+	defer fake.pollServiceInstanceLastOperationMutex.RUnlock()
+	argsForCall := fake.pollServiceInstanceLastOperationArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeActor) PollServiceInstanceLastOperationReturns(result1 v7action.Warnings, result2 error) {
+	fake.pollServiceInstanceLastOperationMutex.Lock()
+	defer fake.pollServiceInstanceLastOperationMutex.Unlock()
+	fake.PollServiceInstanceLastOperationStub = nil
+	fake.pollServiceInstanceLastOperationReturns = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeActor) PollServiceInstanceLastOperationReturnsOnCall(i int, result1 v7action.Warnings, result2 error) {
+	fake.pollServiceInstanceLastOperationMutex.Lock()
+	defer fake.pollServiceInstanceLastOperationMutex.Unlock()
+	fake.PollServiceInstanceLastOperationStub = nil
+	if fake.pollServiceInstanceLastOperationReturnsOnCall == nil {
+		fake.pollServiceInstanceLastOperationReturnsOnCall = make(map[int]struct {
+			result1 v7action.Warnings
+			result2 error
+		})
+	}
+	fake.pollServiceInstanceLastOperationReturnsOnCall[i] = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeActor) PollStart(arg1 resources.Application, arg2 bool, arg3 func(string)) (v7action.Warnings, error) {
 	fake.pollStartMutex.Lock()
 	ret, specificReturn := fake.pollStartReturnsOnCall[len(fake.pollStartArgsForCall)]
@@ -17015,6 +19813,76 @@ func (fake *FakeActor) ShareServiceInstanceToSpaceAndOrgReturnsOnCall(i int, res
 	}{result1, result2}
 }
 
+func (fake *FakeActor) ShareServiceInstanceToSpaces(arg1 string, arg2 string, arg3 string, arg4 v7action.BulkServiceInstanceSharingParams) ([]resources.Space, v7action.Warnings, error) {
+	fake.shareServiceInstanceToSpacesMutex.Lock()
+	ret, specificReturn := fake.shareServiceInstanceToSpacesReturnsOnCall[len(fake.shareServiceInstanceToSpacesArgsForCall)]
+	fake.shareServiceInstanceToSpacesArgsForCall = append(fake.shareServiceInstanceToSpacesArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 string
+		arg4 v7action.BulkServiceInstanceSharingParams
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.ShareServiceInstanceToSpacesStub
+	fakeReturns := fake.shareServiceInstanceToSpacesReturns
+	fake.recordInvocation("ShareServiceInstanceToSpaces", []interface{}{arg1, arg2, arg3, arg4})
+	fake.shareServiceInstanceToSpacesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) ShareServiceInstanceToSpacesCallCount() int {
+	fake.shareServiceInstanceToSpacesMutex.RLock()
+	defer fake.shareServiceInstanceToSpacesMutex.RUnlock()
+	return len(fake.shareServiceInstanceToSpacesArgsForCall)
+}
+
+func (fake *FakeActor) ShareServiceInstanceToSpacesCalls(stub func(string, string, string, v7action.BulkServiceInstanceSharingParams) ([]resources.Space, v7action.Warnings, error)) {
+	fake.shareServiceInstanceToSpacesMutex.Lock()
+	defer fake.shareServiceInstanceToSpacesMutex.Unlock()
+	fake.ShareServiceInstanceToSpacesStub = stub
+}
+
+func (fake *FakeActor) ShareServiceInstanceToSpacesArgsForCall(i int) (string, string, string, v7action.BulkServiceInstanceSharingParams) {
+	fake.shareServiceInstanceToSpacesMutex.RLock()
+	defer fake.shareServiceInstanceToSpacesMutex.RUnlock()
+	argsForCall := fake.shareServiceInstanceToSpacesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeActor) ShareServiceInstanceToSpacesReturns(result1 []resources.Space, result2 v7action.Warnings, result3 error) {
+	fake.shareServiceInstanceToSpacesMutex.Lock()
+	defer fake.shareServiceInstanceToSpacesMutex.Unlock()
+	fake.ShareServiceInstanceToSpacesStub = nil
+	fake.shareServiceInstanceToSpacesReturns = struct {
+		result1 []resources.Space
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) ShareServiceInstanceToSpacesReturnsOnCall(i int, result1 []resources.Space, result2 v7action.Warnings, result3 error) {
+	fake.shareServiceInstanceToSpacesMutex.Lock()
+	defer fake.shareServiceInstanceToSpacesMutex.Unlock()
+	fake.ShareServiceInstanceToSpacesStub = nil
+	if fake.shareServiceInstanceToSpacesReturnsOnCall == nil {
+		fake.shareServiceInstanceToSpacesReturnsOnCall = make(map[int]struct {
+			result1 []resources.Space
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.shareServiceInstanceToSpacesReturnsOnCall[i] = struct {
+		result1 []resources.Space
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeActor) StageApplicationPackage(arg1 string) (resources.Build, v7action.Warnings, error) {
 	fake.stageApplicationPackageMutex.Lock()
 	ret, specificReturn := fake.stageApplicationPackageReturnsOnCall[len(fake.stageApplicationPackageArgsForCall)]
@@ -17740,6 +20608,74 @@ func (fake *FakeActor) UnshareRouteReturnsOnCall(i int, result1 v7action.Warning
 	}{result1, result2}
 }
 
+func (fake *FakeActor) UnshareServiceInstanceFromAllSpaces(arg1 string, arg2 string) ([]ccv3.SpaceWithOrganization, v7action.Warnings, error) {
+	fake.unshareServiceInstanceFromAllSpacesMutex.Lock()
+	ret, specificReturn := fake.unshareServiceInstanceFromAllSpacesReturnsOnCall[len(fake.unshareServiceInstanceFromAllSpacesArgsForCall)]
+	fake.unshareServiceInstanceFromAllSpacesArgsForCall = append(fake.unshareServiceInstanceFromAllSpacesArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.UnshareServiceInstanceFromAllSpacesStub
+	fakeReturns := fake.unshareServiceInstanceFromAllSpacesReturns
+	fake.recordInvocation("UnshareServiceInstanceFromAllSpaces", []interface{}{arg1, arg2})
+	fake.unshareServiceInstanceFromAllSpacesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) UnshareServiceInstanceFromAllSpacesCallCount() int {
+	fake.unshareServiceInstanceFromAllSpacesMutex.RLock()
+	defer fake.unshareServiceInstanceFromAllSpacesMutex.RUnlock()
+	return len(fake.unshareServiceInstanceFromAllSpacesArgsForCall)
+}
+
+func (fake *FakeActor) UnshareServiceInstanceFromAllSpacesCalls(stub func(string, string) ([]ccv3.SpaceWithOrganization, v7action.Warnings, error)) {
+	fake.unshareServiceInstanceFromAllSpacesMutex.Lock()
+	defer fake.unshareServiceInstanceFromAllSpacesMutex.Unlock()
+	fake.UnshareServiceInstanceFromAllSpacesStub = stub
+}
+
+func (fake *FakeActor) UnshareServiceInstanceFromAllSpacesArgsForCall(i int) (string, string) {
+	fake.unshareServiceInstanceFromAllSpacesMutex.RLock()
+	defer fake.unshareServiceInstanceFromAllSpacesMutex.RUnlock()
+	argsForCall := fake.unshareServiceInstanceFromAllSpacesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeActor) UnshareServiceInstanceFromAllSpacesReturns(result1 []ccv3.SpaceWithOrganization, result2 v7action.Warnings, result3 error) {
+	fake.unshareServiceInstanceFromAllSpacesMutex.Lock()
+	defer fake.unshareServiceInstanceFromAllSpacesMutex.Unlock()
+	fake.UnshareServiceInstanceFromAllSpacesStub = nil
+	fake.unshareServiceInstanceFromAllSpacesReturns = struct {
+		result1 []ccv3.SpaceWithOrganization
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) UnshareServiceInstanceFromAllSpacesReturnsOnCall(i int, result1 []ccv3.SpaceWithOrganization, result2 v7action.Warnings, result3 error) {
+	fake.unshareServiceInstanceFromAllSpacesMutex.Lock()
+	defer fake.unshareServiceInstanceFromAllSpacesMutex.Unlock()
+	fake.UnshareServiceInstanceFromAllSpacesStub = nil
+	if fake.unshareServiceInstanceFromAllSpacesReturnsOnCall == nil {
+		fake.unshareServiceInstanceFromAllSpacesReturnsOnCall = make(map[int]struct {
+			result1 []ccv3.SpaceWithOrganization
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.unshareServiceInstanceFromAllSpacesReturnsOnCall[i] = struct {
+		result1 []ccv3.SpaceWithOrganization
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeActor) UnshareServiceInstanceFromSpaceAndOrg(arg1 string, arg2 string, arg3 string, arg4 v7action.ServiceInstanceSharingParams) (v7action.Warnings, error) {
 	fake.unshareServiceInstanceFromSpaceAndOrgMutex.Lock()
 	ret, specificReturn := fake.unshareServiceInstanceFromSpaceAndOrgReturnsOnCall[len(fake.unshareServiceInstanceFromSpaceAndOrgArgsForCall)]
@@ -19808,6 +22744,10 @@ func (fake *FakeActor) Invocations() map[string][][]interface{} {
 	defer fake.invocationsMutex.RUnlock()
 	fake.applyOrganizationQuotaByNameMutex.RLock()
 	defer fake.applyOrganizationQuotaByNameMutex.RUnlock()
+	fake.applyRoleChangesMutex.RLock()
+	defer fake.applyRoleChangesMutex.RUnlock()
+	fake.applySpaceImportMutex.RLock()
+	defer fake.applySpaceImportMutex.RUnlock()
 	fake.applySpaceQuotaByNameMutex.RLock()
 	defer fake.applySpaceQuotaByNameMutex.RUnlock()
 	fake.assignIsolationSegmentToSpaceByNameAndSpaceMutex.RLock()
@@ -19820,6 +22760,8 @@ func (fake *FakeActor) Invocations() map[string][][]interface{} {
 	defer fake.cancelDeploymentMutex.RUnlock()
 	fake.checkRouteMutex.RLock()
 	defer fake.checkRouteMutex.RUnlock()
+	fake.checkSpaceEgressMutex.RLock()
+	defer fake.checkSpaceEgressMutex.RUnlock()
 	fake.clearTargetMutex.RLock()
 	defer fake.clearTargetMutex.RUnlock()
 	fake.continueDeploymentMutex.RLock()
@@ -19862,6 +22804,8 @@ func (fake *FakeActor) Invocations() map[string][][]interface{} {
 	defer fake.createSecurityGroupMutex.RUnlock()
 	fake.createServiceAppBindingMutex.RLock()
 	defer fake.createServiceAppBindingMutex.RUnlock()
+	fake.createServiceAppBindingsMutex.RLock()
+	defer fake.createServiceAppBindingsMutex.RUnlock()
 	fake.createServiceBrokerMutex.RLock()
 	defer fake.createServiceBrokerMutex.RUnlock()
 	fake.createServiceKeyMutex.RLock()
@@ -19906,6 +22850,10 @@ func (fake *FakeActor) Invocations() map[string][][]interface{} {
 	defer fake.deleteSecurityGroupMutex.RUnlock()
 	fake.deleteServiceAppBindingMutex.RLock()
 	defer fake.deleteServiceAppBindingMutex.RUnlock()
+	fake.deleteServiceAppBindingByGUIDMutex.RLock()
+	defer fake.deleteServiceAppBindingByGUIDMutex.RUnlock()
+	fake.deleteServiceAppBindingsMutex.RLock()
+	defer fake.deleteServiceAppBindingsMutex.RUnlock()
 	fake.deleteServiceBrokerMutex.RLock()
 	defer fake.deleteServiceBrokerMutex.RUnlock()
 	fake.deleteServiceInstanceMutex.RLock()
@@ -19918,8 +22866,14 @@ func (fake *FakeActor) Invocations() map[string][][]interface{} {
 	defer fake.deleteSpaceQuotaByNameMutex.RUnlock()
 	fake.deleteSpaceRoleMutex.RLock()
 	defer fake.deleteSpaceRoleMutex.RUnlock()
+	fake.deleteStaleResourcesMutex.RLock()
+	defer fake.deleteStaleResourcesMutex.RUnlock()
 	fake.deleteUserMutex.RLock()
 	defer fake.deleteUserMutex.RUnlock()
+	fake.deleteUserRolesMutex.RLock()
+	defer fake.deleteUserRolesMutex.RUnlock()
+	fake.diffServiceBrokerCatalogMutex.RLock()
+	defer fake.diffServiceBrokerCatalogMutex.RUnlock()
 	fake.diffSpaceManifestMutex.RLock()
 	defer fake.diffSpaceManifestMutex.RUnlock()
 	fake.disableFeatureFlagMutex.RLock()
@@ -19938,10 +22892,14 @@ func (fake *FakeActor) Invocations() map[string][][]interface{} {
 	defer fake.entitleIsolationSegmentToOrganizationByNameMutex.RUnlock()
 	fake.getAppFeatureMutex.RLock()
 	defer fake.getAppFeatureMutex.RUnlock()
+	fake.getAppFeaturesMutex.RLock()
+	defer fake.getAppFeaturesMutex.RUnlock()
 	fake.getAppSummariesForSpaceMutex.RLock()
 	defer fake.getAppSummariesForSpaceMutex.RUnlock()
 	fake.getApplicationByNameAndSpaceMutex.RLock()
 	defer fake.getApplicationByNameAndSpaceMutex.RUnlock()
+	fake.getApplicationCrashesMutex.RLock()
+	defer fake.getApplicationCrashesMutex.RUnlock()
 	fake.getApplicationDropletsMutex.RLock()
 	defer fake.getApplicationDropletsMutex.RUnlock()
 	fake.getApplicationLabelsMutex.RLock()
@@ -19960,12 +22918,20 @@ func (fake *FakeActor) Invocations() map[string][][]interface{} {
 	defer fake.getApplicationRoutesMutex.RUnlock()
 	fake.getApplicationTasksMutex.RLock()
 	defer fake.getApplicationTasksMutex.RUnlock()
+	fake.getApplicationTasksWithFilterMutex.RLock()
+	defer fake.getApplicationTasksWithFilterMutex.RUnlock()
 	fake.getApplicationsByNamesAndSpaceMutex.RLock()
 	defer fake.getApplicationsByNamesAndSpaceMutex.RUnlock()
+	fake.getApplicationsBySpaceAndLabelSelectorMutex.RLock()
+	defer fake.getApplicationsBySpaceAndLabelSelectorMutex.RUnlock()
 	fake.getBuildpackLabelsMutex.RLock()
 	defer fake.getBuildpackLabelsMutex.RUnlock()
+	fake.getBuildpackUsageMutex.RLock()
+	defer fake.getBuildpackUsageMutex.RUnlock()
 	fake.getBuildpacksMutex.RLock()
 	defer fake.getBuildpacksMutex.RUnlock()
+	fake.getCurrentDropletByApplicationMutex.RLock()
+	defer fake.getCurrentDropletByApplicationMutex.RUnlock()
 	fake.getCurrentUserMutex.RLock()
 	defer fake.getCurrentUserMutex.RUnlock()
 	fake.getDefaultDomainMutex.RLock()
@@ -19986,6 +22952,8 @@ func (fake *FakeActor) Invocations() map[string][][]interface{} {
 	defer fake.getEnvironmentVariableGroupByRevisionMutex.RUnlock()
 	fake.getEnvironmentVariablesByApplicationNameAndSpaceMutex.RLock()
 	defer fake.getEnvironmentVariablesByApplicationNameAndSpaceMutex.RUnlock()
+	fake.getEventsMutex.RLock()
+	defer fake.getEventsMutex.RUnlock()
 	fake.getFeatureFlagByNameMutex.RLock()
 	defer fake.getFeatureFlagByNameMutex.RUnlock()
 	fake.getFeatureFlagsMutex.RLock()
@@ -20030,18 +22998,30 @@ func (fake *FakeActor) Invocations() map[string][][]interface{} {
 	defer fake.getOrganizationsMutex.RUnlock()
 	fake.getProcessByTypeAndApplicationMutex.RLock()
 	defer fake.getProcessByTypeAndApplicationMutex.RUnlock()
+	fake.getProcessInstancesMutex.RLock()
+	defer fake.getProcessInstancesMutex.RUnlock()
+	fake.getQuotaUsageMutex.RLock()
+	defer fake.getQuotaUsageMutex.RUnlock()
 	fake.getRawApplicationManifestByNameAndSpaceMutex.RLock()
 	defer fake.getRawApplicationManifestByNameAndSpaceMutex.RUnlock()
 	fake.getRecentEventsByApplicationNameAndSpaceMutex.RLock()
 	defer fake.getRecentEventsByApplicationNameAndSpaceMutex.RUnlock()
 	fake.getRecentLogsForApplicationByNameAndSpaceMutex.RLock()
 	defer fake.getRecentLogsForApplicationByNameAndSpaceMutex.RUnlock()
+	fake.getRecentLogsForTaskMutex.RLock()
+	defer fake.getRecentLogsForTaskMutex.RUnlock()
 	fake.getRevisionByApplicationAndVersionMutex.RLock()
 	defer fake.getRevisionByApplicationAndVersionMutex.RUnlock()
 	fake.getRevisionsByApplicationNameAndSpaceMutex.RLock()
 	defer fake.getRevisionsByApplicationNameAndSpaceMutex.RUnlock()
+	fake.getRoleChangesMutex.RLock()
+	defer fake.getRoleChangesMutex.RUnlock()
 	fake.getRootResponseMutex.RLock()
 	defer fake.getRootResponseMutex.RUnlock()
+	fake.getRouteBindingSummariesMutex.RLock()
+	defer fake.getRouteBindingSummariesMutex.RUnlock()
+	fake.getRouteBindingSummaryForRouteMutex.RLock()
+	defer fake.getRouteBindingSummaryForRouteMutex.RUnlock()
 	fake.getRouteByAttributesMutex.RLock()
 	defer fake.getRouteByAttributesMutex.RUnlock()
 	fake.getRouteDestinationByAppGUIDMutex.RLock()
@@ -20072,8 +23052,12 @@ func (fake *FakeActor) Invocations() map[string][][]interface{} {
 	defer fake.getSecurityGroupsMutex.RUnlock()
 	fake.getServiceAccessMutex.RLock()
 	defer fake.getServiceAccessMutex.RUnlock()
+	fake.getServiceAppBindingsByServiceInstanceMutex.RLock()
+	defer fake.getServiceAppBindingsByServiceInstanceMutex.RUnlock()
 	fake.getServiceBrokerByNameMutex.RLock()
 	defer fake.getServiceBrokerByNameMutex.RUnlock()
+	fake.getServiceBrokerCatalogMutex.RLock()
+	defer fake.getServiceBrokerCatalogMutex.RUnlock()
 	fake.getServiceBrokerLabelsMutex.RLock()
 	defer fake.getServiceBrokerLabelsMutex.RUnlock()
 	fake.getServiceBrokersMutex.RLock()
@@ -20086,6 +23070,8 @@ func (fake *FakeActor) Invocations() map[string][][]interface{} {
 	defer fake.getServiceInstanceLabelsMutex.RUnlock()
 	fake.getServiceInstanceParametersMutex.RLock()
 	defer fake.getServiceInstanceParametersMutex.RUnlock()
+	fake.getServiceInstanceUpgradeCandidatesMutex.RLock()
+	defer fake.getServiceInstanceUpgradeCandidatesMutex.RUnlock()
 	fake.getServiceInstancesForSpaceMutex.RLock()
 	defer fake.getServiceInstancesForSpaceMutex.RUnlock()
 	fake.getServiceKeyByServiceInstanceAndNameMutex.RLock()
@@ -20100,8 +23086,12 @@ func (fake *FakeActor) Invocations() map[string][][]interface{} {
 	defer fake.getServicePlanByNameOfferingAndBrokerMutex.RUnlock()
 	fake.getServicePlanLabelsMutex.RLock()
 	defer fake.getServicePlanLabelsMutex.RUnlock()
+	fake.getSharedServiceInstanceSummariesMutex.RLock()
+	defer fake.getSharedServiceInstanceSummariesMutex.RUnlock()
 	fake.getSpaceByNameAndOrganizationMutex.RLock()
 	defer fake.getSpaceByNameAndOrganizationMutex.RUnlock()
+	fake.getSpaceExportMutex.RLock()
+	defer fake.getSpaceExportMutex.RUnlock()
 	fake.getSpaceFeatureMutex.RLock()
 	defer fake.getSpaceFeatureMutex.RUnlock()
 	fake.getSpaceLabelsMutex.RLock()
@@ -20118,10 +23108,16 @@ func (fake *FakeActor) Invocations() map[string][][]interface{} {
 	defer fake.getStackByNameMutex.RUnlock()
 	fake.getStackLabelsMutex.RLock()
 	defer fake.getStackLabelsMutex.RUnlock()
+	fake.getStackMigrationReportMutex.RLock()
+	defer fake.getStackMigrationReportMutex.RUnlock()
 	fake.getStacksMutex.RLock()
 	defer fake.getStacksMutex.RUnlock()
+	fake.getStaleResourcesMutex.RLock()
+	defer fake.getStaleResourcesMutex.RUnlock()
 	fake.getStreamingLogsForApplicationByNameAndSpaceMutex.RLock()
 	defer fake.getStreamingLogsForApplicationByNameAndSpaceMutex.RUnlock()
+	fake.getStreamingLogsForTaskMutex.RLock()
+	defer fake.getStreamingLogsForTaskMutex.RUnlock()
 	fake.getTaskBySequenceIDAndApplicationMutex.RLock()
 	defer fake.getTaskBySequenceIDAndApplicationMutex.RUnlock()
 	fake.getUAAAPIVersionMutex.RLock()
@@ -20130,6 +23126,8 @@ func (fake *FakeActor) Invocations() map[string][][]interface{} {
 	defer fake.getUnstagedNewestPackageGUIDMutex.RUnlock()
 	fake.getUserMutex.RLock()
 	defer fake.getUserMutex.RUnlock()
+	fake.getUserRolesMutex.RLock()
+	defer fake.getUserRolesMutex.RUnlock()
 	fake.makeCurlRequestMutex.RLock()
 	defer fake.makeCurlRequestMutex.RUnlock()
 	fake.mapRouteMutex.RLock()
@@ -20140,10 +23138,14 @@ func (fake *FakeActor) Invocations() map[string][][]interface{} {
 	defer fake.moveRouteMutex.RUnlock()
 	fake.parseAccessTokenMutex.RLock()
 	defer fake.parseAccessTokenMutex.RUnlock()
+	fake.planSpaceImportMutex.RLock()
+	defer fake.planSpaceImportMutex.RUnlock()
 	fake.pollBuildMutex.RLock()
 	defer fake.pollBuildMutex.RUnlock()
 	fake.pollPackageMutex.RLock()
 	defer fake.pollPackageMutex.RUnlock()
+	fake.pollServiceInstanceLastOperationMutex.RLock()
+	defer fake.pollServiceInstanceLastOperationMutex.RUnlock()
 	fake.pollStartMutex.RLock()
 	defer fake.pollStartMutex.RUnlock()
 	fake.pollStartForDeploymentMutex.RLock()
@@ -20208,6 +23210,8 @@ func (fake *FakeActor) Invocations() map[string][][]interface{} {
 	defer fake.shareRouteMutex.RUnlock()
 	fake.shareServiceInstanceToSpaceAndOrgMutex.RLock()
 	defer fake.shareServiceInstanceToSpaceAndOrgMutex.RUnlock()
+	fake.shareServiceInstanceToSpacesMutex.RLock()
+	defer fake.shareServiceInstanceToSpacesMutex.RUnlock()
 	fake.stageApplicationPackageMutex.RLock()
 	defer fake.stageApplicationPackageMutex.RUnlock()
 	fake.stagePackageMutex.RLock()
@@ -20230,6 +23234,8 @@ func (fake *FakeActor) Invocations() map[string][][]interface{} {
 	defer fake.unsharePrivateDomainMutex.RUnlock()
 	fake.unshareRouteMutex.RLock()
 	defer fake.unshareRouteMutex.RUnlock()
+	fake.unshareServiceInstanceFromAllSpacesMutex.RLock()
+	defer fake.unshareServiceInstanceFromAllSpacesMutex.RUnlock()
 	fake.unshareServiceInstanceFromSpaceAndOrgMutex.RLock()
 	defer fake.unshareServiceInstanceFromSpaceAndOrgMutex.RUnlock()
 	fake.updateAppFeatureMutex.RLock()
@@ -0,0 +1,203 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package v7fakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/actor/cfnetworkingaction"
+	v7 "code.cloudfoundry.org/cli/command/v7"
+)
+
+type FakeApplyNetworkPoliciesActor struct {
+	ApplyPolicyChangesStub        func(cfnetworkingaction.PolicyChanges) error
+	applyPolicyChangesMutex       sync.RWMutex
+	applyPolicyChangesArgsForCall []struct {
+		arg1 cfnetworkingaction.PolicyChanges
+	}
+	applyPolicyChangesReturns struct {
+		result1 error
+	}
+	applyPolicyChangesReturnsOnCall map[int]struct {
+		result1 error
+	}
+	GetPolicyChangesStub        func([]cfnetworkingaction.PolicySpec, bool) (cfnetworkingaction.PolicyChanges, cfnetworkingaction.Warnings, error)
+	getPolicyChangesMutex       sync.RWMutex
+	getPolicyChangesArgsForCall []struct {
+		arg1 []cfnetworkingaction.PolicySpec
+		arg2 bool
+	}
+	getPolicyChangesReturns struct {
+		result1 cfnetworkingaction.PolicyChanges
+		result2 cfnetworkingaction.Warnings
+		result3 error
+	}
+	getPolicyChangesReturnsOnCall map[int]struct {
+		result1 cfnetworkingaction.PolicyChanges
+		result2 cfnetworkingaction.Warnings
+		result3 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeApplyNetworkPoliciesActor) ApplyPolicyChanges(arg1 cfnetworkingaction.PolicyChanges) error {
+	fake.applyPolicyChangesMutex.Lock()
+	ret, specificReturn := fake.applyPolicyChangesReturnsOnCall[len(fake.applyPolicyChangesArgsForCall)]
+	fake.applyPolicyChangesArgsForCall = append(fake.applyPolicyChangesArgsForCall, struct {
+		arg1 cfnetworkingaction.PolicyChanges
+	}{arg1})
+	stub := fake.ApplyPolicyChangesStub
+	fakeReturns := fake.applyPolicyChangesReturns
+	fake.recordInvocation("ApplyPolicyChanges", []interface{}{arg1})
+	fake.applyPolicyChangesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeApplyNetworkPoliciesActor) ApplyPolicyChangesCallCount() int {
+	fake.applyPolicyChangesMutex.RLock()
+	defer fake.applyPolicyChangesMutex.RUnlock()
+	return len(fake.applyPolicyChangesArgsForCall)
+}
+
+func (fake *FakeApplyNetworkPoliciesActor) ApplyPolicyChangesCalls(stub func(cfnetworkingaction.PolicyChanges) error) {
+	fake.applyPolicyChangesMutex.Lock()
+	defer fake.applyPolicyChangesMutex.Unlock()
+	fake.ApplyPolicyChangesStub = stub
+}
+
+func (fake *FakeApplyNetworkPoliciesActor) ApplyPolicyChangesArgsForCall(i int) cfnetworkingaction.PolicyChanges {
+	fake.applyPolicyChangesMutex.RLock()
+	defer fake.applyPolicyChangesMutex.RUnlock()
+	argsForCall := fake.applyPolicyChangesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeApplyNetworkPoliciesActor) ApplyPolicyChangesReturns(result1 error) {
+	fake.applyPolicyChangesMutex.Lock()
+	defer fake.applyPolicyChangesMutex.Unlock()
+	fake.ApplyPolicyChangesStub = nil
+	fake.applyPolicyChangesReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeApplyNetworkPoliciesActor) ApplyPolicyChangesReturnsOnCall(i int, result1 error) {
+	fake.applyPolicyChangesMutex.Lock()
+	defer fake.applyPolicyChangesMutex.Unlock()
+	fake.ApplyPolicyChangesStub = nil
+	if fake.applyPolicyChangesReturnsOnCall == nil {
+		fake.applyPolicyChangesReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.applyPolicyChangesReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeApplyNetworkPoliciesActor) GetPolicyChanges(arg1 []cfnetworkingaction.PolicySpec, arg2 bool) (cfnetworkingaction.PolicyChanges, cfnetworkingaction.Warnings, error) {
+	var arg1Copy []cfnetworkingaction.PolicySpec
+	if arg1 != nil {
+		arg1Copy = make([]cfnetworkingaction.PolicySpec, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.getPolicyChangesMutex.Lock()
+	ret, specificReturn := fake.getPolicyChangesReturnsOnCall[len(fake.getPolicyChangesArgsForCall)]
+	fake.getPolicyChangesArgsForCall = append(fake.getPolicyChangesArgsForCall, struct {
+		arg1 []cfnetworkingaction.PolicySpec
+		arg2 bool
+	}{arg1Copy, arg2})
+	stub := fake.GetPolicyChangesStub
+	fakeReturns := fake.getPolicyChangesReturns
+	fake.recordInvocation("GetPolicyChanges", []interface{}{arg1Copy, arg2})
+	fake.getPolicyChangesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeApplyNetworkPoliciesActor) GetPolicyChangesCallCount() int {
+	fake.getPolicyChangesMutex.RLock()
+	defer fake.getPolicyChangesMutex.RUnlock()
+	return len(fake.getPolicyChangesArgsForCall)
+}
+
+func (fake *FakeApplyNetworkPoliciesActor) GetPolicyChangesCalls(stub func([]cfnetworkingaction.PolicySpec, bool) (cfnetworkingaction.PolicyChanges, cfnetworkingaction.Warnings, error)) {
+	fake.getPolicyChangesMutex.Lock()
+	defer fake.getPolicyChangesMutex.Unlock()
+	fake.GetPolicyChangesStub = stub
+}
+
+func (fake *FakeApplyNetworkPoliciesActor) GetPolicyChangesArgsForCall(i int) ([]cfnetworkingaction.PolicySpec, bool) {
+	fake.getPolicyChangesMutex.RLock()
+	defer fake.getPolicyChangesMutex.RUnlock()
+	argsForCall := fake.getPolicyChangesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeApplyNetworkPoliciesActor) GetPolicyChangesReturns(result1 cfnetworkingaction.PolicyChanges, result2 cfnetworkingaction.Warnings, result3 error) {
+	fake.getPolicyChangesMutex.Lock()
+	defer fake.getPolicyChangesMutex.Unlock()
+	fake.GetPolicyChangesStub = nil
+	fake.getPolicyChangesReturns = struct {
+		result1 cfnetworkingaction.PolicyChanges
+		result2 cfnetworkingaction.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeApplyNetworkPoliciesActor) GetPolicyChangesReturnsOnCall(i int, result1 cfnetworkingaction.PolicyChanges, result2 cfnetworkingaction.Warnings, result3 error) {
+	fake.getPolicyChangesMutex.Lock()
+	defer fake.getPolicyChangesMutex.Unlock()
+	fake.GetPolicyChangesStub = nil
+	if fake.getPolicyChangesReturnsOnCall == nil {
+		fake.getPolicyChangesReturnsOnCall = make(map[int]struct {
+			result1 cfnetworkingaction.PolicyChanges
+			result2 cfnetworkingaction.Warnings
+			result3 error
+		})
+	}
+	fake.getPolicyChangesReturnsOnCall[i] = struct {
+		result1 cfnetworkingaction.PolicyChanges
+		result2 cfnetworkingaction.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeApplyNetworkPoliciesActor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.applyPolicyChangesMutex.RLock()
+	defer fake.applyPolicyChangesMutex.RUnlock()
+	fake.getPolicyChangesMutex.RLock()
+	defer fake.getPolicyChangesMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeApplyNetworkPoliciesActor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v7.ApplyNetworkPoliciesActor = new(FakeApplyNetworkPoliciesActor)
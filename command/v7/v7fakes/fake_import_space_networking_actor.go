@@ -0,0 +1,213 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package v7fakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/actor/cfnetworkingaction"
+	v7 "code.cloudfoundry.org/cli/command/v7"
+)
+
+type FakeImportSpaceNetworkingActor struct {
+	AddNetworkPolicyStub        func(string, string, string, string, string, int, int) (cfnetworkingaction.Warnings, error)
+	addNetworkPolicyMutex       sync.RWMutex
+	addNetworkPolicyArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 string
+		arg4 string
+		arg5 string
+		arg6 int
+		arg7 int
+	}
+	addNetworkPolicyReturns struct {
+		result1 cfnetworkingaction.Warnings
+		result2 error
+	}
+	addNetworkPolicyReturnsOnCall map[int]struct {
+		result1 cfnetworkingaction.Warnings
+		result2 error
+	}
+	NetworkPoliciesBySpaceStub        func(string) ([]cfnetworkingaction.Policy, cfnetworkingaction.Warnings, error)
+	networkPoliciesBySpaceMutex       sync.RWMutex
+	networkPoliciesBySpaceArgsForCall []struct {
+		arg1 string
+	}
+	networkPoliciesBySpaceReturns struct {
+		result1 []cfnetworkingaction.Policy
+		result2 cfnetworkingaction.Warnings
+		result3 error
+	}
+	networkPoliciesBySpaceReturnsOnCall map[int]struct {
+		result1 []cfnetworkingaction.Policy
+		result2 cfnetworkingaction.Warnings
+		result3 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeImportSpaceNetworkingActor) AddNetworkPolicy(arg1 string, arg2 string, arg3 string, arg4 string, arg5 string, arg6 int, arg7 int) (cfnetworkingaction.Warnings, error) {
+	fake.addNetworkPolicyMutex.Lock()
+	ret, specificReturn := fake.addNetworkPolicyReturnsOnCall[len(fake.addNetworkPolicyArgsForCall)]
+	fake.addNetworkPolicyArgsForCall = append(fake.addNetworkPolicyArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 string
+		arg4 string
+		arg5 string
+		arg6 int
+		arg7 int
+	}{arg1, arg2, arg3, arg4, arg5, arg6, arg7})
+	stub := fake.AddNetworkPolicyStub
+	fakeReturns := fake.addNetworkPolicyReturns
+	fake.recordInvocation("AddNetworkPolicy", []interface{}{arg1, arg2, arg3, arg4, arg5, arg6, arg7})
+	fake.addNetworkPolicyMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5, arg6, arg7)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeImportSpaceNetworkingActor) AddNetworkPolicyCallCount() int {
+	fake.addNetworkPolicyMutex.RLock()
+	defer fake.addNetworkPolicyMutex.RUnlock()
+	return len(fake.addNetworkPolicyArgsForCall)
+}
+
+func (fake *FakeImportSpaceNetworkingActor) AddNetworkPolicyCalls(stub func(string, string, string, string, string, int, int) (cfnetworkingaction.Warnings, error)) {
+	fake.addNetworkPolicyMutex.Lock()
+	defer fake.addNetworkPolicyMutex.Unlock()
+	fake.AddNetworkPolicyStub = stub
+}
+
+func (fake *FakeImportSpaceNetworkingActor) AddNetworkPolicyArgsForCall(i int) (string, string, string, string, string, int, int) {
+	fake.addNetworkPolicyMutex.RLock()
+	defer fake.addNetworkPolicyMutex.RUnlock()
+	argsForCall := fake.addNetworkPolicyArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5, argsForCall.arg6, argsForCall.arg7
+}
+
+func (fake *FakeImportSpaceNetworkingActor) AddNetworkPolicyReturns(result1 cfnetworkingaction.Warnings, result2 error) {
+	fake.addNetworkPolicyMutex.Lock()
+	defer fake.addNetworkPolicyMutex.Unlock()
+	fake.AddNetworkPolicyStub = nil
+	fake.addNetworkPolicyReturns = struct {
+		result1 cfnetworkingaction.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImportSpaceNetworkingActor) AddNetworkPolicyReturnsOnCall(i int, result1 cfnetworkingaction.Warnings, result2 error) {
+	fake.addNetworkPolicyMutex.Lock()
+	defer fake.addNetworkPolicyMutex.Unlock()
+	fake.AddNetworkPolicyStub = nil
+	if fake.addNetworkPolicyReturnsOnCall == nil {
+		fake.addNetworkPolicyReturnsOnCall = make(map[int]struct {
+			result1 cfnetworkingaction.Warnings
+			result2 error
+		})
+	}
+	fake.addNetworkPolicyReturnsOnCall[i] = struct {
+		result1 cfnetworkingaction.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImportSpaceNetworkingActor) NetworkPoliciesBySpace(arg1 string) ([]cfnetworkingaction.Policy, cfnetworkingaction.Warnings, error) {
+	fake.networkPoliciesBySpaceMutex.Lock()
+	ret, specificReturn := fake.networkPoliciesBySpaceReturnsOnCall[len(fake.networkPoliciesBySpaceArgsForCall)]
+	fake.networkPoliciesBySpaceArgsForCall = append(fake.networkPoliciesBySpaceArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.NetworkPoliciesBySpaceStub
+	fakeReturns := fake.networkPoliciesBySpaceReturns
+	fake.recordInvocation("NetworkPoliciesBySpace", []interface{}{arg1})
+	fake.networkPoliciesBySpaceMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeImportSpaceNetworkingActor) NetworkPoliciesBySpaceCallCount() int {
+	fake.networkPoliciesBySpaceMutex.RLock()
+	defer fake.networkPoliciesBySpaceMutex.RUnlock()
+	return len(fake.networkPoliciesBySpaceArgsForCall)
+}
+
+func (fake *FakeImportSpaceNetworkingActor) NetworkPoliciesBySpaceCalls(stub func(string) ([]cfnetworkingaction.Policy, cfnetworkingaction.Warnings, error)) {
+	fake.networkPoliciesBySpaceMutex.Lock()
+	defer fake.networkPoliciesBySpaceMutex.Unlock()
+	fake.NetworkPoliciesBySpaceStub = stub
+}
+
+func (fake *FakeImportSpaceNetworkingActor) NetworkPoliciesBySpaceArgsForCall(i int) string {
+	fake.networkPoliciesBySpaceMutex.RLock()
+	defer fake.networkPoliciesBySpaceMutex.RUnlock()
+	argsForCall := fake.networkPoliciesBySpaceArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeImportSpaceNetworkingActor) NetworkPoliciesBySpaceReturns(result1 []cfnetworkingaction.Policy, result2 cfnetworkingaction.Warnings, result3 error) {
+	fake.networkPoliciesBySpaceMutex.Lock()
+	defer fake.networkPoliciesBySpaceMutex.Unlock()
+	fake.NetworkPoliciesBySpaceStub = nil
+	fake.networkPoliciesBySpaceReturns = struct {
+		result1 []cfnetworkingaction.Policy
+		result2 cfnetworkingaction.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeImportSpaceNetworkingActor) NetworkPoliciesBySpaceReturnsOnCall(i int, result1 []cfnetworkingaction.Policy, result2 cfnetworkingaction.Warnings, result3 error) {
+	fake.networkPoliciesBySpaceMutex.Lock()
+	defer fake.networkPoliciesBySpaceMutex.Unlock()
+	fake.NetworkPoliciesBySpaceStub = nil
+	if fake.networkPoliciesBySpaceReturnsOnCall == nil {
+		fake.networkPoliciesBySpaceReturnsOnCall = make(map[int]struct {
+			result1 []cfnetworkingaction.Policy
+			result2 cfnetworkingaction.Warnings
+			result3 error
+		})
+	}
+	fake.networkPoliciesBySpaceReturnsOnCall[i] = struct {
+		result1 []cfnetworkingaction.Policy
+		result2 cfnetworkingaction.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeImportSpaceNetworkingActor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.addNetworkPolicyMutex.RLock()
+	defer fake.addNetworkPolicyMutex.RUnlock()
+	fake.networkPoliciesBySpaceMutex.RLock()
+	defer fake.networkPoliciesBySpaceMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeImportSpaceNetworkingActor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v7.ImportSpaceNetworkingActor = new(FakeImportSpaceNetworkingActor)
@@ -9,6 +9,35 @@ import (
 )
 
 type FakeNetworkPoliciesActor struct {
+	NetworkPoliciesStub        func() ([]cfnetworkingaction.Policy, cfnetworkingaction.Warnings, error)
+	networkPoliciesMutex       sync.RWMutex
+	networkPoliciesArgsForCall []struct {
+	}
+	networkPoliciesReturns struct {
+		result1 []cfnetworkingaction.Policy
+		result2 cfnetworkingaction.Warnings
+		result3 error
+	}
+	networkPoliciesReturnsOnCall map[int]struct {
+		result1 []cfnetworkingaction.Policy
+		result2 cfnetworkingaction.Warnings
+		result3 error
+	}
+	NetworkPoliciesByOrgStub        func(string) ([]cfnetworkingaction.Policy, cfnetworkingaction.Warnings, error)
+	networkPoliciesByOrgMutex       sync.RWMutex
+	networkPoliciesByOrgArgsForCall []struct {
+		arg1 string
+	}
+	networkPoliciesByOrgReturns struct {
+		result1 []cfnetworkingaction.Policy
+		result2 cfnetworkingaction.Warnings
+		result3 error
+	}
+	networkPoliciesByOrgReturnsOnCall map[int]struct {
+		result1 []cfnetworkingaction.Policy
+		result2 cfnetworkingaction.Warnings
+		result3 error
+	}
 	NetworkPoliciesBySpaceStub        func(string) ([]cfnetworkingaction.Policy, cfnetworkingaction.Warnings, error)
 	networkPoliciesBySpaceMutex       sync.RWMutex
 	networkPoliciesBySpaceArgsForCall []struct {
@@ -44,21 +73,148 @@ type FakeNetworkPoliciesActor struct {
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeNetworkPoliciesActor) NetworkPolicies() ([]cfnetworkingaction.Policy, cfnetworkingaction.Warnings, error) {
+	fake.networkPoliciesMutex.Lock()
+	ret, specificReturn := fake.networkPoliciesReturnsOnCall[len(fake.networkPoliciesArgsForCall)]
+	fake.networkPoliciesArgsForCall = append(fake.networkPoliciesArgsForCall, struct {
+	}{})
+	stub := fake.NetworkPoliciesStub
+	fakeReturns := fake.networkPoliciesReturns
+	fake.recordInvocation("NetworkPolicies", []interface{}{})
+	fake.networkPoliciesMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeNetworkPoliciesActor) NetworkPoliciesCallCount() int {
+	fake.networkPoliciesMutex.RLock()
+	defer fake.networkPoliciesMutex.RUnlock()
+	return len(fake.networkPoliciesArgsForCall)
+}
+
+func (fake *FakeNetworkPoliciesActor) NetworkPoliciesCalls(stub func() ([]cfnetworkingaction.Policy, cfnetworkingaction.Warnings, error)) {
+	fake.networkPoliciesMutex.Lock()
+	defer fake.networkPoliciesMutex.Unlock()
+	fake.NetworkPoliciesStub = stub
+}
+
+func (fake *FakeNetworkPoliciesActor) NetworkPoliciesReturns(result1 []cfnetworkingaction.Policy, result2 cfnetworkingaction.Warnings, result3 error) {
+	fake.networkPoliciesMutex.Lock()
+	defer fake.networkPoliciesMutex.Unlock()
+	fake.NetworkPoliciesStub = nil
+	fake.networkPoliciesReturns = struct {
+		result1 []cfnetworkingaction.Policy
+		result2 cfnetworkingaction.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeNetworkPoliciesActor) NetworkPoliciesReturnsOnCall(i int, result1 []cfnetworkingaction.Policy, result2 cfnetworkingaction.Warnings, result3 error) {
+	fake.networkPoliciesMutex.Lock()
+	defer fake.networkPoliciesMutex.Unlock()
+	fake.NetworkPoliciesStub = nil
+	if fake.networkPoliciesReturnsOnCall == nil {
+		fake.networkPoliciesReturnsOnCall = make(map[int]struct {
+			result1 []cfnetworkingaction.Policy
+			result2 cfnetworkingaction.Warnings
+			result3 error
+		})
+	}
+	fake.networkPoliciesReturnsOnCall[i] = struct {
+		result1 []cfnetworkingaction.Policy
+		result2 cfnetworkingaction.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeNetworkPoliciesActor) NetworkPoliciesByOrg(arg1 string) ([]cfnetworkingaction.Policy, cfnetworkingaction.Warnings, error) {
+	fake.networkPoliciesByOrgMutex.Lock()
+	ret, specificReturn := fake.networkPoliciesByOrgReturnsOnCall[len(fake.networkPoliciesByOrgArgsForCall)]
+	fake.networkPoliciesByOrgArgsForCall = append(fake.networkPoliciesByOrgArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.NetworkPoliciesByOrgStub
+	fakeReturns := fake.networkPoliciesByOrgReturns
+	fake.recordInvocation("NetworkPoliciesByOrg", []interface{}{arg1})
+	fake.networkPoliciesByOrgMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeNetworkPoliciesActor) NetworkPoliciesByOrgCallCount() int {
+	fake.networkPoliciesByOrgMutex.RLock()
+	defer fake.networkPoliciesByOrgMutex.RUnlock()
+	return len(fake.networkPoliciesByOrgArgsForCall)
+}
+
+func (fake *FakeNetworkPoliciesActor) NetworkPoliciesByOrgCalls(stub func(string) ([]cfnetworkingaction.Policy, cfnetworkingaction.Warnings, error)) {
+	fake.networkPoliciesByOrgMutex.Lock()
+	defer fake.networkPoliciesByOrgMutex.Unlock()
+	fake.NetworkPoliciesByOrgStub = stub
+}
+
+func (fake *FakeNetworkPoliciesActor) NetworkPoliciesByOrgArgsForCall(i int) string {
+	fake.networkPoliciesByOrgMutex.RLock()
+	defer fake.networkPoliciesByOrgMutex.RUnlock()
+	argsForCall := fake.networkPoliciesByOrgArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeNetworkPoliciesActor) NetworkPoliciesByOrgReturns(result1 []cfnetworkingaction.Policy, result2 cfnetworkingaction.Warnings, result3 error) {
+	fake.networkPoliciesByOrgMutex.Lock()
+	defer fake.networkPoliciesByOrgMutex.Unlock()
+	fake.NetworkPoliciesByOrgStub = nil
+	fake.networkPoliciesByOrgReturns = struct {
+		result1 []cfnetworkingaction.Policy
+		result2 cfnetworkingaction.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeNetworkPoliciesActor) NetworkPoliciesByOrgReturnsOnCall(i int, result1 []cfnetworkingaction.Policy, result2 cfnetworkingaction.Warnings, result3 error) {
+	fake.networkPoliciesByOrgMutex.Lock()
+	defer fake.networkPoliciesByOrgMutex.Unlock()
+	fake.NetworkPoliciesByOrgStub = nil
+	if fake.networkPoliciesByOrgReturnsOnCall == nil {
+		fake.networkPoliciesByOrgReturnsOnCall = make(map[int]struct {
+			result1 []cfnetworkingaction.Policy
+			result2 cfnetworkingaction.Warnings
+			result3 error
+		})
+	}
+	fake.networkPoliciesByOrgReturnsOnCall[i] = struct {
+		result1 []cfnetworkingaction.Policy
+		result2 cfnetworkingaction.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeNetworkPoliciesActor) NetworkPoliciesBySpace(arg1 string) ([]cfnetworkingaction.Policy, cfnetworkingaction.Warnings, error) {
 	fake.networkPoliciesBySpaceMutex.Lock()
 	ret, specificReturn := fake.networkPoliciesBySpaceReturnsOnCall[len(fake.networkPoliciesBySpaceArgsForCall)]
 	fake.networkPoliciesBySpaceArgsForCall = append(fake.networkPoliciesBySpaceArgsForCall, struct {
 		arg1 string
 	}{arg1})
+	stub := fake.NetworkPoliciesBySpaceStub
+	fakeReturns := fake.networkPoliciesBySpaceReturns
 	fake.recordInvocation("NetworkPoliciesBySpace", []interface{}{arg1})
 	fake.networkPoliciesBySpaceMutex.Unlock()
-	if fake.NetworkPoliciesBySpaceStub != nil {
-		return fake.NetworkPoliciesBySpaceStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
 	}
-	fakeReturns := fake.networkPoliciesBySpaceReturns
 	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
 }
 
@@ -117,15 +273,16 @@ func (fake *FakeNetworkPoliciesActor) NetworkPoliciesBySpaceAndAppName(arg1 stri
 		arg1 string
 		arg2 string
 	}{arg1, arg2})
+	stub := fake.NetworkPoliciesBySpaceAndAppNameStub
+	fakeReturns := fake.networkPoliciesBySpaceAndAppNameReturns
 	fake.recordInvocation("NetworkPoliciesBySpaceAndAppName", []interface{}{arg1, arg2})
 	fake.networkPoliciesBySpaceAndAppNameMutex.Unlock()
-	if fake.NetworkPoliciesBySpaceAndAppNameStub != nil {
-		return fake.NetworkPoliciesBySpaceAndAppNameStub(arg1, arg2)
+	if stub != nil {
+		return stub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
 	}
-	fakeReturns := fake.networkPoliciesBySpaceAndAppNameReturns
 	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
 }
 
@@ -180,6 +337,10 @@ func (fake *FakeNetworkPoliciesActor) NetworkPoliciesBySpaceAndAppNameReturnsOnC
 func (fake *FakeNetworkPoliciesActor) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.networkPoliciesMutex.RLock()
+	defer fake.networkPoliciesMutex.RUnlock()
+	fake.networkPoliciesByOrgMutex.RLock()
+	defer fake.networkPoliciesByOrgMutex.RUnlock()
 	fake.networkPoliciesBySpaceMutex.RLock()
 	defer fake.networkPoliciesBySpaceMutex.RUnlock()
 	fake.networkPoliciesBySpaceAndAppNameMutex.RLock()
@@ -0,0 +1,67 @@
+package v7
+
+import (
+	"time"
+
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/resources"
+)
+
+type WaitServiceCommand struct {
+	BaseCommand
+
+	RequiredArgs    flag.ServiceInstance `positional-args:"yes"`
+	Timeout         flag.Duration        `long:"timeout" description:"Maximum time to wait for the operation to complete (e.g. 30m, 2h). Default: no limit"`
+	usage           interface{}          `usage:"CF_NAME wait-service SERVICE_INSTANCE [--timeout TIMEOUT]\n\n   Exits with a non-zero status if the operation fails or the timeout is reached.\n   Only the last operation of the service instance is watched; the Cloud Controller job that started it cannot be looked up afterwards.\n\nEXAMPLES:\n   CF_NAME wait-service mydb\n   CF_NAME wait-service mydb --timeout 45m"`
+	relatedCommands interface{}          `related_commands:"create-service, delete-service, service, update-service, upgrade-service"`
+}
+
+func (cmd WaitServiceCommand) Execute(args []string) error {
+	if err := cmd.SharedActor.CheckTarget(true, true); err != nil {
+		return err
+	}
+
+	user, err := cmd.Actor.GetCurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Waiting for the last operation of service instance {{.ServiceInstance}} in org {{.Org}} / space {{.Space}} as {{.User}}...", map[string]interface{}{
+		"ServiceInstance": cmd.RequiredArgs.ServiceInstance,
+		"Org":             cmd.Config.TargetedOrganization().Name,
+		"Space":           cmd.Config.TargetedSpace().Name,
+		"User":            user.Name,
+	})
+	cmd.UI.DisplayNewline()
+
+	if err := watchServiceInstanceLastOperation(cmd.UI, cmd.Actor, string(cmd.RequiredArgs.ServiceInstance), cmd.Config.TargetedSpace().GUID, cmd.Timeout.Value); err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayOK()
+	return nil
+}
+
+// watchServiceInstanceLastOperation displays each state the last operation of
+// a service instance passes through until it completes.
+func watchServiceInstanceLastOperation(ui command.UI, actor Actor, serviceInstanceName, spaceGUID string, timeout time.Duration) error {
+	warnings, err := actor.PollServiceInstanceLastOperation(serviceInstanceName, spaceGUID, timeout, func(operation resources.LastOperation) {
+		if operation == (resources.LastOperation{}) {
+			ui.DisplayText(indent + "There is no last operation available for this service instance.")
+			return
+		}
+
+		status := lastOperation(operation)
+		if operation.Description != "" {
+			status += ": " + operation.Description
+		}
+		ui.DisplayText(indent+"{{.Time}}  {{.Status}}", map[string]interface{}{
+			"Time":   time.Now().Format("15:04:05"),
+			"Status": status,
+		})
+	})
+	ui.DisplayWarnings(warnings)
+	return err
+}
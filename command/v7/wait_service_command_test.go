@@ -0,0 +1,150 @@
+package v7_test
+
+import (
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	v7 "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("wait-service Command", func() {
+	var (
+		cmd             v7.WaitServiceCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeActor
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+
+		cmd = v7.WaitServiceCommand{
+			BaseCommand: v7.BaseCommand{
+				UI:          testUI,
+				Config:      fakeConfig,
+				SharedActor: fakeSharedActor,
+				Actor:       fakeActor,
+			},
+		}
+		setPositionalFlags(&cmd, "my-db")
+
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+		fakeActor.GetCurrentUserReturns(configv3.User{Name: "steve"}, nil)
+
+		fakeActor.PollServiceInstanceLastOperationStub = func(_, _ string, _ time.Duration, handle func(resources.LastOperation)) (v7action.Warnings, error) {
+			handle(resources.LastOperation{Type: resources.UpdateOperation, State: resources.OperationInProgress, Description: "resizing disk"})
+			handle(resources.LastOperation{Type: resources.UpdateOperation, State: resources.OperationSucceeded})
+			return v7action.Warnings{"poll warning"}, nil
+		}
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	It("checks the org and space are targeted", func() {
+		Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+		checkOrg, checkSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+		Expect(checkOrg).To(BeTrue())
+		Expect(checkSpace).To(BeTrue())
+	})
+
+	It("polls the last operation without a timeout", func() {
+		Expect(fakeActor.PollServiceInstanceLastOperationCallCount()).To(Equal(1))
+		name, spaceGUID, timeout, _ := fakeActor.PollServiceInstanceLastOperationArgsForCall(0)
+		Expect(name).To(Equal("my-db"))
+		Expect(spaceGUID).To(Equal("some-space-guid"))
+		Expect(timeout).To(BeZero())
+	})
+
+	It("displays each state of the operation", func() {
+		Expect(executeErr).NotTo(HaveOccurred())
+
+		Expect(testUI.Out).To(Say(`Waiting for the last operation of service instance my-db in org some-org / space some-space as steve\.\.\.`))
+		Expect(testUI.Out).To(Say(`\d{2}:\d{2}:\d{2}\s+update in progress: resizing disk`))
+		Expect(testUI.Out).To(Say(`\d{2}:\d{2}:\d{2}\s+update succeeded`))
+		Expect(testUI.Out).To(Say(`OK`))
+		Expect(testUI.Err).To(Say("poll warning"))
+	})
+
+	When("a timeout is given", func() {
+		BeforeEach(func() {
+			setFlag(&cmd, "--timeout", flag.Duration{Value: 45 * time.Minute, IsSet: true})
+		})
+
+		It("passes it to the actor", func() {
+			_, _, timeout, _ := fakeActor.PollServiceInstanceLastOperationArgsForCall(0)
+			Expect(timeout).To(Equal(45 * time.Minute))
+		})
+	})
+
+	When("the instance has no last operation", func() {
+		BeforeEach(func() {
+			fakeActor.PollServiceInstanceLastOperationStub = func(_, _ string, _ time.Duration, handle func(resources.LastOperation)) (v7action.Warnings, error) {
+				handle(resources.LastOperation{})
+				return nil, nil
+			}
+		})
+
+		It("says so", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(testUI.Out).To(Say(`There is no last operation available for this service instance\.`))
+			Expect(testUI.Out).To(Say(`OK`))
+		})
+	})
+
+	When("the operation fails", func() {
+		BeforeEach(func() {
+			fakeActor.PollServiceInstanceLastOperationStub = nil
+			fakeActor.PollServiceInstanceLastOperationReturns(
+				v7action.Warnings{"poll warning"},
+				actionerror.ServiceInstanceOperationFailedError{Name: "my-db", Operation: "update", Description: "disk full"},
+			)
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError(actionerror.ServiceInstanceOperationFailedError{Name: "my-db", Operation: "update", Description: "disk full"}))
+			Expect(testUI.Err).To(Say("poll warning"))
+			Expect(testUI.Out).NotTo(Say(`OK`))
+		})
+	})
+
+	When("the timeout is reached", func() {
+		BeforeEach(func() {
+			fakeActor.PollServiceInstanceLastOperationStub = nil
+			fakeActor.PollServiceInstanceLastOperationReturns(nil, actionerror.ServiceInstanceOperationTimeoutError{Name: "my-db"})
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError(actionerror.ServiceInstanceOperationTimeoutError{Name: "my-db"}))
+		})
+	})
+
+	When("checking the target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(errors.New("not targeted"))
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError("not targeted"))
+			Expect(fakeActor.PollServiceInstanceLastOperationCallCount()).To(Equal(0))
+		})
+	})
+})
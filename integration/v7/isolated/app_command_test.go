@@ -42,7 +42,9 @@ var _ = Describe("app command", func() {
 				Eventually(session).Should(Say("USAGE:"))
 				Eventually(session).Should(Say("cf app APP_NAME"))
 				Eventually(session).Should(Say("OPTIONS:"))
-				Eventually(session).Should(Say("--guid      Retrieve and display the given app's guid.  All other health and status output for the app is suppressed."))
+				Eventually(session).Should(Say(`--guid\s+Retrieve and display the given app's guid.  All other health and status output for the app is suppressed.`))
+				Eventually(session).Should(Say(`--interval\s+Time between refreshes when using --watch \(e.g. 10s, 1m\). Default: 5s`))
+				Eventually(session).Should(Say(`--watch\s+Continuously refresh the app's instance stats until interrupted`))
 				Eventually(session).Should(Say("SEE ALSO:"))
 				Eventually(session).Should(Say("apps, events, logs, map-route, push, unmap-route"))
 				Eventually(session).Should(Exit(0))
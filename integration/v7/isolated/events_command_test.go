@@ -27,14 +27,14 @@ var _ = Describe("events command", func() {
 			It("appears in cf help -a", func() {
 				session := helpers.CF("help", "-a")
 				Eventually(session).Should(Exit(0))
-				Expect(session).To(HaveCommandInCategoryWithDescription("events", "APPS", "Show recent app events"))
+				Expect(session).To(HaveCommandInCategoryWithDescription("events", "APPS", "Show recent app events, or search audit events in a space, org or foundation"))
 			})
 
 			It("Displays command usage to output", func() {
 				session := helpers.CF("events", "--help")
 
 				Eventually(session).Should(Say("NAME:"))
-				Eventually(session).Should(Say("events - Show recent app events"))
+				Eventually(session).Should(Say("events - Show recent app events, or search audit events in a space, org or foundation"))
 				Eventually(session).Should(Say("USAGE:"))
 				Eventually(session).Should(Say("cf events APP_NAME"))
 				Eventually(session).Should(Say("SEE ALSO:"))
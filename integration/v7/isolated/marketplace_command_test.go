@@ -18,14 +18,25 @@ var _ = Describe("marketplace command", func() {
 			Say(`NAME:`),
 			Say(`marketplace - List available offerings in the marketplace`),
 			Say(`USAGE:`),
-			Say(`cf marketplace \[-e SERVICE_OFFERING\] \[-b SERVICE_BROKER\] \[--no-plans\]`),
+			Say(`cf marketplace \[-e SERVICE_OFFERING \[-p SERVICE_PLAN\]\] \[-b SERVICE_BROKER\] \[--no-plans\]`),
+			Say(`\[--search TEXT\] \[--tag TAG\] \[--free-only\] \[--bindable\] \[--show-unavailable\]`),
+			Say(`EXAMPLES:`),
+			Say(`cf marketplace --search postgres --free-only`),
+			Say(`cf marketplace --tag cache --bindable`),
+			Say(`cf marketplace -e my-db -p small`),
 			Say(`ALIAS:`),
 			Say(`m`),
 			Say(`OPTIONS:`),
 			Say(`-e\s+Show plan details for a particular service offering`),
+			Say(`-p\s+Show all details of a particular plan of the service offering. Requires -e`),
+			Say(`-b\s+Only show details for a particular service broker`),
 			Say(`--no-plans\s+Hide plan information for service offerings`),
 			Say(`--show-unavailable\s+Show plans that are not available for use`),
-			Say(`create-service, services`),
+			Say(`--search\s+Only show offerings and plans whose name, description or tags contain the text`),
+			Say(`--tag\s+Only show offerings with the tag`),
+			Say(`--free-only\s+Only show free plans`),
+			Say(`--bindable\s+Only show plans that can be bound to apps`),
+			Say(`create-service, service-plan-schema, services`),
 		)
 
 		When("the --help flag is set", func() {
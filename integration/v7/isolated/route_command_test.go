@@ -47,7 +47,7 @@ var _ = Describe("route command", func() {
 			Eventually(session).Should(Say(`\n`))
 
 			Eventually(session).Should(Say(`SEE ALSO:`))
-			Eventually(session).Should(Say(`create-route, delete-route, routes`))
+			Eventually(session).Should(Say(`create-route, delete-route, route-service-bindings, routes`))
 
 			Eventually(session).Should(Exit(0))
 		})
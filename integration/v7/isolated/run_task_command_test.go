@@ -22,7 +22,7 @@ var _ = Describe("run-task command", func() {
 			Expect(session).To(Say("USAGE:"))
 			Expect(session).To(Say(`   cf run-task APP_NAME \[--command COMMAND\] \[-k DISK] \[-m MEMORY\] \[-l LOG_RATE_LIMIT\] \[--name TASK_NAME\] \[--process PROCESS_TYPE\]`))
 			Expect(session).To(Say("TIP:"))
-			Expect(session).To(Say("   Use 'cf logs' to display the logs of the app and all its tasks. If your task name is unique, grep this command's output for the task name to view task-specific logs."))
+			Expect(session).To(Say("   Use 'cf task-logs' to display the logs of a single task, or '--wait' to follow them until the task completes."))
 			Expect(session).To(Say("EXAMPLES:"))
 			Expect(session).To(Say(`   cf run-task my-app --command "bundle exec rake db:migrate" --name migrate`))
 			Expect(session).To(Say("ALIAS:"))
@@ -35,7 +35,7 @@ var _ = Describe("run-task command", func() {
 			Expect(session).To(Say(`   --name             Name to give the task \(generated if omitted\)`))
 			Expect(session).To(Say(`   --process          Process type to use as a template for command, memory, and disk for the created task`))
 			Expect(session).To(Say("SEE ALSO:"))
-			Expect(session).To(Say("   logs, tasks, task, terminate-task"))
+			Expect(session).To(Say("   logs, tasks, task, task-logs, terminate-task"))
 		})
 	})
 
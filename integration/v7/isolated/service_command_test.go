@@ -24,14 +24,15 @@ var _ = Describe("service command", func() {
 			Say(fmt.Sprintf(`\s+%s - Show service instance info\n`, serviceCommand)),
 			Say(`\n`),
 			Say(`USAGE:\n`),
-			Say(`\s+cf service SERVICE_INSTANCE\n`),
+			Say(`\s+cf service SERVICE_INSTANCE \[--guid \| --params \| --watch\]\n`),
 			Say(`\n`),
 			Say(`OPTIONS:\n`),
 			Say(`\s+--guid\s+Retrieve and display the given service instances's guid. All other output is suppressed.\n`),
 			Say(`\s+--params\s+Retrieve and display the given service instances's parameters. All other output is suppressed.\n`),
+			Say(`\s+--watch\s+Keep displaying the status of an operation in progress until it completes\n`),
 			Say(`\n`),
 			Say(`SEE ALSO:\n`),
-			Say(`\s+bind-service, rename-service, update-service\n`),
+			Say(`\s+bind-service, rename-service, update-service, wait-service\n`),
 			Say(`$`),
 		)
 
@@ -24,12 +24,14 @@ var _ = Describe("share-service command", func() {
 			Say("NAME:"),
 			Say("share-service - Share a service instance with another space"),
 			Say("USAGE:"),
-			Say(`cf share-service SERVICE_INSTANCE -s OTHER_SPACE \[-o OTHER_ORG\]`),
+			Say(`cf share-service SERVICE_INSTANCE -s OTHER_SPACE\[,OTHER_SPACE\.\.\.\] \[-o OTHER_ORG\]`),
+			Say(`cf share-service SERVICE_INSTANCE --all-spaces \[-o OTHER_ORG\]`),
 			Say("OPTIONS:"),
-			Say(`-s\s+The space to share the service instance into`),
+			Say(`-s\s+The space to share the service instance into, or a comma-separated list of spaces`),
 			Say(`-o\s+Org of the other space \(Default: targeted org\)`),
+			Say(`--all-spaces\s+Share the service instance into every space of the org`),
 			Say("SEE ALSO:"),
-			Say("bind-service, service, services, unshare-service"),
+			Say("bind-service, service, services, shared-services, unshare-service"),
 		)
 
 		When("the -h flag is specified", func() {
@@ -53,7 +55,7 @@ var _ = Describe("share-service command", func() {
 			It("fails with an error and prints help", func() {
 				session := helpers.CF(shareServiceCommand, serviceInstanceName)
 				Eventually(session).Should(Exit(1))
-				Expect(session.Err).To(Say("Incorrect Usage: the required flag `-s' was not specified"))
+				Expect(session.Err).To(Say("Incorrect Usage: either -s or --all-spaces must be provided"))
 				Expect(session.Out).To(matchHelpMessage)
 			})
 		})
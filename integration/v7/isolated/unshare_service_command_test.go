@@ -32,12 +32,14 @@ var _ = Describe("unshare-service command", func() {
 			Say("unshare-service - Unshare a shared service instance from a space"),
 			Say("USAGE:"),
 			Say(`cf unshare-service SERVICE_INSTANCE -s OTHER_SPACE \[-o OTHER_ORG\] \[-f\]`),
+			Say(`cf unshare-service SERVICE_INSTANCE --all \[-f\]`),
 			Say("OPTIONS:"),
 			Say(`-s\s+Space to unshare the service instance from`),
 			Say(`-o\s+Org of the other space \(Default: targeted org\)`),
+			Say(`--all\s+Unshare the service instance from every space it is shared into`),
 			Say(`-f\s+Force unshare without confirmation`),
 			Say("SEE ALSO:"),
-			Say("delete-service, service, services, share-service, unbind-service"),
+			Say("delete-service, service, services, share-service, shared-services, unbind-service"),
 		)
 
 		When("the -h flag is specified", func() {
@@ -62,7 +64,7 @@ var _ = Describe("unshare-service command", func() {
 			It("fails with an error and prints help", func() {
 				session := helpers.CF(unshareServiceCommand, serviceInstanceName)
 				Eventually(session).Should(Exit(1))
-				Expect(session.Err).To(Say("Incorrect Usage: the required flag `-s' was not specified"))
+				Expect(session.Err).To(Say("Incorrect Usage: either -s or --all must be provided"))
 				Expect(session.Out).To(matchHelpMessage)
 			})
 		})
@@ -2,7 +2,9 @@ package resources
 
 type ApplicationFeature struct {
 	// Name of the application feature
-	Name    string
-	Enabled bool
+	Name string
+	// Description of what the application feature does
+	Description string
+	Enabled     bool
 	//Reason  string `json:omitempty`
 }
@@ -29,6 +29,8 @@ type Application struct {
 	Credentials map[string]interface{}
 	// CurrentDropletGUID is the unique identifier of the droplet currently attached to the application.
 	CurrentDropletGUID string
+	// UpdatedAt is the time with zone when the application was last updated.
+	UpdatedAt string
 }
 
 // ApplicationNameOnly represents only the name field of a Cloud Controller V3 Application
@@ -92,6 +94,7 @@ func (a *Application) UnmarshalJSON(data []byte) error {
 	}
 	a.State = ccApp.State
 	a.Metadata = ccApp.Metadata
+	a.UpdatedAt = ccApp.UpdatedAt
 
 	return nil
 }
@@ -133,6 +136,7 @@ type ccApplication struct {
 	GUID          string                    `json:"guid,omitempty"`
 	State         constant.ApplicationState `json:"state,omitempty"`
 	Metadata      *Metadata                 `json:"metadata,omitempty"`
+	UpdatedAt     string                    `json:"updated_at,omitempty"`
 }
 
 func (ccApp *ccApplication) setAutodetectedBuildpackLifecycle(a Application) {
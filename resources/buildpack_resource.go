@@ -27,6 +27,8 @@ type Buildpack struct {
 	Stack string
 	// State is the current state of the buildpack.
 	State string
+	// UpdatedAt is the time with zone when the buildpack was last updated.
+	UpdatedAt string
 	// Links are links to related resources.
 	Links APILinks
 	// Metadata is used for custom tagging of API resources
@@ -62,16 +64,17 @@ func (buildpack Buildpack) MarshalJSON() ([]byte, error) {
 
 func (buildpack *Buildpack) UnmarshalJSON(data []byte) error {
 	var ccBuildpack struct {
-		GUID     string         `json:"guid,omitempty"`
-		Links    APILinks       `json:"links,omitempty"`
-		Name     string         `json:"name,omitempty"`
-		Filename string         `json:"filename,omitempty"`
-		Stack    string         `json:"stack,omitempty"`
-		State    string         `json:"state,omitempty"`
-		Enabled  types.NullBool `json:"enabled"`
-		Locked   types.NullBool `json:"locked"`
-		Position types.NullInt  `json:"position"`
-		Metadata *Metadata      `json:"metadata"`
+		GUID      string         `json:"guid,omitempty"`
+		Links     APILinks       `json:"links,omitempty"`
+		Name      string         `json:"name,omitempty"`
+		Filename  string         `json:"filename,omitempty"`
+		Stack     string         `json:"stack,omitempty"`
+		State     string         `json:"state,omitempty"`
+		UpdatedAt string         `json:"updated_at,omitempty"`
+		Enabled   types.NullBool `json:"enabled"`
+		Locked    types.NullBool `json:"locked"`
+		Position  types.NullInt  `json:"position"`
+		Metadata  *Metadata      `json:"metadata"`
 	}
 
 	err := cloudcontroller.DecodeJSON(data, &ccBuildpack)
@@ -87,6 +90,7 @@ func (buildpack *Buildpack) UnmarshalJSON(data []byte) error {
 	buildpack.Position = ccBuildpack.Position
 	buildpack.Stack = ccBuildpack.Stack
 	buildpack.State = ccBuildpack.State
+	buildpack.UpdatedAt = ccBuildpack.UpdatedAt
 	buildpack.Links = ccBuildpack.Links
 	buildpack.Metadata = ccBuildpack.Metadata
 
@@ -2,6 +2,7 @@ package resources
 
 import (
 	"encoding/json"
+	"path"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
@@ -20,6 +21,9 @@ type Droplet struct {
 	GUID string `json:"guid"`
 	// Image is the Docker image name.
 	Image string `json:"image"`
+	// PackageGUID is the unique identifier of the package the droplet was
+	// staged from. It is empty for droplets that were uploaded.
+	PackageGUID string `json:"-"`
 	// Stack is the root filesystem to use with the buildpack.
 	Stack string `json:"stack,omitempty"`
 	// State is the current state of the droplet.
@@ -107,6 +111,11 @@ func (d *Droplet) UnmarshalJSON(data []byte) error {
 				} `json:"data,omitempty"`
 			} `json:"app,omitempty"`
 		}
+		Links struct {
+			Package struct {
+				HREF string `json:"href"`
+			} `json:"package"`
+		} `json:"links"`
 	}
 
 	err := cloudcontroller.DecodeJSON(data, &alias)
@@ -121,6 +130,9 @@ func (d *Droplet) UnmarshalJSON(data []byte) error {
 	d.Stack = alias.Stack
 	d.State = alias.State
 	d.AppGUID = alias.Relationships.App.Data.GUID
+	if href := alias.Links.Package.HREF; href != "" {
+		d.PackageGUID = path.Base(href)
+	}
 
 	return nil
 }
@@ -0,0 +1,58 @@
+package resources_test
+
+import (
+	"encoding/json"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	. "code.cloudfoundry.org/cli/resources"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("droplet resource", func() {
+	DescribeTable(
+		"Unmarshaling",
+		func(droplet Droplet, serialized string) {
+			var parsed Droplet
+			Expect(json.Unmarshal([]byte(serialized), &parsed)).NotTo(HaveOccurred())
+			Expect(parsed).To(Equal(droplet))
+		},
+		Entry(
+			"staged from a package",
+			Droplet{
+				GUID:        "droplet-guid",
+				AppGUID:     "app-guid",
+				PackageGUID: "package-guid",
+				State:       constant.DropletStaged,
+			},
+			`{
+				"guid": "droplet-guid",
+				"state": "STAGED",
+				"relationships": {
+					"app": {
+						"data": {
+							"guid": "app-guid"
+						}
+					}
+				},
+				"links": {
+					"package": {
+						"href": "https://api.example.com/v3/packages/package-guid"
+					}
+				}
+			}`,
+		),
+		Entry(
+			"uploaded",
+			Droplet{
+				GUID:  "droplet-guid",
+				State: constant.DropletStaged,
+			},
+			`{
+				"guid": "droplet-guid",
+				"state": "STAGED",
+				"links": {}
+			}`,
+		),
+	)
+})
@@ -24,6 +24,8 @@ type AppLimit struct {
 	InstanceMemory    *types.NullInt `json:"per_process_memory_in_mb,omitempty"`
 	TotalAppInstances *types.NullInt `json:"total_instances,omitempty"`
 	TotalLogVolume    *types.NullInt `json:"log_rate_limit_in_bytes_per_second,omitempty"`
+	// PerAppTasks is nil when tasks are unlimited.
+	PerAppTasks *types.NullInt `json:"per_app_tasks,omitempty"`
 }
 
 func (al *AppLimit) UnmarshalJSON(rawJSON []byte) error {
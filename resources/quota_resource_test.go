@@ -28,6 +28,8 @@ var _ = Describe("quota limits", func() {
 			Entry("total app instances", AppLimit{TotalAppInstances: &types.NullInt{IsSet: true, Value: 1}}, []byte(`{"total_instances":1}`)),
 			Entry("total app instances", AppLimit{TotalAppInstances: nil}, []byte(`{}`)),
 			Entry("total app instances", AppLimit{TotalAppInstances: &types.NullInt{IsSet: false}}, []byte(`{"total_instances":null}`)),
+			Entry("per app tasks", AppLimit{PerAppTasks: &types.NullInt{IsSet: true, Value: 1}}, []byte(`{"per_app_tasks":1}`)),
+			Entry("per app tasks", AppLimit{PerAppTasks: nil}, []byte(`{}`)),
 		)
 
 		DescribeTable("UnmarshalJSON",
@@ -60,6 +60,7 @@ var _ = Describe("service credential binding resource", func() {
 				}
 			}`,
 		),
+		Entry("created at", ServiceCredentialBinding{CreatedAt: "2024-03-05T14:00:00Z"}, `{"created_at": "2024-03-05T14:00:00Z"}`),
 		Entry(
 			"everything",
 			ServiceCredentialBinding{
@@ -22,6 +22,14 @@ type ServiceOffering struct {
 	ServiceBrokerName string `json:"-"`
 	// Shareable if the offering support service instance sharing
 	AllowsInstanceSharing bool `json:"shareable"`
+	// BrokerCatalogID is the identifier of the offering in the broker catalog
+	BrokerCatalogID string `jsonry:"broker_catalog.id"`
+	// BrokerCatalogMetadata is the metadata of the offering in the broker catalog
+	BrokerCatalogMetadata map[string]interface{} `jsonry:"broker_catalog.metadata"`
+	// Bindable if service instances of the offering can be bound
+	Bindable bool `jsonry:"broker_catalog.features.bindable"`
+	// PlanUpdateable if service instances of the offering can change plan
+	PlanUpdateable bool `jsonry:"broker_catalog.features.plan_updateable"`
 
 	Metadata *Metadata `json:"metadata"`
 }
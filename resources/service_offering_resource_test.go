@@ -24,6 +24,25 @@ var _ = Describe("service offering resource", func() {
 		Entry("documentation_url", ServiceOffering{DocumentationURL: "https://docs.com"}, `{"documentation_url": "https://docs.com"}`),
 		Entry("tags", ServiceOffering{Tags: types.NewOptionalStringSlice("foo", "bar")}, `{"tags": ["foo", "bar"]}`),
 		Entry("tags empty", ServiceOffering{Tags: types.NewOptionalStringSlice()}, `{"tags": []}`),
+		Entry(
+			"broker catalog",
+			ServiceOffering{
+				BrokerCatalogID:       "fake-catalog-id",
+				BrokerCatalogMetadata: map[string]interface{}{"displayName": "Fake"},
+				Bindable:              true,
+				PlanUpdateable:        true,
+			},
+			`{
+				"broker_catalog": {
+					"id": "fake-catalog-id",
+					"metadata": {"displayName": "Fake"},
+					"features": {
+						"bindable": true,
+						"plan_updateable": true
+					}
+				}
+			}`,
+		),
 		Entry(
 			"service broker guid",
 			ServiceOffering{ServiceBrokerGUID: "fake-service-broker-guid"},
@@ -31,6 +31,18 @@ type ServicePlan struct {
 	MaintenanceInfoDescription string `jsonry:"maintenance_info.description"`
 	// MaintenanceInfoVersion is the version of the service plan
 	MaintenanceInfoVersion string `jsonry:"maintenance_info.version"`
+	// ServiceInstanceCreateSchema is the JSON schema for parameters when creating a service instance
+	ServiceInstanceCreateSchema map[string]interface{} `jsonry:"schemas.service_instance.create.parameters"`
+	// ServiceInstanceUpdateSchema is the JSON schema for parameters when updating a service instance
+	ServiceInstanceUpdateSchema map[string]interface{} `jsonry:"schemas.service_instance.update.parameters"`
+	// ServiceBindingCreateSchema is the JSON schema for parameters when creating a service binding or key
+	ServiceBindingCreateSchema map[string]interface{} `jsonry:"schemas.service_binding.create.parameters"`
+	// BrokerCatalogID is the identifier of the plan in the broker catalog
+	BrokerCatalogID string `jsonry:"broker_catalog.id"`
+	// BrokerCatalogMetadata is the metadata of the plan in the broker catalog
+	BrokerCatalogMetadata map[string]interface{} `jsonry:"broker_catalog.metadata"`
+	// Bindable if service instances of the plan can be bound
+	Bindable bool `jsonry:"broker_catalog.features.bindable"`
 
 	Metadata *Metadata `json:"metadata"`
 }
@@ -36,6 +36,23 @@ var _ = Describe("service plan resource", func() {
 				}
 			}`,
 		),
+		Entry(
+			"broker catalog",
+			ServicePlan{
+				BrokerCatalogID:       "fake-catalog-id",
+				BrokerCatalogMetadata: map[string]interface{}{"bullets": []interface{}{"fast"}},
+				Bindable:              true,
+			},
+			`{
+				"broker_catalog": {
+					"id": "fake-catalog-id",
+					"metadata": {"bullets": ["fast"]},
+					"features": {
+						"bindable": true
+					}
+				}
+			}`,
+		),
 		Entry(
 			"detailed",
 			ServicePlan{
@@ -61,6 +78,18 @@ var _ = Describe("service plan resource", func() {
 				SpaceGUID:                  "fake-space-guid",
 				MaintenanceInfoDescription: "cool upgrade",
 				MaintenanceInfoVersion:     "1.2.3",
+				ServiceInstanceCreateSchema: map[string]interface{}{
+					"type":     "object",
+					"required": []interface{}{"size"},
+				},
+				ServiceInstanceUpdateSchema: map[string]interface{}{
+					"type": "object",
+				},
+				ServiceBindingCreateSchema: map[string]interface{}{
+					"properties": map[string]interface{}{
+						"role": map[string]interface{}{"enum": []interface{}{"read", "write"}},
+					},
+				},
 				Metadata: &Metadata{
 					Labels: map[string]types.NullString{
 						"foo": types.NewNullString("bar"),
@@ -91,6 +120,30 @@ var _ = Describe("service plan resource", func() {
 					"description": "cool upgrade",
 					"version": "1.2.3"
 				},
+				"schemas": {
+					"service_instance": {
+						"create": {
+							"parameters": {
+								"type": "object",
+								"required": ["size"]
+							}
+						},
+						"update": {
+							"parameters": {
+								"type": "object"
+							}
+						}
+					},
+					"service_binding": {
+						"create": {
+							"parameters": {
+								"properties": {
+									"role": {"enum": ["read", "write"]}
+								}
+							}
+						}
+					}
+				},
 				"metadata": {
 					"labels": {
 						"foo": "bar",
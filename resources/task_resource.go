@@ -26,6 +26,9 @@ type Task struct {
 	SequenceID int64 `json:"sequence_id,omitempty"`
 	// State represents the task state.
 	State constant.TaskState `json:"state,omitempty"`
+	// UpdatedAt represents the time with zone when the object was last
+	// updated. For tasks in a terminal state, this is when the task completed.
+	UpdatedAt string `json:"updated_at,omitempty"`
 	// Tasks can use a process as a template to fill in
 	// command, memory, disk values
 	//
@@ -0,0 +1,22 @@
+package resources
+
+import (
+	"code.cloudfoundry.org/jsonry"
+)
+
+// UsageSummary is the usage of an organization or space that counts toward
+// its quota.
+type UsageSummary struct {
+	StartedInstances int `jsonry:"usage_summary.started_instances"`
+	MemoryInMB       int `jsonry:"usage_summary.memory_in_mb"`
+	Routes           int `jsonry:"usage_summary.routes"`
+	ServiceInstances int `jsonry:"usage_summary.service_instances"`
+	ReservedPorts    int `jsonry:"usage_summary.reserved_ports"`
+	Domains          int `jsonry:"usage_summary.domains"`
+	PerAppTasks      int `jsonry:"usage_summary.per_app_tasks"`
+	ServiceKeys      int `jsonry:"usage_summary.service_keys"`
+}
+
+func (u *UsageSummary) UnmarshalJSON(data []byte) error {
+	return jsonry.Unmarshal(data, u)
+}
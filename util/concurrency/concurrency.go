@@ -0,0 +1,35 @@
+package concurrency
+
+// RunBounded calls work for each index in [0, n) with at most parallel calls
+// in flight. done is called with the index and result of each call as it
+// completes, on the calling goroutine, so it may safely update shared state.
+// RunBounded returns once done has been called for every index.
+func RunBounded[T any](parallel int, n int, work func(int) T, done func(int, T)) {
+	type result struct {
+		index int
+		value T
+	}
+
+	indexes := make(chan int)
+	results := make(chan result)
+
+	for worker := 0; worker < min(max(parallel, 1), n); worker++ {
+		go func() {
+			for i := range indexes {
+				results <- result{index: i, value: work(i)}
+			}
+		}()
+	}
+
+	go func() {
+		for i := 0; i < n; i++ {
+			indexes <- i
+		}
+		close(indexes)
+	}()
+
+	for i := 0; i < n; i++ {
+		r := <-results
+		done(r.index, r.value)
+	}
+}
@@ -0,0 +1,68 @@
+package concurrency_test
+
+import (
+	"sync/atomic"
+	"time"
+
+	"code.cloudfoundry.org/cli/util/concurrency"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("RunBounded", func() {
+	var (
+		inFlight, maxInFlight int32
+		results               map[int]int
+	)
+
+	work := func(i int) int {
+		current := atomic.AddInt32(&inFlight, 1)
+		for {
+			highest := atomic.LoadInt32(&maxInFlight)
+			if current <= highest || atomic.CompareAndSwapInt32(&maxInFlight, highest, current) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+		atomic.AddInt32(&inFlight, -1)
+		return i * i
+	}
+
+	done := func(i int, square int) {
+		results[i] = square
+	}
+
+	BeforeEach(func() {
+		inFlight, maxInFlight = 0, 0
+		results = map[int]int{}
+	})
+
+	It("calls done with the result of every index", func() {
+		concurrency.RunBounded(3, 10, work, done)
+		Expect(results).To(HaveLen(10))
+		for i := 0; i < 10; i++ {
+			Expect(results).To(HaveKeyWithValue(i, i*i))
+		}
+	})
+
+	It("runs at most parallel calls at a time", func() {
+		concurrency.RunBounded(3, 10, work, done)
+		Expect(maxInFlight).To(BeNumerically("<=", 3))
+		Expect(maxInFlight).To(BeNumerically(">", 1))
+	})
+
+	When("parallel is below 1", func() {
+		It("runs the calls one at a time", func() {
+			concurrency.RunBounded(0, 4, work, done)
+			Expect(results).To(HaveLen(4))
+			Expect(maxInFlight).To(Equal(int32(1)))
+		})
+	})
+
+	When("there is nothing to run", func() {
+		It("returns without calling done", func() {
+			concurrency.RunBounded(3, 0, work, done)
+			Expect(results).To(BeEmpty())
+		})
+	})
+})
@@ -0,0 +1,440 @@
+// Package jsonschema validates decoded JSON values against the subset of JSON
+// Schema (draft-04 to draft-07) used by service brokers to describe
+// configuration parameters. Keywords it does not know, such as format, are
+// ignored rather than rejected, and so are references it cannot resolve, such
+// as those to other documents.
+package jsonschema
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+// ValidationError describes a value that does not match its schema. Path
+// locates the value in the document, e.g. $.nodes[1].name.
+type ValidationError struct {
+	Path    string
+	Message string
+}
+
+func (e ValidationError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Path, e.Message)
+}
+
+// Validate returns every violation of schema by value, ordered by path. Both
+// are expected to be decoded by encoding/json into interface{} values, with
+// numbers as float64 or json.Number.
+func Validate(schema map[string]interface{}, value interface{}) []ValidationError {
+	schema, _ = normalize(schema).(map[string]interface{})
+	value = normalize(value)
+
+	v := validator{root: schema, visited: map[string]bool{}}
+	v.validate(schema, value, "$")
+
+	sort.SliceStable(v.errors, func(i, j int) bool { return v.errors[i].Path < v.errors[j].Path })
+	return v.errors
+}
+
+type validator struct {
+	root   map[string]interface{}
+	errors []ValidationError
+	// visited holds the references being followed for each path, so that
+	// recursive schemas do not loop on the same value forever.
+	visited map[string]bool
+}
+
+func (v *validator) fail(path string, format string, args ...interface{}) {
+	v.errors = append(v.errors, ValidationError{Path: path, Message: fmt.Sprintf(format, args...)})
+}
+
+// matches reports whether value is valid against schema without recording
+// errors, for the combining keywords.
+func (v *validator) matches(schema interface{}, value interface{}, path string) bool {
+	nested := validator{root: v.root, visited: v.visited}
+	nested.validateAny(schema, value, path)
+	return len(nested.errors) == 0
+}
+
+func (v *validator) validateAny(schema interface{}, value interface{}, path string) {
+	switch s := schema.(type) {
+	case bool:
+		if !s {
+			v.fail(path, "no value is allowed")
+		}
+	case map[string]interface{}:
+		v.validate(s, value, path)
+	}
+}
+
+func (v *validator) validate(schema map[string]interface{}, value interface{}, path string) {
+	if ref, ok := schema["$ref"].(string); ok {
+		resolved, found := v.resolve(ref)
+		key := path + " " + ref
+		if !found || v.visited[key] {
+			return
+		}
+
+		v.visited[key] = true
+		defer delete(v.visited, key)
+		v.validateAny(resolved, value, path)
+		return
+	}
+
+	if types, ok := schemaTypes(schema["type"]); ok && !hasType(value, types) {
+		v.fail(path, "must be of type %s, got %s", strings.Join(types, " or "), typeName(value))
+		return
+	}
+
+	if enum, ok := schema["enum"].([]interface{}); ok && !containsValue(enum, value) {
+		v.fail(path, "must be one of %s", formatValues(enum))
+	}
+	if constant, ok := schema["const"]; ok && !equal(constant, value) {
+		v.fail(path, "must be %s", formatValue(constant))
+	}
+
+	switch typed := value.(type) {
+	case map[string]interface{}:
+		v.validateObject(schema, typed, path)
+	case []interface{}:
+		v.validateArray(schema, typed, path)
+	case string:
+		v.validateString(schema, typed, path)
+	case float64:
+		v.validateNumber(schema, typed, path)
+	}
+
+	v.validateCombinations(schema, value, path)
+}
+
+func (v *validator) validateObject(schema map[string]interface{}, object map[string]interface{}, path string) {
+	if required, ok := schema["required"].([]interface{}); ok {
+		for _, name := range required {
+			if key, ok := name.(string); ok {
+				if _, present := object[key]; !present {
+					v.fail(path, "missing required property %q", key)
+				}
+			}
+		}
+	}
+
+	if min, ok := number(schema["minProperties"]); ok && float64(len(object)) < min {
+		v.fail(path, "must have at least %s properties", formatNumber(min))
+	}
+	if max, ok := number(schema["maxProperties"]); ok && float64(len(object)) > max {
+		v.fail(path, "must have at most %s properties", formatNumber(max))
+	}
+
+	properties, _ := schema["properties"].(map[string]interface{})
+	patternProperties, _ := schema["patternProperties"].(map[string]interface{})
+	additional, hasAdditional := schema["additionalProperties"]
+
+	for _, key := range sortedKeys(object) {
+		propertyPath := path + "." + key
+		matched := false
+
+		if propertySchema, ok := properties[key]; ok {
+			matched = true
+			v.validateAny(propertySchema, object[key], propertyPath)
+		}
+		for pattern, patternSchema := range patternProperties {
+			if re, err := regexp.Compile(pattern); err == nil && re.MatchString(key) {
+				matched = true
+				v.validateAny(patternSchema, object[key], propertyPath)
+			}
+		}
+
+		if matched || !hasAdditional {
+			continue
+		}
+		if allowed, ok := additional.(bool); ok {
+			if !allowed {
+				v.fail(propertyPath, "property is not allowed")
+			}
+			continue
+		}
+		v.validateAny(additional, object[key], propertyPath)
+	}
+}
+
+func (v *validator) validateArray(schema map[string]interface{}, array []interface{}, path string) {
+	if min, ok := number(schema["minItems"]); ok && float64(len(array)) < min {
+		v.fail(path, "must have at least %s items", formatNumber(min))
+	}
+	if max, ok := number(schema["maxItems"]); ok && float64(len(array)) > max {
+		v.fail(path, "must have at most %s items", formatNumber(max))
+	}
+	if unique, _ := schema["uniqueItems"].(bool); unique {
+		for i := range array {
+			for j := 0; j < i; j++ {
+				if equal(array[i], array[j]) {
+					v.fail(fmt.Sprintf("%s[%d]", path, i), "duplicates item %d", j)
+				}
+			}
+		}
+	}
+
+	switch items := schema["items"].(type) {
+	case []interface{}:
+		for i, item := range array {
+			itemPath := fmt.Sprintf("%s[%d]", path, i)
+			if i < len(items) {
+				v.validateAny(items[i], item, itemPath)
+				continue
+			}
+			if additional, ok := schema["additionalItems"]; ok {
+				if allowed, isBool := additional.(bool); isBool && !allowed {
+					v.fail(itemPath, "additional items are not allowed")
+				} else if !isBool {
+					v.validateAny(additional, item, itemPath)
+				}
+			}
+		}
+	case nil:
+	default:
+		for i, item := range array {
+			v.validateAny(items, item, fmt.Sprintf("%s[%d]", path, i))
+		}
+	}
+}
+
+func (v *validator) validateString(schema map[string]interface{}, s string, path string) {
+	length := float64(utf8.RuneCountInString(s))
+	if min, ok := number(schema["minLength"]); ok && length < min {
+		v.fail(path, "must be at least %s characters long", formatNumber(min))
+	}
+	if max, ok := number(schema["maxLength"]); ok && length > max {
+		v.fail(path, "must be at most %s characters long", formatNumber(max))
+	}
+	if pattern, ok := schema["pattern"].(string); ok {
+		if re, err := regexp.Compile(pattern); err == nil && !re.MatchString(s) {
+			v.fail(path, "must match pattern %s", pattern)
+		}
+	}
+}
+
+func (v *validator) validateNumber(schema map[string]interface{}, n float64, path string) {
+	// draft-04 expresses exclusive bounds as booleans next to minimum and
+	// maximum; later drafts use numeric exclusiveMinimum and exclusiveMaximum.
+	if min, ok := number(schema["minimum"]); ok {
+		if exclusive, _ := schema["exclusiveMinimum"].(bool); exclusive && n <= min {
+			v.fail(path, "must be greater than %s", formatNumber(min))
+		} else if n < min {
+			v.fail(path, "must be greater than or equal to %s", formatNumber(min))
+		}
+	}
+	if max, ok := number(schema["maximum"]); ok {
+		if exclusive, _ := schema["exclusiveMaximum"].(bool); exclusive && n >= max {
+			v.fail(path, "must be less than %s", formatNumber(max))
+		} else if n > max {
+			v.fail(path, "must be less than or equal to %s", formatNumber(max))
+		}
+	}
+	if min, ok := number(schema["exclusiveMinimum"]); ok && n <= min {
+		v.fail(path, "must be greater than %s", formatNumber(min))
+	}
+	if max, ok := number(schema["exclusiveMaximum"]); ok && n >= max {
+		v.fail(path, "must be less than %s", formatNumber(max))
+	}
+	if divisor, ok := number(schema["multipleOf"]); ok && divisor > 0 {
+		quotient := n / divisor
+		if math.Abs(quotient-math.Round(quotient)) > 1e-9 {
+			v.fail(path, "must be a multiple of %s", formatNumber(divisor))
+		}
+	}
+}
+
+func (v *validator) validateCombinations(schema map[string]interface{}, value interface{}, path string) {
+	if allOf, ok := schema["allOf"].([]interface{}); ok {
+		for _, subschema := range allOf {
+			v.validateAny(subschema, value, path)
+		}
+	}
+
+	if anyOf, ok := schema["anyOf"].([]interface{}); ok {
+		matched := false
+		for _, subschema := range anyOf {
+			if v.matches(subschema, value, path) {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			v.fail(path, "must match at least one of the allowed schemas")
+		}
+	}
+
+	if oneOf, ok := schema["oneOf"].([]interface{}); ok {
+		matches := 0
+		for _, subschema := range oneOf {
+			if v.matches(subschema, value, path) {
+				matches++
+			}
+		}
+		if matches != 1 {
+			v.fail(path, "must match exactly one of the allowed schemas, matched %d", matches)
+		}
+	}
+
+	if not, ok := schema["not"]; ok && v.matches(not, value, path) {
+		v.fail(path, "must not match the disallowed schema")
+	}
+}
+
+// resolve looks up a reference within the root schema, such as
+// #/definitions/node.
+func (v *validator) resolve(ref string) (interface{}, bool) {
+	if !strings.HasPrefix(ref, "#") {
+		return nil, false
+	}
+
+	var current interface{} = v.root
+	for _, token := range strings.Split(strings.TrimPrefix(strings.TrimPrefix(ref, "#"), "/"), "/") {
+		if token == "" {
+			continue
+		}
+		token = strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")
+
+		object, ok := current.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		if current, ok = object[token]; !ok {
+			return nil, false
+		}
+	}
+	return current, true
+}
+
+// normalize converts json.Number values to float64 so that numbers compare
+// equal however they were decoded.
+func normalize(value interface{}) interface{} {
+	switch v := value.(type) {
+	case json.Number:
+		if n, err := v.Float64(); err == nil {
+			return n
+		}
+		return v.String()
+	case map[string]interface{}:
+		normalized := make(map[string]interface{}, len(v))
+		for key, item := range v {
+			normalized[key] = normalize(item)
+		}
+		return normalized
+	case []interface{}:
+		normalized := make([]interface{}, len(v))
+		for i, item := range v {
+			normalized[i] = normalize(item)
+		}
+		return normalized
+	default:
+		return value
+	}
+}
+
+func schemaTypes(raw interface{}) ([]string, bool) {
+	switch t := raw.(type) {
+	case string:
+		return []string{t}, true
+	case []interface{}:
+		var types []string
+		for _, name := range t {
+			if s, ok := name.(string); ok {
+				types = append(types, s)
+			}
+		}
+		return types, len(types) > 0
+	}
+	return nil, false
+}
+
+func hasType(value interface{}, types []string) bool {
+	for _, t := range types {
+		switch t {
+		case "integer":
+			if n, ok := value.(float64); ok && n == math.Trunc(n) {
+				return true
+			}
+		case "number":
+			if _, ok := value.(float64); ok {
+				return true
+			}
+		default:
+			if typeName(value) == t {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func typeName(value interface{}) string {
+	switch value.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "boolean"
+	case float64:
+		return "number"
+	case string:
+		return "string"
+	case []interface{}:
+		return "array"
+	case map[string]interface{}:
+		return "object"
+	default:
+		return fmt.Sprintf("%T", value)
+	}
+}
+
+func number(raw interface{}) (float64, bool) {
+	n, ok := raw.(float64)
+	return n, ok
+}
+
+func containsValue(values []interface{}, value interface{}) bool {
+	for _, candidate := range values {
+		if equal(candidate, value) {
+			return true
+		}
+	}
+	return false
+}
+
+func equal(a, b interface{}) bool {
+	return reflect.DeepEqual(a, b)
+}
+
+func sortedKeys(object map[string]interface{}) []string {
+	keys := make([]string, 0, len(object))
+	for key := range object {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func formatValues(values []interface{}) string {
+	formatted := make([]string, len(values))
+	for i, value := range values {
+		formatted[i] = formatValue(value)
+	}
+	return "[" + strings.Join(formatted, ", ") + "]"
+}
+
+func formatValue(value interface{}) string {
+	raw, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+	return string(raw)
+}
+
+func formatNumber(n float64) string {
+	return formatValue(n)
+}
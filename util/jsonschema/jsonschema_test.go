@@ -0,0 +1,239 @@
+package jsonschema_test
+
+import (
+	"encoding/json"
+	"strings"
+
+	"code.cloudfoundry.org/cli/util/jsonschema"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func decode(raw string) interface{} {
+	var value interface{}
+	Expect(json.Unmarshal([]byte(raw), &value)).To(Succeed())
+	return value
+}
+
+func decodeSchema(raw string) map[string]interface{} {
+	return decode(raw).(map[string]interface{})
+}
+
+var _ = Describe("Validate", func() {
+	var (
+		schema map[string]interface{}
+		value  string
+		errs   []jsonschema.ValidationError
+	)
+
+	JustBeforeEach(func() {
+		errs = jsonschema.Validate(schema, decode(value))
+	})
+
+	When("validating objects", func() {
+		BeforeEach(func() {
+			schema = decodeSchema(`{
+				"type": "object",
+				"required": ["name", "size"],
+				"additionalProperties": false,
+				"properties": {
+					"name": {"type": "string", "minLength": 3, "pattern": "^[a-z-]+$"},
+					"size": {"type": "integer", "minimum": 1, "maximum": 10},
+					"tier": {"enum": ["gold", "silver"]}
+				}
+			}`)
+		})
+
+		When("the value is valid", func() {
+			BeforeEach(func() {
+				value = `{"name": "my-db", "size": 3, "tier": "gold"}`
+			})
+
+			It("returns no errors", func() {
+				Expect(errs).To(BeEmpty())
+			})
+		})
+
+		When("the value is invalid", func() {
+			BeforeEach(func() {
+				value = `{"name": "DB", "size": 2.5, "tier": "bronze", "extra": true}`
+			})
+
+			It("returns every error with its path", func() {
+				Expect(errs).To(ConsistOf(
+					jsonschema.ValidationError{Path: "$.extra", Message: "property is not allowed"},
+					jsonschema.ValidationError{Path: "$.name", Message: "must be at least 3 characters long"},
+					jsonschema.ValidationError{Path: "$.name", Message: "must match pattern ^[a-z-]+$"},
+					jsonschema.ValidationError{Path: "$.size", Message: "must be of type integer, got number"},
+					jsonschema.ValidationError{Path: "$.tier", Message: `must be one of ["gold", "silver"]`},
+				))
+			})
+		})
+
+		When("a required property is missing", func() {
+			BeforeEach(func() {
+				value = `{"name": "my-db"}`
+			})
+
+			It("reports the missing property on the object", func() {
+				Expect(errs).To(ConsistOf(
+					jsonschema.ValidationError{Path: "$", Message: `missing required property "size"`},
+				))
+			})
+		})
+
+		When("the value is not an object", func() {
+			BeforeEach(func() {
+				value = `["my-db"]`
+			})
+
+			It("reports the type mismatch", func() {
+				Expect(errs).To(ConsistOf(
+					jsonschema.ValidationError{Path: "$", Message: "must be of type object, got array"},
+				))
+			})
+		})
+	})
+
+	When("validating arrays", func() {
+		BeforeEach(func() {
+			schema = decodeSchema(`{
+				"type": "object",
+				"properties": {
+					"nodes": {
+						"type": "array",
+						"maxItems": 3,
+						"uniqueItems": true,
+						"items": {"$ref": "#/definitions/node"}
+					}
+				},
+				"definitions": {
+					"node": {"type": "object", "required": ["name"], "properties": {"name": {"type": "string"}}}
+				}
+			}`)
+			value = `{"nodes": [{"name": "a"}, {"name": 1}, {"name": "a"}, {}]}`
+		})
+
+		It("reports errors on the items by index", func() {
+			Expect(errs).To(Equal([]jsonschema.ValidationError{
+				{Path: "$.nodes", Message: "must have at most 3 items"},
+				{Path: "$.nodes[1].name", Message: "must be of type string, got number"},
+				{Path: "$.nodes[2]", Message: "duplicates item 0"},
+				{Path: "$.nodes[3]", Message: `missing required property "name"`},
+			}))
+		})
+	})
+
+	When("validating numbers", func() {
+		When("the schema uses draft-04 exclusive bounds", func() {
+			BeforeEach(func() {
+				schema = decodeSchema(`{"minimum": 0, "exclusiveMinimum": true, "maximum": 5, "exclusiveMaximum": true}`)
+				value = `5`
+			})
+
+			It("treats the bounds as exclusive", func() {
+				Expect(errs).To(ConsistOf(jsonschema.ValidationError{Path: "$", Message: "must be less than 5"}))
+			})
+		})
+
+		When("the schema uses draft-06 exclusive bounds", func() {
+			BeforeEach(func() {
+				schema = decodeSchema(`{"exclusiveMinimum": 0, "multipleOf": 2}`)
+				value = `-3`
+			})
+
+			It("reports each violation", func() {
+				Expect(errs).To(ConsistOf(
+					jsonschema.ValidationError{Path: "$", Message: "must be greater than 0"},
+					jsonschema.ValidationError{Path: "$", Message: "must be a multiple of 2"},
+				))
+			})
+		})
+	})
+
+	When("combining schemas", func() {
+		BeforeEach(func() {
+			schema = decodeSchema(`{
+				"properties": {
+					"any": {"anyOf": [{"type": "string"}, {"type": "integer"}]},
+					"one": {"oneOf": [{"type": "number"}, {"type": "integer"}]},
+					"not": {"not": {"const": "root"}}
+				}
+			}`)
+			value = `{"any": true, "one": 1, "not": "root"}`
+		})
+
+		It("reports values that do not satisfy the combination", func() {
+			Expect(errs).To(Equal([]jsonschema.ValidationError{
+				{Path: "$.any", Message: "must match at least one of the allowed schemas"},
+				{Path: "$.not", Message: "must not match the disallowed schema"},
+				{Path: "$.one", Message: "must match exactly one of the allowed schemas, matched 2"},
+			}))
+		})
+	})
+
+	When("numbers are decoded as json.Number", func() {
+		BeforeEach(func() {
+			decoder := json.NewDecoder(strings.NewReader(`{"properties": {"size": {"minimum": 2, "enum": [1, 2, 3]}}}`))
+			decoder.UseNumber()
+			schema = nil
+			Expect(decoder.Decode(&schema)).To(Succeed())
+			value = `{"size": 1}`
+		})
+
+		It("compares them as numbers", func() {
+			Expect(errs).To(ConsistOf(jsonschema.ValidationError{Path: "$.size", Message: "must be greater than or equal to 2"}))
+		})
+	})
+
+	When("the schema uses unknown keywords", func() {
+		BeforeEach(func() {
+			schema = decodeSchema(`{"$schema": "http://json-schema.org/draft-07/schema#", "properties": {"email": {"type": "string", "format": "email"}}}`)
+			value = `{"email": "not-an-email"}`
+		})
+
+		It("ignores them", func() {
+			Expect(errs).To(BeEmpty())
+		})
+	})
+
+	When("a reference cannot be resolved", func() {
+		BeforeEach(func() {
+			schema = decodeSchema(`{"properties": {"a": {"$ref": "#/definitions/missing"}, "b": {"$ref": "https://example.com/schema.json"}}}`)
+			value = `{"a": 1, "b": "anything"}`
+		})
+
+		It("allows any value", func() {
+			Expect(errs).To(BeEmpty())
+		})
+	})
+
+	When("a schema refers to itself", func() {
+		BeforeEach(func() {
+			schema = decodeSchema(`{"$ref": "#"}`)
+			value = `{"a": 1}`
+		})
+
+		It("does not loop", func() {
+			Expect(errs).To(BeEmpty())
+		})
+	})
+
+	When("definitions refer to each other without consuming the value", func() {
+		BeforeEach(func() {
+			schema = decodeSchema(`{
+				"anyOf": [{"$ref": "#/definitions/a"}],
+				"definitions": {
+					"a": {"allOf": [{"$ref": "#/definitions/b"}], "type": "object"},
+					"b": {"anyOf": [{"$ref": "#/definitions/a"}]}
+				}
+			}`)
+			value = `"not an object"`
+		})
+
+		It("validates the value once", func() {
+			Expect(errs).To(ConsistOf(jsonschema.ValidationError{Path: "$", Message: "must match at least one of the allowed schemas"}))
+			Expect(jsonschema.Validate(schema, decode(`{"a": 1}`))).To(BeEmpty())
+		})
+	})
+})
@@ -0,0 +1,39 @@
+// Package portrange reads and writes the "PORT" and "START-END" port ranges
+// of network policies and security group rules.
+package portrange
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Format returns the port on its own when the range holds a single port.
+func Format(startPort int, endPort int) string {
+	if startPort == endPort {
+		return strconv.Itoa(startPort)
+	}
+	return fmt.Sprintf("%d-%d", startPort, endPort)
+}
+
+// Parse reads a single port or a range of ports between 1 and 65535.
+func Parse(ports string) (int, int, error) {
+	bounds := strings.SplitN(ports, "-", 2)
+
+	start, err := strconv.Atoi(bounds[0])
+	if err != nil {
+		return 0, 0, err
+	}
+	end := start
+	if len(bounds) == 2 {
+		end, err = strconv.Atoi(bounds[1])
+		if err != nil {
+			return 0, 0, err
+		}
+	}
+
+	if start < 1 || end > 65535 || start > end {
+		return 0, 0, fmt.Errorf("invalid port range %s", ports)
+	}
+	return start, end, nil
+}
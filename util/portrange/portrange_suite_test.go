@@ -0,0 +1,13 @@
+package portrange_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestPortrange(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Port Range Suite")
+}
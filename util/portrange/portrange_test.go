@@ -0,0 +1,44 @@
+package portrange_test
+
+import (
+	"code.cloudfoundry.org/cli/util/portrange"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("portrange", func() {
+	Describe("Format", func() {
+		It("writes a single port on its own", func() {
+			Expect(portrange.Format(8080, 8080)).To(Equal("8080"))
+		})
+
+		It("writes a range as START-END", func() {
+			Expect(portrange.Format(8080, 8090)).To(Equal("8080-8090"))
+		})
+	})
+
+	Describe("Parse", func() {
+		DescribeTable("valid ranges",
+			func(ports string, start int, end int) {
+				parsedStart, parsedEnd, err := portrange.Parse(ports)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(parsedStart).To(Equal(start))
+				Expect(parsedEnd).To(Equal(end))
+			},
+			Entry("a single port", "443", 443, 443),
+			Entry("a range", "1-65535", 1, 65535),
+		)
+
+		DescribeTable("invalid ranges",
+			func(ports string) {
+				_, _, err := portrange.Parse(ports)
+				Expect(err).To(HaveOccurred())
+			},
+			Entry("not a number", "http"),
+			Entry("a bad end", "80-http"),
+			Entry("port zero", "0"),
+			Entry("a port above 65535", "65536"),
+			Entry("a reversed range", "90-80"),
+		)
+	})
+})
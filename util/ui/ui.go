@@ -174,6 +174,20 @@ func NewTestUI(in io.Reader, out io.Writer, err io.Writer) *UI {
 	}
 }
 
+// ClearScreen clears the terminal and moves the cursor to the top-left corner
+// so that the next output redraws the screen in place. It does nothing when
+// UI.Out is not a terminal.
+func (ui *UI) ClearScreen() {
+	if !ui.IsTTY {
+		return
+	}
+
+	ui.terminalLock.Lock()
+	defer ui.terminalLock.Unlock()
+
+	fmt.Fprint(ui.Out, "\033[H\033[2J")
+}
+
 // DeferText translates the template, substitutes in templateValues, and
 // Enqueues the output to be presented later via FlushDeferred. Only the first
 // map in templateValues is used.
@@ -36,6 +36,30 @@ var _ = Describe("UI", func() {
 		ui.Err = errBuff
 	})
 
+	Describe("ClearScreen", func() {
+		When("the output is a terminal", func() {
+			BeforeEach(func() {
+				ui.IsTTY = true
+			})
+
+			It("clears the screen and moves the cursor home", func() {
+				ui.ClearScreen()
+				Expect(out.Contents()).To(Equal([]byte("\033[H\033[2J")))
+			})
+		})
+
+		When("the output is not a terminal", func() {
+			BeforeEach(func() {
+				ui.IsTTY = false
+			})
+
+			It("does nothing", func() {
+				ui.ClearScreen()
+				Expect(out.Contents()).To(BeEmpty())
+			})
+		})
+	})
+
 	Describe("DisplayDeprecationWarning", func() {
 		It("displays the deprecation warning to ui.Err", func() {
 			ui.DisplayDeprecationWarning()